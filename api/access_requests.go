@@ -0,0 +1,269 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"firebase.google.com/go/v4/auth"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/store"
+)
+
+// TrustedDomain reports whether the email address is from one of the trusted domains
+func TrustedDomain(email string, trustedDomains []string) bool {
+	mailComponents := strings.Split(email, "@")
+	domain := mailComponents[len(mailComponents)-1]
+	for _, trusted := range trustedDomains {
+		if domain == trusted {
+			return true
+		}
+	}
+	return false
+}
+
+// firebaseClient returns the Firebase Auth client used to verify users, or nil if users aren't authenticated by Firebase
+func (rt *Runtime) firebaseClient() *auth.Client {
+	if v, ok := rt.Verifier.(*FirebaseVerifier); ok {
+		return v.Client
+	}
+	return nil
+}
+
+func (rt *Runtime) accessRequestModel(r *store.AccessRequest) *models.AccessRequest {
+	uid, status, justification := r.UID, r.Status, r.Justification
+	created := strfmt.DateTime(r.Created)
+	trusted := TrustedDomain(r.Email, rt.TrustedDomains)
+	m := &models.AccessRequest{
+		UID:           &uid,
+		Name:          r.Name,
+		Email:         r.Email,
+		Provider:      r.Provider,
+		Justification: &justification,
+		Status:        &status,
+		TrustedDomain: &trusted,
+		Created:       &created,
+		DecidedBy:     r.DecidedBy,
+		DecidedByName: r.DecidedByName,
+		Reason:        r.Reason,
+	}
+	if !r.Decided.IsZero() {
+		decided := strfmt.DateTime(r.Decided)
+		m.Decided = &decided
+	}
+	return m
+}
+
+// ResolveAccessRequest records the decision on the user's pending access request, if they have one.
+// It doesn't change whether the user is authorized.
+func ResolveAccessRequest(ctx context.Context, st store.Store, uid string, approved bool, deciderUID, deciderName, reason string) error {
+	r, found, err := st.GetAccessRequest(ctx, uid)
+	if err != nil || !found || r.Status != models.AccessRequestStatusPending {
+		return err
+	}
+
+	r.Status = models.AccessRequestStatusDenied
+	if approved {
+		r.Status = models.AccessRequestStatusApproved
+	}
+	r.Decided = time.Now().UTC()
+	r.DecidedBy = deciderUID
+	r.DecidedByName = deciderName
+	r.Reason = reason
+	if err = st.SaveAccessRequest(ctx, r); err != nil {
+		return err
+	}
+	log.WithContext(ctx).WithFields(log.Fields{"user": uid, "status": r.Status, "decidedBy": deciderUID}).Info("Access request resolved")
+	return nil
+}
+
+// NewGetMyAccessRequestHandler creates a handler
+func NewGetMyAccessRequestHandler(rt *Runtime) operations.GetMyAccessRequestHandler {
+	return &getMyAccessRequestHandlerImp{rt: rt}
+}
+
+type getMyAccessRequestHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *getMyAccessRequestHandlerImp) Handle(params operations.GetMyAccessRequestParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.GetMyAccessRequestDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	r, found, err := h.rt.Store.GetAccessRequest(params.HTTPRequest.Context(), principal.UID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if !found {
+		return fail(404, "you haven't requested access")
+	}
+	return &operations.GetMyAccessRequestOK{Payload: h.rt.accessRequestModel(r)}
+}
+
+// NewRequestAccessHandler creates a handler
+func NewRequestAccessHandler(rt *Runtime) operations.RequestAccessHandler {
+	return &requestAccessHandlerImp{rt: rt}
+}
+
+type requestAccessHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *requestAccessHandlerImp) Handle(params operations.RequestAccessParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.RequestAccessDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if h.rt.hasAccess(principal) {
+		return fail(409, "you already have access")
+	}
+
+	ctx := params.HTTPRequest.Context()
+	r, found, err := h.rt.Store.GetAccessRequest(ctx, principal.UID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if found && r.Status == models.AccessRequestStatusApproved {
+		return fail(409, "your request has already been approved")
+	}
+	opened := !found || r.Status == models.AccessRequestStatusDenied
+	if opened {
+		r = &store.AccessRequest{UID: principal.UID, Created: time.Now().UTC()}
+	}
+	r.Name = principal.Name
+	r.Email = principal.Email
+	r.Provider = principal.Provider
+	r.Justification = *params.Body.Justification
+	r.Status = models.AccessRequestStatusPending
+
+	if err = h.rt.Store.SaveAccessRequest(ctx, r); err != nil {
+		return fail(500, err.Error())
+	}
+	if opened {
+		AccessRequestAlert(h.rt, principal)
+	}
+	h.rt.audit(params.HTTPRequest, principal, "accessrequest.request", principal.UID, nil, h.rt.accessRequestModel(r))
+	return &operations.RequestAccessOK{Payload: h.rt.accessRequestModel(r)}
+}
+
+// NewListAccessRequestsHandler creates a handler
+func NewListAccessRequestsHandler(rt *Runtime) operations.ListAccessRequestsHandler {
+	return &listAccessRequestsHandlerImp{rt: rt}
+}
+
+type listAccessRequestsHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listAccessRequestsHandlerImp) Handle(params operations.ListAccessRequestsParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListAccessRequestsDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+
+	status := ""
+	if params.Status != nil {
+		status = *params.Status
+	}
+	requests, err := h.rt.Store.ListAccessRequests(params.HTTPRequest.Context(), status)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	sort.Slice(requests, func(i, j int) bool { return requests[i].Created.After(requests[j].Created) })
+
+	payload := make([]*models.AccessRequest, len(requests))
+	for i, r := range requests {
+		payload[i] = h.rt.accessRequestModel(r)
+	}
+	return &operations.ListAccessRequestsOK{Payload: payload}
+}
+
+// decideAccessRequest approves or denies the user's pending request, authorizing them if it is approved
+func decideAccessRequest(ctx context.Context, rt *Runtime, principal *models.User, uid string, approve bool, decision *models.AccessDecision) (*models.AccessRequest, int, string) {
+	if !rt.Allowed(principal, AdminPermission) {
+		return nil, 403, forbidden(AdminPermission)
+	}
+	if decision == nil {
+		decision = &models.AccessDecision{}
+	}
+
+	r, found, err := rt.Store.GetAccessRequest(ctx, uid)
+	if err != nil {
+		return nil, 500, err.Error()
+	}
+	if !found {
+		return nil, 404, "user " + uid + " hasn't requested access"
+	}
+	if r.Status != models.AccessRequestStatusPending {
+		return nil, 409, "the request has already been " + r.Status
+	}
+
+	if approve {
+		if !TrustedDomain(r.Email, rt.TrustedDomains) && !decision.Force {
+			return nil, 409, fmt.Sprintf("%v is not from a trusted domain; set force to approve the request anyway", r.Email)
+		}
+		if err = SetManuallyAuthorized(ctx, rt.firebaseClient(), rt.Store, uid, true); err != nil {
+			log.WithContext(ctx).WithFields(log.Fields{"user": uid, "error": err}).Error("Access request: failed to authorize user")
+			return nil, 500, "error authorizing user"
+		}
+	}
+	if err = ResolveAccessRequest(ctx, rt.Store, uid, approve, principal.UID, principal.Name, decision.Reason); err != nil {
+		return nil, 500, err.Error()
+	}
+
+	r, _, err = rt.Store.GetAccessRequest(ctx, uid)
+	if err != nil {
+		return nil, 500, err.Error()
+	}
+	return rt.accessRequestModel(r), 200, ""
+}
+
+// NewApproveAccessRequestHandler creates a handler
+func NewApproveAccessRequestHandler(rt *Runtime) operations.ApproveAccessRequestHandler {
+	return &approveAccessRequestHandlerImp{rt: rt}
+}
+
+type approveAccessRequestHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *approveAccessRequestHandlerImp) Handle(params operations.ApproveAccessRequestParams, principal *models.User) middleware.Responder {
+	r, code, msg := decideAccessRequest(params.HTTPRequest.Context(), h.rt, principal, params.UID, true, params.Body)
+	if r == nil {
+		return operations.NewApproveAccessRequestDefault(code).WithPayload(&models.Error{Message: &msg})
+	}
+	h.rt.audit(params.HTTPRequest, principal, "accessrequest.approve", params.UID, nil, r)
+	return &operations.ApproveAccessRequestOK{Payload: r}
+}
+
+// NewDenyAccessRequestHandler creates a handler
+func NewDenyAccessRequestHandler(rt *Runtime) operations.DenyAccessRequestHandler {
+	return &denyAccessRequestHandlerImp{rt: rt}
+}
+
+type denyAccessRequestHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *denyAccessRequestHandlerImp) Handle(params operations.DenyAccessRequestParams, principal *models.User) middleware.Responder {
+	r, code, msg := decideAccessRequest(params.HTTPRequest.Context(), h.rt, principal, params.UID, false, params.Body)
+	if r == nil {
+		return operations.NewDenyAccessRequestDefault(code).WithPayload(&models.Error{Message: &msg})
+	}
+	h.rt.audit(params.HTTPRequest, principal, "accessrequest.deny", params.UID, nil, r)
+	return &operations.DenyAccessRequestOK{Payload: r}
+}
@@ -0,0 +1,22 @@
+package api
+
+import "testing"
+
+func TestTrustedDomain(t *testing.T) {
+	trusted := []string{"example.com", "example.org"}
+	cases := []struct {
+		email string
+		want  bool
+	}{
+		{"jane@example.com", true},
+		{"jane@example.org", true},
+		{"jane@sub.example.com", false},
+		{"jane@example.com.evil.net", false},
+		{"", false},
+	}
+	for _, c := range cases {
+		if got := TrustedDomain(c.email, trusted); got != c.want {
+			t.Errorf("TrustedDomain(%v) == %v, want %v", c.email, got, c.want)
+		}
+	}
+}
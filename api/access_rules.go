@@ -0,0 +1,144 @@
+package api
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// AccessRule grants access, and optionally roles, to every user that meets all of its conditions.
+// This lets a whole department or group be onboarded at once, rather than authorizing users one by one.
+type AccessRule struct {
+	Name         string   // describes the rule in logs
+	Provider     string   // the identity provider the user signed in with
+	EmailDomains []string // the domain of the user's email address, which must be verified
+	Claim        string   // the name of a token claim, or SAML attribute, that must have one of Values
+	Values       []string
+	Roles        []string // the roles granted to users without any explicitly granted roles; if empty they get the default roles
+
+	roles models.Roles
+}
+
+// AccessRules is an ordered list of rules
+type AccessRules []AccessRule
+
+// NewAccessRules validates the rules and returns them ready for use
+func NewAccessRules(rules []AccessRule) (AccessRules, error) {
+	validated := make(AccessRules, len(rules))
+	for i, r := range rules {
+		name := r.Name
+		if name == "" {
+			name = fmt.Sprintf("#%d", i+1)
+			r.Name = name
+		}
+		if r.Provider == "" && len(r.EmailDomains) == 0 && r.Claim == "" {
+			return nil, fmt.Errorf("access rule %v has no conditions, so would grant access to everyone", name)
+		}
+		if (r.Claim == "") != (len(r.Values) == 0) {
+			return nil, fmt.Errorf("access rule %v must have both a claim and the values it can take", name)
+		}
+		roles, err := ParseRoles(r.Roles)
+		if err != nil {
+			return nil, fmt.Errorf("access rule %v: %v", name, err)
+		}
+		r.roles = roles
+		validated[i] = r
+	}
+	return validated, nil
+}
+
+// claimValues returns the values of the named claim from the user's token, or from the attributes of their SAML assertion
+func claimValues(u *models.User, name string) []string {
+	sources := []map[string]interface{}{u.Claims}
+	if u.Token != nil {
+		sources = append(sources, u.Token.Claims)
+		if firebase, ok := u.Token.Claims["firebase"].(map[string]interface{}); ok {
+			if attrs, ok := firebase["sign_in_attributes"].(map[string]interface{}); ok {
+				sources = append(sources, attrs)
+			}
+		}
+	}
+
+	for _, src := range sources {
+		v, ok := src[name]
+		if !ok {
+			continue
+		}
+		switch v := v.(type) {
+		case []interface{}:
+			values := make([]string, len(v))
+			for i, item := range v {
+				values[i] = fmt.Sprint(item)
+			}
+			return values
+		case nil:
+			return nil
+		default:
+			return []string{fmt.Sprint(v)}
+		}
+	}
+	return nil
+}
+
+func (r AccessRule) matches(u *models.User) bool {
+	if r.Provider != "" && r.Provider != u.Provider {
+		return false
+	}
+	if len(r.EmailDomains) > 0 {
+		if !u.EmailVerified {
+			return false
+		}
+		domain := u.Email[strings.LastIndex(u.Email, "@")+1:]
+		found := false
+		for _, d := range r.EmailDomains {
+			if strings.EqualFold(domain, d) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	if r.Claim != "" {
+		found := false
+		for _, have := range claimValues(u, r.Claim) {
+			for _, want := range r.Values {
+				if have == want {
+					found = true
+				}
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+// Evaluate reports whether any rule grants the user access, and the roles granted by all of the rules they match
+func (rules AccessRules) Evaluate(u *models.User) (bool, models.Roles) {
+	granted := false
+	roles := models.Roles{}
+	seen := map[models.Role]bool{}
+	for _, r := range rules {
+		if !r.matches(u) {
+			continue
+		}
+		granted = true
+		for _, role := range r.roles {
+			if !seen[role] {
+				seen[role] = true
+				roles = append(roles, role)
+			}
+		}
+	}
+	return granted, roles
+}
+
+// hasAccess reports whether the user is allowed to use the API at all, by manual authorization, their provider, or an access rule.
+// Deactivated users never have access.
+func (rt *Runtime) hasAccess(u *models.User) bool {
+	return !u.Deactivated && (u.ManuallyAuthorized || rt.AuthConfig.whitelisted(u.Provider) || u.RuleAccess)
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"testing"
+
+	"firebase.google.com/go/v4/auth"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+func TestAccessRules(t *testing.T) {
+	rules, err := NewAccessRules([]AccessRule{
+		{Name: "security", Provider: "saml.corp", Claim: "Department", Values: []string{"Security"}, Roles: []string{"securityAdmin"}},
+		{Name: "engineering", Claim: "groups", Values: []string{"engineering"}, Roles: []string{"projectContributor"}},
+		{Name: "staff", EmailDomains: []string{"example.com"}},
+	})
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	saml := &models.User{Provider: "saml.corp", Email: "a@partner.net", EmailVerified: true, Token: &auth.Token{Claims: map[string]interface{}{
+		"firebase": map[string]interface{}{"sign_in_attributes": map[string]interface{}{"Department": "Security"}},
+	}}}
+	otherProvider := &models.User{Provider: "saml.other", Email: "a@partner.net", Token: saml.Token}
+	oidc := &models.User{Provider: "oidc", Email: "b@Example.COM", EmailVerified: true, Claims: map[string]interface{}{"groups": []interface{}{"staff", "engineering"}}}
+	unverified := &models.User{Provider: "google.com", Email: "c@example.com"}
+
+	cases := []struct {
+		name      string
+		user      *models.User
+		wantGrant bool
+		wantRoles models.Roles
+	}{
+		{"SAML attribute", saml, true, models.Roles{models.RoleSecurityAdmin}},
+		{"attribute from another provider", otherProvider, false, models.Roles{}},
+		{"group claim and domain", oidc, true, models.Roles{models.RoleProjectContributor}},
+		{"unverified email", unverified, false, models.Roles{}},
+	}
+	for _, c := range cases {
+		granted, roles := rules.Evaluate(c.user)
+		if granted != c.wantGrant || len(roles) != len(c.wantRoles) || (len(roles) > 0 && roles[0] != c.wantRoles[0]) {
+			t.Errorf("%v: Evaluate() == %v, %v; want %v, %v", c.name, granted, roles, c.wantGrant, c.wantRoles)
+		}
+	}
+
+	// Roles from rules apply to users without explicit roles, and replace the defaults
+	rt := &Runtime{DefaultRoles: models.Roles{models.RoleViewer}, AccessRules: rules}
+	oidc.RuleAccess, oidc.RuleRoles = rules.Evaluate(oidc)
+	if !rt.hasAccess(oidc) || !rt.Allowed(oidc, EditPermission) {
+		t.Error("Access rule didn't grant access and roles")
+	}
+	oidc.Roles = models.Roles{models.RoleViewer}
+	if rt.Allowed(oidc, EditPermission) {
+		t.Error("Access rule roles overrode explicitly granted roles")
+	}
+}
+
+func TestNewAccessRules(t *testing.T) {
+	invalid := [][]AccessRule{
+		{{Name: "everyone", Roles: []string{"viewer"}}},
+		{{Claim: "groups"}},
+		{{Provider: "google.com", Roles: []string{"superuser"}}},
+	}
+	for _, rules := range invalid {
+		if _, err := NewAccessRules(rules); err == nil {
+			t.Errorf("NewAccessRules(%+v) should have failed", rules)
+		}
+	}
+}
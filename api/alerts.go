@@ -0,0 +1,58 @@
+package api
+
+import (
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// Events about users, see Notification
+const (
+	EventUserSignInRequest = "user.signin-request" // an unauthorized user attempted to sign in
+	EventUserAccessRequest = "user.access-request" // a user asked for access, explaining why
+	EventUserFirstSignIn   = "user.first-signin"   // an authorized user signed in for the first time
+)
+
+// RequestAccessAlert sends a notification about a new user attempting to log in and on success records it in the user's local data
+func RequestAccessAlert(rt *Runtime, user *models.User) {
+	if rt.RequestAccessAlerts {
+		sendAlert(EventUserSignInRequest, "New user request", rt, user)
+	} else {
+		log.Debug("New user request, alerts not configured")
+	}
+}
+
+// AccessRequestAlert sends a notification that a user has asked for access, for admins to approve or deny
+func AccessRequestAlert(rt *Runtime, user *models.User) {
+	if rt.RequestAccessAlerts {
+		sendAlert(EventUserAccessRequest, "Access requested, approve or deny it through the access requests API", rt, user)
+	} else {
+		log.Debug("Access requested, alerts not configured")
+	}
+}
+
+// NewUserAlert sends a notification about a user's first login and on success records it in the user's local data
+func NewUserAlert(rt *Runtime, user *models.User) {
+	if rt.NewUserAlerts {
+		sendAlert(EventUserFirstSignIn, "First sign-in from this authorized user", rt, user)
+	} else {
+		log.Debug("New user sign-in, alerts not configured")
+	}
+}
+
+func sendAlert(event string, title string, rt *Runtime, user *models.User) {
+	rt.notify(&Notification{
+		Event:   event,
+		Title:   title,
+		Subject: user.Name,
+		Fields: []NotificationField{
+			{Name: "Email", Value: user.Email},
+			{Name: "Authenticated By", Value: user.Provider},
+			{Name: "UID", Value: user.UID},
+		},
+		ImageURL: user.PictureURL,
+		Key:      event + "/" + user.UID,
+		UID:      user.UID,
+	})
+	// the successful sending of the alert will be recorded by NotificationWorker
+}
@@ -2,12 +2,14 @@ package api
 
 import (
 	"context"
+	"crypto/ed25519"
+	"net"
 	"time"
 
-	"firebase.google.com/go/v4/auth"
 	"github.com/go-openapi/loads"
 	log "github.com/sirupsen/logrus"
 
+	"github.com/ThalesGroup/besec/api/models"
 	"github.com/ThalesGroup/besec/api/restapi"
 	"github.com/ThalesGroup/besec/api/restapi/operations"
 	"github.com/ThalesGroup/besec/lib"
@@ -18,12 +20,22 @@ import (
 type Runtime struct {
 	practicesCache      map[string]practiceCache // keyed on version
 	Store               store.Store
-	AuthClient          *auth.Client
+	Verifier            IdentityVerifier // nil if authentication is disabled
 	AuthConfig          ExtendedAuthConfig
-	RequestAccessAlerts bool // Whether to send notifications to admins when a new unauthorized user attempts to login
-	NewUserAlerts       bool // Whether to send notifications to admins when an authorized user signs in for the first time
-	SlackChan           chan SlackMessage
+	RequestAccessAlerts bool                       // Whether to send notifications to admins when a new unauthorized user attempts to login
+	NewUserAlerts       bool                       // Whether to send notifications to admins when an authorized user signs in for the first time
+	Notifications       *NotificationRouter        // nil if notifications are disabled
 	PublicPaths         map[string]map[string]bool // map from path to a map from HTTP method to whether it is public
+	DefaultRoles        models.Roles               // The roles of authorized users who haven't been explicitly granted any
+	TrustedDomains      []string                   // Email domains that admins can grant access to without extra confirmation
+	AccessRules         AccessRules                // Rules that grant access and roles to users based on their identity
+	AttestationKey      ed25519.PrivateKey         // The key plan attestations are signed with, nil if attestations are disabled
+	Reminders           ReminderConfig             // How often projects should be assessed, and their owners reminded when they're overdue
+	IssueTrackers       []IssueTracker             // The trackers that tasks' issues are looked up and created in
+	PlanReviews         bool                       // Whether committed plans must be approved by a security reviewer to count towards the org's metrics
+	WebhookNetworks     []*net.IPNet               // Internal networks that webhooks may be sent to
+	issues              *issueCache                // recently fetched issues
+	notifyWake          chan struct{}              // wakes NotificationWorker when notifications are queued
 }
 
 type practiceCache struct {
@@ -35,22 +47,45 @@ type practiceCache struct {
 // This limits the pain when that happens if we have a long-running process.
 const practiceCacheLength = "2m"
 
+// RuntimeOptions holds the optional settings of a Runtime; the zero value disables each of them
+type RuntimeOptions struct {
+	DefaultRoles    models.Roles       // The roles of authorized users who haven't been explicitly granted any
+	TrustedDomains  []string           // Email domains that admins can grant access to without extra confirmation
+	AccessRules     AccessRules        // Rules that grant access and roles to users based on their identity
+	AttestationKey  ed25519.PrivateKey // The key plan attestations are signed with, nil if attestations are disabled
+	Reminders       ReminderConfig     // How often projects should be assessed, and their owners reminded when they're overdue
+	IssueTrackers   []IssueTracker     // The trackers that tasks' issues are looked up and created in
+	PlanReviews     bool               // Whether committed plans must be approved by a security reviewer to count towards the org's metrics
+	WebhookNetworks []*net.IPNet       // Internal networks that webhooks may be sent to
+}
+
 // NewRuntime creates a Runtime with the given parameters
 func NewRuntime(Store store.Store,
-	AuthClient *auth.Client,
+	Verifier IdentityVerifier,
 	AuthConfig ExtendedAuthConfig,
 	RequestAccessAlerts bool,
 	NewUserAlerts bool,
-	SlackChan chan SlackMessage,
+	Notifications *NotificationRouter,
+	Options RuntimeOptions,
 ) *Runtime {
 	return &Runtime{
 		practicesCache:      map[string]practiceCache{},
 		Store:               Store,
-		AuthClient:          AuthClient,
+		Verifier:            Verifier,
 		AuthConfig:          AuthConfig,
 		RequestAccessAlerts: RequestAccessAlerts,
 		NewUserAlerts:       NewUserAlerts,
-		SlackChan:           SlackChan,
+		Notifications:       Notifications,
+		DefaultRoles:        Options.DefaultRoles,
+		TrustedDomains:      Options.TrustedDomains,
+		AccessRules:         Options.AccessRules,
+		AttestationKey:      Options.AttestationKey,
+		Reminders:           Options.Reminders,
+		IssueTrackers:       Options.IssueTrackers,
+		PlanReviews:         Options.PlanReviews,
+		WebhookNetworks:     Options.WebhookNetworks,
+		issues:              newIssueCache(),
+		notifyWake:          make(chan struct{}, 1),
 	}
 }
 
@@ -64,6 +99,31 @@ func NewAPI(rt *Runtime) *restapi.Server {
 	API := operations.NewBesecAPI(swaggerSpec)
 	API.LoggedInHandler = NewLoggedInHandler(rt)
 	API.GetAuthConfigHandler = NewGetAuthConfigHandler(rt)
+	API.GetCurrentUserHandler = NewGetCurrentUserHandler(rt)
+	API.ListAuditEventsHandler = NewListAuditEventsHandler(rt)
+	API.ListNotificationsHandler = NewListNotificationsHandler(rt)
+	API.ListWebhooksHandler = NewListWebhooksHandler(rt)
+	API.CreateWebhookHandler = NewCreateWebhookHandler(rt)
+	API.DeleteWebhookHandler = NewDeleteWebhookHandler(rt)
+	API.TestWebhookHandler = NewTestWebhookHandler(rt)
+	API.ListWebhookDeliveriesHandler = NewListWebhookDeliveriesHandler(rt)
+	API.ListUsersHandler = NewListUsersHandler(rt)
+	API.GetUserHandler = NewGetUserHandler(rt)
+	API.AuthorizeUserHandler = NewAuthorizeUserHandler(rt)
+	API.DeauthorizeUserHandler = NewDeauthorizeUserHandler(rt)
+	API.RemoveUserHandler = NewRemoveUserHandler(rt)
+	API.GetUserRolesHandler = NewGetUserRolesHandler(rt)
+	API.SetUserRolesHandler = NewSetUserRolesHandler(rt)
+
+	API.GetMyAccessRequestHandler = NewGetMyAccessRequestHandler(rt)
+	API.RequestAccessHandler = NewRequestAccessHandler(rt)
+	API.ListAccessRequestsHandler = NewListAccessRequestsHandler(rt)
+	API.ApproveAccessRequestHandler = NewApproveAccessRequestHandler(rt)
+	API.DenyAccessRequestHandler = NewDenyAccessRequestHandler(rt)
+
+	API.ListAPITokensHandler = NewListAPITokensHandler(rt)
+	API.CreateAPITokenHandler = NewCreateAPITokenHandler(rt)
+	API.RevokeAPITokenHandler = NewRevokeAPITokenHandler(rt)
 
 	API.ListPracticesVersionsHandler = NewListPracticesVersionsHandler(rt)
 	API.GetPracticesHandler = NewGetPracticesHandler(rt)
@@ -73,6 +133,28 @@ func NewAPI(rt *Runtime) *restapi.Server {
 	API.CreateProjectHandler = NewCreateProjectHandler(rt)
 	API.UpdateProjectHandler = NewUpdateProjectHandler(rt)
 	API.DeleteProjectHandler = NewDeleteProjectHandler(rt)
+	API.ListProjectMembersHandler = NewListProjectMembersHandler(rt)
+	API.SetProjectMemberHandler = NewSetProjectMemberHandler(rt)
+	API.RemoveProjectMemberHandler = NewRemoveProjectMemberHandler(rt)
+	API.GetProjectSubscriptionHandler = NewGetProjectSubscriptionHandler(rt)
+	API.SubscribeToProjectHandler = NewSubscribeToProjectHandler(rt)
+	API.UnsubscribeFromProjectHandler = NewUnsubscribeFromProjectHandler(rt)
+
+	API.ListOrgUnitsHandler = NewListOrgUnitsHandler(rt)
+	API.GetOrgUnitHandler = NewGetOrgUnitHandler(rt)
+	API.CreateOrgUnitHandler = NewCreateOrgUnitHandler(rt)
+	API.UpdateOrgUnitHandler = NewUpdateOrgUnitHandler(rt)
+	API.DeleteOrgUnitHandler = NewDeleteOrgUnitHandler(rt)
+	API.ListOverdueProjectsHandler = NewListOverdueProjectsHandler(rt)
+	API.ListOpenTasksHandler = NewListOpenTasksHandler(rt)
+	API.ListRiskAcceptancesHandler = NewListRiskAcceptancesHandler(rt)
+	API.GetCommitPolicyHandler = NewGetCommitPolicyHandler(rt)
+	API.SetCommitPolicyHandler = NewSetCommitPolicyHandler(rt)
+	API.ReviewPlanRevisionHandler = NewReviewPlanRevisionHandler(rt)
+	API.ListPlanReviewsHandler = NewListPlanReviewsHandler(rt)
+	API.CreateTaskIssueHandler = NewCreateTaskIssueHandler(rt)
+	API.ApproveRiskAcceptanceHandler = NewApproveRiskAcceptanceHandler(rt)
+	API.GetMaturityMetricsHandler = NewGetMaturityMetricsHandler(rt)
 
 	API.GetPlanHandler = NewGetPlanHandler(rt)
 	API.CreatePlanHandler = NewCreatePlanHandler(rt)
@@ -80,10 +162,11 @@ func NewAPI(rt *Runtime) *restapi.Server {
 	API.CreatePlanRevisionHandler = NewCreatePlanRevisionHandler(rt)
 	API.GetPlanVersionsHandler = NewGetPlanVersionsHandler(rt)
 	API.GetPlanRevisionHandler = NewGetPlanRevisionHandler(rt)
+	API.GetPlanRevisionAttestationHandler = NewGetPlanRevisionAttestationHandler(rt)
 	API.GetPlanRevisionPracticeResponsesHandler = NewGetPlanRevisionPracticeResponsesHandler(rt)
 
 	API.Logger = log.Infof
-	if rt.AuthClient == nil {
+	if rt.Verifier == nil {
 		API.KeyAuth = MakeDummyKeyAuth(rt)
 	} else {
 		API.KeyAuth = MakeKeyAuth(rt)
@@ -0,0 +1,95 @@
+package api
+
+import (
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// NewGetPlanRevisionAttestationHandler creates a handler
+func NewGetPlanRevisionAttestationHandler(rt *Runtime) operations.GetPlanRevisionAttestationHandler {
+	return &getPlanRevisionAttestationHandlerImp{rt: rt}
+}
+
+type getPlanRevisionAttestationHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *getPlanRevisionAttestationHandlerImp) Handle(params operations.GetPlanRevisionAttestationParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.GetPlanRevisionAttestationDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	if h.rt.AttestationKey == nil {
+		return fail(501, "attestations haven't been configured on this server")
+	}
+
+	ctx := params.HTTPRequest.Context()
+	logger := log.WithContext(ctx).WithFields(log.Fields{"plan": params.ID, "revision": params.RevID})
+
+	// The whole history is retrieved so that we only attest to revisions whose history hasn't been tampered with
+	revs, head, found, err := h.rt.Store.GetPlanRevisionChain(ctx, params.ID)
+	if err != nil {
+		return fail(500, "error retrieving plan")
+	}
+	if !found {
+		return fail(404, "plan not found")
+	}
+	var rev *lib.ChainedRevision
+	for i := range revs {
+		if revs[i].ID == params.RevID {
+			rev = &revs[i]
+		}
+	}
+	if rev == nil || rev.Plan == nil {
+		return fail(404, "plan revision not found")
+	}
+	if !rev.Plan.Details.Committed {
+		return fail(400, "only committed plan revisions can be attested")
+	}
+	if report := lib.VerifyRevisionChain(revs, head); !report.Intact {
+		logger.WithField("problems", report.Problems).Error("Refusing to attest to a plan whose revision history isn't intact")
+		return fail(409, "the plan's revision history isn't intact, so it can't be attested")
+	}
+
+	projects := make([]lib.AttestedProject, 0, len(rev.Plan.Details.Projects))
+	for _, id := range rev.Plan.Details.Projects {
+		ap := lib.AttestedProject{ID: id}
+		p, found, err := h.rt.Store.GetProject(ctx, id)
+		if err != nil {
+			return fail(500, "error retrieving the plan's projects")
+		}
+		if found && p.Attributes != nil && p.Attributes.Name != nil {
+			ap.Name = *p.Attributes.Name
+		}
+		projects = append(projects, ap)
+	}
+
+	statement, err := lib.NewPlanStatement(params.ID, params.RevID, rev.Hash, rev.Plan, projects)
+	if err != nil {
+		logger.WithField("error", err).Error("Failed to create plan statement")
+		return fail(500, "error creating attestation")
+	}
+	env, err := statement.Sign(h.rt.AttestationKey)
+	if err != nil {
+		logger.WithField("error", err).Error("Failed to sign plan statement")
+		return fail(500, "error creating attestation")
+	}
+	return &operations.GetPlanRevisionAttestationOK{Payload: envelopeModel(env)}
+}
+
+func envelopeModel(e *lib.Envelope) *models.DsseEnvelope {
+	sigs := make([]*models.DsseEnvelopeSignaturesItems0, len(e.Signatures))
+	for i, s := range e.Signatures {
+		sig := s.Sig
+		sigs[i] = &models.DsseEnvelopeSignaturesItems0{Keyid: s.KeyID, Sig: &sig}
+	}
+	return &models.DsseEnvelope{PayloadType: &e.PayloadType, Payload: &e.Payload, Signatures: sigs}
+}
@@ -0,0 +1,162 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"strings"
+	"time"
+	"unicode/utf8"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+	"github.com/ThalesGroup/besec/store"
+)
+
+const (
+	defaultAuditLimit = 100
+	maxAuditSummary   = 10000 // bytes
+)
+
+// auditSummary returns a JSON representation of v to record in the audit log, or an empty string if v is nil
+func auditSummary(v interface{}) string {
+	if v == nil {
+		return ""
+	}
+	b, err := json.Marshal(v)
+	if err != nil {
+		log.WithField("error", err).Warn("Failed to summarize audit target")
+		return ""
+	}
+	s := string(b)
+	if s == "null" {
+		return ""
+	}
+	if len(s) > maxAuditSummary {
+		// don't cut a multi-byte character in half
+		end := maxAuditSummary
+		for end > 0 && !utf8.RuneStart(s[end]) {
+			end--
+		}
+		s = s[:end] + "…"
+	}
+	return s
+}
+
+// planAuditSummary summarizes a plan revision; the full responses are kept in the revision itself
+func planAuditSummary(revID string, p *lib.Plan) interface{} {
+	return struct {
+		Revision         string          `json:"revision"`
+		Details          lib.PlanDetails `json:"details"`
+		PracticesVersion string          `json:"practicesVersion"`
+	}{revID, p.Details, p.Responses.PracticesVersion}
+}
+
+// RecordAudit appends an event to the audit log, with summaries of the target before and after the change.
+// The target type is the first component of the action, e.g. project for project.delete.
+// Failures are logged rather than returned, as the change has already been made.
+func RecordAudit(ctx context.Context, st store.Store, e *store.AuditEvent, before, after interface{}) {
+	e.Time = time.Now().UTC()
+	e.TargetType = strings.SplitN(e.Action, ".", 2)[0]
+	e.Before = auditSummary(before)
+	e.After = auditSummary(after)
+	if _, err := st.RecordAuditEvent(ctx, e); err != nil {
+		log.WithContext(ctx).WithFields(log.Fields{"action": e.Action, "target": e.TargetID, "actor": e.Actor, "error": err}).Error("Failed to record audit event")
+	}
+}
+
+// requestID returns the trace ID of the request, from the X-Cloud-Trace-Context header set by Cloud Run
+func requestID(r *http.Request) string {
+	trace := r.Header.Get("X-Cloud-Trace-Context")
+	return strings.SplitN(trace, "/", 2)[0]
+}
+
+// audit records a change made through the API by the principal
+func (rt *Runtime) audit(r *http.Request, principal *models.User, action string, targetID string, before, after interface{}) {
+	actorName := principal.Name
+	if principal.APITokenID != "" {
+		actorName += " (API token " + principal.APITokenID + ")"
+	}
+	RecordAudit(r.Context(), rt.Store, &store.AuditEvent{
+		Actor:     principal.UID,
+		ActorName: actorName,
+		Action:    action,
+		TargetID:  targetID,
+		RequestID: requestID(r),
+	}, before, after)
+}
+
+func auditEventModel(e *store.AuditEvent) *models.AuditEvent {
+	id, actor, action := e.ID, e.Actor, e.Action
+	t := strfmt.DateTime(e.Time)
+	return &models.AuditEvent{
+		ID:         &id,
+		Time:       &t,
+		Actor:      &actor,
+		ActorName:  e.ActorName,
+		Action:     &action,
+		TargetType: e.TargetType,
+		TargetID:   e.TargetID,
+		RequestID:  e.RequestID,
+		Before:     e.Before,
+		After:      e.After,
+	}
+}
+
+// NewListAuditEventsHandler creates a handler
+func NewListAuditEventsHandler(rt *Runtime) operations.ListAuditEventsHandler {
+	return &listAuditEventsHandlerImp{rt: rt}
+}
+
+type listAuditEventsHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listAuditEventsHandlerImp) Handle(params operations.ListAuditEventsParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListAuditEventsDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AuditPermission) {
+		return fail(403, forbidden(AuditPermission))
+	}
+
+	q := store.AuditQuery{Limit: defaultAuditLimit}
+	if params.Actor != nil {
+		q.Actor = *params.Actor
+	}
+	if params.Action != nil {
+		q.Action = *params.Action
+	}
+	if params.TargetType != nil {
+		q.TargetType = *params.TargetType
+	}
+	if params.TargetID != nil {
+		q.TargetID = *params.TargetID
+	}
+	if params.Since != nil {
+		q.Since = time.Time(*params.Since)
+	}
+	if params.Until != nil {
+		q.Until = time.Time(*params.Until)
+	}
+	if params.Limit != nil {
+		q.Limit = int(*params.Limit)
+	}
+
+	events, err := h.rt.Store.ListAuditEvents(params.HTTPRequest.Context(), q)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	payload := make([]*models.AuditEvent, len(events))
+	for i, e := range events {
+		payload[i] = auditEventModel(e)
+	}
+	return &operations.ListAuditEventsOK{Payload: payload}
+}
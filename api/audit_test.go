@@ -0,0 +1,46 @@
+package api
+
+import (
+	"net/http"
+	"strings"
+	"testing"
+	"unicode/utf8"
+)
+
+func TestAuditSummary(t *testing.T) {
+	var nilMap map[string]string
+	cases := []struct {
+		in   interface{}
+		want string
+	}{
+		{nil, ""},
+		{nilMap, ""},
+		{map[string]string{"name": "x"}, `{"name":"x"}`},
+		{[]string{"viewer", "auditor"}, `["viewer","auditor"]`},
+	}
+	for _, c := range cases {
+		if got := auditSummary(c.in); got != c.want {
+			t.Errorf("auditSummary(%#v) = %q, want %q", c.in, got, c.want)
+		}
+	}
+
+	long := auditSummary(strings.Repeat("a", 2*maxAuditSummary))
+	if len(long) != maxAuditSummary+len("…") {
+		t.Errorf("long summary wasn't truncated, length %d", len(long))
+	}
+	// a JSON string starts with a quote, so a two-byte character straddles the limit
+	if long = auditSummary(strings.Repeat("é", maxAuditSummary)); !utf8.ValidString(long) || len(long) != maxAuditSummary-1+len("…") {
+		t.Errorf("summary wasn't truncated on a character boundary: length %d, valid %v", len(long), utf8.ValidString(long))
+	}
+}
+
+func TestRequestID(t *testing.T) {
+	r, _ := http.NewRequest("GET", "/", nil)
+	if id := requestID(r); id != "" {
+		t.Errorf("got request ID %q without a trace header", id)
+	}
+	r.Header.Set("X-Cloud-Trace-Context", "105445aa7843bc8bf206b12000100000/1;o=1")
+	if id := requestID(r); id != "105445aa7843bc8bf206b12000100000" {
+		t.Errorf("got request ID %q", id)
+	}
+}
@@ -61,19 +61,17 @@ func InitAuthClient(projectID string, useCreds bool, serviceAccount string) *aut
 	return client
 }
 
-// MakeKeyAuth returns a function that creates a user from the token in the provided Authorization header
+// MakeKeyAuth returns a function that creates a user from the ID token or API token in the provided Authorization header
 func MakeKeyAuth(rt *Runtime) func(string) (*models.User, error) {
 	return func(authHeader string) (*models.User, error) {
 		if !strings.HasPrefix(authHeader, "Bearer ") {
 			return nil, fmt.Errorf("invalid authorization header, expected format is 'Bearer <id token>'")
 		}
-		idToken := authHeader[7:]
-		token, err := rt.AuthClient.VerifyIDToken(context.Background(), idToken)
-		if err != nil {
-			log.WithFields(log.Fields{"error": err}).Info("Error validating ID token")
-			return nil, fmt.Errorf("invalid ID token")
+		token := authHeader[7:]
+		if strings.HasPrefix(token, APITokenPrefix) {
+			return rt.verifyAPIToken(context.Background(), token)
 		}
-		return NewUser(token, rt.AuthConfig)
+		return rt.Verifier.VerifyToken(context.Background(), token)
 	}
 }
 
@@ -89,11 +87,30 @@ func MakeDummyKeyAuth(rt *Runtime) func(string) (*models.User, error) {
 				PictureURL:         "https://fonts.gstatic.com/s/i/materialicons/person/v1/24px.svg",
 				ManuallyAuthorized: true,
 				CreationAlertSent:  true,
+				Roles:              models.Roles{models.RoleSecurityAdmin},
+				LookedUp:           true,
 			},
 			nil
 	}
 }
 
+// apiBasePath is the path the API is served under, the basePath in swagger.yaml
+const apiBasePath = "/v1alpha1"
+
+// isRoute reports whether the request is to exactly the API path, with one of the methods. The escaped path is compared,
+// as that's what requests are routed on: an encoded slash mustn't let a request to another operation match.
+func isRoute(r *http.Request, path string, methods ...string) bool {
+	if r.URL.EscapedPath() != apiBasePath+path {
+		return false
+	}
+	for _, m := range methods {
+		if r.Method == m {
+			return true
+		}
+	}
+	return false
+}
+
 // MakeAuthorizer returns an authorizer that checks that the authenticated user is allowed to make API requests
 func MakeAuthorizer(rt *Runtime) runtime.AuthorizerFunc {
 	return runtime.AuthorizerFunc(func(r *http.Request, user interface{}) error {
@@ -105,31 +122,38 @@ func MakeAuthorizer(rt *Runtime) runtime.AuthorizerFunc {
 		ctx := r.Context()
 		logger := log.WithContext(ctx).WithFields(log.Fields{"user": u.UID})
 
-		// Check if use has been individually authorized, or all users from this provider have access
-		authorized := u.ManuallyAuthorized || rt.AuthConfig.whitelisted(u.Provider)
-
-		if !authorized && !u.LookedUp {
-			// They may be manually authorized, but their token hasn't been refreshed yet - check the database
-			// We also want to know if an alert has been sent for them
+		if !u.LookedUp {
+			// We always need the user's roles, which are only recorded in the database.
+			// The database is also more current than their token's claims for manual authorization,
+			// and we want to know if an alert has been sent for them
 			err := rt.Store.GetUserData(ctx, u)
 			if err != nil {
 				logger.Errorf("Authorizer: Failed to look up user data: %v", err)
 				return fmt.Errorf("Internal error when checking authorization")
 			}
-			if u.ManuallyAuthorized {
-				logger.Debug("User is manually authorized in the DB but not via their token")
-				authorized = true
+		}
+
+		if isRoute(r, "/auth", http.MethodPost) {
+			// Users provisioned over SCIM before they first signed in are recorded under a placeholder UID
+			if err := rt.linkProvisionedUser(ctx, u); err != nil {
+				logger.Errorf("Authorizer: Failed to link provisioned user: %v", err)
+				return fmt.Errorf("Internal error when checking authorization")
 			}
 		}
 
-		if strings.HasSuffix(r.URL.Path, "/auth") {
+		// Check if use has been individually authorized, or all users from this provider or matching an access rule have access
+		u.RuleAccess, u.RuleRoles = rt.AccessRules.Evaluate(u)
+		authorized := rt.hasAccess(u)
+
+		if isRoute(r, "/auth", http.MethodPost) {
 			// Clients POST to this once on login, to allow us to do these one-off tasks
 			if err := loginTasks(ctx, rt, logger, u, authorized); err != nil {
 				return err
 			}
 		}
 
-		if authorized {
+		if authorized || isRoute(r, "/access-request", http.MethodGet, http.MethodPut) {
+			// Users without access can still ask for it
 			return nil
 		}
 
@@ -161,6 +185,7 @@ func loginTasks(ctx context.Context, rt *Runtime, logger *log.Entry, u *models.U
 			// Save the user's details, as they aren't available via Firebase Auth when the user isn't logged in
 			u.LocalData.Name = u.Name
 			u.LocalData.Email = u.Email
+			u.LocalData.Provider = u.Provider
 			if err := rt.Store.SaveUserData(ctx, u); err != nil {
 				logger.Errorf("Authorizer: failed to persist user details from token to store: %v", err)
 				return fmt.Errorf("Internal error when checking authorization")
@@ -324,14 +349,24 @@ func GetManuallyAuthorized(ctx context.Context, client *auth.Client, store store
 	return claim || (user.LocalData != nil && user.LocalData.ManuallyAuthorized), nil
 }
 
-// SetManuallyAuthorized records whether the user is manually authorized, in the store and as a custom claim for when their token is next created
+// SetManuallyAuthorized records whether the user is manually authorized, in the store and as a custom claim for when their token is next created.
+// If client is nil, as for users from an OIDC provider rather than Firebase, it is only recorded in the store.
+// Deauthorized users' subscriptions to project events are deleted.
 func SetManuallyAuthorized(ctx context.Context, client *auth.Client, store store.Store, uid string, value bool) error {
-	err := setClaim(ctx, client, uid, manuallyAuthorizedClaim, value)
-	if err != nil {
-		return fmt.Errorf("Failed to set custom claims for user %v: %v", uid, err)
+	if client != nil {
+		err := setClaim(ctx, client, uid, manuallyAuthorizedClaim, value)
+		if err != nil {
+			return fmt.Errorf("Failed to set custom claims for user %v: %v", uid, err)
+		}
 	}
 
-	return store.SetManuallyAuthorized(ctx, uid, value)
+	if err := store.SetManuallyAuthorized(ctx, uid, value); err != nil {
+		return err
+	}
+	if !value {
+		return DeleteUserSubscriptions(ctx, store, uid)
+	}
+	return nil
 }
 
 // NewExtendedAuthConfig validates the AuthConfig and returns an extended type
@@ -345,6 +380,9 @@ func NewExtendedAuthConfig(authConfig models.AuthConfig) ExtendedAuthConfig {
 			}
 		}
 	}
+	if authConfig.Flow == models.AuthConfigFlowOidc && authConfig.Oidc == nil {
+		log.Fatal("The auth flow is oidc, but no oidc provider is configured")
+	}
 	return ExtendedAuthConfig{authConfig}
 }
 
@@ -354,6 +392,9 @@ type ExtendedAuthConfig struct {
 }
 
 func (c *ExtendedAuthConfig) whitelisted(provider string) bool {
+	if c.Oidc != nil && c.oidcProvider() == provider {
+		return c.Oidc.Whitelisted
+	}
 	for _, p := range c.Providers {
 		if *p.ID == provider {
 			return p.Whitelisted
@@ -370,3 +411,11 @@ func (c *ExtendedAuthConfig) samlClaimsMap(provider string) *models.SamlProvider
 	}
 	return nil
 }
+
+// oidcProvider returns the provider ID of users authenticated by the OIDC provider
+func (c *ExtendedAuthConfig) oidcProvider() string {
+	if c.Oidc.ID == "" {
+		return defaultOIDCProvider
+	}
+	return c.Oidc.ID
+}
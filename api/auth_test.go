@@ -58,3 +58,29 @@ func TestMakeAuthorizer(t *testing.T) {
 		}
 	}
 }
+
+func TestIsRoute(t *testing.T) {
+	request := func(method string, target string) *http.Request {
+		u, err := url.ParseRequestURI(target)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return &http.Request{Method: method, URL: u}
+	}
+	cases := []struct {
+		method, target string
+		want           bool
+	}{
+		{http.MethodPut, "/v1alpha1/access-request", true},
+		{http.MethodGet, "/v1alpha1/access-request", true},
+		{http.MethodPost, "/v1alpha1/access-request", false},
+		{http.MethodGet, "/v1alpha1/user/x/access-request", false},
+		{http.MethodPut, "/v1alpha1/project%2F..%2Faccess-request", false}, // decodes to a path ending in /access-request
+		{http.MethodGet, "/v1alpha1/access-requests", false},
+	}
+	for _, c := range cases {
+		if got := isRoute(request(c.method, c.target), "/access-request", http.MethodGet, http.MethodPut); got != c.want {
+			t.Errorf("%v %v: got %v, want %v", c.method, c.target, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// NewApproveAccessRequestParams creates a new ApproveAccessRequestParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewApproveAccessRequestParams() *ApproveAccessRequestParams {
+	return &ApproveAccessRequestParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewApproveAccessRequestParamsWithTimeout creates a new ApproveAccessRequestParams object
+// with the ability to set a timeout on a request.
+func NewApproveAccessRequestParamsWithTimeout(timeout time.Duration) *ApproveAccessRequestParams {
+	return &ApproveAccessRequestParams{
+		timeout: timeout,
+	}
+}
+
+// NewApproveAccessRequestParamsWithContext creates a new ApproveAccessRequestParams object
+// with the ability to set a context for a request.
+func NewApproveAccessRequestParamsWithContext(ctx context.Context) *ApproveAccessRequestParams {
+	return &ApproveAccessRequestParams{
+		Context: ctx,
+	}
+}
+
+// NewApproveAccessRequestParamsWithHTTPClient creates a new ApproveAccessRequestParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewApproveAccessRequestParamsWithHTTPClient(client *http.Client) *ApproveAccessRequestParams {
+	return &ApproveAccessRequestParams{
+		HTTPClient: client,
+	}
+}
+
+/* ApproveAccessRequestParams contains all the parameters to send to the API endpoint
+   for the approve access request operation.
+
+   Typically these are written to a http.Request.
+*/
+type ApproveAccessRequestParams struct {
+
+	// Body.
+	Body *models.AccessDecision
+
+	// UID.
+	UID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the approve access request params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApproveAccessRequestParams) WithDefaults() *ApproveAccessRequestParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the approve access request params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApproveAccessRequestParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the approve access request params
+func (o *ApproveAccessRequestParams) WithTimeout(timeout time.Duration) *ApproveAccessRequestParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the approve access request params
+func (o *ApproveAccessRequestParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the approve access request params
+func (o *ApproveAccessRequestParams) WithContext(ctx context.Context) *ApproveAccessRequestParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the approve access request params
+func (o *ApproveAccessRequestParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the approve access request params
+func (o *ApproveAccessRequestParams) WithHTTPClient(client *http.Client) *ApproveAccessRequestParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the approve access request params
+func (o *ApproveAccessRequestParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the approve access request params
+func (o *ApproveAccessRequestParams) WithBody(body *models.AccessDecision) *ApproveAccessRequestParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the approve access request params
+func (o *ApproveAccessRequestParams) SetBody(body *models.AccessDecision) {
+	o.Body = body
+}
+
+// WithUID adds the uid to the approve access request params
+func (o *ApproveAccessRequestParams) WithUID(uid string) *ApproveAccessRequestParams {
+	o.SetUID(uid)
+	return o
+}
+
+// SetUID adds the uid to the approve access request params
+func (o *ApproveAccessRequestParams) SetUID(uid string) {
+	o.UID = uid
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ApproveAccessRequestParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param uid
+	if err := r.SetPathParam("uid", o.UID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ApproveAccessRequestReader is a Reader for the ApproveAccessRequest structure.
+type ApproveAccessRequestReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ApproveAccessRequestReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewApproveAccessRequestOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewApproveAccessRequestDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewApproveAccessRequestOK creates a ApproveAccessRequestOK with default headers values
+func NewApproveAccessRequestOK() *ApproveAccessRequestOK {
+	return &ApproveAccessRequestOK{}
+}
+
+/* ApproveAccessRequestOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ApproveAccessRequestOK struct {
+	Payload *models.AccessRequest
+}
+
+func (o *ApproveAccessRequestOK) Error() string {
+	return fmt.Sprintf("[POST /access-requests/{uid}/approve][%d] approveAccessRequestOK  %+v", 200, o.Payload)
+}
+func (o *ApproveAccessRequestOK) GetPayload() *models.AccessRequest {
+	return o.Payload
+}
+
+func (o *ApproveAccessRequestOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AccessRequest)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApproveAccessRequestDefault creates a ApproveAccessRequestDefault with default headers values
+func NewApproveAccessRequestDefault(code int) *ApproveAccessRequestDefault {
+	return &ApproveAccessRequestDefault{
+		_statusCode: code,
+	}
+}
+
+/* ApproveAccessRequestDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ApproveAccessRequestDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the approve access request default response
+func (o *ApproveAccessRequestDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ApproveAccessRequestDefault) Error() string {
+	return fmt.Sprintf("[POST /access-requests/{uid}/approve][%d] approveAccessRequest default  %+v", o._statusCode, o.Payload)
+}
+func (o *ApproveAccessRequestDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ApproveAccessRequestDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
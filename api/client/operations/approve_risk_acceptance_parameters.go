@@ -0,0 +1,184 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewApproveRiskAcceptanceParams creates a new ApproveRiskAcceptanceParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewApproveRiskAcceptanceParams() *ApproveRiskAcceptanceParams {
+	return &ApproveRiskAcceptanceParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewApproveRiskAcceptanceParamsWithTimeout creates a new ApproveRiskAcceptanceParams object
+// with the ability to set a timeout on a request.
+func NewApproveRiskAcceptanceParamsWithTimeout(timeout time.Duration) *ApproveRiskAcceptanceParams {
+	return &ApproveRiskAcceptanceParams{
+		timeout: timeout,
+	}
+}
+
+// NewApproveRiskAcceptanceParamsWithContext creates a new ApproveRiskAcceptanceParams object
+// with the ability to set a context for a request.
+func NewApproveRiskAcceptanceParamsWithContext(ctx context.Context) *ApproveRiskAcceptanceParams {
+	return &ApproveRiskAcceptanceParams{
+		Context: ctx,
+	}
+}
+
+// NewApproveRiskAcceptanceParamsWithHTTPClient creates a new ApproveRiskAcceptanceParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewApproveRiskAcceptanceParamsWithHTTPClient(client *http.Client) *ApproveRiskAcceptanceParams {
+	return &ApproveRiskAcceptanceParams{
+		HTTPClient: client,
+	}
+}
+
+/* ApproveRiskAcceptanceParams contains all the parameters to send to the API endpoint
+   for the approve risk acceptance operation.
+
+   Typically these are written to a http.Request.
+*/
+type ApproveRiskAcceptanceParams struct {
+
+	// ID.
+	ID string
+
+	// PracticeID.
+	PracticeID string
+
+	// TaskID.
+	TaskID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the approve risk acceptance params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApproveRiskAcceptanceParams) WithDefaults() *ApproveRiskAcceptanceParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the approve risk acceptance params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ApproveRiskAcceptanceParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) WithTimeout(timeout time.Duration) *ApproveRiskAcceptanceParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) WithContext(ctx context.Context) *ApproveRiskAcceptanceParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) WithHTTPClient(client *http.Client) *ApproveRiskAcceptanceParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) WithID(id string) *ApproveRiskAcceptanceParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithPracticeID adds the practiceID to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) WithPracticeID(practiceID string) *ApproveRiskAcceptanceParams {
+	o.SetPracticeID(practiceID)
+	return o
+}
+
+// SetPracticeID adds the practiceId to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) SetPracticeID(practiceID string) {
+	o.PracticeID = practiceID
+}
+
+// WithTaskID adds the taskID to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) WithTaskID(taskID string) *ApproveRiskAcceptanceParams {
+	o.SetTaskID(taskID)
+	return o
+}
+
+// SetTaskID adds the taskId to the approve risk acceptance params
+func (o *ApproveRiskAcceptanceParams) SetTaskID(taskID string) {
+	o.TaskID = taskID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ApproveRiskAcceptanceParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	// path param practiceId
+	if err := r.SetPathParam("practiceId", o.PracticeID); err != nil {
+		return err
+	}
+
+	// path param taskId
+	if err := r.SetPathParam("taskId", o.TaskID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ApproveRiskAcceptanceReader is a Reader for the ApproveRiskAcceptance structure.
+type ApproveRiskAcceptanceReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ApproveRiskAcceptanceReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewApproveRiskAcceptanceCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewApproveRiskAcceptanceDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewApproveRiskAcceptanceCreated creates a ApproveRiskAcceptanceCreated with default headers values
+func NewApproveRiskAcceptanceCreated() *ApproveRiskAcceptanceCreated {
+	return &ApproveRiskAcceptanceCreated{}
+}
+
+/* ApproveRiskAcceptanceCreated describes a response with status code 201, with default header values.
+
+Approved
+*/
+type ApproveRiskAcceptanceCreated struct {
+	Payload string
+}
+
+func (o *ApproveRiskAcceptanceCreated) Error() string {
+	return fmt.Sprintf("[POST /plan/{id}/practice/{practiceId}/task/{taskId}/risk-acceptance/approval][%d] approveRiskAcceptanceCreated  %+v", 201, o.Payload)
+}
+func (o *ApproveRiskAcceptanceCreated) GetPayload() string {
+	return o.Payload
+}
+
+func (o *ApproveRiskAcceptanceCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewApproveRiskAcceptanceDefault creates a ApproveRiskAcceptanceDefault with default headers values
+func NewApproveRiskAcceptanceDefault(code int) *ApproveRiskAcceptanceDefault {
+	return &ApproveRiskAcceptanceDefault{
+		_statusCode: code,
+	}
+}
+
+/* ApproveRiskAcceptanceDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ApproveRiskAcceptanceDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the approve risk acceptance default response
+func (o *ApproveRiskAcceptanceDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ApproveRiskAcceptanceDefault) Error() string {
+	return fmt.Sprintf("[POST /plan/{id}/practice/{practiceId}/task/{taskId}/risk-acceptance/approval][%d] approveRiskAcceptance default  %+v", o._statusCode, o.Payload)
+}
+func (o *ApproveRiskAcceptanceDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ApproveRiskAcceptanceDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// NewAuthorizeUserParams creates a new AuthorizeUserParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewAuthorizeUserParams() *AuthorizeUserParams {
+	return &AuthorizeUserParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewAuthorizeUserParamsWithTimeout creates a new AuthorizeUserParams object
+// with the ability to set a timeout on a request.
+func NewAuthorizeUserParamsWithTimeout(timeout time.Duration) *AuthorizeUserParams {
+	return &AuthorizeUserParams{
+		timeout: timeout,
+	}
+}
+
+// NewAuthorizeUserParamsWithContext creates a new AuthorizeUserParams object
+// with the ability to set a context for a request.
+func NewAuthorizeUserParamsWithContext(ctx context.Context) *AuthorizeUserParams {
+	return &AuthorizeUserParams{
+		Context: ctx,
+	}
+}
+
+// NewAuthorizeUserParamsWithHTTPClient creates a new AuthorizeUserParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewAuthorizeUserParamsWithHTTPClient(client *http.Client) *AuthorizeUserParams {
+	return &AuthorizeUserParams{
+		HTTPClient: client,
+	}
+}
+
+/* AuthorizeUserParams contains all the parameters to send to the API endpoint
+   for the authorize user operation.
+
+   Typically these are written to a http.Request.
+*/
+type AuthorizeUserParams struct {
+
+	// Body.
+	Body *models.AccessDecision
+
+	// UID.
+	UID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the authorize user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthorizeUserParams) WithDefaults() *AuthorizeUserParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the authorize user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *AuthorizeUserParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the authorize user params
+func (o *AuthorizeUserParams) WithTimeout(timeout time.Duration) *AuthorizeUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the authorize user params
+func (o *AuthorizeUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the authorize user params
+func (o *AuthorizeUserParams) WithContext(ctx context.Context) *AuthorizeUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the authorize user params
+func (o *AuthorizeUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the authorize user params
+func (o *AuthorizeUserParams) WithHTTPClient(client *http.Client) *AuthorizeUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the authorize user params
+func (o *AuthorizeUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the authorize user params
+func (o *AuthorizeUserParams) WithBody(body *models.AccessDecision) *AuthorizeUserParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the authorize user params
+func (o *AuthorizeUserParams) SetBody(body *models.AccessDecision) {
+	o.Body = body
+}
+
+// WithUID adds the uid to the authorize user params
+func (o *AuthorizeUserParams) WithUID(uid string) *AuthorizeUserParams {
+	o.SetUID(uid)
+	return o
+}
+
+// SetUID adds the uid to the authorize user params
+func (o *AuthorizeUserParams) SetUID(uid string) {
+	o.UID = uid
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *AuthorizeUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param uid
+	if err := r.SetPathParam("uid", o.UID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
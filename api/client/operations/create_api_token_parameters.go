@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// NewCreateAPITokenParams creates a new CreateAPITokenParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateAPITokenParams() *CreateAPITokenParams {
+	return &CreateAPITokenParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateAPITokenParamsWithTimeout creates a new CreateAPITokenParams object
+// with the ability to set a timeout on a request.
+func NewCreateAPITokenParamsWithTimeout(timeout time.Duration) *CreateAPITokenParams {
+	return &CreateAPITokenParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateAPITokenParamsWithContext creates a new CreateAPITokenParams object
+// with the ability to set a context for a request.
+func NewCreateAPITokenParamsWithContext(ctx context.Context) *CreateAPITokenParams {
+	return &CreateAPITokenParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateAPITokenParamsWithHTTPClient creates a new CreateAPITokenParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateAPITokenParamsWithHTTPClient(client *http.Client) *CreateAPITokenParams {
+	return &CreateAPITokenParams{
+		HTTPClient: client,
+	}
+}
+
+/* CreateAPITokenParams contains all the parameters to send to the API endpoint
+   for the create Api token operation.
+
+   Typically these are written to a http.Request.
+*/
+type CreateAPITokenParams struct {
+
+	// Body.
+	Body *models.APITokenRequest
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create Api token params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateAPITokenParams) WithDefaults() *CreateAPITokenParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create Api token params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateAPITokenParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create Api token params
+func (o *CreateAPITokenParams) WithTimeout(timeout time.Duration) *CreateAPITokenParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create Api token params
+func (o *CreateAPITokenParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create Api token params
+func (o *CreateAPITokenParams) WithContext(ctx context.Context) *CreateAPITokenParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create Api token params
+func (o *CreateAPITokenParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create Api token params
+func (o *CreateAPITokenParams) WithHTTPClient(client *http.Client) *CreateAPITokenParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create Api token params
+func (o *CreateAPITokenParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create Api token params
+func (o *CreateAPITokenParams) WithBody(body *models.APITokenRequest) *CreateAPITokenParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create Api token params
+func (o *CreateAPITokenParams) SetBody(body *models.APITokenRequest) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateAPITokenParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
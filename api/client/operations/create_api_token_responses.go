@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// CreateAPITokenReader is a Reader for the CreateAPIToken structure.
+type CreateAPITokenReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateAPITokenReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateAPITokenCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCreateAPITokenDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateAPITokenCreated creates a CreateAPITokenCreated with default headers values
+func NewCreateAPITokenCreated() *CreateAPITokenCreated {
+	return &CreateAPITokenCreated{}
+}
+
+/* CreateAPITokenCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type CreateAPITokenCreated struct {
+	Payload *models.NewAPIToken
+}
+
+func (o *CreateAPITokenCreated) Error() string {
+	return fmt.Sprintf("[POST /tokens][%d] createApiTokenCreated  %+v", 201, o.Payload)
+}
+func (o *CreateAPITokenCreated) GetPayload() *models.NewAPIToken {
+	return o.Payload
+}
+
+func (o *CreateAPITokenCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NewAPIToken)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateAPITokenDefault creates a CreateAPITokenDefault with default headers values
+func NewCreateAPITokenDefault(code int) *CreateAPITokenDefault {
+	return &CreateAPITokenDefault{
+		_statusCode: code,
+	}
+}
+
+/* CreateAPITokenDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type CreateAPITokenDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the create Api token default response
+func (o *CreateAPITokenDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateAPITokenDefault) Error() string {
+	return fmt.Sprintf("[POST /tokens][%d] createApiToken default  %+v", o._statusCode, o.Payload)
+}
+func (o *CreateAPITokenDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateAPITokenDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// NewCreateOrgUnitParams creates a new CreateOrgUnitParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateOrgUnitParams() *CreateOrgUnitParams {
+	return &CreateOrgUnitParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateOrgUnitParamsWithTimeout creates a new CreateOrgUnitParams object
+// with the ability to set a timeout on a request.
+func NewCreateOrgUnitParamsWithTimeout(timeout time.Duration) *CreateOrgUnitParams {
+	return &CreateOrgUnitParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateOrgUnitParamsWithContext creates a new CreateOrgUnitParams object
+// with the ability to set a context for a request.
+func NewCreateOrgUnitParamsWithContext(ctx context.Context) *CreateOrgUnitParams {
+	return &CreateOrgUnitParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateOrgUnitParamsWithHTTPClient creates a new CreateOrgUnitParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateOrgUnitParamsWithHTTPClient(client *http.Client) *CreateOrgUnitParams {
+	return &CreateOrgUnitParams{
+		HTTPClient: client,
+	}
+}
+
+/* CreateOrgUnitParams contains all the parameters to send to the API endpoint
+   for the create org unit operation.
+
+   Typically these are written to a http.Request.
+*/
+type CreateOrgUnitParams struct {
+
+	// Body.
+	Body *models.OrgUnitDetails
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create org unit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateOrgUnitParams) WithDefaults() *CreateOrgUnitParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create org unit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateOrgUnitParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create org unit params
+func (o *CreateOrgUnitParams) WithTimeout(timeout time.Duration) *CreateOrgUnitParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create org unit params
+func (o *CreateOrgUnitParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create org unit params
+func (o *CreateOrgUnitParams) WithContext(ctx context.Context) *CreateOrgUnitParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create org unit params
+func (o *CreateOrgUnitParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create org unit params
+func (o *CreateOrgUnitParams) WithHTTPClient(client *http.Client) *CreateOrgUnitParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create org unit params
+func (o *CreateOrgUnitParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create org unit params
+func (o *CreateOrgUnitParams) WithBody(body *models.OrgUnitDetails) *CreateOrgUnitParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create org unit params
+func (o *CreateOrgUnitParams) SetBody(body *models.OrgUnitDetails) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateOrgUnitParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// CreateOrgUnitReader is a Reader for the CreateOrgUnit structure.
+type CreateOrgUnitReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateOrgUnitReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateOrgUnitCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCreateOrgUnitDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateOrgUnitCreated creates a CreateOrgUnitCreated with default headers values
+func NewCreateOrgUnitCreated() *CreateOrgUnitCreated {
+	return &CreateOrgUnitCreated{}
+}
+
+/* CreateOrgUnitCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type CreateOrgUnitCreated struct {
+	Payload string
+}
+
+func (o *CreateOrgUnitCreated) Error() string {
+	return fmt.Sprintf("[POST /orgunit][%d] createOrgUnitCreated  %+v", 201, o.Payload)
+}
+func (o *CreateOrgUnitCreated) GetPayload() string {
+	return o.Payload
+}
+
+func (o *CreateOrgUnitCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateOrgUnitDefault creates a CreateOrgUnitDefault with default headers values
+func NewCreateOrgUnitDefault(code int) *CreateOrgUnitDefault {
+	return &CreateOrgUnitDefault{
+		_statusCode: code,
+	}
+}
+
+/* CreateOrgUnitDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type CreateOrgUnitDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the create org unit default response
+func (o *CreateOrgUnitDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateOrgUnitDefault) Error() string {
+	return fmt.Sprintf("[POST /orgunit][%d] createOrgUnit default  %+v", o._statusCode, o.Payload)
+}
+func (o *CreateOrgUnitDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateOrgUnitDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
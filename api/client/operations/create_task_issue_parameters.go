@@ -0,0 +1,201 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewCreateTaskIssueParams creates a new CreateTaskIssueParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewCreateTaskIssueParams() *CreateTaskIssueParams {
+	return &CreateTaskIssueParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewCreateTaskIssueParamsWithTimeout creates a new CreateTaskIssueParams object
+// with the ability to set a timeout on a request.
+func NewCreateTaskIssueParamsWithTimeout(timeout time.Duration) *CreateTaskIssueParams {
+	return &CreateTaskIssueParams{
+		timeout: timeout,
+	}
+}
+
+// NewCreateTaskIssueParamsWithContext creates a new CreateTaskIssueParams object
+// with the ability to set a context for a request.
+func NewCreateTaskIssueParamsWithContext(ctx context.Context) *CreateTaskIssueParams {
+	return &CreateTaskIssueParams{
+		Context: ctx,
+	}
+}
+
+// NewCreateTaskIssueParamsWithHTTPClient creates a new CreateTaskIssueParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewCreateTaskIssueParamsWithHTTPClient(client *http.Client) *CreateTaskIssueParams {
+	return &CreateTaskIssueParams{
+		HTTPClient: client,
+	}
+}
+
+/* CreateTaskIssueParams contains all the parameters to send to the API endpoint
+   for the create task issue operation.
+
+   Typically these are written to a http.Request.
+*/
+type CreateTaskIssueParams struct {
+
+	// Body.
+	Body CreateTaskIssueBody
+
+	// ID.
+	ID string
+
+	// PracticeID.
+	PracticeID string
+
+	// TaskID.
+	TaskID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the create task issue params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateTaskIssueParams) WithDefaults() *CreateTaskIssueParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the create task issue params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *CreateTaskIssueParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the create task issue params
+func (o *CreateTaskIssueParams) WithTimeout(timeout time.Duration) *CreateTaskIssueParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the create task issue params
+func (o *CreateTaskIssueParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the create task issue params
+func (o *CreateTaskIssueParams) WithContext(ctx context.Context) *CreateTaskIssueParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the create task issue params
+func (o *CreateTaskIssueParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the create task issue params
+func (o *CreateTaskIssueParams) WithHTTPClient(client *http.Client) *CreateTaskIssueParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the create task issue params
+func (o *CreateTaskIssueParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the create task issue params
+func (o *CreateTaskIssueParams) WithBody(body CreateTaskIssueBody) *CreateTaskIssueParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the create task issue params
+func (o *CreateTaskIssueParams) SetBody(body CreateTaskIssueBody) {
+	o.Body = body
+}
+
+// WithID adds the id to the create task issue params
+func (o *CreateTaskIssueParams) WithID(id string) *CreateTaskIssueParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the create task issue params
+func (o *CreateTaskIssueParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithPracticeID adds the practiceID to the create task issue params
+func (o *CreateTaskIssueParams) WithPracticeID(practiceID string) *CreateTaskIssueParams {
+	o.SetPracticeID(practiceID)
+	return o
+}
+
+// SetPracticeID adds the practiceId to the create task issue params
+func (o *CreateTaskIssueParams) SetPracticeID(practiceID string) {
+	o.PracticeID = practiceID
+}
+
+// WithTaskID adds the taskID to the create task issue params
+func (o *CreateTaskIssueParams) WithTaskID(taskID string) *CreateTaskIssueParams {
+	o.SetTaskID(taskID)
+	return o
+}
+
+// SetTaskID adds the taskId to the create task issue params
+func (o *CreateTaskIssueParams) SetTaskID(taskID string) {
+	o.TaskID = taskID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *CreateTaskIssueParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	// path param practiceId
+	if err := r.SetPathParam("practiceId", o.PracticeID); err != nil {
+		return err
+	}
+
+	// path param taskId
+	if err := r.SetPathParam("taskId", o.TaskID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
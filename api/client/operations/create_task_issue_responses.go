@@ -0,0 +1,155 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// CreateTaskIssueReader is a Reader for the CreateTaskIssue structure.
+type CreateTaskIssueReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateTaskIssueReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateTaskIssueCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCreateTaskIssueDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateTaskIssueCreated creates a CreateTaskIssueCreated with default headers values
+func NewCreateTaskIssueCreated() *CreateTaskIssueCreated {
+	return &CreateTaskIssueCreated{}
+}
+
+/* CreateTaskIssueCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type CreateTaskIssueCreated struct {
+	Payload *lib.LinkedIssue
+}
+
+func (o *CreateTaskIssueCreated) Error() string {
+	return fmt.Sprintf("[POST /plan/{id}/practice/{practiceId}/task/{taskId}/issue][%d] createTaskIssueCreated  %+v", 201, o.Payload)
+}
+func (o *CreateTaskIssueCreated) GetPayload() *lib.LinkedIssue {
+	return o.Payload
+}
+
+func (o *CreateTaskIssueCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(lib.LinkedIssue)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateTaskIssueDefault creates a CreateTaskIssueDefault with default headers values
+func NewCreateTaskIssueDefault(code int) *CreateTaskIssueDefault {
+	return &CreateTaskIssueDefault{
+		_statusCode: code,
+	}
+}
+
+/* CreateTaskIssueDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type CreateTaskIssueDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the create task issue default response
+func (o *CreateTaskIssueDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateTaskIssueDefault) Error() string {
+	return fmt.Sprintf("[POST /plan/{id}/practice/{practiceId}/task/{taskId}/issue][%d] createTaskIssue default  %+v", o._statusCode, o.Payload)
+}
+func (o *CreateTaskIssueDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateTaskIssueDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*CreateTaskIssueBody create task issue body
+swagger:model CreateTaskIssueBody
+*/
+type CreateTaskIssueBody struct {
+
+	// The name of the issue tracker to use, if more than one is configured. Defaults to the first tracker that serves the plan's projects.
+	Tracker string `json:"tracker,omitempty"`
+}
+
+// Validate validates this create task issue body
+func (o *CreateTaskIssueBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this create task issue body based on context it is used
+func (o *CreateTaskIssueBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateTaskIssueBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateTaskIssueBody) UnmarshalBinary(b []byte) error {
+	var res CreateTaskIssueBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// CreateWebhookReader is a Reader for the CreateWebhook structure.
+type CreateWebhookReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *CreateWebhookReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 201:
+		result := NewCreateWebhookCreated()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewCreateWebhookDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewCreateWebhookCreated creates a CreateWebhookCreated with default headers values
+func NewCreateWebhookCreated() *CreateWebhookCreated {
+	return &CreateWebhookCreated{}
+}
+
+/* CreateWebhookCreated describes a response with status code 201, with default header values.
+
+Created
+*/
+type CreateWebhookCreated struct {
+	Payload *models.NewWebhook
+}
+
+func (o *CreateWebhookCreated) Error() string {
+	return fmt.Sprintf("[POST /webhooks][%d] createWebhookCreated  %+v", 201, o.Payload)
+}
+func (o *CreateWebhookCreated) GetPayload() *models.NewWebhook {
+	return o.Payload
+}
+
+func (o *CreateWebhookCreated) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.NewWebhook)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewCreateWebhookDefault creates a CreateWebhookDefault with default headers values
+func NewCreateWebhookDefault(code int) *CreateWebhookDefault {
+	return &CreateWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+/* CreateWebhookDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type CreateWebhookDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the create webhook default response
+func (o *CreateWebhookDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *CreateWebhookDefault) Error() string {
+	return fmt.Sprintf("[POST /webhooks][%d] createWebhook default  %+v", o._statusCode, o.Payload)
+}
+func (o *CreateWebhookDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *CreateWebhookDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
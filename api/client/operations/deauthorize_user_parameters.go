@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeauthorizeUserParams creates a new DeauthorizeUserParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeauthorizeUserParams() *DeauthorizeUserParams {
+	return &DeauthorizeUserParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeauthorizeUserParamsWithTimeout creates a new DeauthorizeUserParams object
+// with the ability to set a timeout on a request.
+func NewDeauthorizeUserParamsWithTimeout(timeout time.Duration) *DeauthorizeUserParams {
+	return &DeauthorizeUserParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeauthorizeUserParamsWithContext creates a new DeauthorizeUserParams object
+// with the ability to set a context for a request.
+func NewDeauthorizeUserParamsWithContext(ctx context.Context) *DeauthorizeUserParams {
+	return &DeauthorizeUserParams{
+		Context: ctx,
+	}
+}
+
+// NewDeauthorizeUserParamsWithHTTPClient creates a new DeauthorizeUserParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeauthorizeUserParamsWithHTTPClient(client *http.Client) *DeauthorizeUserParams {
+	return &DeauthorizeUserParams{
+		HTTPClient: client,
+	}
+}
+
+/* DeauthorizeUserParams contains all the parameters to send to the API endpoint
+   for the deauthorize user operation.
+
+   Typically these are written to a http.Request.
+*/
+type DeauthorizeUserParams struct {
+
+	// UID.
+	UID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the deauthorize user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeauthorizeUserParams) WithDefaults() *DeauthorizeUserParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the deauthorize user params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeauthorizeUserParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the deauthorize user params
+func (o *DeauthorizeUserParams) WithTimeout(timeout time.Duration) *DeauthorizeUserParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the deauthorize user params
+func (o *DeauthorizeUserParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the deauthorize user params
+func (o *DeauthorizeUserParams) WithContext(ctx context.Context) *DeauthorizeUserParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the deauthorize user params
+func (o *DeauthorizeUserParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the deauthorize user params
+func (o *DeauthorizeUserParams) WithHTTPClient(client *http.Client) *DeauthorizeUserParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the deauthorize user params
+func (o *DeauthorizeUserParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithUID adds the uid to the deauthorize user params
+func (o *DeauthorizeUserParams) WithUID(uid string) *DeauthorizeUserParams {
+	o.SetUID(uid)
+	return o
+}
+
+// SetUID adds the uid to the deauthorize user params
+func (o *DeauthorizeUserParams) SetUID(uid string) {
+	o.UID = uid
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeauthorizeUserParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param uid
+	if err := r.SetPathParam("uid", o.UID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
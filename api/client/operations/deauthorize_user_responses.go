@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DeauthorizeUserReader is a Reader for the DeauthorizeUser structure.
+type DeauthorizeUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeauthorizeUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDeauthorizeUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeauthorizeUserDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeauthorizeUserOK creates a DeauthorizeUserOK with default headers values
+func NewDeauthorizeUserOK() *DeauthorizeUserOK {
+	return &DeauthorizeUserOK{}
+}
+
+/* DeauthorizeUserOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type DeauthorizeUserOK struct {
+	Payload *models.ManagedUser
+}
+
+func (o *DeauthorizeUserOK) Error() string {
+	return fmt.Sprintf("[POST /user/{uid}/deauthorize][%d] deauthorizeUserOK  %+v", 200, o.Payload)
+}
+func (o *DeauthorizeUserOK) GetPayload() *models.ManagedUser {
+	return o.Payload
+}
+
+func (o *DeauthorizeUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ManagedUser)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDeauthorizeUserDefault creates a DeauthorizeUserDefault with default headers values
+func NewDeauthorizeUserDefault(code int) *DeauthorizeUserDefault {
+	return &DeauthorizeUserDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeauthorizeUserDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DeauthorizeUserDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the deauthorize user default response
+func (o *DeauthorizeUserDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeauthorizeUserDefault) Error() string {
+	return fmt.Sprintf("[POST /user/{uid}/deauthorize][%d] deauthorizeUser default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeauthorizeUserDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeauthorizeUserDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeleteOrgUnitParams creates a new DeleteOrgUnitParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDeleteOrgUnitParams() *DeleteOrgUnitParams {
+	return &DeleteOrgUnitParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDeleteOrgUnitParamsWithTimeout creates a new DeleteOrgUnitParams object
+// with the ability to set a timeout on a request.
+func NewDeleteOrgUnitParamsWithTimeout(timeout time.Duration) *DeleteOrgUnitParams {
+	return &DeleteOrgUnitParams{
+		timeout: timeout,
+	}
+}
+
+// NewDeleteOrgUnitParamsWithContext creates a new DeleteOrgUnitParams object
+// with the ability to set a context for a request.
+func NewDeleteOrgUnitParamsWithContext(ctx context.Context) *DeleteOrgUnitParams {
+	return &DeleteOrgUnitParams{
+		Context: ctx,
+	}
+}
+
+// NewDeleteOrgUnitParamsWithHTTPClient creates a new DeleteOrgUnitParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDeleteOrgUnitParamsWithHTTPClient(client *http.Client) *DeleteOrgUnitParams {
+	return &DeleteOrgUnitParams{
+		HTTPClient: client,
+	}
+}
+
+/* DeleteOrgUnitParams contains all the parameters to send to the API endpoint
+   for the delete org unit operation.
+
+   Typically these are written to a http.Request.
+*/
+type DeleteOrgUnitParams struct {
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the delete org unit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteOrgUnitParams) WithDefaults() *DeleteOrgUnitParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the delete org unit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DeleteOrgUnitParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the delete org unit params
+func (o *DeleteOrgUnitParams) WithTimeout(timeout time.Duration) *DeleteOrgUnitParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the delete org unit params
+func (o *DeleteOrgUnitParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the delete org unit params
+func (o *DeleteOrgUnitParams) WithContext(ctx context.Context) *DeleteOrgUnitParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the delete org unit params
+func (o *DeleteOrgUnitParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the delete org unit params
+func (o *DeleteOrgUnitParams) WithHTTPClient(client *http.Client) *DeleteOrgUnitParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the delete org unit params
+func (o *DeleteOrgUnitParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the delete org unit params
+func (o *DeleteOrgUnitParams) WithID(id string) *DeleteOrgUnitParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the delete org unit params
+func (o *DeleteOrgUnitParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DeleteOrgUnitParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
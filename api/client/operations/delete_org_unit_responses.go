@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DeleteOrgUnitReader is a Reader for the DeleteOrgUnit structure.
+type DeleteOrgUnitReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteOrgUnitReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteOrgUnitNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeleteOrgUnitDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteOrgUnitNoContent creates a DeleteOrgUnitNoContent with default headers values
+func NewDeleteOrgUnitNoContent() *DeleteOrgUnitNoContent {
+	return &DeleteOrgUnitNoContent{}
+}
+
+/* DeleteOrgUnitNoContent describes a response with status code 204, with default header values.
+
+Deleted
+*/
+type DeleteOrgUnitNoContent struct {
+}
+
+func (o *DeleteOrgUnitNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /orgunit/{id}][%d] deleteOrgUnitNoContent ", 204)
+}
+
+func (o *DeleteOrgUnitNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteOrgUnitDefault creates a DeleteOrgUnitDefault with default headers values
+func NewDeleteOrgUnitDefault(code int) *DeleteOrgUnitDefault {
+	return &DeleteOrgUnitDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeleteOrgUnitDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DeleteOrgUnitDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the delete org unit default response
+func (o *DeleteOrgUnitDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteOrgUnitDefault) Error() string {
+	return fmt.Sprintf("[DELETE /orgunit/{id}][%d] deleteOrgUnit default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeleteOrgUnitDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteOrgUnitDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DeleteWebhookReader is a Reader for the DeleteWebhook structure.
+type DeleteWebhookReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DeleteWebhookReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewDeleteWebhookNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDeleteWebhookDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDeleteWebhookNoContent creates a DeleteWebhookNoContent with default headers values
+func NewDeleteWebhookNoContent() *DeleteWebhookNoContent {
+	return &DeleteWebhookNoContent{}
+}
+
+/* DeleteWebhookNoContent describes a response with status code 204, with default header values.
+
+Deleted
+*/
+type DeleteWebhookNoContent struct {
+}
+
+func (o *DeleteWebhookNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /webhooks/{id}][%d] deleteWebhookNoContent ", 204)
+}
+
+func (o *DeleteWebhookNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewDeleteWebhookDefault creates a DeleteWebhookDefault with default headers values
+func NewDeleteWebhookDefault(code int) *DeleteWebhookDefault {
+	return &DeleteWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+/* DeleteWebhookDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DeleteWebhookDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the delete webhook default response
+func (o *DeleteWebhookDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DeleteWebhookDefault) Error() string {
+	return fmt.Sprintf("[DELETE /webhooks/{id}][%d] deleteWebhook default  %+v", o._statusCode, o.Payload)
+}
+func (o *DeleteWebhookDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DeleteWebhookDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// NewDenyAccessRequestParams creates a new DenyAccessRequestParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewDenyAccessRequestParams() *DenyAccessRequestParams {
+	return &DenyAccessRequestParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewDenyAccessRequestParamsWithTimeout creates a new DenyAccessRequestParams object
+// with the ability to set a timeout on a request.
+func NewDenyAccessRequestParamsWithTimeout(timeout time.Duration) *DenyAccessRequestParams {
+	return &DenyAccessRequestParams{
+		timeout: timeout,
+	}
+}
+
+// NewDenyAccessRequestParamsWithContext creates a new DenyAccessRequestParams object
+// with the ability to set a context for a request.
+func NewDenyAccessRequestParamsWithContext(ctx context.Context) *DenyAccessRequestParams {
+	return &DenyAccessRequestParams{
+		Context: ctx,
+	}
+}
+
+// NewDenyAccessRequestParamsWithHTTPClient creates a new DenyAccessRequestParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewDenyAccessRequestParamsWithHTTPClient(client *http.Client) *DenyAccessRequestParams {
+	return &DenyAccessRequestParams{
+		HTTPClient: client,
+	}
+}
+
+/* DenyAccessRequestParams contains all the parameters to send to the API endpoint
+   for the deny access request operation.
+
+   Typically these are written to a http.Request.
+*/
+type DenyAccessRequestParams struct {
+
+	// Body.
+	Body *models.AccessDecision
+
+	// UID.
+	UID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the deny access request params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DenyAccessRequestParams) WithDefaults() *DenyAccessRequestParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the deny access request params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *DenyAccessRequestParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the deny access request params
+func (o *DenyAccessRequestParams) WithTimeout(timeout time.Duration) *DenyAccessRequestParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the deny access request params
+func (o *DenyAccessRequestParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the deny access request params
+func (o *DenyAccessRequestParams) WithContext(ctx context.Context) *DenyAccessRequestParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the deny access request params
+func (o *DenyAccessRequestParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the deny access request params
+func (o *DenyAccessRequestParams) WithHTTPClient(client *http.Client) *DenyAccessRequestParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the deny access request params
+func (o *DenyAccessRequestParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the deny access request params
+func (o *DenyAccessRequestParams) WithBody(body *models.AccessDecision) *DenyAccessRequestParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the deny access request params
+func (o *DenyAccessRequestParams) SetBody(body *models.AccessDecision) {
+	o.Body = body
+}
+
+// WithUID adds the uid to the deny access request params
+func (o *DenyAccessRequestParams) WithUID(uid string) *DenyAccessRequestParams {
+	o.SetUID(uid)
+	return o
+}
+
+// SetUID adds the uid to the deny access request params
+func (o *DenyAccessRequestParams) SetUID(uid string) {
+	o.UID = uid
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *DenyAccessRequestParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param uid
+	if err := r.SetPathParam("uid", o.UID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
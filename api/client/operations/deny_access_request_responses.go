@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DenyAccessRequestReader is a Reader for the DenyAccessRequest structure.
+type DenyAccessRequestReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *DenyAccessRequestReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewDenyAccessRequestOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewDenyAccessRequestDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewDenyAccessRequestOK creates a DenyAccessRequestOK with default headers values
+func NewDenyAccessRequestOK() *DenyAccessRequestOK {
+	return &DenyAccessRequestOK{}
+}
+
+/* DenyAccessRequestOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type DenyAccessRequestOK struct {
+	Payload *models.AccessRequest
+}
+
+func (o *DenyAccessRequestOK) Error() string {
+	return fmt.Sprintf("[POST /access-requests/{uid}/deny][%d] denyAccessRequestOK  %+v", 200, o.Payload)
+}
+func (o *DenyAccessRequestOK) GetPayload() *models.AccessRequest {
+	return o.Payload
+}
+
+func (o *DenyAccessRequestOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AccessRequest)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewDenyAccessRequestDefault creates a DenyAccessRequestDefault with default headers values
+func NewDenyAccessRequestDefault(code int) *DenyAccessRequestDefault {
+	return &DenyAccessRequestDefault{
+		_statusCode: code,
+	}
+}
+
+/* DenyAccessRequestDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type DenyAccessRequestDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the deny access request default response
+func (o *DenyAccessRequestDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *DenyAccessRequestDefault) Error() string {
+	return fmt.Sprintf("[POST /access-requests/{uid}/deny][%d] denyAccessRequest default  %+v", o._statusCode, o.Payload)
+}
+func (o *DenyAccessRequestDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *DenyAccessRequestDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetCommitPolicyParams creates a new GetCommitPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetCommitPolicyParams() *GetCommitPolicyParams {
+	return &GetCommitPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetCommitPolicyParamsWithTimeout creates a new GetCommitPolicyParams object
+// with the ability to set a timeout on a request.
+func NewGetCommitPolicyParamsWithTimeout(timeout time.Duration) *GetCommitPolicyParams {
+	return &GetCommitPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetCommitPolicyParamsWithContext creates a new GetCommitPolicyParams object
+// with the ability to set a context for a request.
+func NewGetCommitPolicyParamsWithContext(ctx context.Context) *GetCommitPolicyParams {
+	return &GetCommitPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewGetCommitPolicyParamsWithHTTPClient creates a new GetCommitPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetCommitPolicyParamsWithHTTPClient(client *http.Client) *GetCommitPolicyParams {
+	return &GetCommitPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetCommitPolicyParams contains all the parameters to send to the API endpoint
+   for the get commit policy operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetCommitPolicyParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get commit policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetCommitPolicyParams) WithDefaults() *GetCommitPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get commit policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetCommitPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get commit policy params
+func (o *GetCommitPolicyParams) WithTimeout(timeout time.Duration) *GetCommitPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get commit policy params
+func (o *GetCommitPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get commit policy params
+func (o *GetCommitPolicyParams) WithContext(ctx context.Context) *GetCommitPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get commit policy params
+func (o *GetCommitPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get commit policy params
+func (o *GetCommitPolicyParams) WithHTTPClient(client *http.Client) *GetCommitPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get commit policy params
+func (o *GetCommitPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetCommitPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
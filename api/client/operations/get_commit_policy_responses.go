@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// GetCommitPolicyReader is a Reader for the GetCommitPolicy structure.
+type GetCommitPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetCommitPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetCommitPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetCommitPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetCommitPolicyOK creates a GetCommitPolicyOK with default headers values
+func NewGetCommitPolicyOK() *GetCommitPolicyOK {
+	return &GetCommitPolicyOK{}
+}
+
+/* GetCommitPolicyOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetCommitPolicyOK struct {
+	Payload *lib.CommitPolicy
+}
+
+func (o *GetCommitPolicyOK) Error() string {
+	return fmt.Sprintf("[GET /commit-policy][%d] getCommitPolicyOK  %+v", 200, o.Payload)
+}
+func (o *GetCommitPolicyOK) GetPayload() *lib.CommitPolicy {
+	return o.Payload
+}
+
+func (o *GetCommitPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(lib.CommitPolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetCommitPolicyDefault creates a GetCommitPolicyDefault with default headers values
+func NewGetCommitPolicyDefault(code int) *GetCommitPolicyDefault {
+	return &GetCommitPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetCommitPolicyDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetCommitPolicyDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get commit policy default response
+func (o *GetCommitPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetCommitPolicyDefault) Error() string {
+	return fmt.Sprintf("[GET /commit-policy][%d] getCommitPolicy default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetCommitPolicyDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetCommitPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,354 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewGetMaturityMetricsParams creates a new GetMaturityMetricsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetMaturityMetricsParams() *GetMaturityMetricsParams {
+	return &GetMaturityMetricsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetMaturityMetricsParamsWithTimeout creates a new GetMaturityMetricsParams object
+// with the ability to set a timeout on a request.
+func NewGetMaturityMetricsParamsWithTimeout(timeout time.Duration) *GetMaturityMetricsParams {
+	return &GetMaturityMetricsParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetMaturityMetricsParamsWithContext creates a new GetMaturityMetricsParams object
+// with the ability to set a context for a request.
+func NewGetMaturityMetricsParamsWithContext(ctx context.Context) *GetMaturityMetricsParams {
+	return &GetMaturityMetricsParams{
+		Context: ctx,
+	}
+}
+
+// NewGetMaturityMetricsParamsWithHTTPClient creates a new GetMaturityMetricsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetMaturityMetricsParamsWithHTTPClient(client *http.Client) *GetMaturityMetricsParams {
+	return &GetMaturityMetricsParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetMaturityMetricsParams contains all the parameters to send to the API endpoint
+   for the get maturity metrics operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetMaturityMetricsParams struct {
+
+	/* DataClassification.
+
+	   Only include projects handling data of this classification
+	*/
+	DataClassification *string
+
+	/* InternetFacing.
+
+	   Only include projects that are (or aren't) internet facing
+	*/
+	InternetFacing *bool
+
+	/* OrgUnit.
+
+	   Only report on this org unit and its descendants
+	*/
+	OrgUnit *string
+
+	/* ProductType.
+
+	   Only include projects of this product type
+	*/
+	ProductType *string
+
+	/* Tag.
+
+	   Only include projects with all of these tags
+	*/
+	Tag []string
+
+	/* Tech.
+
+	   Only include projects using all of these technologies
+	*/
+	Tech []string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get maturity metrics params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetMaturityMetricsParams) WithDefaults() *GetMaturityMetricsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get maturity metrics params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetMaturityMetricsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get maturity metrics params
+func (o *GetMaturityMetricsParams) WithTimeout(timeout time.Duration) *GetMaturityMetricsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get maturity metrics params
+func (o *GetMaturityMetricsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get maturity metrics params
+func (o *GetMaturityMetricsParams) WithContext(ctx context.Context) *GetMaturityMetricsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get maturity metrics params
+func (o *GetMaturityMetricsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get maturity metrics params
+func (o *GetMaturityMetricsParams) WithHTTPClient(client *http.Client) *GetMaturityMetricsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get maturity metrics params
+func (o *GetMaturityMetricsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithDataClassification adds the dataClassification to the get maturity metrics params
+func (o *GetMaturityMetricsParams) WithDataClassification(dataClassification *string) *GetMaturityMetricsParams {
+	o.SetDataClassification(dataClassification)
+	return o
+}
+
+// SetDataClassification adds the dataClassification to the get maturity metrics params
+func (o *GetMaturityMetricsParams) SetDataClassification(dataClassification *string) {
+	o.DataClassification = dataClassification
+}
+
+// WithInternetFacing adds the internetFacing to the get maturity metrics params
+func (o *GetMaturityMetricsParams) WithInternetFacing(internetFacing *bool) *GetMaturityMetricsParams {
+	o.SetInternetFacing(internetFacing)
+	return o
+}
+
+// SetInternetFacing adds the internetFacing to the get maturity metrics params
+func (o *GetMaturityMetricsParams) SetInternetFacing(internetFacing *bool) {
+	o.InternetFacing = internetFacing
+}
+
+// WithOrgUnit adds the orgUnit to the get maturity metrics params
+func (o *GetMaturityMetricsParams) WithOrgUnit(orgUnit *string) *GetMaturityMetricsParams {
+	o.SetOrgUnit(orgUnit)
+	return o
+}
+
+// SetOrgUnit adds the orgUnit to the get maturity metrics params
+func (o *GetMaturityMetricsParams) SetOrgUnit(orgUnit *string) {
+	o.OrgUnit = orgUnit
+}
+
+// WithProductType adds the productType to the get maturity metrics params
+func (o *GetMaturityMetricsParams) WithProductType(productType *string) *GetMaturityMetricsParams {
+	o.SetProductType(productType)
+	return o
+}
+
+// SetProductType adds the productType to the get maturity metrics params
+func (o *GetMaturityMetricsParams) SetProductType(productType *string) {
+	o.ProductType = productType
+}
+
+// WithTag adds the tag to the get maturity metrics params
+func (o *GetMaturityMetricsParams) WithTag(tag []string) *GetMaturityMetricsParams {
+	o.SetTag(tag)
+	return o
+}
+
+// SetTag adds the tag to the get maturity metrics params
+func (o *GetMaturityMetricsParams) SetTag(tag []string) {
+	o.Tag = tag
+}
+
+// WithTech adds the tech to the get maturity metrics params
+func (o *GetMaturityMetricsParams) WithTech(tech []string) *GetMaturityMetricsParams {
+	o.SetTech(tech)
+	return o
+}
+
+// SetTech adds the tech to the get maturity metrics params
+func (o *GetMaturityMetricsParams) SetTech(tech []string) {
+	o.Tech = tech
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetMaturityMetricsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.DataClassification != nil {
+
+		// query param dataClassification
+		var qrDataClassification string
+
+		if o.DataClassification != nil {
+			qrDataClassification = *o.DataClassification
+		}
+		qDataClassification := qrDataClassification
+		if qDataClassification != "" {
+
+			if err := r.SetQueryParam("dataClassification", qDataClassification); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.InternetFacing != nil {
+
+		// query param internetFacing
+		var qrInternetFacing bool
+
+		if o.InternetFacing != nil {
+			qrInternetFacing = *o.InternetFacing
+		}
+		qInternetFacing := swag.FormatBool(qrInternetFacing)
+		if qInternetFacing != "" {
+
+			if err := r.SetQueryParam("internetFacing", qInternetFacing); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.OrgUnit != nil {
+
+		// query param orgUnit
+		var qrOrgUnit string
+
+		if o.OrgUnit != nil {
+			qrOrgUnit = *o.OrgUnit
+		}
+		qOrgUnit := qrOrgUnit
+		if qOrgUnit != "" {
+
+			if err := r.SetQueryParam("orgUnit", qOrgUnit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ProductType != nil {
+
+		// query param productType
+		var qrProductType string
+
+		if o.ProductType != nil {
+			qrProductType = *o.ProductType
+		}
+		qProductType := qrProductType
+		if qProductType != "" {
+
+			if err := r.SetQueryParam("productType", qProductType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Tag != nil {
+
+		// binding items for tag
+		joinedTag := o.bindParamTag(reg)
+
+		// query array param tag
+		if err := r.SetQueryParam("tag", joinedTag...); err != nil {
+			return err
+		}
+	}
+
+	if o.Tech != nil {
+
+		// binding items for tech
+		joinedTech := o.bindParamTech(reg)
+
+		// query array param tech
+		if err := r.SetQueryParam("tech", joinedTech...); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindParamGetMaturityMetrics binds the parameter tag
+func (o *GetMaturityMetricsParams) bindParamTag(formats strfmt.Registry) []string {
+	tagIR := o.Tag
+
+	var tagIC []string
+	for _, tagIIR := range tagIR { // explode []string
+
+		tagIIV := tagIIR // string as string
+		tagIC = append(tagIC, tagIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	tagIS := swag.JoinByFormat(tagIC, "multi")
+
+	return tagIS
+}
+
+// bindParamGetMaturityMetrics binds the parameter tech
+func (o *GetMaturityMetricsParams) bindParamTech(formats strfmt.Registry) []string {
+	techIR := o.Tech
+
+	var techIC []string
+	for _, techIIR := range techIR { // explode []string
+
+		techIIV := techIIR // string as string
+		techIC = append(techIC, techIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	techIS := swag.JoinByFormat(techIC, "multi")
+
+	return techIS
+}
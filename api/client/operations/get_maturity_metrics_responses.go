@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetMaturityMetricsReader is a Reader for the GetMaturityMetrics structure.
+type GetMaturityMetricsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetMaturityMetricsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetMaturityMetricsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetMaturityMetricsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetMaturityMetricsOK creates a GetMaturityMetricsOK with default headers values
+func NewGetMaturityMetricsOK() *GetMaturityMetricsOK {
+	return &GetMaturityMetricsOK{}
+}
+
+/* GetMaturityMetricsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetMaturityMetricsOK struct {
+	Payload []*models.OrgUnitMaturity
+}
+
+func (o *GetMaturityMetricsOK) Error() string {
+	return fmt.Sprintf("[GET /metrics/maturity][%d] getMaturityMetricsOK  %+v", 200, o.Payload)
+}
+func (o *GetMaturityMetricsOK) GetPayload() []*models.OrgUnitMaturity {
+	return o.Payload
+}
+
+func (o *GetMaturityMetricsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetMaturityMetricsDefault creates a GetMaturityMetricsDefault with default headers values
+func NewGetMaturityMetricsDefault(code int) *GetMaturityMetricsDefault {
+	return &GetMaturityMetricsDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetMaturityMetricsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetMaturityMetricsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get maturity metrics default response
+func (o *GetMaturityMetricsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetMaturityMetricsDefault) Error() string {
+	return fmt.Sprintf("[GET /metrics/maturity][%d] getMaturityMetrics default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetMaturityMetricsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetMaturityMetricsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
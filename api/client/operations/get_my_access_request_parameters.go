@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetMyAccessRequestParams creates a new GetMyAccessRequestParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetMyAccessRequestParams() *GetMyAccessRequestParams {
+	return &GetMyAccessRequestParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetMyAccessRequestParamsWithTimeout creates a new GetMyAccessRequestParams object
+// with the ability to set a timeout on a request.
+func NewGetMyAccessRequestParamsWithTimeout(timeout time.Duration) *GetMyAccessRequestParams {
+	return &GetMyAccessRequestParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetMyAccessRequestParamsWithContext creates a new GetMyAccessRequestParams object
+// with the ability to set a context for a request.
+func NewGetMyAccessRequestParamsWithContext(ctx context.Context) *GetMyAccessRequestParams {
+	return &GetMyAccessRequestParams{
+		Context: ctx,
+	}
+}
+
+// NewGetMyAccessRequestParamsWithHTTPClient creates a new GetMyAccessRequestParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetMyAccessRequestParamsWithHTTPClient(client *http.Client) *GetMyAccessRequestParams {
+	return &GetMyAccessRequestParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetMyAccessRequestParams contains all the parameters to send to the API endpoint
+   for the get my access request operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetMyAccessRequestParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get my access request params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetMyAccessRequestParams) WithDefaults() *GetMyAccessRequestParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get my access request params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetMyAccessRequestParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get my access request params
+func (o *GetMyAccessRequestParams) WithTimeout(timeout time.Duration) *GetMyAccessRequestParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get my access request params
+func (o *GetMyAccessRequestParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get my access request params
+func (o *GetMyAccessRequestParams) WithContext(ctx context.Context) *GetMyAccessRequestParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get my access request params
+func (o *GetMyAccessRequestParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get my access request params
+func (o *GetMyAccessRequestParams) WithHTTPClient(client *http.Client) *GetMyAccessRequestParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get my access request params
+func (o *GetMyAccessRequestParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetMyAccessRequestParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetMyAccessRequestReader is a Reader for the GetMyAccessRequest structure.
+type GetMyAccessRequestReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetMyAccessRequestReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetMyAccessRequestOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetMyAccessRequestDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetMyAccessRequestOK creates a GetMyAccessRequestOK with default headers values
+func NewGetMyAccessRequestOK() *GetMyAccessRequestOK {
+	return &GetMyAccessRequestOK{}
+}
+
+/* GetMyAccessRequestOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetMyAccessRequestOK struct {
+	Payload *models.AccessRequest
+}
+
+func (o *GetMyAccessRequestOK) Error() string {
+	return fmt.Sprintf("[GET /access-request][%d] getMyAccessRequestOK  %+v", 200, o.Payload)
+}
+func (o *GetMyAccessRequestOK) GetPayload() *models.AccessRequest {
+	return o.Payload
+}
+
+func (o *GetMyAccessRequestOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AccessRequest)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetMyAccessRequestDefault creates a GetMyAccessRequestDefault with default headers values
+func NewGetMyAccessRequestDefault(code int) *GetMyAccessRequestDefault {
+	return &GetMyAccessRequestDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetMyAccessRequestDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetMyAccessRequestDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get my access request default response
+func (o *GetMyAccessRequestDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetMyAccessRequestDefault) Error() string {
+	return fmt.Sprintf("[GET /access-request][%d] getMyAccessRequest default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetMyAccessRequestDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetMyAccessRequestDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
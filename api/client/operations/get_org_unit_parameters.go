@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetOrgUnitParams creates a new GetOrgUnitParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetOrgUnitParams() *GetOrgUnitParams {
+	return &GetOrgUnitParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetOrgUnitParamsWithTimeout creates a new GetOrgUnitParams object
+// with the ability to set a timeout on a request.
+func NewGetOrgUnitParamsWithTimeout(timeout time.Duration) *GetOrgUnitParams {
+	return &GetOrgUnitParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetOrgUnitParamsWithContext creates a new GetOrgUnitParams object
+// with the ability to set a context for a request.
+func NewGetOrgUnitParamsWithContext(ctx context.Context) *GetOrgUnitParams {
+	return &GetOrgUnitParams{
+		Context: ctx,
+	}
+}
+
+// NewGetOrgUnitParamsWithHTTPClient creates a new GetOrgUnitParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetOrgUnitParamsWithHTTPClient(client *http.Client) *GetOrgUnitParams {
+	return &GetOrgUnitParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetOrgUnitParams contains all the parameters to send to the API endpoint
+   for the get org unit operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetOrgUnitParams struct {
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get org unit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetOrgUnitParams) WithDefaults() *GetOrgUnitParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get org unit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetOrgUnitParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get org unit params
+func (o *GetOrgUnitParams) WithTimeout(timeout time.Duration) *GetOrgUnitParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get org unit params
+func (o *GetOrgUnitParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get org unit params
+func (o *GetOrgUnitParams) WithContext(ctx context.Context) *GetOrgUnitParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get org unit params
+func (o *GetOrgUnitParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get org unit params
+func (o *GetOrgUnitParams) WithHTTPClient(client *http.Client) *GetOrgUnitParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get org unit params
+func (o *GetOrgUnitParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the get org unit params
+func (o *GetOrgUnitParams) WithID(id string) *GetOrgUnitParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get org unit params
+func (o *GetOrgUnitParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetOrgUnitParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
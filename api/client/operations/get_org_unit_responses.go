@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetOrgUnitReader is a Reader for the GetOrgUnit structure.
+type GetOrgUnitReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetOrgUnitReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetOrgUnitOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetOrgUnitDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetOrgUnitOK creates a GetOrgUnitOK with default headers values
+func NewGetOrgUnitOK() *GetOrgUnitOK {
+	return &GetOrgUnitOK{}
+}
+
+/* GetOrgUnitOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetOrgUnitOK struct {
+	Payload *models.OrgUnit
+}
+
+func (o *GetOrgUnitOK) Error() string {
+	return fmt.Sprintf("[GET /orgunit/{id}][%d] getOrgUnitOK  %+v", 200, o.Payload)
+}
+func (o *GetOrgUnitOK) GetPayload() *models.OrgUnit {
+	return o.Payload
+}
+
+func (o *GetOrgUnitOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.OrgUnit)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetOrgUnitDefault creates a GetOrgUnitDefault with default headers values
+func NewGetOrgUnitDefault(code int) *GetOrgUnitDefault {
+	return &GetOrgUnitDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetOrgUnitDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetOrgUnitDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get org unit default response
+func (o *GetOrgUnitDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetOrgUnitDefault) Error() string {
+	return fmt.Sprintf("[GET /orgunit/{id}][%d] getOrgUnit default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetOrgUnitDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetOrgUnitDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
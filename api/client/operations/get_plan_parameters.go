@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetPlanParams creates a new GetPlanParams object,
@@ -62,6 +63,12 @@ type GetPlanParams struct {
 	// ID.
 	ID string
 
+	/* VerifyChain.
+
+	   Check the integrity of the plan's revision history, reporting it in the chain property
+	*/
+	VerifyChain *bool
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -126,6 +133,17 @@ func (o *GetPlanParams) SetID(id string) {
 	o.ID = id
 }
 
+// WithVerifyChain adds the verifyChain to the get plan params
+func (o *GetPlanParams) WithVerifyChain(verifyChain *bool) *GetPlanParams {
+	o.SetVerifyChain(verifyChain)
+	return o
+}
+
+// SetVerifyChain adds the verifyChain to the get plan params
+func (o *GetPlanParams) SetVerifyChain(verifyChain *bool) {
+	o.VerifyChain = verifyChain
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *GetPlanParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -139,6 +157,23 @@ func (o *GetPlanParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Regis
 		return err
 	}
 
+	if o.VerifyChain != nil {
+
+		// query param verifyChain
+		var qrVerifyChain bool
+
+		if o.VerifyChain != nil {
+			qrVerifyChain = *o.VerifyChain
+		}
+		qVerifyChain := swag.FormatBool(qrVerifyChain)
+		if qVerifyChain != "" {
+
+			if err := r.SetQueryParam("verifyChain", qVerifyChain); err != nil {
+				return err
+			}
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetPlanRevisionAttestationParams creates a new GetPlanRevisionAttestationParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetPlanRevisionAttestationParams() *GetPlanRevisionAttestationParams {
+	return &GetPlanRevisionAttestationParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetPlanRevisionAttestationParamsWithTimeout creates a new GetPlanRevisionAttestationParams object
+// with the ability to set a timeout on a request.
+func NewGetPlanRevisionAttestationParamsWithTimeout(timeout time.Duration) *GetPlanRevisionAttestationParams {
+	return &GetPlanRevisionAttestationParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetPlanRevisionAttestationParamsWithContext creates a new GetPlanRevisionAttestationParams object
+// with the ability to set a context for a request.
+func NewGetPlanRevisionAttestationParamsWithContext(ctx context.Context) *GetPlanRevisionAttestationParams {
+	return &GetPlanRevisionAttestationParams{
+		Context: ctx,
+	}
+}
+
+// NewGetPlanRevisionAttestationParamsWithHTTPClient creates a new GetPlanRevisionAttestationParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetPlanRevisionAttestationParamsWithHTTPClient(client *http.Client) *GetPlanRevisionAttestationParams {
+	return &GetPlanRevisionAttestationParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetPlanRevisionAttestationParams contains all the parameters to send to the API endpoint
+   for the get plan revision attestation operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetPlanRevisionAttestationParams struct {
+
+	// ID.
+	ID string
+
+	// RevID.
+	RevID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get plan revision attestation params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetPlanRevisionAttestationParams) WithDefaults() *GetPlanRevisionAttestationParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get plan revision attestation params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetPlanRevisionAttestationParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) WithTimeout(timeout time.Duration) *GetPlanRevisionAttestationParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) WithContext(ctx context.Context) *GetPlanRevisionAttestationParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) WithHTTPClient(client *http.Client) *GetPlanRevisionAttestationParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) WithID(id string) *GetPlanRevisionAttestationParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithRevID adds the revID to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) WithRevID(revID string) *GetPlanRevisionAttestationParams {
+	o.SetRevID(revID)
+	return o
+}
+
+// SetRevID adds the revId to the get plan revision attestation params
+func (o *GetPlanRevisionAttestationParams) SetRevID(revID string) {
+	o.RevID = revID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetPlanRevisionAttestationParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	// path param revId
+	if err := r.SetPathParam("revId", o.RevID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetPlanRevisionAttestationReader is a Reader for the GetPlanRevisionAttestation structure.
+type GetPlanRevisionAttestationReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetPlanRevisionAttestationReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetPlanRevisionAttestationOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetPlanRevisionAttestationDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetPlanRevisionAttestationOK creates a GetPlanRevisionAttestationOK with default headers values
+func NewGetPlanRevisionAttestationOK() *GetPlanRevisionAttestationOK {
+	return &GetPlanRevisionAttestationOK{}
+}
+
+/* GetPlanRevisionAttestationOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetPlanRevisionAttestationOK struct {
+	Payload *models.DsseEnvelope
+}
+
+func (o *GetPlanRevisionAttestationOK) Error() string {
+	return fmt.Sprintf("[GET /plan/{id}/revision/{revId}/attestation][%d] getPlanRevisionAttestationOK  %+v", 200, o.Payload)
+}
+func (o *GetPlanRevisionAttestationOK) GetPayload() *models.DsseEnvelope {
+	return o.Payload
+}
+
+func (o *GetPlanRevisionAttestationOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.DsseEnvelope)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetPlanRevisionAttestationDefault creates a GetPlanRevisionAttestationDefault with default headers values
+func NewGetPlanRevisionAttestationDefault(code int) *GetPlanRevisionAttestationDefault {
+	return &GetPlanRevisionAttestationDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetPlanRevisionAttestationDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetPlanRevisionAttestationDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get plan revision attestation default response
+func (o *GetPlanRevisionAttestationDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetPlanRevisionAttestationDefault) Error() string {
+	return fmt.Sprintf("[GET /plan/{id}/revision/{revId}/attestation][%d] getPlanRevisionAttestation default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetPlanRevisionAttestationDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetPlanRevisionAttestationDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
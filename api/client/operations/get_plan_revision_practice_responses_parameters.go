@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetPlanRevisionPracticeResponsesParams creates a new GetPlanRevisionPracticeResponsesParams object,
@@ -62,6 +63,18 @@ type GetPlanRevisionPracticeResponsesParams struct {
 	// ID.
 	ID string
 
+	/* LinkedIssues.
+
+	   Fetch the title and state of each task's issues from the configured issue trackers
+	*/
+	LinkedIssues *bool
+
+	/* NextRevision.
+
+	   Prepare the responses as the starting point for a new revision, answering Yes to the questions of tasks marked as done
+	*/
+	NextRevision *bool
+
 	// RevID.
 	RevID string
 
@@ -129,6 +142,28 @@ func (o *GetPlanRevisionPracticeResponsesParams) SetID(id string) {
 	o.ID = id
 }
 
+// WithLinkedIssues adds the linkedIssues to the get plan revision practice responses params
+func (o *GetPlanRevisionPracticeResponsesParams) WithLinkedIssues(linkedIssues *bool) *GetPlanRevisionPracticeResponsesParams {
+	o.SetLinkedIssues(linkedIssues)
+	return o
+}
+
+// SetLinkedIssues adds the linkedIssues to the get plan revision practice responses params
+func (o *GetPlanRevisionPracticeResponsesParams) SetLinkedIssues(linkedIssues *bool) {
+	o.LinkedIssues = linkedIssues
+}
+
+// WithNextRevision adds the nextRevision to the get plan revision practice responses params
+func (o *GetPlanRevisionPracticeResponsesParams) WithNextRevision(nextRevision *bool) *GetPlanRevisionPracticeResponsesParams {
+	o.SetNextRevision(nextRevision)
+	return o
+}
+
+// SetNextRevision adds the nextRevision to the get plan revision practice responses params
+func (o *GetPlanRevisionPracticeResponsesParams) SetNextRevision(nextRevision *bool) {
+	o.NextRevision = nextRevision
+}
+
 // WithRevID adds the revID to the get plan revision practice responses params
 func (o *GetPlanRevisionPracticeResponsesParams) WithRevID(revID string) *GetPlanRevisionPracticeResponsesParams {
 	o.SetRevID(revID)
@@ -153,6 +188,40 @@ func (o *GetPlanRevisionPracticeResponsesParams) WriteToRequest(r runtime.Client
 		return err
 	}
 
+	if o.LinkedIssues != nil {
+
+		// query param linkedIssues
+		var qrLinkedIssues bool
+
+		if o.LinkedIssues != nil {
+			qrLinkedIssues = *o.LinkedIssues
+		}
+		qLinkedIssues := swag.FormatBool(qrLinkedIssues)
+		if qLinkedIssues != "" {
+
+			if err := r.SetQueryParam("linkedIssues", qLinkedIssues); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.NextRevision != nil {
+
+		// query param nextRevision
+		var qrNextRevision bool
+
+		if o.NextRevision != nil {
+			qrNextRevision = *o.NextRevision
+		}
+		qNextRevision := swag.FormatBool(qrNextRevision)
+		if qNextRevision != "" {
+
+			if err := r.SetQueryParam("nextRevision", qNextRevision); err != nil {
+				return err
+			}
+		}
+	}
+
 	// path param revId
 	if err := r.SetPathParam("revId", o.RevID); err != nil {
 		return err
@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewGetProjectSubscriptionParams creates a new GetProjectSubscriptionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewGetProjectSubscriptionParams() *GetProjectSubscriptionParams {
+	return &GetProjectSubscriptionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewGetProjectSubscriptionParamsWithTimeout creates a new GetProjectSubscriptionParams object
+// with the ability to set a timeout on a request.
+func NewGetProjectSubscriptionParamsWithTimeout(timeout time.Duration) *GetProjectSubscriptionParams {
+	return &GetProjectSubscriptionParams{
+		timeout: timeout,
+	}
+}
+
+// NewGetProjectSubscriptionParamsWithContext creates a new GetProjectSubscriptionParams object
+// with the ability to set a context for a request.
+func NewGetProjectSubscriptionParamsWithContext(ctx context.Context) *GetProjectSubscriptionParams {
+	return &GetProjectSubscriptionParams{
+		Context: ctx,
+	}
+}
+
+// NewGetProjectSubscriptionParamsWithHTTPClient creates a new GetProjectSubscriptionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewGetProjectSubscriptionParamsWithHTTPClient(client *http.Client) *GetProjectSubscriptionParams {
+	return &GetProjectSubscriptionParams{
+		HTTPClient: client,
+	}
+}
+
+/* GetProjectSubscriptionParams contains all the parameters to send to the API endpoint
+   for the get project subscription operation.
+
+   Typically these are written to a http.Request.
+*/
+type GetProjectSubscriptionParams struct {
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the get project subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetProjectSubscriptionParams) WithDefaults() *GetProjectSubscriptionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the get project subscription params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *GetProjectSubscriptionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the get project subscription params
+func (o *GetProjectSubscriptionParams) WithTimeout(timeout time.Duration) *GetProjectSubscriptionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the get project subscription params
+func (o *GetProjectSubscriptionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the get project subscription params
+func (o *GetProjectSubscriptionParams) WithContext(ctx context.Context) *GetProjectSubscriptionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the get project subscription params
+func (o *GetProjectSubscriptionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the get project subscription params
+func (o *GetProjectSubscriptionParams) WithHTTPClient(client *http.Client) *GetProjectSubscriptionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the get project subscription params
+func (o *GetProjectSubscriptionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the get project subscription params
+func (o *GetProjectSubscriptionParams) WithID(id string) *GetProjectSubscriptionParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the get project subscription params
+func (o *GetProjectSubscriptionParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *GetProjectSubscriptionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
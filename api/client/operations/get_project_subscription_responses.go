@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetProjectSubscriptionReader is a Reader for the GetProjectSubscription structure.
+type GetProjectSubscriptionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetProjectSubscriptionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetProjectSubscriptionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetProjectSubscriptionDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetProjectSubscriptionOK creates a GetProjectSubscriptionOK with default headers values
+func NewGetProjectSubscriptionOK() *GetProjectSubscriptionOK {
+	return &GetProjectSubscriptionOK{}
+}
+
+/* GetProjectSubscriptionOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetProjectSubscriptionOK struct {
+	Payload *models.Subscription
+}
+
+func (o *GetProjectSubscriptionOK) Error() string {
+	return fmt.Sprintf("[GET /project/{id}/subscription][%d] getProjectSubscriptionOK  %+v", 200, o.Payload)
+}
+func (o *GetProjectSubscriptionOK) GetPayload() *models.Subscription {
+	return o.Payload
+}
+
+func (o *GetProjectSubscriptionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Subscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetProjectSubscriptionDefault creates a GetProjectSubscriptionDefault with default headers values
+func NewGetProjectSubscriptionDefault(code int) *GetProjectSubscriptionDefault {
+	return &GetProjectSubscriptionDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetProjectSubscriptionDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetProjectSubscriptionDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get project subscription default response
+func (o *GetProjectSubscriptionDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetProjectSubscriptionDefault) Error() string {
+	return fmt.Sprintf("[GET /project/{id}/subscription][%d] getProjectSubscription default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetProjectSubscriptionDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetProjectSubscriptionDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetUserReader is a Reader for the GetUser structure.
+type GetUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetUserOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetUserDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetUserOK creates a GetUserOK with default headers values
+func NewGetUserOK() *GetUserOK {
+	return &GetUserOK{}
+}
+
+/* GetUserOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetUserOK struct {
+	Payload *models.ManagedUser
+}
+
+func (o *GetUserOK) Error() string {
+	return fmt.Sprintf("[GET /user/{uid}][%d] getUserOK  %+v", 200, o.Payload)
+}
+func (o *GetUserOK) GetPayload() *models.ManagedUser {
+	return o.Payload
+}
+
+func (o *GetUserOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.ManagedUser)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUserDefault creates a GetUserDefault with default headers values
+func NewGetUserDefault(code int) *GetUserDefault {
+	return &GetUserDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetUserDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetUserDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get user default response
+func (o *GetUserDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetUserDefault) Error() string {
+	return fmt.Sprintf("[GET /user/{uid}][%d] getUser default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetUserDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetUserDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
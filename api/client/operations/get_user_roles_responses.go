@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetUserRolesReader is a Reader for the GetUserRoles structure.
+type GetUserRolesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *GetUserRolesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewGetUserRolesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewGetUserRolesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewGetUserRolesOK creates a GetUserRolesOK with default headers values
+func NewGetUserRolesOK() *GetUserRolesOK {
+	return &GetUserRolesOK{}
+}
+
+/* GetUserRolesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type GetUserRolesOK struct {
+	Payload models.Roles
+}
+
+func (o *GetUserRolesOK) Error() string {
+	return fmt.Sprintf("[GET /user/{uid}/roles][%d] getUserRolesOK  %+v", 200, o.Payload)
+}
+func (o *GetUserRolesOK) GetPayload() models.Roles {
+	return o.Payload
+}
+
+func (o *GetUserRolesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewGetUserRolesDefault creates a GetUserRolesDefault with default headers values
+func NewGetUserRolesDefault(code int) *GetUserRolesDefault {
+	return &GetUserRolesDefault{
+		_statusCode: code,
+	}
+}
+
+/* GetUserRolesDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type GetUserRolesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the get user roles default response
+func (o *GetUserRolesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *GetUserRolesDefault) Error() string {
+	return fmt.Sprintf("[GET /user/{uid}/roles][%d] getUserRoles default  %+v", o._statusCode, o.Payload)
+}
+func (o *GetUserRolesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *GetUserRolesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListAccessRequestsParams creates a new ListAccessRequestsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListAccessRequestsParams() *ListAccessRequestsParams {
+	return &ListAccessRequestsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAccessRequestsParamsWithTimeout creates a new ListAccessRequestsParams object
+// with the ability to set a timeout on a request.
+func NewListAccessRequestsParamsWithTimeout(timeout time.Duration) *ListAccessRequestsParams {
+	return &ListAccessRequestsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListAccessRequestsParamsWithContext creates a new ListAccessRequestsParams object
+// with the ability to set a context for a request.
+func NewListAccessRequestsParamsWithContext(ctx context.Context) *ListAccessRequestsParams {
+	return &ListAccessRequestsParams{
+		Context: ctx,
+	}
+}
+
+// NewListAccessRequestsParamsWithHTTPClient creates a new ListAccessRequestsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListAccessRequestsParamsWithHTTPClient(client *http.Client) *ListAccessRequestsParams {
+	return &ListAccessRequestsParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListAccessRequestsParams contains all the parameters to send to the API endpoint
+   for the list access requests operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListAccessRequestsParams struct {
+
+	/* Status.
+
+	   Only list requests with this status
+	*/
+	Status *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list access requests params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAccessRequestsParams) WithDefaults() *ListAccessRequestsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list access requests params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAccessRequestsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list access requests params
+func (o *ListAccessRequestsParams) WithTimeout(timeout time.Duration) *ListAccessRequestsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list access requests params
+func (o *ListAccessRequestsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list access requests params
+func (o *ListAccessRequestsParams) WithContext(ctx context.Context) *ListAccessRequestsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list access requests params
+func (o *ListAccessRequestsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list access requests params
+func (o *ListAccessRequestsParams) WithHTTPClient(client *http.Client) *ListAccessRequestsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list access requests params
+func (o *ListAccessRequestsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithStatus adds the status to the list access requests params
+func (o *ListAccessRequestsParams) WithStatus(status *string) *ListAccessRequestsParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the list access requests params
+func (o *ListAccessRequestsParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAccessRequestsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
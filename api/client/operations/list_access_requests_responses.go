@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListAccessRequestsReader is a Reader for the ListAccessRequests structure.
+type ListAccessRequestsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAccessRequestsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAccessRequestsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListAccessRequestsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListAccessRequestsOK creates a ListAccessRequestsOK with default headers values
+func NewListAccessRequestsOK() *ListAccessRequestsOK {
+	return &ListAccessRequestsOK{}
+}
+
+/* ListAccessRequestsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListAccessRequestsOK struct {
+	Payload []*models.AccessRequest
+}
+
+func (o *ListAccessRequestsOK) Error() string {
+	return fmt.Sprintf("[GET /access-requests][%d] listAccessRequestsOK  %+v", 200, o.Payload)
+}
+func (o *ListAccessRequestsOK) GetPayload() []*models.AccessRequest {
+	return o.Payload
+}
+
+func (o *ListAccessRequestsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAccessRequestsDefault creates a ListAccessRequestsDefault with default headers values
+func NewListAccessRequestsDefault(code int) *ListAccessRequestsDefault {
+	return &ListAccessRequestsDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListAccessRequestsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListAccessRequestsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list access requests default response
+func (o *ListAccessRequestsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListAccessRequestsDefault) Error() string {
+	return fmt.Sprintf("[GET /access-requests][%d] listAccessRequests default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListAccessRequestsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListAccessRequestsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
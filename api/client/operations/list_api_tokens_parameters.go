@@ -0,0 +1,162 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListAPITokensParams creates a new ListAPITokensParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListAPITokensParams() *ListAPITokensParams {
+	return &ListAPITokensParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAPITokensParamsWithTimeout creates a new ListAPITokensParams object
+// with the ability to set a timeout on a request.
+func NewListAPITokensParamsWithTimeout(timeout time.Duration) *ListAPITokensParams {
+	return &ListAPITokensParams{
+		timeout: timeout,
+	}
+}
+
+// NewListAPITokensParamsWithContext creates a new ListAPITokensParams object
+// with the ability to set a context for a request.
+func NewListAPITokensParamsWithContext(ctx context.Context) *ListAPITokensParams {
+	return &ListAPITokensParams{
+		Context: ctx,
+	}
+}
+
+// NewListAPITokensParamsWithHTTPClient creates a new ListAPITokensParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListAPITokensParamsWithHTTPClient(client *http.Client) *ListAPITokensParams {
+	return &ListAPITokensParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListAPITokensParams contains all the parameters to send to the API endpoint
+   for the list Api tokens operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListAPITokensParams struct {
+
+	/* All.
+
+	   List every user's and service account's tokens, not just the caller's
+	*/
+	All *bool
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list Api tokens params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAPITokensParams) WithDefaults() *ListAPITokensParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list Api tokens params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAPITokensParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list Api tokens params
+func (o *ListAPITokensParams) WithTimeout(timeout time.Duration) *ListAPITokensParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list Api tokens params
+func (o *ListAPITokensParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list Api tokens params
+func (o *ListAPITokensParams) WithContext(ctx context.Context) *ListAPITokensParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list Api tokens params
+func (o *ListAPITokensParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list Api tokens params
+func (o *ListAPITokensParams) WithHTTPClient(client *http.Client) *ListAPITokensParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list Api tokens params
+func (o *ListAPITokensParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAll adds the all to the list Api tokens params
+func (o *ListAPITokensParams) WithAll(all *bool) *ListAPITokensParams {
+	o.SetAll(all)
+	return o
+}
+
+// SetAll adds the all to the list Api tokens params
+func (o *ListAPITokensParams) SetAll(all *bool) {
+	o.All = all
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAPITokensParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.All != nil {
+
+		// query param all
+		var qrAll bool
+
+		if o.All != nil {
+			qrAll = *o.All
+		}
+		qAll := swag.FormatBool(qrAll)
+		if qAll != "" {
+
+			if err := r.SetQueryParam("all", qAll); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
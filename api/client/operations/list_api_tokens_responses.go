@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListAPITokensReader is a Reader for the ListAPITokens structure.
+type ListAPITokensReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAPITokensReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAPITokensOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListAPITokensDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListAPITokensOK creates a ListAPITokensOK with default headers values
+func NewListAPITokensOK() *ListAPITokensOK {
+	return &ListAPITokensOK{}
+}
+
+/* ListAPITokensOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListAPITokensOK struct {
+	Payload []*models.APIToken
+}
+
+func (o *ListAPITokensOK) Error() string {
+	return fmt.Sprintf("[GET /tokens][%d] listApiTokensOK  %+v", 200, o.Payload)
+}
+func (o *ListAPITokensOK) GetPayload() []*models.APIToken {
+	return o.Payload
+}
+
+func (o *ListAPITokensOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAPITokensDefault creates a ListAPITokensDefault with default headers values
+func NewListAPITokensDefault(code int) *ListAPITokensDefault {
+	return &ListAPITokensDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListAPITokensDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListAPITokensDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list Api tokens default response
+func (o *ListAPITokensDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListAPITokensDefault) Error() string {
+	return fmt.Sprintf("[GET /tokens][%d] listApiTokens default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListAPITokensDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListAPITokensDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,361 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListAuditEventsParams creates a new ListAuditEventsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListAuditEventsParams() *ListAuditEventsParams {
+	return &ListAuditEventsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListAuditEventsParamsWithTimeout creates a new ListAuditEventsParams object
+// with the ability to set a timeout on a request.
+func NewListAuditEventsParamsWithTimeout(timeout time.Duration) *ListAuditEventsParams {
+	return &ListAuditEventsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListAuditEventsParamsWithContext creates a new ListAuditEventsParams object
+// with the ability to set a context for a request.
+func NewListAuditEventsParamsWithContext(ctx context.Context) *ListAuditEventsParams {
+	return &ListAuditEventsParams{
+		Context: ctx,
+	}
+}
+
+// NewListAuditEventsParamsWithHTTPClient creates a new ListAuditEventsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListAuditEventsParamsWithHTTPClient(client *http.Client) *ListAuditEventsParams {
+	return &ListAuditEventsParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListAuditEventsParams contains all the parameters to send to the API endpoint
+   for the list audit events operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListAuditEventsParams struct {
+
+	/* Action.
+
+	   e.g. project.delete
+	*/
+	Action *string
+
+	/* Actor.
+
+	   The UID of the user or service account that made the changes
+	*/
+	Actor *string
+
+	/* Limit.
+
+	   The maximum number of events to return, 100 if not set
+	*/
+	Limit *int64
+
+	// Since.
+	//
+	// Format: date-time
+	Since *strfmt.DateTime
+
+	// TargetID.
+	TargetID *string
+
+	/* TargetType.
+
+	   e.g. project
+	*/
+	TargetType *string
+
+	// Until.
+	//
+	// Format: date-time
+	Until *strfmt.DateTime
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list audit events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAuditEventsParams) WithDefaults() *ListAuditEventsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list audit events params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListAuditEventsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list audit events params
+func (o *ListAuditEventsParams) WithTimeout(timeout time.Duration) *ListAuditEventsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list audit events params
+func (o *ListAuditEventsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list audit events params
+func (o *ListAuditEventsParams) WithContext(ctx context.Context) *ListAuditEventsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list audit events params
+func (o *ListAuditEventsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list audit events params
+func (o *ListAuditEventsParams) WithHTTPClient(client *http.Client) *ListAuditEventsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list audit events params
+func (o *ListAuditEventsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAction adds the action to the list audit events params
+func (o *ListAuditEventsParams) WithAction(action *string) *ListAuditEventsParams {
+	o.SetAction(action)
+	return o
+}
+
+// SetAction adds the action to the list audit events params
+func (o *ListAuditEventsParams) SetAction(action *string) {
+	o.Action = action
+}
+
+// WithActor adds the actor to the list audit events params
+func (o *ListAuditEventsParams) WithActor(actor *string) *ListAuditEventsParams {
+	o.SetActor(actor)
+	return o
+}
+
+// SetActor adds the actor to the list audit events params
+func (o *ListAuditEventsParams) SetActor(actor *string) {
+	o.Actor = actor
+}
+
+// WithLimit adds the limit to the list audit events params
+func (o *ListAuditEventsParams) WithLimit(limit *int64) *ListAuditEventsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list audit events params
+func (o *ListAuditEventsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithSince adds the since to the list audit events params
+func (o *ListAuditEventsParams) WithSince(since *strfmt.DateTime) *ListAuditEventsParams {
+	o.SetSince(since)
+	return o
+}
+
+// SetSince adds the since to the list audit events params
+func (o *ListAuditEventsParams) SetSince(since *strfmt.DateTime) {
+	o.Since = since
+}
+
+// WithTargetID adds the targetID to the list audit events params
+func (o *ListAuditEventsParams) WithTargetID(targetID *string) *ListAuditEventsParams {
+	o.SetTargetID(targetID)
+	return o
+}
+
+// SetTargetID adds the targetId to the list audit events params
+func (o *ListAuditEventsParams) SetTargetID(targetID *string) {
+	o.TargetID = targetID
+}
+
+// WithTargetType adds the targetType to the list audit events params
+func (o *ListAuditEventsParams) WithTargetType(targetType *string) *ListAuditEventsParams {
+	o.SetTargetType(targetType)
+	return o
+}
+
+// SetTargetType adds the targetType to the list audit events params
+func (o *ListAuditEventsParams) SetTargetType(targetType *string) {
+	o.TargetType = targetType
+}
+
+// WithUntil adds the until to the list audit events params
+func (o *ListAuditEventsParams) WithUntil(until *strfmt.DateTime) *ListAuditEventsParams {
+	o.SetUntil(until)
+	return o
+}
+
+// SetUntil adds the until to the list audit events params
+func (o *ListAuditEventsParams) SetUntil(until *strfmt.DateTime) {
+	o.Until = until
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListAuditEventsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Action != nil {
+
+		// query param action
+		var qrAction string
+
+		if o.Action != nil {
+			qrAction = *o.Action
+		}
+		qAction := qrAction
+		if qAction != "" {
+
+			if err := r.SetQueryParam("action", qAction); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Actor != nil {
+
+		// query param actor
+		var qrActor string
+
+		if o.Actor != nil {
+			qrActor = *o.Actor
+		}
+		qActor := qrActor
+		if qActor != "" {
+
+			if err := r.SetQueryParam("actor", qActor); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Since != nil {
+
+		// query param since
+		var qrSince strfmt.DateTime
+
+		if o.Since != nil {
+			qrSince = *o.Since
+		}
+		qSince := qrSince.String()
+		if qSince != "" {
+
+			if err := r.SetQueryParam("since", qSince); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.TargetID != nil {
+
+		// query param targetId
+		var qrTargetID string
+
+		if o.TargetID != nil {
+			qrTargetID = *o.TargetID
+		}
+		qTargetID := qrTargetID
+		if qTargetID != "" {
+
+			if err := r.SetQueryParam("targetId", qTargetID); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.TargetType != nil {
+
+		// query param targetType
+		var qrTargetType string
+
+		if o.TargetType != nil {
+			qrTargetType = *o.TargetType
+		}
+		qTargetType := qrTargetType
+		if qTargetType != "" {
+
+			if err := r.SetQueryParam("targetType", qTargetType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Until != nil {
+
+		// query param until
+		var qrUntil strfmt.DateTime
+
+		if o.Until != nil {
+			qrUntil = *o.Until
+		}
+		qUntil := qrUntil.String()
+		if qUntil != "" {
+
+			if err := r.SetQueryParam("until", qUntil); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListAuditEventsReader is a Reader for the ListAuditEvents structure.
+type ListAuditEventsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListAuditEventsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListAuditEventsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListAuditEventsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListAuditEventsOK creates a ListAuditEventsOK with default headers values
+func NewListAuditEventsOK() *ListAuditEventsOK {
+	return &ListAuditEventsOK{}
+}
+
+/* ListAuditEventsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListAuditEventsOK struct {
+	Payload []*models.AuditEvent
+}
+
+func (o *ListAuditEventsOK) Error() string {
+	return fmt.Sprintf("[GET /audit][%d] listAuditEventsOK  %+v", 200, o.Payload)
+}
+func (o *ListAuditEventsOK) GetPayload() []*models.AuditEvent {
+	return o.Payload
+}
+
+func (o *ListAuditEventsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListAuditEventsDefault creates a ListAuditEventsDefault with default headers values
+func NewListAuditEventsDefault(code int) *ListAuditEventsDefault {
+	return &ListAuditEventsDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListAuditEventsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListAuditEventsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list audit events default response
+func (o *ListAuditEventsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListAuditEventsDefault) Error() string {
+	return fmt.Sprintf("[GET /audit][%d] listAuditEvents default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListAuditEventsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListAuditEventsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,193 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListNotificationsParams creates a new ListNotificationsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListNotificationsParams() *ListNotificationsParams {
+	return &ListNotificationsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListNotificationsParamsWithTimeout creates a new ListNotificationsParams object
+// with the ability to set a timeout on a request.
+func NewListNotificationsParamsWithTimeout(timeout time.Duration) *ListNotificationsParams {
+	return &ListNotificationsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListNotificationsParamsWithContext creates a new ListNotificationsParams object
+// with the ability to set a context for a request.
+func NewListNotificationsParamsWithContext(ctx context.Context) *ListNotificationsParams {
+	return &ListNotificationsParams{
+		Context: ctx,
+	}
+}
+
+// NewListNotificationsParamsWithHTTPClient creates a new ListNotificationsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListNotificationsParamsWithHTTPClient(client *http.Client) *ListNotificationsParams {
+	return &ListNotificationsParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListNotificationsParams contains all the parameters to send to the API endpoint
+   for the list notifications operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListNotificationsParams struct {
+
+	/* Limit.
+
+	   The maximum number of messages to return, 100 if not set
+	*/
+	Limit *int64
+
+	// Status.
+	Status *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list notifications params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListNotificationsParams) WithDefaults() *ListNotificationsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list notifications params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListNotificationsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list notifications params
+func (o *ListNotificationsParams) WithTimeout(timeout time.Duration) *ListNotificationsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list notifications params
+func (o *ListNotificationsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list notifications params
+func (o *ListNotificationsParams) WithContext(ctx context.Context) *ListNotificationsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list notifications params
+func (o *ListNotificationsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list notifications params
+func (o *ListNotificationsParams) WithHTTPClient(client *http.Client) *ListNotificationsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list notifications params
+func (o *ListNotificationsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithLimit adds the limit to the list notifications params
+func (o *ListNotificationsParams) WithLimit(limit *int64) *ListNotificationsParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list notifications params
+func (o *ListNotificationsParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WithStatus adds the status to the list notifications params
+func (o *ListNotificationsParams) WithStatus(status *string) *ListNotificationsParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the list notifications params
+func (o *ListNotificationsParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListNotificationsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
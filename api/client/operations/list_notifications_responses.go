@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListNotificationsReader is a Reader for the ListNotifications structure.
+type ListNotificationsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListNotificationsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListNotificationsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListNotificationsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListNotificationsOK creates a ListNotificationsOK with default headers values
+func NewListNotificationsOK() *ListNotificationsOK {
+	return &ListNotificationsOK{}
+}
+
+/* ListNotificationsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListNotificationsOK struct {
+	Payload []*models.OutboxMessage
+}
+
+func (o *ListNotificationsOK) Error() string {
+	return fmt.Sprintf("[GET /notifications][%d] listNotificationsOK  %+v", 200, o.Payload)
+}
+func (o *ListNotificationsOK) GetPayload() []*models.OutboxMessage {
+	return o.Payload
+}
+
+func (o *ListNotificationsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListNotificationsDefault creates a ListNotificationsDefault with default headers values
+func NewListNotificationsDefault(code int) *ListNotificationsDefault {
+	return &ListNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListNotificationsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListNotificationsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list notifications default response
+func (o *ListNotificationsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListNotificationsDefault) Error() string {
+	return fmt.Sprintf("[GET /notifications][%d] listNotifications default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListNotificationsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListNotificationsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
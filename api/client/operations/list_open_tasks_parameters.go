@@ -0,0 +1,229 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListOpenTasksParams creates a new ListOpenTasksParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListOpenTasksParams() *ListOpenTasksParams {
+	return &ListOpenTasksParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListOpenTasksParamsWithTimeout creates a new ListOpenTasksParams object
+// with the ability to set a timeout on a request.
+func NewListOpenTasksParamsWithTimeout(timeout time.Duration) *ListOpenTasksParams {
+	return &ListOpenTasksParams{
+		timeout: timeout,
+	}
+}
+
+// NewListOpenTasksParamsWithContext creates a new ListOpenTasksParams object
+// with the ability to set a context for a request.
+func NewListOpenTasksParamsWithContext(ctx context.Context) *ListOpenTasksParams {
+	return &ListOpenTasksParams{
+		Context: ctx,
+	}
+}
+
+// NewListOpenTasksParamsWithHTTPClient creates a new ListOpenTasksParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListOpenTasksParamsWithHTTPClient(client *http.Client) *ListOpenTasksParams {
+	return &ListOpenTasksParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListOpenTasksParams contains all the parameters to send to the API endpoint
+   for the list open tasks operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListOpenTasksParams struct {
+
+	/* Assignee.
+
+	   Only list tasks assigned to this person
+	*/
+	Assignee *string
+
+	/* Project.
+
+	   Only list the project's tasks
+	*/
+	Project *string
+
+	/* Status.
+
+	   Only list tasks with this status
+	*/
+	Status *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list open tasks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListOpenTasksParams) WithDefaults() *ListOpenTasksParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list open tasks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListOpenTasksParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list open tasks params
+func (o *ListOpenTasksParams) WithTimeout(timeout time.Duration) *ListOpenTasksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list open tasks params
+func (o *ListOpenTasksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list open tasks params
+func (o *ListOpenTasksParams) WithContext(ctx context.Context) *ListOpenTasksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list open tasks params
+func (o *ListOpenTasksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list open tasks params
+func (o *ListOpenTasksParams) WithHTTPClient(client *http.Client) *ListOpenTasksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list open tasks params
+func (o *ListOpenTasksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAssignee adds the assignee to the list open tasks params
+func (o *ListOpenTasksParams) WithAssignee(assignee *string) *ListOpenTasksParams {
+	o.SetAssignee(assignee)
+	return o
+}
+
+// SetAssignee adds the assignee to the list open tasks params
+func (o *ListOpenTasksParams) SetAssignee(assignee *string) {
+	o.Assignee = assignee
+}
+
+// WithProject adds the project to the list open tasks params
+func (o *ListOpenTasksParams) WithProject(project *string) *ListOpenTasksParams {
+	o.SetProject(project)
+	return o
+}
+
+// SetProject adds the project to the list open tasks params
+func (o *ListOpenTasksParams) SetProject(project *string) {
+	o.Project = project
+}
+
+// WithStatus adds the status to the list open tasks params
+func (o *ListOpenTasksParams) WithStatus(status *string) *ListOpenTasksParams {
+	o.SetStatus(status)
+	return o
+}
+
+// SetStatus adds the status to the list open tasks params
+func (o *ListOpenTasksParams) SetStatus(status *string) {
+	o.Status = status
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListOpenTasksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Assignee != nil {
+
+		// query param assignee
+		var qrAssignee string
+
+		if o.Assignee != nil {
+			qrAssignee = *o.Assignee
+		}
+		qAssignee := qrAssignee
+		if qAssignee != "" {
+
+			if err := r.SetQueryParam("assignee", qAssignee); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Project != nil {
+
+		// query param project
+		var qrProject string
+
+		if o.Project != nil {
+			qrProject = *o.Project
+		}
+		qProject := qrProject
+		if qProject != "" {
+
+			if err := r.SetQueryParam("project", qProject); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Status != nil {
+
+		// query param status
+		var qrStatus string
+
+		if o.Status != nil {
+			qrStatus = *o.Status
+		}
+		qStatus := qrStatus
+		if qStatus != "" {
+
+			if err := r.SetQueryParam("status", qStatus); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListOpenTasksReader is a Reader for the ListOpenTasks structure.
+type ListOpenTasksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListOpenTasksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListOpenTasksOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListOpenTasksDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListOpenTasksOK creates a ListOpenTasksOK with default headers values
+func NewListOpenTasksOK() *ListOpenTasksOK {
+	return &ListOpenTasksOK{}
+}
+
+/* ListOpenTasksOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListOpenTasksOK struct {
+	Payload []*models.OpenTask
+}
+
+func (o *ListOpenTasksOK) Error() string {
+	return fmt.Sprintf("[GET /tasks][%d] listOpenTasksOK  %+v", 200, o.Payload)
+}
+func (o *ListOpenTasksOK) GetPayload() []*models.OpenTask {
+	return o.Payload
+}
+
+func (o *ListOpenTasksOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListOpenTasksDefault creates a ListOpenTasksDefault with default headers values
+func NewListOpenTasksDefault(code int) *ListOpenTasksDefault {
+	return &ListOpenTasksDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListOpenTasksDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListOpenTasksDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list open tasks default response
+func (o *ListOpenTasksDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListOpenTasksDefault) Error() string {
+	return fmt.Sprintf("[GET /tasks][%d] listOpenTasks default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListOpenTasksDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListOpenTasksDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
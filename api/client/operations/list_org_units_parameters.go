@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListOrgUnitsParams creates a new ListOrgUnitsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListOrgUnitsParams() *ListOrgUnitsParams {
+	return &ListOrgUnitsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListOrgUnitsParamsWithTimeout creates a new ListOrgUnitsParams object
+// with the ability to set a timeout on a request.
+func NewListOrgUnitsParamsWithTimeout(timeout time.Duration) *ListOrgUnitsParams {
+	return &ListOrgUnitsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListOrgUnitsParamsWithContext creates a new ListOrgUnitsParams object
+// with the ability to set a context for a request.
+func NewListOrgUnitsParamsWithContext(ctx context.Context) *ListOrgUnitsParams {
+	return &ListOrgUnitsParams{
+		Context: ctx,
+	}
+}
+
+// NewListOrgUnitsParamsWithHTTPClient creates a new ListOrgUnitsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListOrgUnitsParamsWithHTTPClient(client *http.Client) *ListOrgUnitsParams {
+	return &ListOrgUnitsParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListOrgUnitsParams contains all the parameters to send to the API endpoint
+   for the list org units operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListOrgUnitsParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list org units params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListOrgUnitsParams) WithDefaults() *ListOrgUnitsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list org units params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListOrgUnitsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list org units params
+func (o *ListOrgUnitsParams) WithTimeout(timeout time.Duration) *ListOrgUnitsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list org units params
+func (o *ListOrgUnitsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list org units params
+func (o *ListOrgUnitsParams) WithContext(ctx context.Context) *ListOrgUnitsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list org units params
+func (o *ListOrgUnitsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list org units params
+func (o *ListOrgUnitsParams) WithHTTPClient(client *http.Client) *ListOrgUnitsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list org units params
+func (o *ListOrgUnitsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListOrgUnitsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
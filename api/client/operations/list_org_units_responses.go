@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListOrgUnitsReader is a Reader for the ListOrgUnits structure.
+type ListOrgUnitsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListOrgUnitsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListOrgUnitsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListOrgUnitsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListOrgUnitsOK creates a ListOrgUnitsOK with default headers values
+func NewListOrgUnitsOK() *ListOrgUnitsOK {
+	return &ListOrgUnitsOK{}
+}
+
+/* ListOrgUnitsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListOrgUnitsOK struct {
+	Payload []*models.OrgUnit
+}
+
+func (o *ListOrgUnitsOK) Error() string {
+	return fmt.Sprintf("[GET /orgunit][%d] listOrgUnitsOK  %+v", 200, o.Payload)
+}
+func (o *ListOrgUnitsOK) GetPayload() []*models.OrgUnit {
+	return o.Payload
+}
+
+func (o *ListOrgUnitsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListOrgUnitsDefault creates a ListOrgUnitsDefault with default headers values
+func NewListOrgUnitsDefault(code int) *ListOrgUnitsDefault {
+	return &ListOrgUnitsDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListOrgUnitsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListOrgUnitsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list org units default response
+func (o *ListOrgUnitsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListOrgUnitsDefault) Error() string {
+	return fmt.Sprintf("[GET /orgunit][%d] listOrgUnits default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListOrgUnitsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListOrgUnitsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,161 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListOverdueProjectsParams creates a new ListOverdueProjectsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListOverdueProjectsParams() *ListOverdueProjectsParams {
+	return &ListOverdueProjectsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListOverdueProjectsParamsWithTimeout creates a new ListOverdueProjectsParams object
+// with the ability to set a timeout on a request.
+func NewListOverdueProjectsParamsWithTimeout(timeout time.Duration) *ListOverdueProjectsParams {
+	return &ListOverdueProjectsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListOverdueProjectsParamsWithContext creates a new ListOverdueProjectsParams object
+// with the ability to set a context for a request.
+func NewListOverdueProjectsParamsWithContext(ctx context.Context) *ListOverdueProjectsParams {
+	return &ListOverdueProjectsParams{
+		Context: ctx,
+	}
+}
+
+// NewListOverdueProjectsParamsWithHTTPClient creates a new ListOverdueProjectsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListOverdueProjectsParamsWithHTTPClient(client *http.Client) *ListOverdueProjectsParams {
+	return &ListOverdueProjectsParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListOverdueProjectsParams contains all the parameters to send to the API endpoint
+   for the list overdue projects operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListOverdueProjectsParams struct {
+
+	/* OrgUnit.
+
+	   Only include projects in this org unit or the units below it
+	*/
+	OrgUnit *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list overdue projects params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListOverdueProjectsParams) WithDefaults() *ListOverdueProjectsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list overdue projects params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListOverdueProjectsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list overdue projects params
+func (o *ListOverdueProjectsParams) WithTimeout(timeout time.Duration) *ListOverdueProjectsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list overdue projects params
+func (o *ListOverdueProjectsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list overdue projects params
+func (o *ListOverdueProjectsParams) WithContext(ctx context.Context) *ListOverdueProjectsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list overdue projects params
+func (o *ListOverdueProjectsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list overdue projects params
+func (o *ListOverdueProjectsParams) WithHTTPClient(client *http.Client) *ListOverdueProjectsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list overdue projects params
+func (o *ListOverdueProjectsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithOrgUnit adds the orgUnit to the list overdue projects params
+func (o *ListOverdueProjectsParams) WithOrgUnit(orgUnit *string) *ListOverdueProjectsParams {
+	o.SetOrgUnit(orgUnit)
+	return o
+}
+
+// SetOrgUnit adds the orgUnit to the list overdue projects params
+func (o *ListOverdueProjectsParams) SetOrgUnit(orgUnit *string) {
+	o.OrgUnit = orgUnit
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListOverdueProjectsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.OrgUnit != nil {
+
+		// query param orgUnit
+		var qrOrgUnit string
+
+		if o.OrgUnit != nil {
+			qrOrgUnit = *o.OrgUnit
+		}
+		qOrgUnit := qrOrgUnit
+		if qOrgUnit != "" {
+
+			if err := r.SetQueryParam("orgUnit", qOrgUnit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
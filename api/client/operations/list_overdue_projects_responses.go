@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListOverdueProjectsReader is a Reader for the ListOverdueProjects structure.
+type ListOverdueProjectsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListOverdueProjectsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListOverdueProjectsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListOverdueProjectsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListOverdueProjectsOK creates a ListOverdueProjectsOK with default headers values
+func NewListOverdueProjectsOK() *ListOverdueProjectsOK {
+	return &ListOverdueProjectsOK{}
+}
+
+/* ListOverdueProjectsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListOverdueProjectsOK struct {
+	Payload []*models.OverdueProject
+}
+
+func (o *ListOverdueProjectsOK) Error() string {
+	return fmt.Sprintf("[GET /overdue-projects][%d] listOverdueProjectsOK  %+v", 200, o.Payload)
+}
+func (o *ListOverdueProjectsOK) GetPayload() []*models.OverdueProject {
+	return o.Payload
+}
+
+func (o *ListOverdueProjectsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListOverdueProjectsDefault creates a ListOverdueProjectsDefault with default headers values
+func NewListOverdueProjectsDefault(code int) *ListOverdueProjectsDefault {
+	return &ListOverdueProjectsDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListOverdueProjectsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListOverdueProjectsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list overdue projects default response
+func (o *ListOverdueProjectsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListOverdueProjectsDefault) Error() string {
+	return fmt.Sprintf("[GET /overdue-projects][%d] listOverdueProjects default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListOverdueProjectsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListOverdueProjectsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,158 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListPlanReviewsParams creates a new ListPlanReviewsParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListPlanReviewsParams() *ListPlanReviewsParams {
+	return &ListPlanReviewsParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListPlanReviewsParamsWithTimeout creates a new ListPlanReviewsParams object
+// with the ability to set a timeout on a request.
+func NewListPlanReviewsParamsWithTimeout(timeout time.Duration) *ListPlanReviewsParams {
+	return &ListPlanReviewsParams{
+		timeout: timeout,
+	}
+}
+
+// NewListPlanReviewsParamsWithContext creates a new ListPlanReviewsParams object
+// with the ability to set a context for a request.
+func NewListPlanReviewsParamsWithContext(ctx context.Context) *ListPlanReviewsParams {
+	return &ListPlanReviewsParams{
+		Context: ctx,
+	}
+}
+
+// NewListPlanReviewsParamsWithHTTPClient creates a new ListPlanReviewsParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListPlanReviewsParamsWithHTTPClient(client *http.Client) *ListPlanReviewsParams {
+	return &ListPlanReviewsParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListPlanReviewsParams contains all the parameters to send to the API endpoint
+   for the list plan reviews operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListPlanReviewsParams struct {
+
+	// State.
+	State *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list plan reviews params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListPlanReviewsParams) WithDefaults() *ListPlanReviewsParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list plan reviews params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListPlanReviewsParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list plan reviews params
+func (o *ListPlanReviewsParams) WithTimeout(timeout time.Duration) *ListPlanReviewsParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list plan reviews params
+func (o *ListPlanReviewsParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list plan reviews params
+func (o *ListPlanReviewsParams) WithContext(ctx context.Context) *ListPlanReviewsParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list plan reviews params
+func (o *ListPlanReviewsParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list plan reviews params
+func (o *ListPlanReviewsParams) WithHTTPClient(client *http.Client) *ListPlanReviewsParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list plan reviews params
+func (o *ListPlanReviewsParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithState adds the state to the list plan reviews params
+func (o *ListPlanReviewsParams) WithState(state *string) *ListPlanReviewsParams {
+	o.SetState(state)
+	return o
+}
+
+// SetState adds the state to the list plan reviews params
+func (o *ListPlanReviewsParams) SetState(state *string) {
+	o.State = state
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListPlanReviewsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.State != nil {
+
+		// query param state
+		var qrState string
+
+		if o.State != nil {
+			qrState = *o.State
+		}
+		qState := qrState
+		if qState != "" {
+
+			if err := r.SetQueryParam("state", qState); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListPlanReviewsReader is a Reader for the ListPlanReviews structure.
+type ListPlanReviewsReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListPlanReviewsReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListPlanReviewsOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListPlanReviewsDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListPlanReviewsOK creates a ListPlanReviewsOK with default headers values
+func NewListPlanReviewsOK() *ListPlanReviewsOK {
+	return &ListPlanReviewsOK{}
+}
+
+/* ListPlanReviewsOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListPlanReviewsOK struct {
+	Payload []*models.ReviewSummary
+}
+
+func (o *ListPlanReviewsOK) Error() string {
+	return fmt.Sprintf("[GET /reviews][%d] listPlanReviewsOK  %+v", 200, o.Payload)
+}
+func (o *ListPlanReviewsOK) GetPayload() []*models.ReviewSummary {
+	return o.Payload
+}
+
+func (o *ListPlanReviewsOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListPlanReviewsDefault creates a ListPlanReviewsDefault with default headers values
+func NewListPlanReviewsDefault(code int) *ListPlanReviewsDefault {
+	return &ListPlanReviewsDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListPlanReviewsDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListPlanReviewsDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list plan reviews default response
+func (o *ListPlanReviewsDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListPlanReviewsDefault) Error() string {
+	return fmt.Sprintf("[GET /reviews][%d] listPlanReviews default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListPlanReviewsDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListPlanReviewsDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
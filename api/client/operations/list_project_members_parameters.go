@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListProjectMembersParams creates a new ListProjectMembersParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListProjectMembersParams() *ListProjectMembersParams {
+	return &ListProjectMembersParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListProjectMembersParamsWithTimeout creates a new ListProjectMembersParams object
+// with the ability to set a timeout on a request.
+func NewListProjectMembersParamsWithTimeout(timeout time.Duration) *ListProjectMembersParams {
+	return &ListProjectMembersParams{
+		timeout: timeout,
+	}
+}
+
+// NewListProjectMembersParamsWithContext creates a new ListProjectMembersParams object
+// with the ability to set a context for a request.
+func NewListProjectMembersParamsWithContext(ctx context.Context) *ListProjectMembersParams {
+	return &ListProjectMembersParams{
+		Context: ctx,
+	}
+}
+
+// NewListProjectMembersParamsWithHTTPClient creates a new ListProjectMembersParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListProjectMembersParamsWithHTTPClient(client *http.Client) *ListProjectMembersParams {
+	return &ListProjectMembersParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListProjectMembersParams contains all the parameters to send to the API endpoint
+   for the list project members operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListProjectMembersParams struct {
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list project members params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListProjectMembersParams) WithDefaults() *ListProjectMembersParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list project members params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListProjectMembersParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list project members params
+func (o *ListProjectMembersParams) WithTimeout(timeout time.Duration) *ListProjectMembersParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list project members params
+func (o *ListProjectMembersParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list project members params
+func (o *ListProjectMembersParams) WithContext(ctx context.Context) *ListProjectMembersParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list project members params
+func (o *ListProjectMembersParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list project members params
+func (o *ListProjectMembersParams) WithHTTPClient(client *http.Client) *ListProjectMembersParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list project members params
+func (o *ListProjectMembersParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the list project members params
+func (o *ListProjectMembersParams) WithID(id string) *ListProjectMembersParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the list project members params
+func (o *ListProjectMembersParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListProjectMembersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
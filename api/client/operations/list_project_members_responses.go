@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListProjectMembersReader is a Reader for the ListProjectMembers structure.
+type ListProjectMembersReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListProjectMembersReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListProjectMembersOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListProjectMembersDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListProjectMembersOK creates a ListProjectMembersOK with default headers values
+func NewListProjectMembersOK() *ListProjectMembersOK {
+	return &ListProjectMembersOK{}
+}
+
+/* ListProjectMembersOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListProjectMembersOK struct {
+	Payload []*models.ProjectMember
+}
+
+func (o *ListProjectMembersOK) Error() string {
+	return fmt.Sprintf("[GET /project/{id}/members][%d] listProjectMembersOK  %+v", 200, o.Payload)
+}
+func (o *ListProjectMembersOK) GetPayload() []*models.ProjectMember {
+	return o.Payload
+}
+
+func (o *ListProjectMembersOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListProjectMembersDefault creates a ListProjectMembersDefault with default headers values
+func NewListProjectMembersDefault(code int) *ListProjectMembersDefault {
+	return &ListProjectMembersDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListProjectMembersDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListProjectMembersDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list project members default response
+func (o *ListProjectMembersDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListProjectMembersDefault) Error() string {
+	return fmt.Sprintf("[GET /project/{id}/members][%d] listProjectMembers default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListProjectMembersDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListProjectMembersDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -14,6 +14,7 @@ import (
 	"github.com/go-openapi/runtime"
 	cr "github.com/go-openapi/runtime/client"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewListProjectsParams creates a new ListProjectsParams object,
@@ -58,6 +59,43 @@ func NewListProjectsParamsWithHTTPClient(client *http.Client) *ListProjectsParam
    Typically these are written to a http.Request.
 */
 type ListProjectsParams struct {
+
+	/* DataClassification.
+
+	   Only include projects handling data of this classification
+	*/
+	DataClassification *string
+
+	/* InternetFacing.
+
+	   Only include projects that are (or aren't) internet facing
+	*/
+	InternetFacing *bool
+
+	/* Mine.
+
+	   Only list projects the user is an owner or member of
+	*/
+	Mine *bool
+
+	/* ProductType.
+
+	   Only include projects of this product type
+	*/
+	ProductType *string
+
+	/* Tag.
+
+	   Only include projects with all of these tags
+	*/
+	Tag []string
+
+	/* Tech.
+
+	   Only include projects using all of these technologies
+	*/
+	Tech []string
+
 	timeout    time.Duration
 	Context    context.Context
 	HTTPClient *http.Client
@@ -75,7 +113,18 @@ func (o *ListProjectsParams) WithDefaults() *ListProjectsParams {
 //
 // All values with no default are reset to their zero value.
 func (o *ListProjectsParams) SetDefaults() {
-	// no default values defined for this parameter
+	var (
+		mineDefault = bool(false)
+	)
+
+	val := ListProjectsParams{
+		Mine: &mineDefault,
+	}
+
+	val.timeout = o.timeout
+	val.Context = o.Context
+	val.HTTPClient = o.HTTPClient
+	*o = val
 }
 
 // WithTimeout adds the timeout to the list projects params
@@ -111,6 +160,72 @@ func (o *ListProjectsParams) SetHTTPClient(client *http.Client) {
 	o.HTTPClient = client
 }
 
+// WithDataClassification adds the dataClassification to the list projects params
+func (o *ListProjectsParams) WithDataClassification(dataClassification *string) *ListProjectsParams {
+	o.SetDataClassification(dataClassification)
+	return o
+}
+
+// SetDataClassification adds the dataClassification to the list projects params
+func (o *ListProjectsParams) SetDataClassification(dataClassification *string) {
+	o.DataClassification = dataClassification
+}
+
+// WithInternetFacing adds the internetFacing to the list projects params
+func (o *ListProjectsParams) WithInternetFacing(internetFacing *bool) *ListProjectsParams {
+	o.SetInternetFacing(internetFacing)
+	return o
+}
+
+// SetInternetFacing adds the internetFacing to the list projects params
+func (o *ListProjectsParams) SetInternetFacing(internetFacing *bool) {
+	o.InternetFacing = internetFacing
+}
+
+// WithMine adds the mine to the list projects params
+func (o *ListProjectsParams) WithMine(mine *bool) *ListProjectsParams {
+	o.SetMine(mine)
+	return o
+}
+
+// SetMine adds the mine to the list projects params
+func (o *ListProjectsParams) SetMine(mine *bool) {
+	o.Mine = mine
+}
+
+// WithProductType adds the productType to the list projects params
+func (o *ListProjectsParams) WithProductType(productType *string) *ListProjectsParams {
+	o.SetProductType(productType)
+	return o
+}
+
+// SetProductType adds the productType to the list projects params
+func (o *ListProjectsParams) SetProductType(productType *string) {
+	o.ProductType = productType
+}
+
+// WithTag adds the tag to the list projects params
+func (o *ListProjectsParams) WithTag(tag []string) *ListProjectsParams {
+	o.SetTag(tag)
+	return o
+}
+
+// SetTag adds the tag to the list projects params
+func (o *ListProjectsParams) SetTag(tag []string) {
+	o.Tag = tag
+}
+
+// WithTech adds the tech to the list projects params
+func (o *ListProjectsParams) WithTech(tech []string) *ListProjectsParams {
+	o.SetTech(tech)
+	return o
+}
+
+// SetTech adds the tech to the list projects params
+func (o *ListProjectsParams) SetTech(tech []string) {
+	o.Tech = tech
+}
+
 // WriteToRequest writes these params to a swagger request
 func (o *ListProjectsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
 
@@ -119,8 +234,132 @@ func (o *ListProjectsParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.
 	}
 	var res []error
 
+	if o.DataClassification != nil {
+
+		// query param dataClassification
+		var qrDataClassification string
+
+		if o.DataClassification != nil {
+			qrDataClassification = *o.DataClassification
+		}
+		qDataClassification := qrDataClassification
+		if qDataClassification != "" {
+
+			if err := r.SetQueryParam("dataClassification", qDataClassification); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.InternetFacing != nil {
+
+		// query param internetFacing
+		var qrInternetFacing bool
+
+		if o.InternetFacing != nil {
+			qrInternetFacing = *o.InternetFacing
+		}
+		qInternetFacing := swag.FormatBool(qrInternetFacing)
+		if qInternetFacing != "" {
+
+			if err := r.SetQueryParam("internetFacing", qInternetFacing); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Mine != nil {
+
+		// query param mine
+		var qrMine bool
+
+		if o.Mine != nil {
+			qrMine = *o.Mine
+		}
+		qMine := swag.FormatBool(qrMine)
+		if qMine != "" {
+
+			if err := r.SetQueryParam("mine", qMine); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.ProductType != nil {
+
+		// query param productType
+		var qrProductType string
+
+		if o.ProductType != nil {
+			qrProductType = *o.ProductType
+		}
+		qProductType := qrProductType
+		if qProductType != "" {
+
+			if err := r.SetQueryParam("productType", qProductType); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Tag != nil {
+
+		// binding items for tag
+		joinedTag := o.bindParamTag(reg)
+
+		// query array param tag
+		if err := r.SetQueryParam("tag", joinedTag...); err != nil {
+			return err
+		}
+	}
+
+	if o.Tech != nil {
+
+		// binding items for tech
+		joinedTech := o.bindParamTech(reg)
+
+		// query array param tech
+		if err := r.SetQueryParam("tech", joinedTech...); err != nil {
+			return err
+		}
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindParamListProjects binds the parameter tag
+func (o *ListProjectsParams) bindParamTag(formats strfmt.Registry) []string {
+	tagIR := o.Tag
+
+	var tagIC []string
+	for _, tagIIR := range tagIR { // explode []string
+
+		tagIIV := tagIIR // string as string
+		tagIC = append(tagIC, tagIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	tagIS := swag.JoinByFormat(tagIC, "multi")
+
+	return tagIS
+}
+
+// bindParamListProjects binds the parameter tech
+func (o *ListProjectsParams) bindParamTech(formats strfmt.Registry) []string {
+	techIR := o.Tech
+
+	var techIC []string
+	for _, techIIR := range techIR { // explode []string
+
+		techIIV := techIIR // string as string
+		techIC = append(techIC, techIIV)
+	}
+
+	// items.CollectionFormat: "multi"
+	techIS := swag.JoinByFormat(techIC, "multi")
+
+	return techIS
+}
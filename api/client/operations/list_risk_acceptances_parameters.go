@@ -0,0 +1,196 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListRiskAcceptancesParams creates a new ListRiskAcceptancesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListRiskAcceptancesParams() *ListRiskAcceptancesParams {
+	return &ListRiskAcceptancesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListRiskAcceptancesParamsWithTimeout creates a new ListRiskAcceptancesParams object
+// with the ability to set a timeout on a request.
+func NewListRiskAcceptancesParamsWithTimeout(timeout time.Duration) *ListRiskAcceptancesParams {
+	return &ListRiskAcceptancesParams{
+		timeout: timeout,
+	}
+}
+
+// NewListRiskAcceptancesParamsWithContext creates a new ListRiskAcceptancesParams object
+// with the ability to set a context for a request.
+func NewListRiskAcceptancesParamsWithContext(ctx context.Context) *ListRiskAcceptancesParams {
+	return &ListRiskAcceptancesParams{
+		Context: ctx,
+	}
+}
+
+// NewListRiskAcceptancesParamsWithHTTPClient creates a new ListRiskAcceptancesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListRiskAcceptancesParamsWithHTTPClient(client *http.Client) *ListRiskAcceptancesParams {
+	return &ListRiskAcceptancesParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListRiskAcceptancesParams contains all the parameters to send to the API endpoint
+   for the list risk acceptances operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListRiskAcceptancesParams struct {
+
+	/* Expired.
+
+	   Only list acceptances that have expired (true) or haven't (false)
+	*/
+	Expired *bool
+
+	/* Project.
+
+	   Only list the project's risk acceptances
+	*/
+	Project *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list risk acceptances params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListRiskAcceptancesParams) WithDefaults() *ListRiskAcceptancesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list risk acceptances params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListRiskAcceptancesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) WithTimeout(timeout time.Duration) *ListRiskAcceptancesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) WithContext(ctx context.Context) *ListRiskAcceptancesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) WithHTTPClient(client *http.Client) *ListRiskAcceptancesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithExpired adds the expired to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) WithExpired(expired *bool) *ListRiskAcceptancesParams {
+	o.SetExpired(expired)
+	return o
+}
+
+// SetExpired adds the expired to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) SetExpired(expired *bool) {
+	o.Expired = expired
+}
+
+// WithProject adds the project to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) WithProject(project *string) *ListRiskAcceptancesParams {
+	o.SetProject(project)
+	return o
+}
+
+// SetProject adds the project to the list risk acceptances params
+func (o *ListRiskAcceptancesParams) SetProject(project *string) {
+	o.Project = project
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListRiskAcceptancesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Expired != nil {
+
+		// query param expired
+		var qrExpired bool
+
+		if o.Expired != nil {
+			qrExpired = *o.Expired
+		}
+		qExpired := swag.FormatBool(qrExpired)
+		if qExpired != "" {
+
+			if err := r.SetQueryParam("expired", qExpired); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Project != nil {
+
+		// query param project
+		var qrProject string
+
+		if o.Project != nil {
+			qrProject = *o.Project
+		}
+		qProject := qrProject
+		if qProject != "" {
+
+			if err := r.SetQueryParam("project", qProject); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
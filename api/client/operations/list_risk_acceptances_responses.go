@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListRiskAcceptancesReader is a Reader for the ListRiskAcceptances structure.
+type ListRiskAcceptancesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListRiskAcceptancesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListRiskAcceptancesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListRiskAcceptancesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListRiskAcceptancesOK creates a ListRiskAcceptancesOK with default headers values
+func NewListRiskAcceptancesOK() *ListRiskAcceptancesOK {
+	return &ListRiskAcceptancesOK{}
+}
+
+/* ListRiskAcceptancesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListRiskAcceptancesOK struct {
+	Payload []*models.AcceptedRisk
+}
+
+func (o *ListRiskAcceptancesOK) Error() string {
+	return fmt.Sprintf("[GET /risk-acceptances][%d] listRiskAcceptancesOK  %+v", 200, o.Payload)
+}
+func (o *ListRiskAcceptancesOK) GetPayload() []*models.AcceptedRisk {
+	return o.Payload
+}
+
+func (o *ListRiskAcceptancesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListRiskAcceptancesDefault creates a ListRiskAcceptancesDefault with default headers values
+func NewListRiskAcceptancesDefault(code int) *ListRiskAcceptancesDefault {
+	return &ListRiskAcceptancesDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListRiskAcceptancesDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListRiskAcceptancesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list risk acceptances default response
+func (o *ListRiskAcceptancesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListRiskAcceptancesDefault) Error() string {
+	return fmt.Sprintf("[GET /risk-acceptances][%d] listRiskAcceptances default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListRiskAcceptancesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListRiskAcceptancesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
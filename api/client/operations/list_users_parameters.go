@@ -0,0 +1,295 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListUsersParams creates a new ListUsersParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListUsersParams() *ListUsersParams {
+	return &ListUsersParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListUsersParamsWithTimeout creates a new ListUsersParams object
+// with the ability to set a timeout on a request.
+func NewListUsersParamsWithTimeout(timeout time.Duration) *ListUsersParams {
+	return &ListUsersParams{
+		timeout: timeout,
+	}
+}
+
+// NewListUsersParamsWithContext creates a new ListUsersParams object
+// with the ability to set a context for a request.
+func NewListUsersParamsWithContext(ctx context.Context) *ListUsersParams {
+	return &ListUsersParams{
+		Context: ctx,
+	}
+}
+
+// NewListUsersParamsWithHTTPClient creates a new ListUsersParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListUsersParamsWithHTTPClient(client *http.Client) *ListUsersParams {
+	return &ListUsersParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListUsersParams contains all the parameters to send to the API endpoint
+   for the list users operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListUsersParams struct {
+
+	/* Authorized.
+
+	   Only include users that are (or are not) manually authorized
+	*/
+	Authorized *bool
+
+	/* Domain.
+
+	   Only include users whose email address is from this domain
+	*/
+	Domain *string
+
+	/* PageSize.
+
+	   The maximum number of users to return, 100 if not set
+	*/
+	PageSize *int64
+
+	// PageToken.
+	PageToken *string
+
+	/* Provider.
+
+	   Only include users who signed in with this identity provider
+	*/
+	Provider *string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list users params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListUsersParams) WithDefaults() *ListUsersParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list users params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListUsersParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list users params
+func (o *ListUsersParams) WithTimeout(timeout time.Duration) *ListUsersParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list users params
+func (o *ListUsersParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list users params
+func (o *ListUsersParams) WithContext(ctx context.Context) *ListUsersParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list users params
+func (o *ListUsersParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list users params
+func (o *ListUsersParams) WithHTTPClient(client *http.Client) *ListUsersParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list users params
+func (o *ListUsersParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithAuthorized adds the authorized to the list users params
+func (o *ListUsersParams) WithAuthorized(authorized *bool) *ListUsersParams {
+	o.SetAuthorized(authorized)
+	return o
+}
+
+// SetAuthorized adds the authorized to the list users params
+func (o *ListUsersParams) SetAuthorized(authorized *bool) {
+	o.Authorized = authorized
+}
+
+// WithDomain adds the domain to the list users params
+func (o *ListUsersParams) WithDomain(domain *string) *ListUsersParams {
+	o.SetDomain(domain)
+	return o
+}
+
+// SetDomain adds the domain to the list users params
+func (o *ListUsersParams) SetDomain(domain *string) {
+	o.Domain = domain
+}
+
+// WithPageSize adds the pageSize to the list users params
+func (o *ListUsersParams) WithPageSize(pageSize *int64) *ListUsersParams {
+	o.SetPageSize(pageSize)
+	return o
+}
+
+// SetPageSize adds the pageSize to the list users params
+func (o *ListUsersParams) SetPageSize(pageSize *int64) {
+	o.PageSize = pageSize
+}
+
+// WithPageToken adds the pageToken to the list users params
+func (o *ListUsersParams) WithPageToken(pageToken *string) *ListUsersParams {
+	o.SetPageToken(pageToken)
+	return o
+}
+
+// SetPageToken adds the pageToken to the list users params
+func (o *ListUsersParams) SetPageToken(pageToken *string) {
+	o.PageToken = pageToken
+}
+
+// WithProvider adds the provider to the list users params
+func (o *ListUsersParams) WithProvider(provider *string) *ListUsersParams {
+	o.SetProvider(provider)
+	return o
+}
+
+// SetProvider adds the provider to the list users params
+func (o *ListUsersParams) SetProvider(provider *string) {
+	o.Provider = provider
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListUsersParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if o.Authorized != nil {
+
+		// query param authorized
+		var qrAuthorized bool
+
+		if o.Authorized != nil {
+			qrAuthorized = *o.Authorized
+		}
+		qAuthorized := swag.FormatBool(qrAuthorized)
+		if qAuthorized != "" {
+
+			if err := r.SetQueryParam("authorized", qAuthorized); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Domain != nil {
+
+		// query param domain
+		var qrDomain string
+
+		if o.Domain != nil {
+			qrDomain = *o.Domain
+		}
+		qDomain := qrDomain
+		if qDomain != "" {
+
+			if err := r.SetQueryParam("domain", qDomain); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageSize != nil {
+
+		// query param pageSize
+		var qrPageSize int64
+
+		if o.PageSize != nil {
+			qrPageSize = *o.PageSize
+		}
+		qPageSize := swag.FormatInt64(qrPageSize)
+		if qPageSize != "" {
+
+			if err := r.SetQueryParam("pageSize", qPageSize); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.PageToken != nil {
+
+		// query param pageToken
+		var qrPageToken string
+
+		if o.PageToken != nil {
+			qrPageToken = *o.PageToken
+		}
+		qPageToken := qrPageToken
+		if qPageToken != "" {
+
+			if err := r.SetQueryParam("pageToken", qPageToken); err != nil {
+				return err
+			}
+		}
+	}
+
+	if o.Provider != nil {
+
+		// query param provider
+		var qrProvider string
+
+		if o.Provider != nil {
+			qrProvider = *o.Provider
+		}
+		qProvider := qrProvider
+		if qProvider != "" {
+
+			if err := r.SetQueryParam("provider", qProvider); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,181 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListWebhookDeliveriesParams creates a new ListWebhookDeliveriesParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListWebhookDeliveriesParams() *ListWebhookDeliveriesParams {
+	return &ListWebhookDeliveriesParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListWebhookDeliveriesParamsWithTimeout creates a new ListWebhookDeliveriesParams object
+// with the ability to set a timeout on a request.
+func NewListWebhookDeliveriesParamsWithTimeout(timeout time.Duration) *ListWebhookDeliveriesParams {
+	return &ListWebhookDeliveriesParams{
+		timeout: timeout,
+	}
+}
+
+// NewListWebhookDeliveriesParamsWithContext creates a new ListWebhookDeliveriesParams object
+// with the ability to set a context for a request.
+func NewListWebhookDeliveriesParamsWithContext(ctx context.Context) *ListWebhookDeliveriesParams {
+	return &ListWebhookDeliveriesParams{
+		Context: ctx,
+	}
+}
+
+// NewListWebhookDeliveriesParamsWithHTTPClient creates a new ListWebhookDeliveriesParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListWebhookDeliveriesParamsWithHTTPClient(client *http.Client) *ListWebhookDeliveriesParams {
+	return &ListWebhookDeliveriesParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListWebhookDeliveriesParams contains all the parameters to send to the API endpoint
+   for the list webhook deliveries operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListWebhookDeliveriesParams struct {
+
+	// ID.
+	ID string
+
+	/* Limit.
+
+	   The maximum number of deliveries to return, 100 if not set
+	*/
+	Limit *int64
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list webhook deliveries params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListWebhookDeliveriesParams) WithDefaults() *ListWebhookDeliveriesParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list webhook deliveries params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListWebhookDeliveriesParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithTimeout(timeout time.Duration) *ListWebhookDeliveriesParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithContext(ctx context.Context) *ListWebhookDeliveriesParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithHTTPClient(client *http.Client) *ListWebhookDeliveriesParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithID(id string) *ListWebhookDeliveriesParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithLimit adds the limit to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) WithLimit(limit *int64) *ListWebhookDeliveriesParams {
+	o.SetLimit(limit)
+	return o
+}
+
+// SetLimit adds the limit to the list webhook deliveries params
+func (o *ListWebhookDeliveriesParams) SetLimit(limit *int64) {
+	o.Limit = limit
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListWebhookDeliveriesParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if o.Limit != nil {
+
+		// query param limit
+		var qrLimit int64
+
+		if o.Limit != nil {
+			qrLimit = *o.Limit
+		}
+		qLimit := swag.FormatInt64(qrLimit)
+		if qLimit != "" {
+
+			if err := r.SetQueryParam("limit", qLimit); err != nil {
+				return err
+			}
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
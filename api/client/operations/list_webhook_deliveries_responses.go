@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListWebhookDeliveriesReader is a Reader for the ListWebhookDeliveries structure.
+type ListWebhookDeliveriesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListWebhookDeliveriesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListWebhookDeliveriesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListWebhookDeliveriesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListWebhookDeliveriesOK creates a ListWebhookDeliveriesOK with default headers values
+func NewListWebhookDeliveriesOK() *ListWebhookDeliveriesOK {
+	return &ListWebhookDeliveriesOK{}
+}
+
+/* ListWebhookDeliveriesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListWebhookDeliveriesOK struct {
+	Payload []*models.OutboxMessage
+}
+
+func (o *ListWebhookDeliveriesOK) Error() string {
+	return fmt.Sprintf("[GET /webhooks/{id}/deliveries][%d] listWebhookDeliveriesOK  %+v", 200, o.Payload)
+}
+func (o *ListWebhookDeliveriesOK) GetPayload() []*models.OutboxMessage {
+	return o.Payload
+}
+
+func (o *ListWebhookDeliveriesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhookDeliveriesDefault creates a ListWebhookDeliveriesDefault with default headers values
+func NewListWebhookDeliveriesDefault(code int) *ListWebhookDeliveriesDefault {
+	return &ListWebhookDeliveriesDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListWebhookDeliveriesDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListWebhookDeliveriesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list webhook deliveries default response
+func (o *ListWebhookDeliveriesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListWebhookDeliveriesDefault) Error() string {
+	return fmt.Sprintf("[GET /webhooks/{id}/deliveries][%d] listWebhookDeliveries default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListWebhookDeliveriesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListWebhookDeliveriesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,126 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListWebhooksParams creates a new ListWebhooksParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewListWebhooksParams() *ListWebhooksParams {
+	return &ListWebhooksParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewListWebhooksParamsWithTimeout creates a new ListWebhooksParams object
+// with the ability to set a timeout on a request.
+func NewListWebhooksParamsWithTimeout(timeout time.Duration) *ListWebhooksParams {
+	return &ListWebhooksParams{
+		timeout: timeout,
+	}
+}
+
+// NewListWebhooksParamsWithContext creates a new ListWebhooksParams object
+// with the ability to set a context for a request.
+func NewListWebhooksParamsWithContext(ctx context.Context) *ListWebhooksParams {
+	return &ListWebhooksParams{
+		Context: ctx,
+	}
+}
+
+// NewListWebhooksParamsWithHTTPClient creates a new ListWebhooksParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewListWebhooksParamsWithHTTPClient(client *http.Client) *ListWebhooksParams {
+	return &ListWebhooksParams{
+		HTTPClient: client,
+	}
+}
+
+/* ListWebhooksParams contains all the parameters to send to the API endpoint
+   for the list webhooks operation.
+
+   Typically these are written to a http.Request.
+*/
+type ListWebhooksParams struct {
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the list webhooks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListWebhooksParams) WithDefaults() *ListWebhooksParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the list webhooks params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ListWebhooksParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the list webhooks params
+func (o *ListWebhooksParams) WithTimeout(timeout time.Duration) *ListWebhooksParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the list webhooks params
+func (o *ListWebhooksParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the list webhooks params
+func (o *ListWebhooksParams) WithContext(ctx context.Context) *ListWebhooksParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the list webhooks params
+func (o *ListWebhooksParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the list webhooks params
+func (o *ListWebhooksParams) WithHTTPClient(client *http.Client) *ListWebhooksParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the list webhooks params
+func (o *ListWebhooksParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ListWebhooksParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
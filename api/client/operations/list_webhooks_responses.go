@@ -0,0 +1,113 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListWebhooksReader is a Reader for the ListWebhooks structure.
+type ListWebhooksReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ListWebhooksReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewListWebhooksOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewListWebhooksDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewListWebhooksOK creates a ListWebhooksOK with default headers values
+func NewListWebhooksOK() *ListWebhooksOK {
+	return &ListWebhooksOK{}
+}
+
+/* ListWebhooksOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ListWebhooksOK struct {
+	Payload []*models.Webhook
+}
+
+func (o *ListWebhooksOK) Error() string {
+	return fmt.Sprintf("[GET /webhooks][%d] listWebhooksOK  %+v", 200, o.Payload)
+}
+func (o *ListWebhooksOK) GetPayload() []*models.Webhook {
+	return o.Payload
+}
+
+func (o *ListWebhooksOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	// response payload
+	if err := consumer.Consume(response.Body(), &o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewListWebhooksDefault creates a ListWebhooksDefault with default headers values
+func NewListWebhooksDefault(code int) *ListWebhooksDefault {
+	return &ListWebhooksDefault{
+		_statusCode: code,
+	}
+}
+
+/* ListWebhooksDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ListWebhooksDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the list webhooks default response
+func (o *ListWebhooksDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ListWebhooksDefault) Error() string {
+	return fmt.Sprintf("[GET /webhooks][%d] listWebhooks default  %+v", o._statusCode, o.Payload)
+}
+func (o *ListWebhooksDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ListWebhooksDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
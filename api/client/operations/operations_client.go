@@ -28,22 +28,56 @@ type ClientOption func(*runtime.ClientOperation)
 
 // ClientService is the interface for Client methods
 type ClientService interface {
+	ApproveAccessRequest(params *ApproveAccessRequestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApproveAccessRequestOK, error)
+
+	ApproveRiskAcceptance(params *ApproveRiskAcceptanceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApproveRiskAcceptanceCreated, error)
+
+	AuthorizeUser(params *AuthorizeUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthorizeUserOK, error)
+
+	CreateAPIToken(params *CreateAPITokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateAPITokenCreated, error)
+
+	CreateOrgUnit(params *CreateOrgUnitParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateOrgUnitCreated, error)
+
 	CreatePlan(params *CreatePlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreatePlanCreated, error)
 
 	CreatePlanRevision(params *CreatePlanRevisionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreatePlanRevisionOK, error)
 
 	CreateProject(params *CreateProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateProjectCreated, error)
 
+	CreateTaskIssue(params *CreateTaskIssueParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateTaskIssueCreated, error)
+
+	CreateWebhook(params *CreateWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateWebhookCreated, error)
+
+	DeauthorizeUser(params *DeauthorizeUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeauthorizeUserOK, error)
+
+	DeleteOrgUnit(params *DeleteOrgUnitParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteOrgUnitNoContent, error)
+
 	DeletePlan(params *DeletePlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeletePlanNoContent, error)
 
 	DeleteProject(params *DeleteProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectNoContent, error)
 
+	DeleteWebhook(params *DeleteWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteWebhookNoContent, error)
+
+	DenyAccessRequest(params *DenyAccessRequestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DenyAccessRequestOK, error)
+
 	GetAuthConfig(params *GetAuthConfigParams, opts ...ClientOption) (*GetAuthConfigOK, error)
 
+	GetCommitPolicy(params *GetCommitPolicyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetCommitPolicyOK, error)
+
+	GetCurrentUser(params *GetCurrentUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetCurrentUserOK, error)
+
+	GetMaturityMetrics(params *GetMaturityMetricsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetMaturityMetricsOK, error)
+
+	GetMyAccessRequest(params *GetMyAccessRequestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetMyAccessRequestOK, error)
+
+	GetOrgUnit(params *GetOrgUnitParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetOrgUnitOK, error)
+
 	GetPlan(params *GetPlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanOK, error)
 
 	GetPlanRevision(params *GetPlanRevisionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanRevisionOK, error)
 
+	GetPlanRevisionAttestation(params *GetPlanRevisionAttestationParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanRevisionAttestationOK, error)
+
 	GetPlanRevisionPracticeResponses(params *GetPlanRevisionPracticeResponsesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanRevisionPracticeResponsesOK, error)
 
 	GetPlanVersions(params *GetPlanVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanVersionsOK, error)
@@ -52,34 +86,90 @@ type ClientService interface {
 
 	GetProject(params *GetProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectOK, error)
 
+	GetProjectSubscription(params *GetProjectSubscriptionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectSubscriptionOK, error)
+
+	GetUser(params *GetUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUserOK, error)
+
+	GetUserRoles(params *GetUserRolesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUserRolesOK, error)
+
+	ListAccessRequests(params *ListAccessRequestsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAccessRequestsOK, error)
+
+	ListAPITokens(params *ListAPITokensParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAPITokensOK, error)
+
+	ListAuditEvents(params *ListAuditEventsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAuditEventsOK, error)
+
+	ListNotifications(params *ListNotificationsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListNotificationsOK, error)
+
+	ListOpenTasks(params *ListOpenTasksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListOpenTasksOK, error)
+
+	ListOrgUnits(params *ListOrgUnitsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListOrgUnitsOK, error)
+
+	ListOverdueProjects(params *ListOverdueProjectsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListOverdueProjectsOK, error)
+
+	ListPlanReviews(params *ListPlanReviewsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListPlanReviewsOK, error)
+
 	ListPracticesVersions(params *ListPracticesVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListPracticesVersionsOK, error)
 
+	ListProjectMembers(params *ListProjectMembersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListProjectMembersOK, error)
+
 	ListProjects(params *ListProjectsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListProjectsOK, error)
 
+	ListRiskAcceptances(params *ListRiskAcceptancesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListRiskAcceptancesOK, error)
+
+	ListUsers(params *ListUsersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListUsersOK, error)
+
+	ListWebhookDeliveries(params *ListWebhookDeliveriesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListWebhookDeliveriesOK, error)
+
+	ListWebhooks(params *ListWebhooksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListWebhooksOK, error)
+
 	LoggedIn(params *LoggedInParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*LoggedInOK, error)
 
+	RemoveProjectMember(params *RemoveProjectMemberParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RemoveProjectMemberNoContent, error)
+
+	RemoveUser(params *RemoveUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RemoveUserNoContent, error)
+
+	RequestAccess(params *RequestAccessParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RequestAccessOK, error)
+
+	ReviewPlanRevision(params *ReviewPlanRevisionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReviewPlanRevisionOK, error)
+
+	RevokeAPIToken(params *RevokeAPITokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RevokeAPITokenNoContent, error)
+
+	SetCommitPolicy(params *SetCommitPolicyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetCommitPolicyOK, error)
+
+	SetProjectMember(params *SetProjectMemberParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetProjectMemberOK, error)
+
+	SetUserRoles(params *SetUserRolesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetUserRolesOK, error)
+
+	SubscribeToProject(params *SubscribeToProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SubscribeToProjectOK, error)
+
+	TestWebhook(params *TestWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TestWebhookOK, error)
+
+	UnsubscribeFromProject(params *UnsubscribeFromProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UnsubscribeFromProjectNoContent, error)
+
+	UpdateOrgUnit(params *UpdateOrgUnitParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateOrgUnitOK, error)
+
 	UpdateProject(params *UpdateProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateProjectOK, error)
 
 	SetTransport(transport runtime.ClientTransport)
 }
 
 /*
-  CreatePlan create plan API
+  ApproveAccessRequest Grant the user access, by manually authorizing them
 */
-func (a *Client) CreatePlan(params *CreatePlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreatePlanCreated, error) {
+func (a *Client) ApproveAccessRequest(params *ApproveAccessRequestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApproveAccessRequestOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewCreatePlanParams()
+		params = NewApproveAccessRequestParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "createPlan",
+		ID:                 "approveAccessRequest",
 		Method:             "POST",
-		PathPattern:        "/plan",
+		PathPattern:        "/access-requests/{uid}/approve",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &CreatePlanReader{formats: a.formats},
+		Reader:             &ApproveAccessRequestReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -92,32 +182,33 @@ func (a *Client) CreatePlan(params *CreatePlanParams, authInfo runtime.ClientAut
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*CreatePlanCreated)
+	success, ok := result.(*ApproveAccessRequestOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*CreatePlanDefault)
+	unexpectedSuccess := result.(*ApproveAccessRequestDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  CreatePlanRevision create plan revision API
+  ApproveRiskAcceptance Approves the task's risk acceptance in the plan's latest revision, recording the approver in a new revision of the plan. This needs the review or admin permission, and approvers can't approve acceptances they wrote. The new revision's maturity includes the approval, and it keeps the review state of the revision it's based on.
+
 */
-func (a *Client) CreatePlanRevision(params *CreatePlanRevisionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreatePlanRevisionOK, error) {
+func (a *Client) ApproveRiskAcceptance(params *ApproveRiskAcceptanceParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ApproveRiskAcceptanceCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewCreatePlanRevisionParams()
+		params = NewApproveRiskAcceptanceParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "createPlanRevision",
+		ID:                 "approveRiskAcceptance",
 		Method:             "POST",
-		PathPattern:        "/plan/{id}",
+		PathPattern:        "/plan/{id}/practice/{practiceId}/task/{taskId}/risk-acceptance/approval",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &CreatePlanRevisionReader{formats: a.formats},
+		Reader:             &ApproveRiskAcceptanceReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -130,32 +221,32 @@ func (a *Client) CreatePlanRevision(params *CreatePlanRevisionParams, authInfo r
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*CreatePlanRevisionOK)
+	success, ok := result.(*ApproveRiskAcceptanceCreated)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*CreatePlanRevisionDefault)
+	unexpectedSuccess := result.(*ApproveRiskAcceptanceDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  CreateProject create project API
+  AuthorizeUser Manually authorize the user, approving their pending access request if they have one
 */
-func (a *Client) CreateProject(params *CreateProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateProjectCreated, error) {
+func (a *Client) AuthorizeUser(params *AuthorizeUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*AuthorizeUserOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewCreateProjectParams()
+		params = NewAuthorizeUserParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "createProject",
+		ID:                 "authorizeUser",
 		Method:             "POST",
-		PathPattern:        "/project",
+		PathPattern:        "/user/{uid}/authorize",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &CreateProjectReader{formats: a.formats},
+		Reader:             &AuthorizeUserReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -168,32 +259,33 @@ func (a *Client) CreateProject(params *CreateProjectParams, authInfo runtime.Cli
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*CreateProjectCreated)
+	success, ok := result.(*AuthorizeUserOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*CreateProjectDefault)
+	unexpectedSuccess := result.(*AuthorizeUserDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  DeletePlan Delete this plan and all of the revisions associated with it
+  CreateAPIToken Create a long-lived API token. Without a service account, the token acts as the caller, limited to its scopes. Only security admins can create service account tokens. The secret is only returned here; only a hash of it is kept.
+
 */
-func (a *Client) DeletePlan(params *DeletePlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeletePlanNoContent, error) {
+func (a *Client) CreateAPIToken(params *CreateAPITokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateAPITokenCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewDeletePlanParams()
+		params = NewCreateAPITokenParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "deletePlan",
-		Method:             "DELETE",
-		PathPattern:        "/plan/{id}",
+		ID:                 "createApiToken",
+		Method:             "POST",
+		PathPattern:        "/tokens",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &DeletePlanReader{formats: a.formats},
+		Reader:             &CreateAPITokenReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -206,32 +298,32 @@ func (a *Client) DeletePlan(params *DeletePlanParams, authInfo runtime.ClientAut
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*DeletePlanNoContent)
+	success, ok := result.(*CreateAPITokenCreated)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*DeletePlanDefault)
+	unexpectedSuccess := result.(*CreateAPITokenDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  DeleteProject delete project API
+  CreateOrgUnit Create an org unit. Top-level units can only be created by security admins; other units by the leads of any of their ancestors.
 */
-func (a *Client) DeleteProject(params *DeleteProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectNoContent, error) {
+func (a *Client) CreateOrgUnit(params *CreateOrgUnitParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateOrgUnitCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewDeleteProjectParams()
+		params = NewCreateOrgUnitParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "deleteProject",
-		Method:             "DELETE",
-		PathPattern:        "/project/{id}",
+		ID:                 "createOrgUnit",
+		Method:             "POST",
+		PathPattern:        "/orgunit",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &DeleteProjectReader{formats: a.formats},
+		Reader:             &CreateOrgUnitReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -244,32 +336,34 @@ func (a *Client) DeleteProject(params *DeleteProjectParams, authInfo runtime.Cli
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*DeleteProjectNoContent)
+	success, ok := result.(*CreateOrgUnitCreated)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*DeleteProjectDefault)
+	unexpectedSuccess := result.(*CreateOrgUnitDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetAuthConfig get auth config API
+  CreatePlan Create a plan with its first revision. A committed revision must meet the commit policy, otherwise the error lists the violations.
+
 */
-func (a *Client) GetAuthConfig(params *GetAuthConfigParams, opts ...ClientOption) (*GetAuthConfigOK, error) {
+func (a *Client) CreatePlan(params *CreatePlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreatePlanCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetAuthConfigParams()
+		params = NewCreatePlanParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getAuthConfig",
-		Method:             "GET",
-		PathPattern:        "/auth",
+		ID:                 "createPlan",
+		Method:             "POST",
+		PathPattern:        "/plan",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetAuthConfigReader{formats: a.formats},
+		Reader:             &CreatePlanReader{formats: a.formats},
+		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
 	}
@@ -281,32 +375,33 @@ func (a *Client) GetAuthConfig(params *GetAuthConfigParams, opts ...ClientOption
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetAuthConfigOK)
+	success, ok := result.(*CreatePlanCreated)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetAuthConfigDefault)
+	unexpectedSuccess := result.(*CreatePlanDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetPlan get plan API
+  CreatePlanRevision Create a new revision of the plan. A committed revision must meet the commit policy, otherwise the error lists the violations.
+
 */
-func (a *Client) GetPlan(params *GetPlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanOK, error) {
+func (a *Client) CreatePlanRevision(params *CreatePlanRevisionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreatePlanRevisionOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetPlanParams()
+		params = NewCreatePlanRevisionParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getPlan",
-		Method:             "GET",
+		ID:                 "createPlanRevision",
+		Method:             "POST",
 		PathPattern:        "/plan/{id}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetPlanReader{formats: a.formats},
+		Reader:             &CreatePlanRevisionReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -319,32 +414,32 @@ func (a *Client) GetPlan(params *GetPlanParams, authInfo runtime.ClientAuthInfoW
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetPlanOK)
+	success, ok := result.(*CreatePlanRevisionOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetPlanDefault)
+	unexpectedSuccess := result.(*CreatePlanRevisionDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetPlanRevision get plan revision API
+  CreateProject create project API
 */
-func (a *Client) GetPlanRevision(params *GetPlanRevisionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanRevisionOK, error) {
+func (a *Client) CreateProject(params *CreateProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateProjectCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetPlanRevisionParams()
+		params = NewCreateProjectParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getPlanRevision",
-		Method:             "GET",
-		PathPattern:        "/plan/{id}/revision/{revId}",
+		ID:                 "createProject",
+		Method:             "POST",
+		PathPattern:        "/project",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetPlanRevisionReader{formats: a.formats},
+		Reader:             &CreateProjectReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -357,32 +452,33 @@ func (a *Client) GetPlanRevision(params *GetPlanRevisionParams, authInfo runtime
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetPlanRevisionOK)
+	success, ok := result.(*CreateProjectCreated)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetPlanRevisionDefault)
+	unexpectedSuccess := result.(*CreateProjectDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetPlanRevisionPracticeResponses get plan revision practice responses API
+  CreateTaskIssue Creates an issue for a prioritised task in the plan's latest revision, from the task's title and description, and links it to the task in a new revision of the plan. The new revision keeps the review state of the revision it's based on.
+
 */
-func (a *Client) GetPlanRevisionPracticeResponses(params *GetPlanRevisionPracticeResponsesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanRevisionPracticeResponsesOK, error) {
+func (a *Client) CreateTaskIssue(params *CreateTaskIssueParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateTaskIssueCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetPlanRevisionPracticeResponsesParams()
+		params = NewCreateTaskIssueParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getPlanRevisionPracticeResponses",
-		Method:             "GET",
-		PathPattern:        "/plan/{id}/revision/{revId}/responses",
+		ID:                 "createTaskIssue",
+		Method:             "POST",
+		PathPattern:        "/plan/{id}/practice/{practiceId}/task/{taskId}/issue",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetPlanRevisionPracticeResponsesReader{formats: a.formats},
+		Reader:             &CreateTaskIssueReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -395,32 +491,33 @@ func (a *Client) GetPlanRevisionPracticeResponses(params *GetPlanRevisionPractic
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetPlanRevisionPracticeResponsesOK)
+	success, ok := result.(*CreateTaskIssueCreated)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetPlanRevisionPracticeResponsesDefault)
+	unexpectedSuccess := result.(*CreateTaskIssueDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetPlanVersions get plan versions API
+  CreateWebhook Register an outbound webhook, which is sent the events it subscribes to as signed JSON. Requires the admin permission. The signing secret is only returned here.
+
 */
-func (a *Client) GetPlanVersions(params *GetPlanVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanVersionsOK, error) {
+func (a *Client) CreateWebhook(params *CreateWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*CreateWebhookCreated, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetPlanVersionsParams()
+		params = NewCreateWebhookParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getPlanVersions",
-		Method:             "GET",
-		PathPattern:        "/plan/{id}/versions",
+		ID:                 "createWebhook",
+		Method:             "POST",
+		PathPattern:        "/webhooks",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetPlanVersionsReader{formats: a.formats},
+		Reader:             &CreateWebhookReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -433,32 +530,32 @@ func (a *Client) GetPlanVersions(params *GetPlanVersionsParams, authInfo runtime
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetPlanVersionsOK)
+	success, ok := result.(*CreateWebhookCreated)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetPlanVersionsDefault)
+	unexpectedSuccess := result.(*CreateWebhookDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetPractices get practices API
+  DeauthorizeUser Remove the user's manual authorization
 */
-func (a *Client) GetPractices(params *GetPracticesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPracticesOK, error) {
+func (a *Client) DeauthorizeUser(params *DeauthorizeUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeauthorizeUserOK, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetPracticesParams()
+		params = NewDeauthorizeUserParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getPractices",
-		Method:             "GET",
-		PathPattern:        "/practices/{version}",
+		ID:                 "deauthorizeUser",
+		Method:             "POST",
+		PathPattern:        "/user/{uid}/deauthorize",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetPracticesReader{formats: a.formats},
+		Reader:             &DeauthorizeUserReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -471,32 +568,32 @@ func (a *Client) GetPractices(params *GetPracticesParams, authInfo runtime.Clien
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetPracticesOK)
+	success, ok := result.(*DeauthorizeUserOK)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetPracticesDefault)
+	unexpectedSuccess := result.(*DeauthorizeUserDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  GetProject get project API
+  DeleteOrgUnit Delete an org unit. It can't have any child units or projects.
 */
-func (a *Client) GetProject(params *GetProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectOK, error) {
+func (a *Client) DeleteOrgUnit(params *DeleteOrgUnitParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteOrgUnitNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewGetProjectParams()
+		params = NewDeleteOrgUnitParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "getProject",
-		Method:             "GET",
-		PathPattern:        "/project/{id}",
+		ID:                 "deleteOrgUnit",
+		Method:             "DELETE",
+		PathPattern:        "/orgunit/{id}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &GetProjectReader{formats: a.formats},
+		Reader:             &DeleteOrgUnitReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -509,32 +606,32 @@ func (a *Client) GetProject(params *GetProjectParams, authInfo runtime.ClientAut
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*GetProjectOK)
+	success, ok := result.(*DeleteOrgUnitNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*GetProjectDefault)
+	unexpectedSuccess := result.(*DeleteOrgUnitDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  ListPracticesVersions list practices versions API
+  DeletePlan Delete this plan and all of the revisions associated with it
 */
-func (a *Client) ListPracticesVersions(params *ListPracticesVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListPracticesVersionsOK, error) {
+func (a *Client) DeletePlan(params *DeletePlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeletePlanNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListPracticesVersionsParams()
+		params = NewDeletePlanParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "listPracticesVersions",
-		Method:             "GET",
-		PathPattern:        "/practices",
+		ID:                 "deletePlan",
+		Method:             "DELETE",
+		PathPattern:        "/plan/{id}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &ListPracticesVersionsReader{formats: a.formats},
+		Reader:             &DeletePlanReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -547,32 +644,32 @@ func (a *Client) ListPracticesVersions(params *ListPracticesVersionsParams, auth
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*ListPracticesVersionsOK)
+	success, ok := result.(*DeletePlanNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*ListPracticesVersionsDefault)
+	unexpectedSuccess := result.(*DeletePlanDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  ListProjects list projects API
+  DeleteProject delete project API
 */
-func (a *Client) ListProjects(params *ListProjectsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListProjectsOK, error) {
+func (a *Client) DeleteProject(params *DeleteProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteProjectNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewListProjectsParams()
+		params = NewDeleteProjectParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "listProjects",
-		Method:             "GET",
-		PathPattern:        "/project",
+		ID:                 "deleteProject",
+		Method:             "DELETE",
+		PathPattern:        "/project/{id}",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &ListProjectsReader{formats: a.formats},
+		Reader:             &DeleteProjectReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -585,32 +682,70 @@ func (a *Client) ListProjects(params *ListProjectsParams, authInfo runtime.Clien
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*ListProjectsOK)
+	success, ok := result.(*DeleteProjectNoContent)
 	if ok {
 		return success, nil
 	}
 	// unexpected success response
-	unexpectedSuccess := result.(*ListProjectsDefault)
+	unexpectedSuccess := result.(*DeleteProjectDefault)
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
 /*
-  LoggedIn Used to trigger one-time events like requesting access. Clients should hit this once after obtaining an ID token, and can use or ignore the response.
+  DeleteWebhook Remove an outbound webhook. Requires the admin permission.
 */
-func (a *Client) LoggedIn(params *LoggedInParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*LoggedInOK, error) {
+func (a *Client) DeleteWebhook(params *DeleteWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DeleteWebhookNoContent, error) {
 	// TODO: Validate the params before sending
 	if params == nil {
-		params = NewLoggedInParams()
+		params = NewDeleteWebhookParams()
 	}
 	op := &runtime.ClientOperation{
-		ID:                 "loggedIn",
+		ID:                 "deleteWebhook",
+		Method:             "DELETE",
+		PathPattern:        "/webhooks/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &DeleteWebhookReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*DeleteWebhookNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DeleteWebhookDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  DenyAccessRequest deny access request API
+*/
+func (a *Client) DenyAccessRequest(params *DenyAccessRequestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*DenyAccessRequestOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewDenyAccessRequestParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "denyAccessRequest",
 		Method:             "POST",
-		PathPattern:        "/auth",
+		PathPattern:        "/access-requests/{uid}/deny",
 		ProducesMediaTypes: []string{"application/json"},
 		ConsumesMediaTypes: []string{"application/json"},
 		Schemes:            []string{"http"},
 		Params:             params,
-		Reader:             &LoggedInReader{formats: a.formats},
+		Reader:             &DenyAccessRequestReader{formats: a.formats},
 		AuthInfo:           authInfo,
 		Context:            params.Context,
 		Client:             params.HTTPClient,
@@ -623,7 +758,1229 @@ func (a *Client) LoggedIn(params *LoggedInParams, authInfo runtime.ClientAuthInf
 	if err != nil {
 		return nil, err
 	}
-	success, ok := result.(*LoggedInOK)
+	success, ok := result.(*DenyAccessRequestOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*DenyAccessRequestDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetAuthConfig get auth config API
+*/
+func (a *Client) GetAuthConfig(params *GetAuthConfigParams, opts ...ClientOption) (*GetAuthConfigOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetAuthConfigParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getAuthConfig",
+		Method:             "GET",
+		PathPattern:        "/auth",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetAuthConfigReader{formats: a.formats},
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetAuthConfigOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetAuthConfigDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetCommitPolicy Get the rules, besides every applicable question being answered, that plans must meet to be committed
+*/
+func (a *Client) GetCommitPolicy(params *GetCommitPolicyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetCommitPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetCommitPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getCommitPolicy",
+		Method:             "GET",
+		PathPattern:        "/commit-policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetCommitPolicyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetCommitPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetCommitPolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetCurrentUser The authenticated user, and what they are allowed to do
+*/
+func (a *Client) GetCurrentUser(params *GetCurrentUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetCurrentUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetCurrentUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getCurrentUser",
+		Method:             "GET",
+		PathPattern:        "/me",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetCurrentUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetCurrentUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetCurrentUserDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetMaturityMetrics Roll the maturity of each project up the org unit tree. Each project's maturity is taken from the most recent committed revision of its plans, and each org unit summarises the projects in it and all of its descendants.
+
+*/
+func (a *Client) GetMaturityMetrics(params *GetMaturityMetricsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetMaturityMetricsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetMaturityMetricsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getMaturityMetrics",
+		Method:             "GET",
+		PathPattern:        "/metrics/maturity",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetMaturityMetricsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetMaturityMetricsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetMaturityMetricsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetMyAccessRequest The caller's request for access, if they have made one. Users that don't yet have access can use this.
+*/
+func (a *Client) GetMyAccessRequest(params *GetMyAccessRequestParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetMyAccessRequestOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetMyAccessRequestParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getMyAccessRequest",
+		Method:             "GET",
+		PathPattern:        "/access-request",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetMyAccessRequestReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetMyAccessRequestOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetMyAccessRequestDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetOrgUnit get org unit API
+*/
+func (a *Client) GetOrgUnit(params *GetOrgUnitParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetOrgUnitOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetOrgUnitParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getOrgUnit",
+		Method:             "GET",
+		PathPattern:        "/orgunit/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetOrgUnitReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetOrgUnitOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetOrgUnitDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetPlan get plan API
+*/
+func (a *Client) GetPlan(params *GetPlanParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPlanParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getPlan",
+		Method:             "GET",
+		PathPattern:        "/plan/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPlanReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetPlanOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetPlanDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetPlanRevision get plan revision API
+*/
+func (a *Client) GetPlanRevision(params *GetPlanRevisionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanRevisionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPlanRevisionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getPlanRevision",
+		Method:             "GET",
+		PathPattern:        "/plan/{id}/revision/{revId}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPlanRevisionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetPlanRevisionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetPlanRevisionDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetPlanRevisionAttestation Export a committed plan revision as an in-toto statement, signed in a DSSE envelope with the server's attestation key. The statement includes the plan's projects, date, practices version, maturity, and a digest of the full responses.
+
+*/
+func (a *Client) GetPlanRevisionAttestation(params *GetPlanRevisionAttestationParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanRevisionAttestationOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPlanRevisionAttestationParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getPlanRevisionAttestation",
+		Method:             "GET",
+		PathPattern:        "/plan/{id}/revision/{revId}/attestation",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPlanRevisionAttestationReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetPlanRevisionAttestationOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetPlanRevisionAttestationDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetPlanRevisionPracticeResponses get plan revision practice responses API
+*/
+func (a *Client) GetPlanRevisionPracticeResponses(params *GetPlanRevisionPracticeResponsesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanRevisionPracticeResponsesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPlanRevisionPracticeResponsesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getPlanRevisionPracticeResponses",
+		Method:             "GET",
+		PathPattern:        "/plan/{id}/revision/{revId}/responses",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPlanRevisionPracticeResponsesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetPlanRevisionPracticeResponsesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetPlanRevisionPracticeResponsesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetPlanVersions get plan versions API
+*/
+func (a *Client) GetPlanVersions(params *GetPlanVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPlanVersionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPlanVersionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getPlanVersions",
+		Method:             "GET",
+		PathPattern:        "/plan/{id}/versions",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPlanVersionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetPlanVersionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetPlanVersionsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetPractices get practices API
+*/
+func (a *Client) GetPractices(params *GetPracticesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetPracticesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetPracticesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getPractices",
+		Method:             "GET",
+		PathPattern:        "/practices/{version}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetPracticesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetPracticesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetPracticesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetProject get project API
+*/
+func (a *Client) GetProject(params *GetProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetProjectParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getProject",
+		Method:             "GET",
+		PathPattern:        "/project/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetProjectReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetProjectOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetProjectDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetProjectSubscription Get the current user's subscription to the project's events
+*/
+func (a *Client) GetProjectSubscription(params *GetProjectSubscriptionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetProjectSubscriptionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetProjectSubscriptionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getProjectSubscription",
+		Method:             "GET",
+		PathPattern:        "/project/{id}/subscription",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetProjectSubscriptionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetProjectSubscriptionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetProjectSubscriptionDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetUser get user API
+*/
+func (a *Client) GetUser(params *GetUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUserOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getUser",
+		Method:             "GET",
+		PathPattern:        "/user/{uid}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetUserOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetUserDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  GetUserRoles The roles explicitly granted to a user. Authorized users without any explicit roles get the deployment's default roles.
+*/
+func (a *Client) GetUserRoles(params *GetUserRolesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*GetUserRolesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewGetUserRolesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "getUserRoles",
+		Method:             "GET",
+		PathPattern:        "/user/{uid}/roles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &GetUserRolesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*GetUserRolesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*GetUserRolesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListAccessRequests Requests for access, most recent first
+*/
+func (a *Client) ListAccessRequests(params *ListAccessRequestsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAccessRequestsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAccessRequestsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listAccessRequests",
+		Method:             "GET",
+		PathPattern:        "/access-requests",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListAccessRequestsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAccessRequestsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListAccessRequestsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListAPITokens The caller's API tokens. Security admins can list every token, including service account tokens.
+*/
+func (a *Client) ListAPITokens(params *ListAPITokensParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAPITokensOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAPITokensParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listApiTokens",
+		Method:             "GET",
+		PathPattern:        "/tokens",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListAPITokensReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAPITokensOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListAPITokensDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListAuditEvents The audit log of changes made through the API and the CLI, most recent first. Requires the audit permission.
+
+*/
+func (a *Client) ListAuditEvents(params *ListAuditEventsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListAuditEventsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListAuditEventsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listAuditEvents",
+		Method:             "GET",
+		PathPattern:        "/audit",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListAuditEventsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListAuditEventsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListAuditEventsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListNotifications The notification outbox: notifications waiting to be delivered to each channel, and those recently delivered or given up on, most recently created first. Requires the admin permission.
+
+*/
+func (a *Client) ListNotifications(params *ListNotificationsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListNotificationsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListNotificationsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listNotifications",
+		Method:             "GET",
+		PathPattern:        "/notifications",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListNotificationsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListNotificationsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListNotificationsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListOpenTasks Lists the prioritised tasks that are planned or in progress, from the latest revision of each project's most recent plan, soonest target date first
+*/
+func (a *Client) ListOpenTasks(params *ListOpenTasksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListOpenTasksOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListOpenTasksParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listOpenTasks",
+		Method:             "GET",
+		PathPattern:        "/tasks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListOpenTasksReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListOpenTasksOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListOpenTasksDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListOrgUnits list org units API
+*/
+func (a *Client) ListOrgUnits(params *ListOrgUnitsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListOrgUnitsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListOrgUnitsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listOrgUnits",
+		Method:             "GET",
+		PathPattern:        "/orgunit",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListOrgUnitsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListOrgUnitsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListOrgUnitsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListOverdueProjects Projects whose latest committed plan is older than their cadence, or that have never committed one, most overdue first. Projects without a cadence aren't included.
+
+*/
+func (a *Client) ListOverdueProjects(params *ListOverdueProjectsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListOverdueProjectsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListOverdueProjectsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listOverdueProjects",
+		Method:             "GET",
+		PathPattern:        "/overdue-projects",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListOverdueProjectsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListOverdueProjectsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListOverdueProjectsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListPlanReviews Lists the plans whose latest committed revision is in the review state, submitted by default, oldest first. Use this as a reviewer's queue.
+
+*/
+func (a *Client) ListPlanReviews(params *ListPlanReviewsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListPlanReviewsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListPlanReviewsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listPlanReviews",
+		Method:             "GET",
+		PathPattern:        "/reviews",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListPlanReviewsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListPlanReviewsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListPlanReviewsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListPracticesVersions list practices versions API
+*/
+func (a *Client) ListPracticesVersions(params *ListPracticesVersionsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListPracticesVersionsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListPracticesVersionsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listPracticesVersions",
+		Method:             "GET",
+		PathPattern:        "/practices",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListPracticesVersionsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListPracticesVersionsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListPracticesVersionsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListProjectMembers list project members API
+*/
+func (a *Client) ListProjectMembers(params *ListProjectMembersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListProjectMembersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListProjectMembersParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listProjectMembers",
+		Method:             "GET",
+		PathPattern:        "/project/{id}/members",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListProjectMembersReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListProjectMembersOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListProjectMembersDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListProjects List projects, with those the user is an owner or member of first
+*/
+func (a *Client) ListProjects(params *ListProjectsParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListProjectsOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListProjectsParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listProjects",
+		Method:             "GET",
+		PathPattern:        "/project",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListProjectsReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListProjectsOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListProjectsDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListRiskAcceptances Lists the risk acceptances in each project's latest committed plan, soonest expiry first
+*/
+func (a *Client) ListRiskAcceptances(params *ListRiskAcceptancesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListRiskAcceptancesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListRiskAcceptancesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listRiskAcceptances",
+		Method:             "GET",
+		PathPattern:        "/risk-acceptances",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListRiskAcceptancesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListRiskAcceptancesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListRiskAcceptancesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListUsers The users known to BeSec, in UID order. Only security admins can list users. Pass the nextPageToken of one page as the pageToken of the request for the next.
+
+*/
+func (a *Client) ListUsers(params *ListUsersParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListUsersOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListUsersParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listUsers",
+		Method:             "GET",
+		PathPattern:        "/user",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListUsersReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListUsersOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListUsersDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListWebhookDeliveries The webhook's recent deliveries from the outbox, most recent first. Requires the admin permission.
+*/
+func (a *Client) ListWebhookDeliveries(params *ListWebhookDeliveriesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListWebhookDeliveriesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListWebhookDeliveriesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listWebhookDeliveries",
+		Method:             "GET",
+		PathPattern:        "/webhooks/{id}/deliveries",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListWebhookDeliveriesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListWebhookDeliveriesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListWebhookDeliveriesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ListWebhooks The registered outbound webhooks. Requires the admin permission.
+*/
+func (a *Client) ListWebhooks(params *ListWebhooksParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ListWebhooksOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewListWebhooksParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "listWebhooks",
+		Method:             "GET",
+		PathPattern:        "/webhooks",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ListWebhooksReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ListWebhooksOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ListWebhooksDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  LoggedIn Used to trigger one-time events like requesting access. Clients should hit this once after obtaining an ID token, and can use or ignore the response.
+*/
+func (a *Client) LoggedIn(params *LoggedInParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*LoggedInOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewLoggedInParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "loggedIn",
+		Method:             "POST",
+		PathPattern:        "/auth",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &LoggedInReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*LoggedInOK)
 	if ok {
 		return success, nil
 	}
@@ -632,6 +1989,465 @@ func (a *Client) LoggedIn(params *LoggedInParams, authInfo runtime.ClientAuthInf
 	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
 }
 
+/*
+  RemoveProjectMember remove project member API
+*/
+func (a *Client) RemoveProjectMember(params *RemoveProjectMemberParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RemoveProjectMemberNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRemoveProjectMemberParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "removeProjectMember",
+		Method:             "DELETE",
+		PathPattern:        "/project/{id}/members/{uid}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RemoveProjectMemberReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RemoveProjectMemberNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RemoveProjectMemberDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  RemoveUser Remove the user from the identity platform and delete their local data and API tokens. They can sign in again if their provider is whitelisted or an access rule matches them.
+
+*/
+func (a *Client) RemoveUser(params *RemoveUserParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RemoveUserNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRemoveUserParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "removeUser",
+		Method:             "DELETE",
+		PathPattern:        "/user/{uid}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RemoveUserReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RemoveUserNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RemoveUserDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  RequestAccess Ask the security team for access, explaining why it's needed. Users that don't yet have access can use this. A pending request's justification is replaced; a denied request is reopened.
+
+*/
+func (a *Client) RequestAccess(params *RequestAccessParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RequestAccessOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRequestAccessParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "requestAccess",
+		Method:             "PUT",
+		PathPattern:        "/access-request",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RequestAccessReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RequestAccessOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RequestAccessDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  ReviewPlanRevision Approve a committed revision that's been submitted for review, or request changes to it, optionally with a comment; this needs the review permission, and reviewers can't review their own revisions. Without a decision, just add a comment, which the plan's contributors can also do.
+
+*/
+func (a *Client) ReviewPlanRevision(params *ReviewPlanRevisionParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*ReviewPlanRevisionOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewReviewPlanRevisionParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "reviewPlanRevision",
+		Method:             "POST",
+		PathPattern:        "/plan/{id}/revision/{revId}/review",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &ReviewPlanRevisionReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*ReviewPlanRevisionOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*ReviewPlanRevisionDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  RevokeAPIToken Revoke an API token. Users can revoke their own tokens, security admins can revoke any token.
+*/
+func (a *Client) RevokeAPIToken(params *RevokeAPITokenParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*RevokeAPITokenNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewRevokeAPITokenParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "revokeApiToken",
+		Method:             "DELETE",
+		PathPattern:        "/tokens/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &RevokeAPITokenReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*RevokeAPITokenNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*RevokeAPITokenDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  SetCommitPolicy Replace the rules plans must meet to be committed. Revisions that are already committed aren't affected.
+*/
+func (a *Client) SetCommitPolicy(params *SetCommitPolicyParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetCommitPolicyOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetCommitPolicyParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "setCommitPolicy",
+		Method:             "PUT",
+		PathPattern:        "/commit-policy",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SetCommitPolicyReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SetCommitPolicyOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SetCommitPolicyDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  SetProjectMember Add a user to the project, or change their role in it. Only project owners and security admins can manage members.
+*/
+func (a *Client) SetProjectMember(params *SetProjectMemberParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetProjectMemberOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetProjectMemberParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "setProjectMember",
+		Method:             "PUT",
+		PathPattern:        "/project/{id}/members/{uid}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SetProjectMemberReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SetProjectMemberOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SetProjectMemberDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  SetUserRoles Replace the roles explicitly granted to a user. An empty list reverts the user to the default roles.
+*/
+func (a *Client) SetUserRoles(params *SetUserRolesParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SetUserRolesOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSetUserRolesParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "setUserRoles",
+		Method:             "PUT",
+		PathPattern:        "/user/{uid}/roles",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SetUserRolesReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SetUserRolesOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SetUserRolesDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  SubscribeToProject Notify the current user, by email, of the project's events. Replaces any existing subscription.
+*/
+func (a *Client) SubscribeToProject(params *SubscribeToProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*SubscribeToProjectOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewSubscribeToProjectParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "subscribeToProject",
+		Method:             "PUT",
+		PathPattern:        "/project/{id}/subscription",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &SubscribeToProjectReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*SubscribeToProjectOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*SubscribeToProjectDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  TestWebhook Send a webhook.test event to the webhook straight away, and report the outcome. Requires the admin permission.
+*/
+func (a *Client) TestWebhook(params *TestWebhookParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*TestWebhookOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewTestWebhookParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "testWebhook",
+		Method:             "POST",
+		PathPattern:        "/webhooks/{id}/test",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &TestWebhookReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*TestWebhookOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*TestWebhookDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  UnsubscribeFromProject unsubscribe from project API
+*/
+func (a *Client) UnsubscribeFromProject(params *UnsubscribeFromProjectParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UnsubscribeFromProjectNoContent, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUnsubscribeFromProjectParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "unsubscribeFromProject",
+		Method:             "DELETE",
+		PathPattern:        "/project/{id}/subscription",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UnsubscribeFromProjectReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UnsubscribeFromProjectNoContent)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UnsubscribeFromProjectDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
+/*
+  UpdateOrgUnit Update an org unit. Only security admins and the leads of the unit or any of its ancestors can change it.
+*/
+func (a *Client) UpdateOrgUnit(params *UpdateOrgUnitParams, authInfo runtime.ClientAuthInfoWriter, opts ...ClientOption) (*UpdateOrgUnitOK, error) {
+	// TODO: Validate the params before sending
+	if params == nil {
+		params = NewUpdateOrgUnitParams()
+	}
+	op := &runtime.ClientOperation{
+		ID:                 "updateOrgUnit",
+		Method:             "PUT",
+		PathPattern:        "/orgunit/{id}",
+		ProducesMediaTypes: []string{"application/json"},
+		ConsumesMediaTypes: []string{"application/json"},
+		Schemes:            []string{"http"},
+		Params:             params,
+		Reader:             &UpdateOrgUnitReader{formats: a.formats},
+		AuthInfo:           authInfo,
+		Context:            params.Context,
+		Client:             params.HTTPClient,
+	}
+	for _, opt := range opts {
+		opt(op)
+	}
+
+	result, err := a.transport.Submit(op)
+	if err != nil {
+		return nil, err
+	}
+	success, ok := result.(*UpdateOrgUnitOK)
+	if ok {
+		return success, nil
+	}
+	// unexpected success response
+	unexpectedSuccess := result.(*UpdateOrgUnitDefault)
+	return nil, runtime.NewAPIError("unexpected success response: content available as default response in error", unexpectedSuccess, unexpectedSuccess.Code())
+}
+
 /*
   UpdateProject update project API
 */
@@ -0,0 +1,165 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewRemoveProjectMemberParams creates a new RemoveProjectMemberParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewRemoveProjectMemberParams() *RemoveProjectMemberParams {
+	return &RemoveProjectMemberParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewRemoveProjectMemberParamsWithTimeout creates a new RemoveProjectMemberParams object
+// with the ability to set a timeout on a request.
+func NewRemoveProjectMemberParamsWithTimeout(timeout time.Duration) *RemoveProjectMemberParams {
+	return &RemoveProjectMemberParams{
+		timeout: timeout,
+	}
+}
+
+// NewRemoveProjectMemberParamsWithContext creates a new RemoveProjectMemberParams object
+// with the ability to set a context for a request.
+func NewRemoveProjectMemberParamsWithContext(ctx context.Context) *RemoveProjectMemberParams {
+	return &RemoveProjectMemberParams{
+		Context: ctx,
+	}
+}
+
+// NewRemoveProjectMemberParamsWithHTTPClient creates a new RemoveProjectMemberParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewRemoveProjectMemberParamsWithHTTPClient(client *http.Client) *RemoveProjectMemberParams {
+	return &RemoveProjectMemberParams{
+		HTTPClient: client,
+	}
+}
+
+/* RemoveProjectMemberParams contains all the parameters to send to the API endpoint
+   for the remove project member operation.
+
+   Typically these are written to a http.Request.
+*/
+type RemoveProjectMemberParams struct {
+
+	// ID.
+	ID string
+
+	// UID.
+	UID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the remove project member params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RemoveProjectMemberParams) WithDefaults() *RemoveProjectMemberParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the remove project member params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *RemoveProjectMemberParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the remove project member params
+func (o *RemoveProjectMemberParams) WithTimeout(timeout time.Duration) *RemoveProjectMemberParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the remove project member params
+func (o *RemoveProjectMemberParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the remove project member params
+func (o *RemoveProjectMemberParams) WithContext(ctx context.Context) *RemoveProjectMemberParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the remove project member params
+func (o *RemoveProjectMemberParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the remove project member params
+func (o *RemoveProjectMemberParams) WithHTTPClient(client *http.Client) *RemoveProjectMemberParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the remove project member params
+func (o *RemoveProjectMemberParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the remove project member params
+func (o *RemoveProjectMemberParams) WithID(id string) *RemoveProjectMemberParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the remove project member params
+func (o *RemoveProjectMemberParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithUID adds the uid to the remove project member params
+func (o *RemoveProjectMemberParams) WithUID(uid string) *RemoveProjectMemberParams {
+	o.SetUID(uid)
+	return o
+}
+
+// SetUID adds the uid to the remove project member params
+func (o *RemoveProjectMemberParams) SetUID(uid string) {
+	o.UID = uid
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *RemoveProjectMemberParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	// path param uid
+	if err := r.SetPathParam("uid", o.UID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
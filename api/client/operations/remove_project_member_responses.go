@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RemoveProjectMemberReader is a Reader for the RemoveProjectMember structure.
+type RemoveProjectMemberReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RemoveProjectMemberReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewRemoveProjectMemberNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRemoveProjectMemberDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRemoveProjectMemberNoContent creates a RemoveProjectMemberNoContent with default headers values
+func NewRemoveProjectMemberNoContent() *RemoveProjectMemberNoContent {
+	return &RemoveProjectMemberNoContent{}
+}
+
+/* RemoveProjectMemberNoContent describes a response with status code 204, with default header values.
+
+Removed
+*/
+type RemoveProjectMemberNoContent struct {
+}
+
+func (o *RemoveProjectMemberNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /project/{id}/members/{uid}][%d] removeProjectMemberNoContent ", 204)
+}
+
+func (o *RemoveProjectMemberNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRemoveProjectMemberDefault creates a RemoveProjectMemberDefault with default headers values
+func NewRemoveProjectMemberDefault(code int) *RemoveProjectMemberDefault {
+	return &RemoveProjectMemberDefault{
+		_statusCode: code,
+	}
+}
+
+/* RemoveProjectMemberDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type RemoveProjectMemberDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the remove project member default response
+func (o *RemoveProjectMemberDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RemoveProjectMemberDefault) Error() string {
+	return fmt.Sprintf("[DELETE /project/{id}/members/{uid}][%d] removeProjectMember default  %+v", o._statusCode, o.Payload)
+}
+func (o *RemoveProjectMemberDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RemoveProjectMemberDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RemoveUserReader is a Reader for the RemoveUser structure.
+type RemoveUserReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RemoveUserReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewRemoveUserNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRemoveUserDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRemoveUserNoContent creates a RemoveUserNoContent with default headers values
+func NewRemoveUserNoContent() *RemoveUserNoContent {
+	return &RemoveUserNoContent{}
+}
+
+/* RemoveUserNoContent describes a response with status code 204, with default header values.
+
+Removed
+*/
+type RemoveUserNoContent struct {
+}
+
+func (o *RemoveUserNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /user/{uid}][%d] removeUserNoContent ", 204)
+}
+
+func (o *RemoveUserNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRemoveUserDefault creates a RemoveUserDefault with default headers values
+func NewRemoveUserDefault(code int) *RemoveUserDefault {
+	return &RemoveUserDefault{
+		_statusCode: code,
+	}
+}
+
+/* RemoveUserDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type RemoveUserDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the remove user default response
+func (o *RemoveUserDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RemoveUserDefault) Error() string {
+	return fmt.Sprintf("[DELETE /user/{uid}][%d] removeUser default  %+v", o._statusCode, o.Payload)
+}
+func (o *RemoveUserDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RemoveUserDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
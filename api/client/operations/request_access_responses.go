@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RequestAccessReader is a Reader for the RequestAccess structure.
+type RequestAccessReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RequestAccessReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewRequestAccessOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRequestAccessDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRequestAccessOK creates a RequestAccessOK with default headers values
+func NewRequestAccessOK() *RequestAccessOK {
+	return &RequestAccessOK{}
+}
+
+/* RequestAccessOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type RequestAccessOK struct {
+	Payload *models.AccessRequest
+}
+
+func (o *RequestAccessOK) Error() string {
+	return fmt.Sprintf("[PUT /access-request][%d] requestAccessOK  %+v", 200, o.Payload)
+}
+func (o *RequestAccessOK) GetPayload() *models.AccessRequest {
+	return o.Payload
+}
+
+func (o *RequestAccessOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.AccessRequest)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewRequestAccessDefault creates a RequestAccessDefault with default headers values
+func NewRequestAccessDefault(code int) *RequestAccessDefault {
+	return &RequestAccessDefault{
+		_statusCode: code,
+	}
+}
+
+/* RequestAccessDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type RequestAccessDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the request access default response
+func (o *RequestAccessDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RequestAccessDefault) Error() string {
+	return fmt.Sprintf("[PUT /access-request][%d] requestAccess default  %+v", o._statusCode, o.Payload)
+}
+func (o *RequestAccessDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RequestAccessDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
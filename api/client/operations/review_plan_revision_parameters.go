@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewReviewPlanRevisionParams creates a new ReviewPlanRevisionParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewReviewPlanRevisionParams() *ReviewPlanRevisionParams {
+	return &ReviewPlanRevisionParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewReviewPlanRevisionParamsWithTimeout creates a new ReviewPlanRevisionParams object
+// with the ability to set a timeout on a request.
+func NewReviewPlanRevisionParamsWithTimeout(timeout time.Duration) *ReviewPlanRevisionParams {
+	return &ReviewPlanRevisionParams{
+		timeout: timeout,
+	}
+}
+
+// NewReviewPlanRevisionParamsWithContext creates a new ReviewPlanRevisionParams object
+// with the ability to set a context for a request.
+func NewReviewPlanRevisionParamsWithContext(ctx context.Context) *ReviewPlanRevisionParams {
+	return &ReviewPlanRevisionParams{
+		Context: ctx,
+	}
+}
+
+// NewReviewPlanRevisionParamsWithHTTPClient creates a new ReviewPlanRevisionParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewReviewPlanRevisionParamsWithHTTPClient(client *http.Client) *ReviewPlanRevisionParams {
+	return &ReviewPlanRevisionParams{
+		HTTPClient: client,
+	}
+}
+
+/* ReviewPlanRevisionParams contains all the parameters to send to the API endpoint
+   for the review plan revision operation.
+
+   Typically these are written to a http.Request.
+*/
+type ReviewPlanRevisionParams struct {
+
+	// Body.
+	Body ReviewPlanRevisionBody
+
+	// ID.
+	ID string
+
+	// RevID.
+	RevID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the review plan revision params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReviewPlanRevisionParams) WithDefaults() *ReviewPlanRevisionParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the review plan revision params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *ReviewPlanRevisionParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the review plan revision params
+func (o *ReviewPlanRevisionParams) WithTimeout(timeout time.Duration) *ReviewPlanRevisionParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the review plan revision params
+func (o *ReviewPlanRevisionParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the review plan revision params
+func (o *ReviewPlanRevisionParams) WithContext(ctx context.Context) *ReviewPlanRevisionParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the review plan revision params
+func (o *ReviewPlanRevisionParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the review plan revision params
+func (o *ReviewPlanRevisionParams) WithHTTPClient(client *http.Client) *ReviewPlanRevisionParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the review plan revision params
+func (o *ReviewPlanRevisionParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the review plan revision params
+func (o *ReviewPlanRevisionParams) WithBody(body ReviewPlanRevisionBody) *ReviewPlanRevisionParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the review plan revision params
+func (o *ReviewPlanRevisionParams) SetBody(body ReviewPlanRevisionBody) {
+	o.Body = body
+}
+
+// WithID adds the id to the review plan revision params
+func (o *ReviewPlanRevisionParams) WithID(id string) *ReviewPlanRevisionParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the review plan revision params
+func (o *ReviewPlanRevisionParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithRevID adds the revID to the review plan revision params
+func (o *ReviewPlanRevisionParams) WithRevID(revID string) *ReviewPlanRevisionParams {
+	o.SetRevID(revID)
+	return o
+}
+
+// SetRevID adds the revId to the review plan revision params
+func (o *ReviewPlanRevisionParams) SetRevID(revID string) {
+	o.RevID = revID
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *ReviewPlanRevisionParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	// path param revId
+	if err := r.SetPathParam("revId", o.RevID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
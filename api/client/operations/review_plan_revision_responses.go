@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ReviewPlanRevisionReader is a Reader for the ReviewPlanRevision structure.
+type ReviewPlanRevisionReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *ReviewPlanRevisionReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewReviewPlanRevisionOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewReviewPlanRevisionDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewReviewPlanRevisionOK creates a ReviewPlanRevisionOK with default headers values
+func NewReviewPlanRevisionOK() *ReviewPlanRevisionOK {
+	return &ReviewPlanRevisionOK{}
+}
+
+/* ReviewPlanRevisionOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type ReviewPlanRevisionOK struct {
+	Payload *models.PlanReview
+}
+
+func (o *ReviewPlanRevisionOK) Error() string {
+	return fmt.Sprintf("[POST /plan/{id}/revision/{revId}/review][%d] reviewPlanRevisionOK  %+v", 200, o.Payload)
+}
+func (o *ReviewPlanRevisionOK) GetPayload() *models.PlanReview {
+	return o.Payload
+}
+
+func (o *ReviewPlanRevisionOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.PlanReview)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewReviewPlanRevisionDefault creates a ReviewPlanRevisionDefault with default headers values
+func NewReviewPlanRevisionDefault(code int) *ReviewPlanRevisionDefault {
+	return &ReviewPlanRevisionDefault{
+		_statusCode: code,
+	}
+}
+
+/* ReviewPlanRevisionDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type ReviewPlanRevisionDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the review plan revision default response
+func (o *ReviewPlanRevisionDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *ReviewPlanRevisionDefault) Error() string {
+	return fmt.Sprintf("[POST /plan/{id}/revision/{revId}/review][%d] reviewPlanRevision default  %+v", o._statusCode, o.Payload)
+}
+func (o *ReviewPlanRevisionDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *ReviewPlanRevisionDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*ReviewPlanRevisionBody review plan revision body
+swagger:model ReviewPlanRevisionBody
+*/
+type ReviewPlanRevisionBody struct {
+
+	// comment
+	Comment string `json:"comment,omitempty"`
+
+	// decision
+	// Enum: [approved changes-requested]
+	Decision string `json:"decision,omitempty"`
+}
+
+// Validate validates this review plan revision body
+func (o *ReviewPlanRevisionBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDecision(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var reviewPlanRevisionBodyTypeDecisionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["approved","changes-requested"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		reviewPlanRevisionBodyTypeDecisionPropEnum = append(reviewPlanRevisionBodyTypeDecisionPropEnum, v)
+	}
+}
+
+const (
+
+	// ReviewPlanRevisionBodyDecisionApproved captures enum value "approved"
+	ReviewPlanRevisionBodyDecisionApproved string = "approved"
+
+	// ReviewPlanRevisionBodyDecisionChangesDashRequested captures enum value "changes-requested"
+	ReviewPlanRevisionBodyDecisionChangesDashRequested string = "changes-requested"
+)
+
+// prop value enum
+func (o *ReviewPlanRevisionBody) validateDecisionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, reviewPlanRevisionBodyTypeDecisionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReviewPlanRevisionBody) validateDecision(formats strfmt.Registry) error {
+	if swag.IsZero(o.Decision) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateDecisionEnum("body"+"."+"decision", "body", o.Decision); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this review plan revision body based on context it is used
+func (o *ReviewPlanRevisionBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReviewPlanRevisionBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReviewPlanRevisionBody) UnmarshalBinary(b []byte) error {
+	var res ReviewPlanRevisionBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RevokeAPITokenReader is a Reader for the RevokeAPIToken structure.
+type RevokeAPITokenReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *RevokeAPITokenReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewRevokeAPITokenNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewRevokeAPITokenDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewRevokeAPITokenNoContent creates a RevokeAPITokenNoContent with default headers values
+func NewRevokeAPITokenNoContent() *RevokeAPITokenNoContent {
+	return &RevokeAPITokenNoContent{}
+}
+
+/* RevokeAPITokenNoContent describes a response with status code 204, with default header values.
+
+Revoked
+*/
+type RevokeAPITokenNoContent struct {
+}
+
+func (o *RevokeAPITokenNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /tokens/{id}][%d] revokeApiTokenNoContent ", 204)
+}
+
+func (o *RevokeAPITokenNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewRevokeAPITokenDefault creates a RevokeAPITokenDefault with default headers values
+func NewRevokeAPITokenDefault(code int) *RevokeAPITokenDefault {
+	return &RevokeAPITokenDefault{
+		_statusCode: code,
+	}
+}
+
+/* RevokeAPITokenDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type RevokeAPITokenDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the revoke Api token default response
+func (o *RevokeAPITokenDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *RevokeAPITokenDefault) Error() string {
+	return fmt.Sprintf("[DELETE /tokens/{id}][%d] revokeApiToken default  %+v", o._statusCode, o.Payload)
+}
+func (o *RevokeAPITokenDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *RevokeAPITokenDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
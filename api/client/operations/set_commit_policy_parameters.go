@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// NewSetCommitPolicyParams creates a new SetCommitPolicyParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSetCommitPolicyParams() *SetCommitPolicyParams {
+	return &SetCommitPolicyParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSetCommitPolicyParamsWithTimeout creates a new SetCommitPolicyParams object
+// with the ability to set a timeout on a request.
+func NewSetCommitPolicyParamsWithTimeout(timeout time.Duration) *SetCommitPolicyParams {
+	return &SetCommitPolicyParams{
+		timeout: timeout,
+	}
+}
+
+// NewSetCommitPolicyParamsWithContext creates a new SetCommitPolicyParams object
+// with the ability to set a context for a request.
+func NewSetCommitPolicyParamsWithContext(ctx context.Context) *SetCommitPolicyParams {
+	return &SetCommitPolicyParams{
+		Context: ctx,
+	}
+}
+
+// NewSetCommitPolicyParamsWithHTTPClient creates a new SetCommitPolicyParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSetCommitPolicyParamsWithHTTPClient(client *http.Client) *SetCommitPolicyParams {
+	return &SetCommitPolicyParams{
+		HTTPClient: client,
+	}
+}
+
+/* SetCommitPolicyParams contains all the parameters to send to the API endpoint
+   for the set commit policy operation.
+
+   Typically these are written to a http.Request.
+*/
+type SetCommitPolicyParams struct {
+
+	// Body.
+	Body *lib.CommitPolicy
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the set commit policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetCommitPolicyParams) WithDefaults() *SetCommitPolicyParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the set commit policy params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetCommitPolicyParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the set commit policy params
+func (o *SetCommitPolicyParams) WithTimeout(timeout time.Duration) *SetCommitPolicyParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the set commit policy params
+func (o *SetCommitPolicyParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the set commit policy params
+func (o *SetCommitPolicyParams) WithContext(ctx context.Context) *SetCommitPolicyParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the set commit policy params
+func (o *SetCommitPolicyParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the set commit policy params
+func (o *SetCommitPolicyParams) WithHTTPClient(client *http.Client) *SetCommitPolicyParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the set commit policy params
+func (o *SetCommitPolicyParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the set commit policy params
+func (o *SetCommitPolicyParams) WithBody(body *lib.CommitPolicy) *SetCommitPolicyParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the set commit policy params
+func (o *SetCommitPolicyParams) SetBody(body *lib.CommitPolicy) {
+	o.Body = body
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SetCommitPolicyParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// SetCommitPolicyReader is a Reader for the SetCommitPolicy structure.
+type SetCommitPolicyReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SetCommitPolicyReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSetCommitPolicyOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewSetCommitPolicyDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSetCommitPolicyOK creates a SetCommitPolicyOK with default headers values
+func NewSetCommitPolicyOK() *SetCommitPolicyOK {
+	return &SetCommitPolicyOK{}
+}
+
+/* SetCommitPolicyOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SetCommitPolicyOK struct {
+	Payload *lib.CommitPolicy
+}
+
+func (o *SetCommitPolicyOK) Error() string {
+	return fmt.Sprintf("[PUT /commit-policy][%d] setCommitPolicyOK  %+v", 200, o.Payload)
+}
+func (o *SetCommitPolicyOK) GetPayload() *lib.CommitPolicy {
+	return o.Payload
+}
+
+func (o *SetCommitPolicyOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(lib.CommitPolicy)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSetCommitPolicyDefault creates a SetCommitPolicyDefault with default headers values
+func NewSetCommitPolicyDefault(code int) *SetCommitPolicyDefault {
+	return &SetCommitPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+/* SetCommitPolicyDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type SetCommitPolicyDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the set commit policy default response
+func (o *SetCommitPolicyDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SetCommitPolicyDefault) Error() string {
+	return fmt.Sprintf("[PUT /commit-policy][%d] setCommitPolicy default  %+v", o._statusCode, o.Payload)
+}
+func (o *SetCommitPolicyDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetCommitPolicyDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,182 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewSetProjectMemberParams creates a new SetProjectMemberParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewSetProjectMemberParams() *SetProjectMemberParams {
+	return &SetProjectMemberParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewSetProjectMemberParamsWithTimeout creates a new SetProjectMemberParams object
+// with the ability to set a timeout on a request.
+func NewSetProjectMemberParamsWithTimeout(timeout time.Duration) *SetProjectMemberParams {
+	return &SetProjectMemberParams{
+		timeout: timeout,
+	}
+}
+
+// NewSetProjectMemberParamsWithContext creates a new SetProjectMemberParams object
+// with the ability to set a context for a request.
+func NewSetProjectMemberParamsWithContext(ctx context.Context) *SetProjectMemberParams {
+	return &SetProjectMemberParams{
+		Context: ctx,
+	}
+}
+
+// NewSetProjectMemberParamsWithHTTPClient creates a new SetProjectMemberParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewSetProjectMemberParamsWithHTTPClient(client *http.Client) *SetProjectMemberParams {
+	return &SetProjectMemberParams{
+		HTTPClient: client,
+	}
+}
+
+/* SetProjectMemberParams contains all the parameters to send to the API endpoint
+   for the set project member operation.
+
+   Typically these are written to a http.Request.
+*/
+type SetProjectMemberParams struct {
+
+	// Body.
+	Body SetProjectMemberBody
+
+	// ID.
+	ID string
+
+	// UID.
+	UID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the set project member params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetProjectMemberParams) WithDefaults() *SetProjectMemberParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the set project member params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *SetProjectMemberParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the set project member params
+func (o *SetProjectMemberParams) WithTimeout(timeout time.Duration) *SetProjectMemberParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the set project member params
+func (o *SetProjectMemberParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the set project member params
+func (o *SetProjectMemberParams) WithContext(ctx context.Context) *SetProjectMemberParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the set project member params
+func (o *SetProjectMemberParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the set project member params
+func (o *SetProjectMemberParams) WithHTTPClient(client *http.Client) *SetProjectMemberParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the set project member params
+func (o *SetProjectMemberParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the set project member params
+func (o *SetProjectMemberParams) WithBody(body SetProjectMemberBody) *SetProjectMemberParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the set project member params
+func (o *SetProjectMemberParams) SetBody(body SetProjectMemberBody) {
+	o.Body = body
+}
+
+// WithID adds the id to the set project member params
+func (o *SetProjectMemberParams) WithID(id string) *SetProjectMemberParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the set project member params
+func (o *SetProjectMemberParams) SetID(id string) {
+	o.ID = id
+}
+
+// WithUID adds the uid to the set project member params
+func (o *SetProjectMemberParams) WithUID(uid string) *SetProjectMemberParams {
+	o.SetUID(uid)
+	return o
+}
+
+// SetUID adds the uid to the set project member params
+func (o *SetProjectMemberParams) SetUID(uid string) {
+	o.UID = uid
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *SetProjectMemberParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if err := r.SetBodyParam(o.Body); err != nil {
+		return err
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	// path param uid
+	if err := r.SetPathParam("uid", o.UID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
@@ -0,0 +1,204 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SetProjectMemberReader is a Reader for the SetProjectMember structure.
+type SetProjectMemberReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SetProjectMemberReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSetProjectMemberOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewSetProjectMemberDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSetProjectMemberOK creates a SetProjectMemberOK with default headers values
+func NewSetProjectMemberOK() *SetProjectMemberOK {
+	return &SetProjectMemberOK{}
+}
+
+/* SetProjectMemberOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SetProjectMemberOK struct {
+}
+
+func (o *SetProjectMemberOK) Error() string {
+	return fmt.Sprintf("[PUT /project/{id}/members/{uid}][%d] setProjectMemberOK ", 200)
+}
+
+func (o *SetProjectMemberOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSetProjectMemberDefault creates a SetProjectMemberDefault with default headers values
+func NewSetProjectMemberDefault(code int) *SetProjectMemberDefault {
+	return &SetProjectMemberDefault{
+		_statusCode: code,
+	}
+}
+
+/* SetProjectMemberDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type SetProjectMemberDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the set project member default response
+func (o *SetProjectMemberDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SetProjectMemberDefault) Error() string {
+	return fmt.Sprintf("[PUT /project/{id}/members/{uid}][%d] setProjectMember default  %+v", o._statusCode, o.Payload)
+}
+func (o *SetProjectMemberDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetProjectMemberDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*SetProjectMemberBody set project member body
+swagger:model SetProjectMemberBody
+*/
+type SetProjectMemberBody struct {
+
+	// role
+	// Required: true
+	Role *models.ProjectMemberRole `json:"role"`
+}
+
+// Validate validates this set project member body
+func (o *SetProjectMemberBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *SetProjectMemberBody) validateRole(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"role", "body", o.Role); err != nil {
+		return err
+	}
+
+	if err := validate.Required("body"+"."+"role", "body", o.Role); err != nil {
+		return err
+	}
+
+	if o.Role != nil {
+		if err := o.Role.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("body" + "." + "role")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("body" + "." + "role")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this set project member body based on the context it is used
+func (o *SetProjectMemberBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRole(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *SetProjectMemberBody) contextValidateRole(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Role != nil {
+		if err := o.Role.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("body" + "." + "role")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("body" + "." + "role")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *SetProjectMemberBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *SetProjectMemberBody) UnmarshalBinary(b []byte) error {
+	var res SetProjectMemberBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
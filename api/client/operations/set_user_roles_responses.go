@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SetUserRolesReader is a Reader for the SetUserRoles structure.
+type SetUserRolesReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SetUserRolesReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSetUserRolesOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewSetUserRolesDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSetUserRolesOK creates a SetUserRolesOK with default headers values
+func NewSetUserRolesOK() *SetUserRolesOK {
+	return &SetUserRolesOK{}
+}
+
+/* SetUserRolesOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SetUserRolesOK struct {
+}
+
+func (o *SetUserRolesOK) Error() string {
+	return fmt.Sprintf("[PUT /user/{uid}/roles][%d] setUserRolesOK ", 200)
+}
+
+func (o *SetUserRolesOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewSetUserRolesDefault creates a SetUserRolesDefault with default headers values
+func NewSetUserRolesDefault(code int) *SetUserRolesDefault {
+	return &SetUserRolesDefault{
+		_statusCode: code,
+	}
+}
+
+/* SetUserRolesDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type SetUserRolesDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the set user roles default response
+func (o *SetUserRolesDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SetUserRolesDefault) Error() string {
+	return fmt.Sprintf("[PUT /user/{uid}/roles][%d] setUserRoles default  %+v", o._statusCode, o.Payload)
+}
+func (o *SetUserRolesDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SetUserRolesDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
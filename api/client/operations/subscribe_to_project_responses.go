@@ -0,0 +1,154 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SubscribeToProjectReader is a Reader for the SubscribeToProject structure.
+type SubscribeToProjectReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *SubscribeToProjectReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewSubscribeToProjectOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewSubscribeToProjectDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewSubscribeToProjectOK creates a SubscribeToProjectOK with default headers values
+func NewSubscribeToProjectOK() *SubscribeToProjectOK {
+	return &SubscribeToProjectOK{}
+}
+
+/* SubscribeToProjectOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type SubscribeToProjectOK struct {
+	Payload *models.Subscription
+}
+
+func (o *SubscribeToProjectOK) Error() string {
+	return fmt.Sprintf("[PUT /project/{id}/subscription][%d] subscribeToProjectOK  %+v", 200, o.Payload)
+}
+func (o *SubscribeToProjectOK) GetPayload() *models.Subscription {
+	return o.Payload
+}
+
+func (o *SubscribeToProjectOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Subscription)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewSubscribeToProjectDefault creates a SubscribeToProjectDefault with default headers values
+func NewSubscribeToProjectDefault(code int) *SubscribeToProjectDefault {
+	return &SubscribeToProjectDefault{
+		_statusCode: code,
+	}
+}
+
+/* SubscribeToProjectDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type SubscribeToProjectDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the subscribe to project default response
+func (o *SubscribeToProjectDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *SubscribeToProjectDefault) Error() string {
+	return fmt.Sprintf("[PUT /project/{id}/subscription][%d] subscribeToProject default  %+v", o._statusCode, o.Payload)
+}
+func (o *SubscribeToProjectDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *SubscribeToProjectDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+/*SubscribeToProjectBody subscribe to project body
+swagger:model SubscribeToProjectBody
+*/
+type SubscribeToProjectBody struct {
+
+	// The event types to be notified of, e.g. plan.committed or project.*; every event if empty
+	Events []string `json:"events"`
+}
+
+// Validate validates this subscribe to project body
+func (o *SubscribeToProjectBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this subscribe to project body based on context it is used
+func (o *SubscribeToProjectBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *SubscribeToProjectBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *SubscribeToProjectBody) UnmarshalBinary(b []byte) error {
+	var res SubscribeToProjectBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
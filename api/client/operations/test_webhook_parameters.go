@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewTestWebhookParams creates a new TestWebhookParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewTestWebhookParams() *TestWebhookParams {
+	return &TestWebhookParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewTestWebhookParamsWithTimeout creates a new TestWebhookParams object
+// with the ability to set a timeout on a request.
+func NewTestWebhookParamsWithTimeout(timeout time.Duration) *TestWebhookParams {
+	return &TestWebhookParams{
+		timeout: timeout,
+	}
+}
+
+// NewTestWebhookParamsWithContext creates a new TestWebhookParams object
+// with the ability to set a context for a request.
+func NewTestWebhookParamsWithContext(ctx context.Context) *TestWebhookParams {
+	return &TestWebhookParams{
+		Context: ctx,
+	}
+}
+
+// NewTestWebhookParamsWithHTTPClient creates a new TestWebhookParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewTestWebhookParamsWithHTTPClient(client *http.Client) *TestWebhookParams {
+	return &TestWebhookParams{
+		HTTPClient: client,
+	}
+}
+
+/* TestWebhookParams contains all the parameters to send to the API endpoint
+   for the test webhook operation.
+
+   Typically these are written to a http.Request.
+*/
+type TestWebhookParams struct {
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the test webhook params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *TestWebhookParams) WithDefaults() *TestWebhookParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the test webhook params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *TestWebhookParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the test webhook params
+func (o *TestWebhookParams) WithTimeout(timeout time.Duration) *TestWebhookParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the test webhook params
+func (o *TestWebhookParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the test webhook params
+func (o *TestWebhookParams) WithContext(ctx context.Context) *TestWebhookParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the test webhook params
+func (o *TestWebhookParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the test webhook params
+func (o *TestWebhookParams) WithHTTPClient(client *http.Client) *TestWebhookParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the test webhook params
+func (o *TestWebhookParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithID adds the id to the test webhook params
+func (o *TestWebhookParams) WithID(id string) *TestWebhookParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the test webhook params
+func (o *TestWebhookParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *TestWebhookParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
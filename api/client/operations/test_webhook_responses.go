@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// TestWebhookReader is a Reader for the TestWebhook structure.
+type TestWebhookReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *TestWebhookReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewTestWebhookOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewTestWebhookDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewTestWebhookOK creates a TestWebhookOK with default headers values
+func NewTestWebhookOK() *TestWebhookOK {
+	return &TestWebhookOK{}
+}
+
+/* TestWebhookOK describes a response with status code 200, with default header values.
+
+The test was sent, see the result for whether it was delivered
+*/
+type TestWebhookOK struct {
+	Payload *models.WebhookTestResult
+}
+
+func (o *TestWebhookOK) Error() string {
+	return fmt.Sprintf("[POST /webhooks/{id}/test][%d] testWebhookOK  %+v", 200, o.Payload)
+}
+func (o *TestWebhookOK) GetPayload() *models.WebhookTestResult {
+	return o.Payload
+}
+
+func (o *TestWebhookOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.WebhookTestResult)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
+
+// NewTestWebhookDefault creates a TestWebhookDefault with default headers values
+func NewTestWebhookDefault(code int) *TestWebhookDefault {
+	return &TestWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+/* TestWebhookDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type TestWebhookDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the test webhook default response
+func (o *TestWebhookDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *TestWebhookDefault) Error() string {
+	return fmt.Sprintf("[POST /webhooks/{id}/test][%d] testWebhook default  %+v", o._statusCode, o.Payload)
+}
+func (o *TestWebhookDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *TestWebhookDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// UnsubscribeFromProjectReader is a Reader for the UnsubscribeFromProject structure.
+type UnsubscribeFromProjectReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UnsubscribeFromProjectReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 204:
+		result := NewUnsubscribeFromProjectNoContent()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewUnsubscribeFromProjectDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUnsubscribeFromProjectNoContent creates a UnsubscribeFromProjectNoContent with default headers values
+func NewUnsubscribeFromProjectNoContent() *UnsubscribeFromProjectNoContent {
+	return &UnsubscribeFromProjectNoContent{}
+}
+
+/* UnsubscribeFromProjectNoContent describes a response with status code 204, with default header values.
+
+Unsubscribed
+*/
+type UnsubscribeFromProjectNoContent struct {
+}
+
+func (o *UnsubscribeFromProjectNoContent) Error() string {
+	return fmt.Sprintf("[DELETE /project/{id}/subscription][%d] unsubscribeFromProjectNoContent ", 204)
+}
+
+func (o *UnsubscribeFromProjectNoContent) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUnsubscribeFromProjectDefault creates a UnsubscribeFromProjectDefault with default headers values
+func NewUnsubscribeFromProjectDefault(code int) *UnsubscribeFromProjectDefault {
+	return &UnsubscribeFromProjectDefault{
+		_statusCode: code,
+	}
+}
+
+/* UnsubscribeFromProjectDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type UnsubscribeFromProjectDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the unsubscribe from project default response
+func (o *UnsubscribeFromProjectDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UnsubscribeFromProjectDefault) Error() string {
+	return fmt.Sprintf("[DELETE /project/{id}/subscription][%d] unsubscribeFromProject default  %+v", o._statusCode, o.Payload)
+}
+func (o *UnsubscribeFromProjectDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UnsubscribeFromProjectDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,167 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+	"time"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	cr "github.com/go-openapi/runtime/client"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// NewUpdateOrgUnitParams creates a new UpdateOrgUnitParams object,
+// with the default timeout for this client.
+//
+// Default values are not hydrated, since defaults are normally applied by the API server side.
+//
+// To enforce default values in parameter, use SetDefaults or WithDefaults.
+func NewUpdateOrgUnitParams() *UpdateOrgUnitParams {
+	return &UpdateOrgUnitParams{
+		timeout: cr.DefaultTimeout,
+	}
+}
+
+// NewUpdateOrgUnitParamsWithTimeout creates a new UpdateOrgUnitParams object
+// with the ability to set a timeout on a request.
+func NewUpdateOrgUnitParamsWithTimeout(timeout time.Duration) *UpdateOrgUnitParams {
+	return &UpdateOrgUnitParams{
+		timeout: timeout,
+	}
+}
+
+// NewUpdateOrgUnitParamsWithContext creates a new UpdateOrgUnitParams object
+// with the ability to set a context for a request.
+func NewUpdateOrgUnitParamsWithContext(ctx context.Context) *UpdateOrgUnitParams {
+	return &UpdateOrgUnitParams{
+		Context: ctx,
+	}
+}
+
+// NewUpdateOrgUnitParamsWithHTTPClient creates a new UpdateOrgUnitParams object
+// with the ability to set a custom HTTPClient for a request.
+func NewUpdateOrgUnitParamsWithHTTPClient(client *http.Client) *UpdateOrgUnitParams {
+	return &UpdateOrgUnitParams{
+		HTTPClient: client,
+	}
+}
+
+/* UpdateOrgUnitParams contains all the parameters to send to the API endpoint
+   for the update org unit operation.
+
+   Typically these are written to a http.Request.
+*/
+type UpdateOrgUnitParams struct {
+
+	// Body.
+	Body *models.OrgUnitDetails
+
+	// ID.
+	ID string
+
+	timeout    time.Duration
+	Context    context.Context
+	HTTPClient *http.Client
+}
+
+// WithDefaults hydrates default values in the update org unit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateOrgUnitParams) WithDefaults() *UpdateOrgUnitParams {
+	o.SetDefaults()
+	return o
+}
+
+// SetDefaults hydrates default values in the update org unit params (not the query body).
+//
+// All values with no default are reset to their zero value.
+func (o *UpdateOrgUnitParams) SetDefaults() {
+	// no default values defined for this parameter
+}
+
+// WithTimeout adds the timeout to the update org unit params
+func (o *UpdateOrgUnitParams) WithTimeout(timeout time.Duration) *UpdateOrgUnitParams {
+	o.SetTimeout(timeout)
+	return o
+}
+
+// SetTimeout adds the timeout to the update org unit params
+func (o *UpdateOrgUnitParams) SetTimeout(timeout time.Duration) {
+	o.timeout = timeout
+}
+
+// WithContext adds the context to the update org unit params
+func (o *UpdateOrgUnitParams) WithContext(ctx context.Context) *UpdateOrgUnitParams {
+	o.SetContext(ctx)
+	return o
+}
+
+// SetContext adds the context to the update org unit params
+func (o *UpdateOrgUnitParams) SetContext(ctx context.Context) {
+	o.Context = ctx
+}
+
+// WithHTTPClient adds the HTTPClient to the update org unit params
+func (o *UpdateOrgUnitParams) WithHTTPClient(client *http.Client) *UpdateOrgUnitParams {
+	o.SetHTTPClient(client)
+	return o
+}
+
+// SetHTTPClient adds the HTTPClient to the update org unit params
+func (o *UpdateOrgUnitParams) SetHTTPClient(client *http.Client) {
+	o.HTTPClient = client
+}
+
+// WithBody adds the body to the update org unit params
+func (o *UpdateOrgUnitParams) WithBody(body *models.OrgUnitDetails) *UpdateOrgUnitParams {
+	o.SetBody(body)
+	return o
+}
+
+// SetBody adds the body to the update org unit params
+func (o *UpdateOrgUnitParams) SetBody(body *models.OrgUnitDetails) {
+	o.Body = body
+}
+
+// WithID adds the id to the update org unit params
+func (o *UpdateOrgUnitParams) WithID(id string) *UpdateOrgUnitParams {
+	o.SetID(id)
+	return o
+}
+
+// SetID adds the id to the update org unit params
+func (o *UpdateOrgUnitParams) SetID(id string) {
+	o.ID = id
+}
+
+// WriteToRequest writes these params to a swagger request
+func (o *UpdateOrgUnitParams) WriteToRequest(r runtime.ClientRequest, reg strfmt.Registry) error {
+
+	if err := r.SetTimeout(o.timeout); err != nil {
+		return err
+	}
+	var res []error
+	if o.Body != nil {
+		if err := r.SetBodyParam(o.Body); err != nil {
+			return err
+		}
+	}
+
+	// path param id
+	if err := r.SetPathParam("id", o.ID); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
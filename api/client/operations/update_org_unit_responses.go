@@ -0,0 +1,104 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// UpdateOrgUnitReader is a Reader for the UpdateOrgUnit structure.
+type UpdateOrgUnitReader struct {
+	formats strfmt.Registry
+}
+
+// ReadResponse reads a server response into the received o.
+func (o *UpdateOrgUnitReader) ReadResponse(response runtime.ClientResponse, consumer runtime.Consumer) (interface{}, error) {
+	switch response.Code() {
+	case 200:
+		result := NewUpdateOrgUnitOK()
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		return result, nil
+	default:
+		result := NewUpdateOrgUnitDefault(response.Code())
+		if err := result.readResponse(response, consumer, o.formats); err != nil {
+			return nil, err
+		}
+		if response.Code()/100 == 2 {
+			return result, nil
+		}
+		return nil, result
+	}
+}
+
+// NewUpdateOrgUnitOK creates a UpdateOrgUnitOK with default headers values
+func NewUpdateOrgUnitOK() *UpdateOrgUnitOK {
+	return &UpdateOrgUnitOK{}
+}
+
+/* UpdateOrgUnitOK describes a response with status code 200, with default header values.
+
+OK
+*/
+type UpdateOrgUnitOK struct {
+}
+
+func (o *UpdateOrgUnitOK) Error() string {
+	return fmt.Sprintf("[PUT /orgunit/{id}][%d] updateOrgUnitOK ", 200)
+}
+
+func (o *UpdateOrgUnitOK) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	return nil
+}
+
+// NewUpdateOrgUnitDefault creates a UpdateOrgUnitDefault with default headers values
+func NewUpdateOrgUnitDefault(code int) *UpdateOrgUnitDefault {
+	return &UpdateOrgUnitDefault{
+		_statusCode: code,
+	}
+}
+
+/* UpdateOrgUnitDefault describes a response with status code -1, with default header values.
+
+error
+*/
+type UpdateOrgUnitDefault struct {
+	_statusCode int
+
+	Payload *models.Error
+}
+
+// Code gets the status code for the update org unit default response
+func (o *UpdateOrgUnitDefault) Code() int {
+	return o._statusCode
+}
+
+func (o *UpdateOrgUnitDefault) Error() string {
+	return fmt.Sprintf("[PUT /orgunit/{id}][%d] updateOrgUnit default  %+v", o._statusCode, o.Payload)
+}
+func (o *UpdateOrgUnitDefault) GetPayload() *models.Error {
+	return o.Payload
+}
+
+func (o *UpdateOrgUnitDefault) readResponse(response runtime.ClientResponse, consumer runtime.Consumer, formats strfmt.Registry) error {
+
+	o.Payload = new(models.Error)
+
+	// response payload
+	if err := consumer.Consume(response.Body(), o.Payload); err != nil && err != io.EOF {
+		return err
+	}
+
+	return nil
+}
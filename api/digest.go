@@ -0,0 +1,398 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// EventDigest is a summary of assessment activity over a period, sent to the channels it's routed to
+const EventDigest = "report.digest"
+
+// The periods digests can be scheduled for
+const (
+	DigestWeekly  = "weekly"  // Monday to Sunday
+	DigestMonthly = "monthly" // calendar months
+)
+
+// digestTopTasks is how many of the most commonly prioritised tasks a digest lists
+const digestTopTasks = 10
+
+// digestRiskNotice is how many days after the end of a digest's window it lists risk acceptances expiring within
+const digestRiskNotice = 30
+
+// Digest summarises assessment activity from From until To
+type Digest struct {
+	Period    string                   `json:"period,omitempty"` // empty for a digest of an arbitrary window
+	From      time.Time                `json:"from"`
+	To        time.Time                `json:"to"`
+	Committed []DigestPlan             `json:"committed"` // revisions committed in the window, earliest first
+	Practices []DigestPractice         `json:"practices"` // practices whose maturity changed for at least one project
+	Regressed []DigestRegression       `json:"regressed"` // projects whose maturity decreased for at least one practice
+	Overdue   []*models.OverdueProject `json:"overdue"`   // projects overdue at the end of the window, given the plans committed so far
+	TopTasks  []DigestTask             `json:"topTasks"`  // the tasks prioritised by the most projects at the end of the window
+	// Risks are the risk acceptances, in plans committed by the end of the window, that have expired or are about to, soonest first
+	Risks []*models.AcceptedRisk `json:"risks"`
+}
+
+// DigestPlan is a plan revision committed during a digest's window
+type DigestPlan struct {
+	PlanID     string    `json:"planId"`
+	RevisionID string    `json:"revisionId"`
+	Projects   string    `json:"projects"` // the names of the plan's projects
+	Date       string    `json:"date"`
+	Saved      time.Time `json:"saved"`
+}
+
+// DigestPractice counts the projects whose maturity in a practice changed during a digest's window
+type DigestPractice struct {
+	ID        string `json:"id"`
+	Name      string `json:"name"`
+	Improved  int    `json:"improved"`
+	Regressed int    `json:"regressed"`
+}
+
+// DigestRegression lists the practices whose maturity decreased for a project during a digest's window
+type DigestRegression struct {
+	ProjectID string              `json:"projectId"`
+	Name      string              `json:"name"`
+	Decreases []NotificationField `json:"decreases"` // named by practice
+}
+
+// DigestTask is a task that projects have prioritised
+type DigestTask struct {
+	PracticeID string `json:"practiceId"`
+	TaskID     string `json:"taskId"`
+	Practice   string `json:"practice"`
+	Title      string `json:"title"`
+	Projects   int    `json:"projects"` // how many projects have prioritised it
+}
+
+// DigestPeriod returns the window covered by the most recent complete period before now, in UTC
+func DigestPeriod(period string, now time.Time) (from time.Time, to time.Time, err error) {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	switch period {
+	case DigestWeekly:
+		to = today.AddDate(0, 0, -((int(today.Weekday()) + 6) % 7))
+		return to.AddDate(0, 0, -7), to, nil
+	case DigestMonthly:
+		to = time.Date(now.Year(), now.Month(), 1, 0, 0, 0, 0, time.UTC)
+		return to.AddDate(0, -1, 0), to, nil
+	default:
+		return time.Time{}, time.Time{}, fmt.Errorf("unknown digest period %q, it must be %v or %v", period, DigestWeekly, DigestMonthly)
+	}
+}
+
+// latestCommittedAt returns the most recently dated plan, of those committed in revisions saved before t, or nil if there isn't one.
+// Within a plan, the most recently saved committed revision is used. If reviews isn't nil, it holds the reviews of each
+// chain's revisions keyed on revision ID, and revisions with a review only count if they were approved before t.
+func latestCommittedAt(chains [][]lib.ChainedRevision, reviews []map[string]*models.PlanReview, t time.Time) *lib.Plan {
+	var latest *lib.Plan
+	for i, revs := range chains {
+		var plan *lib.Plan
+		for _, rev := range revs {
+			if !rev.Time.Before(t) || rev.Plan == nil || !rev.Plan.Details.Committed {
+				continue
+			}
+			if reviews != nil {
+				if r := reviews[i][rev.ID]; r != nil && (*r.State != models.PlanReviewStateApproved || !time.Time(r.Reviewed).Before(t)) {
+					continue
+				}
+			}
+			plan = rev.Plan
+		}
+		if plan != nil && (latest == nil || plan.Details.Date >= latest.Details.Date) {
+			latest = plan
+		}
+	}
+	return latest
+}
+
+// BuildDigest summarises the assessment activity from from until to
+func BuildDigest(ctx context.Context, rt *Runtime, from time.Time, to time.Time) (*Digest, error) {
+	projects, err := rt.Store.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	projectNames := map[string]string{}
+	for _, p := range projects {
+		projectNames[p.ID] = p.ID
+		if p.Attributes != nil && p.Attributes.Name != nil {
+			projectNames[p.ID] = *p.Attributes.Name
+		}
+	}
+	joinNames := func(ids []string) string {
+		n := make([]string, len(ids))
+		for i, id := range ids {
+			if n[i] = projectNames[id]; n[i] == "" {
+				n[i] = id
+			}
+		}
+		return strings.Join(n, ", ")
+	}
+
+	d := &Digest{From: from, To: to, Committed: []DigestPlan{}, Practices: []DigestPractice{}, Regressed: []DigestRegression{}, TopTasks: []DigestTask{}, Risks: []*models.AcceptedRisk{}}
+	end, notice := to.Format("2006-01-02"), to.AddDate(0, 0, digestRiskNotice).Format("2006-01-02")
+	chains := map[string][]lib.ChainedRevision{} // plans can belong to several projects, so only look each one up once
+	reviews := map[string]map[string]*models.PlanReview{}
+	for _, p := range projects {
+		for _, planID := range p.Plans {
+			if _, seen := chains[planID]; seen {
+				continue
+			}
+			revs, _, found, err := rt.Store.GetPlanRevisionChain(ctx, planID)
+			if err != nil {
+				return nil, err
+			}
+			if !found {
+				revs = nil
+			}
+			chains[planID] = revs
+			if rt.PlanReviews {
+				versions, err := rt.Store.GetPlanVersions(ctx, planID)
+				if err != nil {
+					return nil, err
+				}
+				reviews[planID] = map[string]*models.PlanReview{}
+				for _, v := range versions {
+					reviews[planID][*v.RevID] = v.Review
+				}
+			}
+			for _, rev := range revs {
+				if rev.Plan != nil && rev.Plan.Details.Committed && !rev.Time.Before(from) && rev.Time.Before(to) {
+					d.Committed = append(d.Committed, DigestPlan{
+						PlanID: planID, RevisionID: rev.ID, Projects: joinNames(rev.Plan.Details.Projects), Date: rev.Plan.Details.Date, Saved: rev.Time,
+					})
+				}
+			}
+		}
+	}
+	sort.SliceStable(d.Committed, func(i, j int) bool { return d.Committed[i].Saved.Before(d.Committed[j].Saved) })
+
+	names := newPracticeNames(rt)
+
+	changes := map[string]*DigestPractice{}
+	prioritised := map[string]*DigestTask{}
+	for _, p := range projects {
+		projectChains := make([][]lib.ChainedRevision, len(p.Plans))
+		var projectReviews []map[string]*models.PlanReview // only counting approved revisions if reviews are enabled
+		if rt.PlanReviews {
+			projectReviews = make([]map[string]*models.PlanReview, len(p.Plans))
+		}
+		for i, planID := range p.Plans {
+			projectChains[i] = chains[planID]
+			if rt.PlanReviews {
+				projectReviews[i] = reviews[planID]
+			}
+		}
+		before, after := latestCommittedAt(projectChains, projectReviews, from), latestCommittedAt(projectChains, projectReviews, to)
+		if after == nil {
+			continue
+		}
+		names.load(ctx, after.Responses.PracticesVersion)
+		if p.Attributes != nil && p.Attributes.Name != nil {
+			for _, r := range planAcceptedRisks(p, after, names, end) {
+				if *r.Expires < notice {
+					d.Risks = append(d.Risks, r)
+				}
+			}
+		}
+
+		if before != nil {
+			for practice, was := range before.Details.Maturity {
+				now, ok := after.Details.Maturity[practice]
+				if !ok || now == was {
+					continue
+				}
+				c := changes[practice]
+				if c == nil {
+					c = &DigestPractice{ID: practice}
+					changes[practice] = c
+				}
+				if now > was {
+					c.Improved++
+				} else {
+					c.Regressed++
+				}
+			}
+			if decreases := maturityDecreases(before.Details.Maturity, after.Details.Maturity); len(decreases) > 0 {
+				for i := range decreases {
+					decreases[i].Name = names.practice(decreases[i].Name)
+				}
+				d.Regressed = append(d.Regressed, DigestRegression{ProjectID: p.ID, Name: projectNames[p.ID], Decreases: decreases})
+			}
+		}
+
+		for practiceID, pr := range after.Responses.PracticeResponses {
+			for taskID, task := range pr.Tasks {
+				if !task.Priority {
+					continue
+				}
+				key := practiceID + "/" + taskID
+				t := prioritised[key]
+				if t == nil {
+					t = &DigestTask{PracticeID: practiceID, TaskID: taskID}
+					prioritised[key] = t
+				}
+				t.Projects++
+			}
+		}
+	}
+
+	for _, c := range changes {
+		c.Name = names.practice(c.ID)
+		d.Practices = append(d.Practices, *c)
+	}
+	sort.Slice(d.Practices, func(i, j int) bool { return d.Practices[i].Name < d.Practices[j].Name })
+	sort.Slice(d.Regressed, func(i, j int) bool { return d.Regressed[i].Name < d.Regressed[j].Name })
+	for _, t := range prioritised {
+		t.Practice, t.Title = names.practice(t.PracticeID), names.task(t.PracticeID, t.TaskID)
+		d.TopTasks = append(d.TopTasks, *t)
+	}
+	sort.Slice(d.TopTasks, func(i, j int) bool {
+		a, b := d.TopTasks[i], d.TopTasks[j]
+		if a.Projects != b.Projects {
+			return a.Projects > b.Projects
+		}
+		return a.PracticeID+"/"+a.TaskID < b.PracticeID+"/"+b.TaskID
+	})
+	if len(d.TopTasks) > digestTopTasks {
+		d.TopTasks = d.TopTasks[:digestTopTasks]
+	}
+	sortAcceptedRisks(d.Risks)
+
+	if d.Overdue, err = rt.overdueProjects(ctx, to); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// digestSection formats the lines of a section of a digest's notification
+func digestSection(lines []string) string {
+	if len(lines) == 0 {
+		return "none"
+	}
+	return "\n" + strings.Join(lines, "\n")
+}
+
+// Notification returns the notification that delivers the digest
+func (d *Digest) Notification() *Notification {
+	title := "Digest"
+	switch d.Period {
+	case DigestWeekly:
+		title = "Weekly digest"
+	case DigestMonthly:
+		title = "Monthly digest"
+	}
+	// To is exclusive, so it's usually midnight at the start of the day after the window
+	last := d.To.Add(-time.Nanosecond)
+
+	committed := make([]string, len(d.Committed))
+	for i, p := range d.Committed {
+		committed[i] = fmt.Sprintf("%v (plan dated %v)", p.Projects, p.Date)
+	}
+	practices := make([]string, len(d.Practices))
+	for i, p := range d.Practices {
+		practices[i] = fmt.Sprintf("%v: %v improved, %v regressed", p.Name, p.Improved, p.Regressed)
+	}
+	regressed := make([]string, len(d.Regressed))
+	for i, r := range d.Regressed {
+		decreases := make([]string, len(r.Decreases))
+		for j, f := range r.Decreases {
+			decreases[j] = f.Name + " " + f.Value
+		}
+		regressed[i] = r.Name + ": " + strings.Join(decreases, "; ")
+	}
+	overdue := make([]string, len(d.Overdue))
+	for i, o := range d.Overdue {
+		if o.Due == "" {
+			overdue[i] = *o.Name + ": no committed plan"
+		} else {
+			overdue[i] = fmt.Sprintf("%v: %v days overdue", *o.Name, o.DaysOverdue)
+		}
+	}
+	tasks := make([]string, len(d.TopTasks))
+	for i, t := range d.TopTasks {
+		tasks[i] = fmt.Sprintf("%v (%v): %v projects", t.Title, t.Practice, t.Projects)
+	}
+	risks := make([]string, len(d.Risks))
+	for i, r := range d.Risks {
+		state := "expires"
+		if r.Expired {
+			state = "expired"
+		}
+		approval := "accepted by " + *r.Approver
+		if !r.Approved {
+			approval = "awaiting approval"
+		}
+		risks[i] = fmt.Sprintf("%v: %v (%v), %v %v, %v", *r.Project, r.Title, r.Practice, state, *r.Expires, approval)
+	}
+
+	return &Notification{
+		Event:   EventDigest,
+		Title:   title,
+		Subject: d.From.Format("2 Jan 2006") + " to " + last.Format("2 Jan 2006"),
+		Fields: []NotificationField{
+			{Name: fmt.Sprintf("Plans committed (%v)", len(d.Committed)), Value: digestSection(committed)},
+			{Name: "Maturity changes by practice", Value: digestSection(practices)},
+			{Name: fmt.Sprintf("Projects that regressed (%v)", len(d.Regressed)), Value: digestSection(regressed)},
+			{Name: fmt.Sprintf("Overdue projects (%v)", len(d.Overdue)), Value: digestSection(overdue)},
+			{Name: "Most commonly prioritised tasks", Value: digestSection(tasks)},
+			{Name: fmt.Sprintf("Risk acceptances to review (%v)", len(d.Risks)), Value: digestSection(risks)},
+		},
+		Data: d,
+		Key:  EventDigest + "/" + d.Period + "/" + d.From.Format(time.RFC3339) + "/" + d.To.Format(time.RFC3339),
+	}
+}
+
+// SendDigests sends the digest for the most recent complete window of each of the periods, unless it has already been sent
+func SendDigests(ctx context.Context, rt *Runtime, periods []string, now time.Time) error {
+	for _, period := range periods {
+		from, to, err := DigestPeriod(period, now)
+		if err != nil {
+			return err
+		}
+		last, err := rt.Store.LastDigest(ctx, period)
+		if err != nil {
+			return err
+		}
+		if !last.Before(to) {
+			continue
+		}
+		d, err := BuildDigest(ctx, rt, from, to)
+		if err != nil {
+			return err
+		}
+		d.Period = period
+		rt.notify(d.Notification())
+		if err = rt.Store.RecordDigest(ctx, period, to); err != nil {
+			return err
+		}
+		log.WithFields(log.Fields{"period": period, "from": from, "to": to}).Info("Sent digest")
+	}
+	return nil
+}
+
+// DigestScheduler sends the digests for the periods as each one ends, checking every interval until ctx is done
+func DigestScheduler(ctx context.Context, rt *Runtime, periods []string, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if err := SendDigests(ctx, rt, periods, time.Now().UTC()); err != nil {
+			log.WithField("error", err).Error("Failed to send digests")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+func TestDigestPeriod(t *testing.T) {
+	day := func(s string) time.Time {
+		d, err := time.Parse("2006-01-02 15:04", s)
+		if err != nil {
+			t.Fatal(err)
+		}
+		return d
+	}
+	for _, c := range []struct {
+		period, now, from, to string
+	}{
+		{DigestWeekly, "2021-06-02 10:00", "2021-05-24 00:00", "2021-05-31 00:00"}, // a Wednesday
+		{DigestWeekly, "2021-05-31 00:00", "2021-05-24 00:00", "2021-05-31 00:00"}, // a Monday
+		{DigestWeekly, "2021-05-30 23:59", "2021-05-17 00:00", "2021-05-24 00:00"}, // a Sunday
+		{DigestMonthly, "2021-03-15 08:00", "2021-02-01 00:00", "2021-03-01 00:00"},
+		{DigestMonthly, "2021-01-01 00:00", "2020-12-01 00:00", "2021-01-01 00:00"},
+	} {
+		from, to, err := DigestPeriod(c.period, day(c.now))
+		if err != nil {
+			t.Fatal(err)
+		}
+		if !from.Equal(day(c.from)) || !to.Equal(day(c.to)) {
+			t.Errorf("%v at %v: got %v to %v, want %v to %v", c.period, c.now, from, to, c.from, c.to)
+		}
+	}
+	if _, _, err := DigestPeriod("daily", time.Now()); err == nil {
+		t.Error("an unknown period was accepted")
+	}
+}
+
+// digestPlan returns a committed plan for the project with the maturity levels, which prioritises the tasks of the "auth" practice
+func digestPlan(project string, date string, maturity map[string]int, tasks ...string) *lib.Plan {
+	pr := lib.PracticeResponse{Tasks: map[string]lib.TaskResponse{}}
+	for _, task := range tasks {
+		pr.Tasks[task] = lib.TaskResponse{Priority: true}
+	}
+	return &lib.Plan{
+		Details:   lib.PlanDetails{Projects: []string{project}, Date: date, Committed: true, Maturity: maturity},
+		Responses: lib.PlanResponses{PracticesVersion: "1", PracticeResponses: map[string]lib.PracticeResponse{"auth": pr}},
+	}
+}
+
+func TestBuildDigest(t *testing.T) {
+	from := time.Date(2021, 5, 24, 0, 0, 0, 0, time.UTC)
+	to := from.AddDate(0, 0, 7)
+	at := func(days int) time.Time { return from.AddDate(0, 0, days) }
+	st := newMemStore()
+	st.projects = []*models.Project{
+		testProject("alpha", "", nil, "plan-a"),
+		testProject("beta", "", nil, "plan-b"),
+		testProject("gamma", "", nil),
+	}
+	st.plans = map[string][]lib.ChainedRevision{
+		"plan-a": {
+			{ID: "a1", Time: at(-30), Plan: digestPlan("alpha", "2021-04-24", map[string]int{"auth": 2, "logs": 1}, "mfa")},
+			{ID: "a2", Time: at(2), Plan: digestPlan("alpha", "2021-05-26", map[string]int{"auth": 1, "logs": 2}, "mfa", "sso")},
+			{ID: "a3", Time: at(3), Plan: &lib.Plan{Details: lib.PlanDetails{Date: "2021-05-27"}}}, // a draft
+		},
+		"plan-b": {
+			{ID: "b1", Time: at(-200), Plan: digestPlan("beta", "2020-11-05", map[string]int{"auth": 1}, "mfa")},
+			{ID: "b2", Time: at(8), Plan: digestPlan("beta", "2021-06-01", map[string]int{"auth": 3})}, // after the window
+		},
+	}
+	st.practices = []lib.Practice{{ID: "auth", Name: "Authentication", Tasks: []lib.Task{{ID: "mfa", Title: "Enforce MFA"}}}}
+	router, err := NewNotificationRouter([]Notifier{&recordingNotifier{name: "slack"}}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &Runtime{Store: st, Notifications: router, notifyWake: make(chan struct{}, 1), practicesCache: map[string]practiceCache{},
+		Reminders: ReminderConfig{DefaultCadence: 180}}
+	ctx := context.Background()
+
+	d, err := BuildDigest(ctx, rt, from, to)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(d.Committed) != 1 || d.Committed[0].RevisionID != "a2" || d.Committed[0].Projects != "alpha" {
+		t.Errorf("unexpected committed plans: %+v", d.Committed)
+	}
+	wantPractices := []DigestPractice{{ID: "auth", Name: "Authentication", Regressed: 1}, {ID: "logs", Name: "logs", Improved: 1}}
+	if !reflect.DeepEqual(d.Practices, wantPractices) {
+		t.Errorf("got practice changes %+v, want %+v", d.Practices, wantPractices)
+	}
+	wantRegressed := []DigestRegression{{ProjectID: "alpha", Name: "alpha", Decreases: []NotificationField{{Name: "Authentication", Value: "from 2 to 1"}}}}
+	if !reflect.DeepEqual(d.Regressed, wantRegressed) {
+		t.Errorf("got regressions %+v, want %+v", d.Regressed, wantRegressed)
+	}
+	overdue := []string{}
+	for _, o := range d.Overdue {
+		overdue = append(overdue, *o.ProjectID)
+	}
+	if !reflect.DeepEqual(overdue, []string{"gamma"}) {
+		t.Errorf("got overdue projects %v", overdue)
+	}
+	wantTasks := []DigestTask{
+		{PracticeID: "auth", TaskID: "mfa", Practice: "Authentication", Title: "Enforce MFA", Projects: 2},
+		{PracticeID: "auth", TaskID: "sso", Practice: "Authentication", Title: "sso", Projects: 1},
+	}
+	if !reflect.DeepEqual(d.TopTasks, wantTasks) {
+		t.Errorf("got top tasks %+v, want %+v", d.TopTasks, wantTasks)
+	}
+	if text, err := NotificationText(d.Notification()); err != nil || text == "" {
+		t.Errorf("couldn't format the digest: %v", err)
+	}
+
+	// Each period's digest is only sent once
+	for i := 0; i < 2; i++ {
+		if err = SendDigests(ctx, rt, []string{DigestWeekly}, to.Add(time.Hour)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if len(st.messages) != 1 || !st.digests[DigestWeekly].Equal(to) {
+		t.Errorf("expected one digest to be queued, got %v", st.messages)
+	}
+}
+
+func TestLatestCommittedAtReviews(t *testing.T) {
+	from := time.Date(2021, 5, 24, 0, 0, 0, 0, time.UTC)
+	approved := review(models.PlanReviewStateApproved)
+	approved.Reviewed = strfmt.DateTime(from.AddDate(0, 0, 3))
+	chains := [][]lib.ChainedRevision{{
+		{ID: "a1", Time: from.AddDate(0, 0, -30), Plan: digestPlan("alpha", "2021-04-24", map[string]int{"auth": 2})},
+		{ID: "a2", Time: from.AddDate(0, 0, 2), Plan: digestPlan("alpha", "2021-05-26", map[string]int{"auth": 1})},
+		{ID: "a3", Time: from.AddDate(0, 0, 4), Plan: digestPlan("alpha", "2021-05-28", map[string]int{"auth": 3})},
+	}}
+	reviews := []map[string]*models.PlanReview{{"a2": approved, "a3": review(models.PlanReviewStateSubmitted)}}
+
+	for _, c := range []struct {
+		days int
+		want string
+	}{
+		{3, "2021-04-24"}, // a2 was committed, but not yet approved
+		{4, "2021-05-26"},
+		{7, "2021-05-26"}, // a3 hasn't been approved
+	} {
+		if got := latestCommittedAt(chains, reviews, from.AddDate(0, 0, c.days)); got == nil || got.Details.Date != c.want {
+			t.Errorf("day %v: got %+v, want the plan dated %v", c.days, got, c.want)
+		}
+	}
+	if got := latestCommittedAt(chains, nil, from.AddDate(0, 0, 7)); got.Details.Date != "2021-05-28" {
+		t.Errorf("without reviews, got the plan dated %v", got.Details.Date)
+	}
+}
@@ -0,0 +1,198 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// Events about plans, projects and practices, see Notification.
+// Plan and project events list the projects they affect, and users subscribed to those projects are notified of them.
+// Each event's Data holds the change, for webhooks.
+const (
+	EventPlanCommitted      = "plan.committed"             // a committed revision of a plan was saved
+	EventPlanDeleted        = "plan.deleted"               // a plan and all of its revisions were deleted
+	EventMaturityDecreased  = "project.maturity-decreased" // a committed plan lowered a project's maturity for at least one practice
+	EventProjectCreated     = "project.created"
+	EventProjectUpdated     = "project.updated" // a project's details were changed
+	EventProjectDeleted     = "project.deleted"
+	EventPracticesPublished = "practices.published" // a new version of the practice definitions was published
+)
+
+// projectNames returns the names of the projects, falling back to the ID of any that can't be found
+func (rt *Runtime) projectNames(ctx context.Context, ids []string) string {
+	names := make([]string, len(ids))
+	for i, id := range ids {
+		names[i] = id
+		if p, found, err := rt.Store.GetProject(ctx, id); err == nil && found && p.Attributes != nil && p.Attributes.Name != nil {
+			names[i] = *p.Attributes.Name
+		}
+	}
+	return strings.Join(names, ", ")
+}
+
+// currentMaturity returns the maturity of each of the projects, only counting approved revisions if plan reviews are
+// enabled, or nil if notifications aren't configured or it can't be determined. It's used to spot changes made by a new
+// plan revision or an approval.
+func (rt *Runtime) currentMaturity(ctx context.Context, ids []string) map[string]map[string]int {
+	if rt.Notifications == nil {
+		return nil
+	}
+	projects := make([]*models.Project, 0, len(ids))
+	for _, id := range ids {
+		p, found, err := rt.Store.GetProject(ctx, id)
+		if err != nil {
+			log.WithContext(ctx).WithFields(log.Fields{"project": id, "error": err}).Warn("Couldn't retrieve project to check its maturity")
+			return nil
+		}
+		if found {
+			projects = append(projects, p)
+		}
+	}
+	levels, err := projectMaturity(ctx, rt, projects, rt.PlanReviews)
+	if err != nil {
+		log.WithContext(ctx).WithField("error", err).Warn("Couldn't determine the maturity of projects")
+		return nil
+	}
+	return levels
+}
+
+// maturityDecreases returns a field for each practice whose maturity is lower after than before, ordered by practice ID.
+// Practices without a maturity level after aren't included, as that's a change to the practices rather than a regression.
+func maturityDecreases(before map[string]int, after map[string]int) []NotificationField {
+	fields := []NotificationField{}
+	for practice, was := range before {
+		if now, ok := after[practice]; ok && now < was {
+			fields = append(fields, NotificationField{Name: practice, Value: fmt.Sprintf("from %v to %v", was, now)})
+		}
+	}
+	sort.Slice(fields, func(i, j int) bool { return fields[i].Name < fields[j].Name })
+	return fields
+}
+
+// planRevised notifies of the events caused by saving a revision of a plan.
+// before is the maturity of the affected projects prior to the revision, from currentMaturity.
+func (rt *Runtime) planRevised(ctx context.Context, principal *models.User, planID string, revID string, plan *lib.Plan, before map[string]map[string]int) {
+	if rt.Notifications == nil || !plan.Details.Committed {
+		return
+	}
+	rt.notify(&Notification{
+		Event:   EventPlanCommitted,
+		Title:   "Plan committed",
+		Subject: rt.projectNames(ctx, plan.Details.Projects),
+		Fields: []NotificationField{
+			{Name: "Plan", Value: planID},
+			{Name: "Revision", Value: revID},
+			{Name: "Date", Value: plan.Details.Date},
+			{Name: "Practices version", Value: plan.Responses.PracticesVersion},
+			{Name: "Committed by", Value: principal.Name},
+		},
+		Projects: plan.Details.Projects,
+		Data:     map[string]interface{}{"planId": planID, "revisionId": revID, "plan": plan},
+		Key:      EventPlanCommitted + "/" + planID + "/" + revID,
+	})
+
+	rt.maturityChanged(ctx, planID, revID, before, NotificationField{Name: "Committed by", Value: principal.Name})
+}
+
+// maturityChanged notifies of decreases in the maturity of projects caused by the revision of a plan counting towards
+// it, whether that's because it was committed or approved. before is the projects' maturity prior to the change, from
+// currentMaturity, and by is who made the change.
+func (rt *Runtime) maturityChanged(ctx context.Context, planID string, revID string, before map[string]map[string]int, by NotificationField) {
+	if before == nil {
+		return
+	}
+	ids := make([]string, 0, len(before))
+	for id := range before {
+		ids = append(ids, id)
+	}
+	sort.Strings(ids)
+	after := rt.currentMaturity(ctx, ids)
+	if after == nil {
+		return
+	}
+	for _, id := range ids {
+		decreases := maturityDecreases(before[id], after[id])
+		if len(decreases) == 0 {
+			continue
+		}
+		rt.notify(&Notification{
+			Event:    EventMaturityDecreased,
+			Title:    "Maturity decreased",
+			Subject:  rt.projectNames(ctx, []string{id}),
+			Fields:   append(decreases, NotificationField{Name: "Plan", Value: planID}, by),
+			Projects: []string{id},
+			Data: map[string]interface{}{
+				"projectId": id, "planId": planID, "revisionId": revID, "before": before[id], "after": after[id],
+			},
+			Key: EventMaturityDecreased + "/" + id + "/" + revID,
+		})
+	}
+}
+
+// planDeleted notifies that the plan has been deleted
+func (rt *Runtime) planDeleted(ctx context.Context, principal *models.User, planID string, details *lib.PlanDetails) {
+	if rt.Notifications == nil {
+		return
+	}
+	n := &Notification{
+		Event:  EventPlanDeleted,
+		Title:  "Plan deleted",
+		Fields: []NotificationField{{Name: "Plan", Value: planID}, {Name: "Deleted by", Value: principal.Name}},
+		Data:   map[string]interface{}{"planId": planID, "details": details},
+		Key:    EventPlanDeleted + "/" + planID,
+	}
+	if details != nil {
+		n.Subject = rt.projectNames(ctx, details.Projects)
+		n.Projects = details.Projects
+	}
+	rt.notify(n)
+}
+
+// projectChanged notifies that a project has been created, updated or deleted. before and after are its details.
+func (rt *Runtime) projectChanged(event string, title string, principal *models.User, id string, before *models.ProjectDetails, after *models.ProjectDetails) {
+	n := &Notification{
+		Event:    event,
+		Title:    title,
+		Fields:   []NotificationField{{Name: "Project", Value: id}, {Name: "By", Value: principal.Name}},
+		Projects: []string{id},
+		Data:     map[string]interface{}{"projectId": id, "before": before, "after": after},
+		Key:      event + "/" + id,
+	}
+	for _, d := range []*models.ProjectDetails{after, before} {
+		if d != nil && d.Name != nil {
+			n.Subject = *d.Name
+			break
+		}
+	}
+	if event == EventProjectUpdated {
+		n.Key += "/" + strconv.FormatInt(time.Now().UnixNano(), 10) // every update is sent
+	}
+	rt.notify(n)
+}
+
+// PracticesPublishedNotification describes the publication of a new version of the practice definitions
+func PracticesPublishedNotification(version string, practices []lib.Practice) *Notification {
+	names := make([]string, len(practices))
+	summary := make([]map[string]string, len(practices))
+	for i, p := range practices {
+		names[i] = p.Name
+		summary[i] = map[string]string{"id": p.ID, "name": p.Name}
+	}
+	return &Notification{
+		Event:   EventPracticesPublished,
+		Title:   "New practices version published",
+		Subject: version,
+		Fields:  []NotificationField{{Name: "Practices", Value: strings.Join(names, ", ")}},
+		Data:    map[string]interface{}{"version": version, "practices": summary},
+		Key:     EventPracticesPublished + "/" + version,
+	}
+}
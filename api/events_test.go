@@ -0,0 +1,99 @@
+package api
+
+import (
+	"context"
+	"net/smtp"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/store"
+)
+
+func TestMaturityDecreases(t *testing.T) {
+	before := map[string]int{"a": 3, "b": 2, "c": 1, "d": 2}
+	after := map[string]int{"a": 1, "b": 2, "c": 2, "e": 0}
+	want := []NotificationField{{Name: "a", Value: "from 3 to 1"}}
+	if got := maturityDecreases(before, after); !reflect.DeepEqual(got, want) {
+		t.Errorf("got %v, want %v", got, want)
+	}
+	if got := maturityDecreases(nil, after); len(got) != 0 {
+		t.Errorf("a project without a previous maturity decreased: %v", got)
+	}
+}
+
+func TestSubscriptionNotifications(t *testing.T) {
+	slack := &recordingNotifier{name: "slack"}
+	mail, err := NewNotifier(NotificationChannelConfig{Name: "mail", Type: "email", SMTPHost: "smtp.example.com", From: "besec@example.com"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var mailedTo [][]string
+	mail.(*emailNotifier).sendMail = func(_ string, _ smtp.Auth, _ string, to []string, _ []byte) error {
+		mailedTo = append(mailedTo, to)
+		return nil
+	}
+
+	if _, err = NewNotificationRouter([]Notifier{slack}, nil, "slack"); err == nil {
+		t.Error("a non-email subscriptions channel was accepted")
+	}
+	router, err := NewNotificationRouter([]Notifier{slack, mail}, nil, "mail")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := routedNames(router.Route(EventPlanCommitted)); !reflect.DeepEqual(got, []string{"slack"}) {
+		t.Errorf("the subscriptions channel was routed to by default: %v", got)
+	}
+
+	st := newMemStore()
+	st.subscriptions = []*store.Subscription{
+		{UID: "u1", ProjectID: "p1", Email: "one@example.com"},
+		{UID: "u2", ProjectID: "p1", Email: "two@example.com", Events: []string{"project.*"}},
+		{UID: "u3", ProjectID: "p2", Email: "three@example.com", Events: []string{EventPlanCommitted}},
+		{UID: "u1", ProjectID: "p2", Email: "one@example.com"},
+		{UID: "u4", ProjectID: "p2", Email: "four@example.com"},
+	}
+	st.users["u4"] = &models.LocalUserData{Deactivated: true}
+	rt := &Runtime{Store: st, Notifications: router, notifyWake: make(chan struct{}, 1)}
+	rt.notify(&Notification{Event: EventPlanCommitted, Title: "Plan committed", Projects: []string{"p1", "p2"}, Key: "k"})
+	if len(st.messages) != 3 {
+		t.Fatalf("expected a message for the slack channel, u1 and u3 but not the deactivated u4, got %v", st.messages)
+	}
+
+	rt.deliverNotifications(context.Background(), time.Now().UTC().Add(time.Minute))
+	if len(slack.sent) != 1 || len(slack.sent[0].Recipients) != 0 {
+		t.Errorf("unexpected slack notifications: %v", slack.sent)
+	}
+	got := map[string]bool{}
+	for _, to := range mailedTo {
+		if len(to) != 1 {
+			t.Errorf("subscription email sent to %v", to)
+			continue
+		}
+		got[to[0]] = true
+	}
+	if !reflect.DeepEqual(got, map[string]bool{"one@example.com": true, "three@example.com": true}) {
+		t.Errorf("subscription emails sent to %v", got)
+	}
+}
+
+func TestDeleteSubscriptions(t *testing.T) {
+	st := newMemStore()
+	st.subscriptions = []*store.Subscription{
+		{UID: "u1", ProjectID: "p1"},
+		{UID: "u1", ProjectID: "p2"},
+		{UID: "u2", ProjectID: "p1"},
+		{UID: "u2", ProjectID: "p2"},
+	}
+	ctx := context.Background()
+	if err := DeleteUserSubscriptions(ctx, st, "u1"); err != nil {
+		t.Fatal(err)
+	}
+	if err := deleteProjectSubscriptions(ctx, st, "p1"); err != nil {
+		t.Fatal(err)
+	}
+	if len(st.subscriptions) != 1 || st.subscriptions[0].UID != "u2" || st.subscriptions[0].ProjectID != "p2" {
+		t.Errorf("unexpected remaining subscriptions: %+v", st.subscriptions)
+	}
+}
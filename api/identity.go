@@ -0,0 +1,36 @@
+package api
+
+import (
+	"context"
+	"fmt"
+
+	"firebase.google.com/go/v4/auth"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// IdentityVerifier checks a bearer token presented to the API, and returns the user it identifies
+type IdentityVerifier interface {
+	VerifyToken(ctx context.Context, token string) (*models.User, error)
+}
+
+// FirebaseVerifier verifies ID tokens issued by Firebase Auth / Google Identity Platform
+type FirebaseVerifier struct {
+	Client     *auth.Client
+	AuthConfig ExtendedAuthConfig
+}
+
+// Check at compile time that the verifiers meet the interface
+var _ IdentityVerifier = (*FirebaseVerifier)(nil)
+var _ IdentityVerifier = (*OIDCVerifier)(nil)
+
+// VerifyToken verifies the Firebase ID token and creates the user it identifies
+func (v *FirebaseVerifier) VerifyToken(ctx context.Context, idToken string) (*models.User, error) {
+	token, err := v.Client.VerifyIDToken(ctx, idToken)
+	if err != nil {
+		log.WithFields(log.Fields{"error": err}).Info("Error validating ID token")
+		return nil, fmt.Errorf("invalid ID token")
+	}
+	return NewUser(token, v.AuthConfig)
+}
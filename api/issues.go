@@ -0,0 +1,591 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net/http"
+	"net/url"
+	"regexp"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// IssueTracker looks up and creates the issues that tasks are tracked with
+type IssueTracker interface {
+	Name() string
+	// Handles reports whether the URL is one of this tracker's issues
+	Handles(issueURL string) bool
+	// GetIssue fetches the title and state of the issue
+	GetIssue(ctx context.Context, issueURL string) (*lib.LinkedIssue, error)
+	// CreateIssue opens a new issue
+	CreateIssue(ctx context.Context, title string, description string) (*lib.LinkedIssue, error)
+	// Serves reports whether issues for the tasks of a plan belonging to these projects are created in this tracker
+	Serves(projects []string) bool
+}
+
+// IssueTrackerConfig is the configuration of an issue tracker
+type IssueTrackerConfig struct {
+	Name string
+	Type string // jira or github
+	// URL is the Jira server, or the GitHub Enterprise server; it defaults to https://github.com for GitHub
+	URL        string
+	Project    string // the key of the Jira project new issues are created in
+	IssueType  string // the type of new Jira issues, defaults to Task
+	Repository string // the GitHub repository new issues are created in, as owner/name
+	Username   string // for Jira basic authentication; without it, the token is sent as a bearer token
+	Token      string
+	// TokenName is the name of the database config entry holding the token, if it isn't set directly
+	TokenName string
+	// Projects are the IDs of the projects whose plans' issues are created in this tracker; it serves every project if empty
+	Projects []string
+}
+
+// servesProjects reports whether a tracker limited to the scope projects serves a plan of the projects
+func servesProjects(scope []string, projects []string) bool {
+	if len(scope) == 0 {
+		return true
+	}
+	for _, p := range projects {
+		for _, s := range scope {
+			if p == s {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// NewIssueTracker creates an issue tracker from its configuration
+func NewIssueTracker(c IssueTrackerConfig) (IssueTracker, error) {
+	if c.Name == "" {
+		return nil, fmt.Errorf("issue trackers must have a name")
+	}
+	switch c.Type {
+	case "jira":
+		return NewJiraTracker(c)
+	case "github":
+		return NewGitHubTracker(c)
+	default:
+		return nil, fmt.Errorf("issue tracker %v has unknown type '%v', it must be jira or github", c.Name, c.Type)
+	}
+}
+
+// trackerClient makes JSON requests to an issue tracker's REST API
+type trackerClient struct {
+	auth   func(r *http.Request)
+	client *http.Client
+}
+
+func newTrackerClient(auth func(r *http.Request)) *trackerClient {
+	return &trackerClient{auth: auth, client: &http.Client{Timeout: 10 * time.Second}}
+}
+
+// do sends the request, encoding in as its body if it's not nil, and decodes the response into out
+func (c *trackerClient) do(ctx context.Context, method string, u string, in interface{}, out interface{}) error {
+	var body []byte
+	if in != nil {
+		var err error
+		if body, err = json.Marshal(in); err != nil {
+			return err
+		}
+	}
+	req, err := http.NewRequestWithContext(ctx, method, u, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Accept", "application/json")
+	if in != nil {
+		req.Header.Set("Content-Type", "application/json")
+	}
+	c.auth(req)
+
+	resp, err := c.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("request to issue tracker failed: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode == http.StatusNotFound {
+		return fmt.Errorf("issue not found")
+	}
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return fmt.Errorf("got error response %v: %s", resp.StatusCode, respBody)
+	}
+	return json.NewDecoder(resp.Body).Decode(out)
+}
+
+var jiraKeyPattern = regexp.MustCompile(`^[A-Z][A-Z0-9_]*-[0-9]+$`)
+
+// jiraTracker uses the Jira REST API (v2, which Jira Cloud and Data Center both support)
+type jiraTracker struct {
+	name      string
+	base      string // without a trailing slash
+	project   string
+	issueType string
+	client    *trackerClient
+	projects  []string
+}
+
+// NewJiraTracker creates an issue tracker for the issues on a Jira server
+func NewJiraTracker(c IssueTrackerConfig) (IssueTracker, error) {
+	if c.URL == "" || c.Token == "" {
+		return nil, fmt.Errorf("jira issue tracker %v needs a URL and a token", c.Name)
+	}
+	if c.IssueType == "" {
+		c.IssueType = "Task"
+	}
+	auth := func(r *http.Request) { r.Header.Set("Authorization", "Bearer "+c.Token) }
+	if c.Username != "" {
+		auth = func(r *http.Request) { r.SetBasicAuth(c.Username, c.Token) }
+	}
+	return &jiraTracker{name: c.Name, base: strings.TrimSuffix(c.URL, "/"), project: c.Project, issueType: c.IssueType, client: newTrackerClient(auth), projects: c.Projects}, nil
+}
+
+func (j *jiraTracker) Name() string {
+	return j.name
+}
+
+// key returns the issue key from a URL like https://jira.example.com/browse/SEC-42
+func (j *jiraTracker) key(issueURL string) (string, bool) {
+	if !strings.HasPrefix(issueURL, j.base+"/browse/") {
+		return "", false
+	}
+	key := strings.TrimPrefix(issueURL, j.base+"/browse/")
+	return key, jiraKeyPattern.MatchString(key)
+}
+
+func (j *jiraTracker) Handles(issueURL string) bool {
+	_, ok := j.key(issueURL)
+	return ok
+}
+
+func (j *jiraTracker) Serves(projects []string) bool {
+	return servesProjects(j.projects, projects)
+}
+
+func (j *jiraTracker) GetIssue(ctx context.Context, issueURL string) (*lib.LinkedIssue, error) {
+	key, ok := j.key(issueURL)
+	if !ok {
+		return nil, fmt.Errorf("%v isn't a Jira issue URL", issueURL)
+	}
+	var issue struct {
+		Fields struct {
+			Summary string
+			Status  struct {
+				Name           string
+				StatusCategory struct {
+					Key string
+				}
+			}
+		}
+	}
+	if err := j.client.do(ctx, http.MethodGet, j.base+"/rest/api/2/issue/"+key+"?fields=summary,status", nil, &issue); err != nil {
+		return nil, err
+	}
+	return &lib.LinkedIssue{
+		URL:     issueURL,
+		Tracker: j.name,
+		Title:   issue.Fields.Summary,
+		State:   issue.Fields.Status.Name,
+		Closed:  issue.Fields.Status.StatusCategory.Key == "done",
+	}, nil
+}
+
+func (j *jiraTracker) CreateIssue(ctx context.Context, title string, description string) (*lib.LinkedIssue, error) {
+	if j.project == "" {
+		return nil, fmt.Errorf("jira issue tracker %v doesn't have a project to create issues in", j.name)
+	}
+	fields := map[string]interface{}{
+		"project":     map[string]string{"key": j.project},
+		"issuetype":   map[string]string{"name": j.issueType},
+		"summary":     title,
+		"description": description,
+	}
+	var created struct {
+		Key string
+	}
+	if err := j.client.do(ctx, http.MethodPost, j.base+"/rest/api/2/issue", map[string]interface{}{"fields": fields}, &created); err != nil {
+		return nil, err
+	}
+	return j.GetIssue(ctx, j.base+"/browse/"+created.Key)
+}
+
+var (
+	githubIssuePattern = regexp.MustCompile(`^/([A-Za-z0-9_.-]+)/([A-Za-z0-9_.-]+)/issues/([0-9]+)$`)
+	githubNamePattern  = regexp.MustCompile(`^[A-Za-z0-9_.-]+$`)
+)
+
+// githubName reports whether s is a valid GitHub owner or repository name
+func githubName(s string) bool {
+	return s != "." && s != ".." && githubNamePattern.MatchString(s)
+}
+
+// githubRepoPath returns the API path of the repository
+func githubRepoPath(owner string, repo string) string {
+	return "/repos/" + url.PathEscape(owner) + "/" + url.PathEscape(repo)
+}
+
+// githubTracker uses the GitHub REST API, on github.com or a GitHub Enterprise server
+type githubTracker struct {
+	name       string
+	host       string
+	api        string // without a trailing slash
+	repository string
+	client     *trackerClient
+	projects   []string
+}
+
+// NewGitHubTracker creates an issue tracker for GitHub issues
+func NewGitHubTracker(c IssueTrackerConfig) (IssueTracker, error) {
+	if c.Token == "" {
+		return nil, fmt.Errorf("github issue tracker %v needs a token", c.Name)
+	}
+	if c.URL == "" {
+		c.URL = "https://github.com"
+	}
+	u, err := url.Parse(c.URL)
+	if err != nil || u.Host == "" {
+		return nil, fmt.Errorf("github issue tracker %v has an invalid URL: %v", c.Name, c.URL)
+	}
+	api := "https://api.github.com"
+	if u.Host != "github.com" {
+		api = strings.TrimSuffix(c.URL, "/") + "/api/v3"
+	}
+	if parts := strings.Split(c.Repository, "/"); c.Repository != "" && (len(parts) != 2 || !githubName(parts[0]) || !githubName(parts[1])) {
+		return nil, fmt.Errorf("github issue tracker %v has an invalid repository %v, it must be owner/name", c.Name, c.Repository)
+	}
+	auth := func(r *http.Request) {
+		r.Header.Set("Authorization", "Bearer "+c.Token)
+		r.Header.Set("Accept", "application/vnd.github+json")
+	}
+	return &githubTracker{name: c.Name, host: u.Host, api: api, repository: c.Repository, client: newTrackerClient(auth), projects: c.Projects}, nil
+}
+
+func (g *githubTracker) Name() string {
+	return g.name
+}
+
+// issuePath returns the API path of the issue from a URL like https://github.com/owner/repo/issues/42
+func (g *githubTracker) issuePath(issueURL string) (string, bool) {
+	u, err := url.Parse(issueURL)
+	if err != nil || u.Host != g.host {
+		return "", false
+	}
+	m := githubIssuePattern.FindStringSubmatch(u.Path)
+	if m == nil || !githubName(m[1]) || !githubName(m[2]) {
+		return "", false
+	}
+	return githubRepoPath(m[1], m[2]) + "/issues/" + m[3], true
+}
+
+func (g *githubTracker) Handles(issueURL string) bool {
+	_, ok := g.issuePath(issueURL)
+	return ok
+}
+
+func (g *githubTracker) Serves(projects []string) bool {
+	return servesProjects(g.projects, projects)
+}
+
+type githubIssue struct {
+	HTMLURL string `json:"html_url"`
+	Title   string `json:"title"`
+	State   string `json:"state"`
+}
+
+func (g *githubTracker) linked(issue *githubIssue) *lib.LinkedIssue {
+	return &lib.LinkedIssue{URL: issue.HTMLURL, Tracker: g.name, Title: issue.Title, State: issue.State, Closed: issue.State == "closed"}
+}
+
+func (g *githubTracker) GetIssue(ctx context.Context, issueURL string) (*lib.LinkedIssue, error) {
+	path, ok := g.issuePath(issueURL)
+	if !ok {
+		return nil, fmt.Errorf("%v isn't a GitHub issue URL", issueURL)
+	}
+	issue := &githubIssue{}
+	if err := g.client.do(ctx, http.MethodGet, g.api+path, nil, issue); err != nil {
+		return nil, err
+	}
+	linked := g.linked(issue)
+	linked.URL = issueURL
+	return linked, nil
+}
+
+func (g *githubTracker) CreateIssue(ctx context.Context, title string, description string) (*lib.LinkedIssue, error) {
+	if g.repository == "" {
+		return nil, fmt.Errorf("github issue tracker %v doesn't have a repository to create issues in", g.name)
+	}
+	issue := &githubIssue{}
+	owner, repo, _ := strings.Cut(g.repository, "/")
+	if err := g.client.do(ctx, http.MethodPost, g.api+githubRepoPath(owner, repo)+"/issues", map[string]string{"title": title, "body": description}, issue); err != nil {
+		return nil, err
+	}
+	return g.linked(issue), nil
+}
+
+// Fetched issues are reused, so that plans can be viewed repeatedly without querying the trackers each time.
+// Failed lookups are reused for less time, so that a tracker that is down doesn't slow down every request.
+const (
+	issueCacheLength      = 5 * time.Minute
+	issueErrorCacheLength = time.Minute
+	issueFetchTimeout     = 5 * time.Second // for all of a plan's issues
+	issueFetchConcurrency = 8
+)
+
+type cachedIssue struct {
+	issue   lib.LinkedIssue
+	expires time.Time
+}
+
+// issueCache holds recently fetched issues, keyed on URL. A nil cache doesn't cache anything.
+type issueCache struct {
+	mu     sync.Mutex
+	issues map[string]cachedIssue
+}
+
+func newIssueCache() *issueCache {
+	return &issueCache{issues: map[string]cachedIssue{}}
+}
+
+func (c *issueCache) get(issueURL string) (lib.LinkedIssue, bool) {
+	if c == nil {
+		return lib.LinkedIssue{}, false
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	cached, ok := c.issues[issueURL]
+	if !ok || time.Now().After(cached.expires) {
+		return lib.LinkedIssue{}, false
+	}
+	return cached.issue, true
+}
+
+func (c *issueCache) put(issue lib.LinkedIssue) {
+	if c == nil {
+		return
+	}
+	length := issueCacheLength
+	if issue.Error != "" {
+		length = issueErrorCacheLength
+	}
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	c.issues[issue.URL] = cachedIssue{issue: issue, expires: time.Now().Add(length)}
+}
+
+// linkedIssue returns the state of the issue, from the tracker that handles its URL
+func (rt *Runtime) linkedIssue(ctx context.Context, issueURL string) lib.LinkedIssue {
+	if issue, ok := rt.issues.get(issueURL); ok {
+		return issue
+	}
+	for _, t := range rt.IssueTrackers {
+		if !t.Handles(issueURL) {
+			continue
+		}
+		issue, err := t.GetIssue(ctx, issueURL)
+		if err != nil {
+			log.WithContext(ctx).WithFields(log.Fields{"tracker": t.Name(), "issue": issueURL, "error": err}).Warn("Couldn't fetch issue")
+			issue = &lib.LinkedIssue{URL: issueURL, Tracker: t.Name(), Error: err.Error()}
+		}
+		rt.issues.put(*issue)
+		return *issue
+	}
+	return lib.LinkedIssue{URL: issueURL, Error: "none of the issue trackers handle this URL"}
+}
+
+// linkedIssues returns the state of each of the issues, keyed on URL. They're fetched concurrently, and those that
+// can't be fetched within issueFetchTimeout are returned with an error.
+func (rt *Runtime) linkedIssues(ctx context.Context, issueURLs []string) map[string]lib.LinkedIssue {
+	ctx, cancel := context.WithTimeout(ctx, issueFetchTimeout)
+	defer cancel()
+
+	issues := make([]lib.LinkedIssue, len(issueURLs))
+	slots := make(chan struct{}, issueFetchConcurrency)
+	var wg sync.WaitGroup
+	for i, issueURL := range issueURLs {
+		wg.Add(1)
+		go func(i int, issueURL string) {
+			defer wg.Done()
+			slots <- struct{}{}
+			defer func() { <-slots }()
+			issues[i] = rt.linkedIssue(ctx, issueURL)
+		}(i, issueURL)
+	}
+	wg.Wait()
+
+	linked := make(map[string]lib.LinkedIssue, len(issueURLs))
+	for i, issueURL := range issueURLs {
+		linked[issueURL] = issues[i]
+	}
+	return linked
+}
+
+// linkIssues fills in the state of each task's issues, and flags the tasks whose issues are all closed but which
+// aren't answered Yes, so that they're re-assessed
+func (rt *Runtime) linkIssues(ctx context.Context, responses *lib.PlanResponses) {
+	issueURLs := []string{}
+	seen := map[string]bool{}
+	for _, pr := range responses.PracticeResponses {
+		for _, t := range pr.Tasks {
+			for _, issueURL := range t.Issues {
+				if !seen[issueURL] {
+					seen[issueURL] = true
+					issueURLs = append(issueURLs, issueURL)
+				}
+			}
+		}
+	}
+	linked := rt.linkedIssues(ctx, issueURLs)
+
+	for practiceID, pr := range responses.PracticeResponses {
+		for taskID, t := range pr.Tasks {
+			if len(t.Issues) == 0 {
+				continue
+			}
+			t.LinkedIssues = make([]lib.LinkedIssue, len(t.Issues))
+			closed := true
+			for i, issueURL := range t.Issues {
+				t.LinkedIssues[i] = linked[issueURL]
+				closed = closed && t.LinkedIssues[i].Closed
+			}
+			pr.Tasks[taskID] = t
+			if result, err := responses.TaskResult(practiceID, taskID); closed && err == nil && result != lib.Yes && result != lib.NA {
+				t.Reassess = true
+				pr.Tasks[taskID] = t
+			}
+		}
+	}
+}
+
+// NewCreateTaskIssueHandler creates a handler
+func NewCreateTaskIssueHandler(rt *Runtime) operations.CreateTaskIssueHandler {
+	return &createTaskIssueHandlerImp{rt: rt}
+}
+
+type createTaskIssueHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *createTaskIssueHandlerImp) Handle(params operations.CreateTaskIssueParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.CreateTaskIssueDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if len(h.rt.IssueTrackers) == 0 {
+		return fail(501, "no issue trackers are configured on this server")
+	}
+
+	ctx := params.HTTPRequest.Context()
+	existing, found, err := h.rt.Store.GetPlan(ctx, params.ID)
+	if err != nil {
+		return fail(500, "error retrieving plan")
+	}
+	if !found {
+		return fail(404, "plan not found")
+	}
+	if code, msg := h.rt.checkContributor(ctx, principal, existing.Attributes.Projects); code != 0 {
+		return fail(code, msg)
+	}
+
+	// Use the named tracker, or the first that serves the plan's projects
+	var tracker IssueTracker
+	for _, t := range h.rt.IssueTrackers {
+		if params.Body.Tracker == "" {
+			if t.Serves(existing.Attributes.Projects) {
+				tracker = t
+				break
+			}
+		} else if t.Name() == params.Body.Tracker {
+			tracker = t
+			break
+		}
+	}
+	if tracker == nil {
+		if params.Body.Tracker == "" {
+			return fail(400, "none of the configured issue trackers serve the plan's projects")
+		}
+		return fail(404, "issue tracker "+params.Body.Tracker+" isn't configured")
+	}
+	if !tracker.Serves(existing.Attributes.Projects) {
+		return fail(400, "issue tracker "+params.Body.Tracker+" doesn't serve the plan's projects")
+	}
+
+	revIDs, err := h.rt.Store.ListPlanRevisionIDs(ctx, params.ID)
+	if err != nil || len(revIDs) == 0 {
+		return fail(500, "couldn't retrieve revisions for plan")
+	}
+	revID := revIDs[len(revIDs)-1]
+	plan, found, err := h.rt.Store.GetPlanRevision(ctx, params.ID, revID)
+	if err != nil || !found || plan == nil {
+		return fail(500, "error retrieving plan")
+	}
+	if t := plan.Responses.PracticeResponses[params.PracticeID].Tasks[params.TaskID]; !t.Priority {
+		return fail(400, "only tasks prioritised in the plan's latest revision can have issues created for them")
+	}
+	// The new revision must satisfy the commit policy like any other, so check before creating an issue it can't link
+	if plan.Details.Committed {
+		if code, rejection := h.rt.commitRejection(ctx, plan); rejection != nil {
+			r := operations.CreateTaskIssueDefault{}
+			return r.WithStatusCode(code).WithPayload(rejection)
+		}
+	}
+	review, err := h.rt.carriedReview(ctx, params.ID, revID)
+	if err != nil {
+		return fail(500, "error retrieving the plan's review")
+	}
+
+	practices, err := h.rt.GetPractices(ctx, plan.Responses.PracticesVersion)
+	if err != nil {
+		return fail(500, "error retrieving practices")
+	}
+	var practice *lib.Practice
+	for i := range practices {
+		if practices[i].ID == params.PracticeID {
+			practice = &practices[i]
+		}
+	}
+	if practice == nil {
+		return fail(404, "practice "+params.PracticeID+" not found")
+	}
+	task, found := practice.TaskFromID(params.TaskID)
+	if !found {
+		return fail(404, "task "+params.TaskID+" not found")
+	}
+
+	description := fmt.Sprintf("%v\n\nThis task from the %v practice is a priority in the BeSec plan for %v (plan %v).",
+		task.Description, practice.Name, h.rt.projectNames(ctx, plan.Details.Projects), params.ID)
+	issue, err := tracker.CreateIssue(ctx, task.Title, description)
+	if err != nil {
+		log.WithContext(ctx).WithFields(log.Fields{"tracker": tracker.Name(), "error": err}).Error("Failed to create issue")
+		return fail(502, "failed to create the issue: "+err.Error())
+	}
+	h.rt.issues.put(*issue)
+	h.rt.audit(params.HTTPRequest, principal, "task.issue.create", params.ID+"/"+params.PracticeID+"/"+params.TaskID, nil, issue)
+
+	// Link the issue to the task in a new revision; only the task's issues change, so its maturity and review state don't
+	pr := plan.Responses.PracticeResponses[params.PracticeID]
+	t := pr.Tasks[params.TaskID]
+	t.Issues = append(append([]string{}, t.Issues...), issue.URL)
+	pr.Tasks[params.TaskID] = t
+	revID, err = h.rt.Store.CreatePlanRevision(ctx, params.ID, plan, principal, review)
+	if err != nil {
+		log.WithContext(ctx).WithFields(log.Fields{"plan": params.ID, "issue": issue.URL, "error": err}).Error("Failed to link the new issue to its task")
+		return fail(500, "created the issue "+issue.URL+", but couldn't link it to the task")
+	}
+	h.rt.audit(params.HTTPRequest, principal, "plan.revise", params.ID, existing.Attributes, planAuditSummary(revID, plan))
+	h.rt.planRevised(ctx, principal, params.ID, revID, plan, nil)
+	return &operations.CreateTaskIssueCreated{Payload: issue}
+}
@@ -0,0 +1,287 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"reflect"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+func TestJiraTracker(t *testing.T) {
+	var created map[string]map[string]interface{}
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if u, p, ok := r.BasicAuth(); !ok || u != "besec@example.com" || p != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/rest/api/2/issue":
+			if err := json.NewDecoder(r.Body).Decode(&created); err != nil {
+				t.Error(err)
+			}
+			w.Write([]byte(`{"key": "SEC-7"}`))
+		case r.URL.Path == "/rest/api/2/issue/SEC-7":
+			w.Write([]byte(`{"fields": {"summary": "Enforce MFA", "status": {"name": "Done", "statusCategory": {"key": "done"}}}}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	jira, err := NewIssueTracker(IssueTrackerConfig{Name: "jira", Type: "jira", URL: srv.URL + "/", Project: "SEC", Username: "besec@example.com", Token: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	for u, want := range map[string]bool{
+		srv.URL + "/browse/SEC-7":         true,
+		srv.URL + "/browse/sec-7":         false,
+		"https://other.example.com/SEC-7": false,
+	} {
+		if got := jira.Handles(u); got != want {
+			t.Errorf("Handles(%v) = %v", u, got)
+		}
+	}
+
+	issue, err := jira.CreateIssue(context.Background(), "Enforce MFA", "Require a second factor")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := &lib.LinkedIssue{URL: srv.URL + "/browse/SEC-7", Tracker: "jira", Title: "Enforce MFA", State: "Done", Closed: true}
+	if !reflect.DeepEqual(issue, want) {
+		t.Errorf("got %+v, want %+v", issue, want)
+	}
+	if created["fields"]["summary"] != "Enforce MFA" || !reflect.DeepEqual(created["fields"]["project"], map[string]interface{}{"key": "SEC"}) {
+		t.Errorf("unexpected issue created: %v", created)
+	}
+	if _, err = jira.GetIssue(context.Background(), srv.URL+"/browse/SEC-8"); err == nil {
+		t.Error("got a missing issue")
+	}
+}
+
+func TestGitHubTracker(t *testing.T) {
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Header.Get("Authorization") != "Bearer secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		switch {
+		case r.Method == http.MethodPost && r.URL.Path == "/api/v3/repos/acme/app/issues":
+			w.Write([]byte(`{"html_url": "https://github.example.com/acme/app/issues/3", "title": "Enforce MFA", "state": "open"}`))
+		case r.URL.Path == "/api/v3/repos/acme/app/issues/3":
+			w.Write([]byte(`{"html_url": "https://github.example.com/acme/app/issues/3", "title": "Enforce MFA", "state": "closed"}`))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer srv.Close()
+
+	gh, err := NewIssueTracker(IssueTrackerConfig{Name: "gh", Type: "github", URL: srv.URL, Repository: "acme/app", Token: "secret"})
+	if err != nil {
+		t.Fatal(err)
+	}
+	issue, err := gh.CreateIssue(context.Background(), "Enforce MFA", "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if issue.Closed || issue.State != "open" || issue.Tracker != "gh" {
+		t.Errorf("unexpected new issue: %+v", issue)
+	}
+	issueURL := srv.URL + "/acme/app/issues/3"
+	if !gh.Handles(issueURL) || gh.Handles(srv.URL+"/acme/app/pull/3") {
+		t.Error("GitHub issue URLs weren't recognised")
+	}
+	for _, u := range []string{"/acme/../issues/3", "/../app/issues/3", "/acme/a%20b/issues/3", "/acme/app%2F..%2F..%2Fuser/issues/3"} {
+		if gh.Handles(srv.URL + u) {
+			t.Errorf("%v was accepted as a GitHub issue URL", u)
+		}
+	}
+	if issue, err = gh.GetIssue(context.Background(), issueURL); err != nil || !issue.Closed || issue.URL != issueURL {
+		t.Errorf("got %+v, %v", issue, err)
+	}
+	for _, repo := range []string{"app", "acme/..", "acme/app?x=1"} {
+		if _, err = NewIssueTracker(IssueTrackerConfig{Name: "gh", Type: "github", Repository: repo, Token: "secret"}); err == nil {
+			t.Errorf("the invalid repository %v was accepted", repo)
+		}
+	}
+}
+
+// staticTracker handles URLs in its issues map, and serves the plans of its projects, or every plan if it has none
+type staticTracker struct {
+	issues   map[string]*lib.LinkedIssue
+	name     string
+	projects []string
+}
+
+func (s *staticTracker) Name() string { return s.name }
+
+func (s *staticTracker) Serves(projects []string) bool { return servesProjects(s.projects, projects) }
+
+func (s *staticTracker) Handles(issueURL string) bool { return s.issues[issueURL] != nil }
+
+func (s *staticTracker) GetIssue(ctx context.Context, issueURL string) (*lib.LinkedIssue, error) {
+	return s.issues[issueURL], nil
+}
+
+func (s *staticTracker) CreateIssue(ctx context.Context, title string, description string) (*lib.LinkedIssue, error) {
+	return nil, nil
+}
+
+func TestLinkIssues(t *testing.T) {
+	tracker := &staticTracker{name: "static", issues: map[string]*lib.LinkedIssue{
+		"closed": {URL: "closed", Tracker: "static", Closed: true},
+		"open":   {URL: "open", Tracker: "static"},
+	}}
+	rt := &Runtime{IssueTrackers: []IssueTracker{tracker}}
+	no := map[string]lib.Answer{"q": {Answer: lib.No}}
+	responses := &lib.PlanResponses{PracticeResponses: map[string]lib.PracticeResponse{"auth": {Tasks: map[string]lib.TaskResponse{
+		"done":      {Answers: no, Issues: []string{"closed"}},
+		"working":   {Answers: no, Issues: []string{"closed", "open"}},
+		"unknown":   {Answers: no, Issues: []string{"https://elsewhere.example.com/1"}},
+		"confirmed": {Answers: map[string]lib.Answer{"q": {Answer: lib.Yes}}, Issues: []string{"closed"}},
+	}}}}
+
+	rt.linkIssues(context.Background(), responses)
+	tasks := responses.PracticeResponses["auth"].Tasks
+	for id, reassess := range map[string]bool{"done": true, "working": false, "unknown": false, "confirmed": false} {
+		if tasks[id].Reassess != reassess {
+			t.Errorf("%v: reassess is %v", id, tasks[id].Reassess)
+		}
+	}
+	if linked := tasks["working"].LinkedIssues; len(linked) != 2 || !linked[0].Closed || linked[1].Closed {
+		t.Errorf("unexpected linked issues: %+v", linked)
+	}
+	if linked := tasks["unknown"].LinkedIssues; len(linked) != 1 || linked[0].Error == "" {
+		t.Errorf("an issue without a tracker wasn't reported: %+v", linked)
+	}
+
+	responses.ClearLinkedIssues()
+	if tasks["done"].LinkedIssues != nil || tasks["done"].Reassess {
+		t.Error("linked issues weren't cleared")
+	}
+}
+
+// failingTracker handles every URL, and fails to fetch any of them
+type failingTracker struct {
+	staticTracker
+	fetched int
+}
+
+func (f *failingTracker) Handles(issueURL string) bool { return true }
+
+func (f *failingTracker) GetIssue(ctx context.Context, issueURL string) (*lib.LinkedIssue, error) {
+	f.fetched++
+	return nil, fmt.Errorf("unavailable")
+}
+
+func TestIssueErrorsCached(t *testing.T) {
+	tracker := &failingTracker{}
+	rt := &Runtime{IssueTrackers: []IssueTracker{tracker}, issues: newIssueCache()}
+	for i := 0; i < 2; i++ {
+		linked := rt.linkedIssues(context.Background(), []string{"https://issues.example.com/1"})
+		if issue := linked["https://issues.example.com/1"]; issue.Error == "" {
+			t.Errorf("the failure wasn't reported: %+v", issue)
+		}
+	}
+	if tracker.fetched != 1 {
+		t.Errorf("the failed issue was fetched %v times", tracker.fetched)
+	}
+}
+
+// creatingTracker creates issues with sequential URLs
+type creatingTracker struct {
+	staticTracker
+}
+
+func (c *creatingTracker) CreateIssue(ctx context.Context, title string, description string) (*lib.LinkedIssue, error) {
+	return &lib.LinkedIssue{URL: "https://issues.example.com/" + title, Tracker: c.Name(), Title: title}, nil
+}
+
+func TestCreateTaskIssue(t *testing.T) {
+	st := newMemStore()
+	st.projects = []*models.Project{testProject("alpha", "", nil, "plan-a")}
+	st.practices = []lib.Practice{{ID: "auth", Name: "Authentication", Tasks: []lib.Task{{ID: "mfa", Title: "Enforce MFA"}}}}
+	st.plans["plan-a"] = singleRevision(&lib.Plan{
+		Details: lib.PlanDetails{Projects: []string{"alpha"}, Date: "2021-06-01", Committed: true},
+		Responses: lib.PlanResponses{PracticeResponses: map[string]lib.PracticeResponse{
+			"auth": {Tasks: map[string]lib.TaskResponse{"mfa": {Priority: true, Issues: []string{"https://issues.example.com/old"}}}},
+		}},
+	})
+	st.reviews["r1"] = review(models.PlanReviewStateApproved)
+	st.reviews["r1"].ReviewerUID = "reviewer"
+	rt := &Runtime{Store: st, IssueTrackers: []IssueTracker{&creatingTracker{}}, PlanReviews: true, practicesCache: map[string]practiceCache{}}
+
+	req := httptest.NewRequest(http.MethodPost, "/v1alpha1/plan/plan-a/practice/auth/task/mfa/issue", nil)
+	owner := &models.User{UID: "u-alpha", Roles: models.Roles{models.RoleProjectOwner}}
+	params := operations.CreateTaskIssueParams{HTTPRequest: req, ID: "plan-a", PracticeID: "auth", TaskID: "mfa", Body: operations.CreateTaskIssueBody{}}
+	if _, ok := NewCreateTaskIssueHandler(rt).Handle(params, owner).(*operations.CreateTaskIssueCreated); !ok {
+		t.Fatal("the issue wasn't created")
+	}
+
+	revs := st.plans["plan-a"]
+	if len(revs) != 2 || revs[1].AuthorUID != "u-alpha" || !revs[1].Plan.Details.Committed {
+		t.Fatalf("the issue wasn't linked in a new committed revision: %+v", revs)
+	}
+	want := []string{"https://issues.example.com/old", "https://issues.example.com/Enforce MFA"}
+	if got := revs[1].Plan.Responses.PracticeResponses["auth"].Tasks["mfa"].Issues; !reflect.DeepEqual(got, want) {
+		t.Errorf("the task's issues are %v, want %v", got, want)
+	}
+	if got := revs[0].Plan.Responses.PracticeResponses["auth"].Tasks["mfa"].Issues; len(got) != 1 {
+		t.Errorf("the earlier revision was changed: %v", got)
+	}
+	if review := st.reviews["r2"]; review == nil || *review.State != models.PlanReviewStateApproved || review.ReviewerUID != "reviewer" {
+		t.Errorf("the new revision didn't keep the approval: %+v", review)
+	}
+
+	st.policy = &lib.CommitPolicy{Rules: []lib.CommitRule{lib.RuleNoFutureDate}}
+	st.plans["plan-a"][1].Plan.Details.Date = "2999-01-01"
+	rec := httptest.NewRecorder()
+	NewCreateTaskIssueHandler(rt).Handle(params, owner).WriteResponse(rec, runtime.JSONProducer())
+	if rec.Code != http.StatusBadRequest || len(st.plans["plan-a"]) != 2 {
+		t.Errorf("a revision that breaks the commit policy got %v", rec.Code)
+	}
+}
+
+func TestCreateTaskIssueTracker(t *testing.T) {
+	st := newMemStore()
+	st.projects = []*models.Project{testProject("alpha", "", nil, "plan-a")}
+	st.practices = []lib.Practice{{ID: "auth", Name: "Authentication", Tasks: []lib.Task{{ID: "mfa", Title: "Enforce MFA"}}}}
+	st.plans["plan-a"] = singleRevision(&lib.Plan{
+		Details:   lib.PlanDetails{Projects: []string{"alpha"}, Date: "2021-06-01"},
+		Responses: lib.PlanResponses{PracticeResponses: map[string]lib.PracticeResponse{"auth": {Tasks: map[string]lib.TaskResponse{"mfa": {Priority: true}}}}},
+	})
+	rt := &Runtime{Store: st, practicesCache: map[string]practiceCache{}, IssueTrackers: []IssueTracker{
+		&creatingTracker{staticTracker{name: "beta", projects: []string{"beta"}}},
+		&creatingTracker{staticTracker{name: "alpha", projects: []string{"alpha"}}},
+	}}
+	owner := &models.User{UID: "u-alpha", Roles: models.Roles{models.RoleProjectOwner}}
+	create := func(tracker string) middleware.Responder {
+		req := httptest.NewRequest(http.MethodPost, "/v1alpha1/plan/plan-a/practice/auth/task/mfa/issue", nil)
+		params := operations.CreateTaskIssueParams{HTTPRequest: req, ID: "plan-a", PracticeID: "auth", TaskID: "mfa", Body: operations.CreateTaskIssueBody{Tracker: tracker}}
+		return NewCreateTaskIssueHandler(rt).Handle(params, owner)
+	}
+
+	if created, ok := create("").(*operations.CreateTaskIssueCreated); !ok || created.Payload.Tracker != "alpha" {
+		t.Errorf("the issue wasn't created in the tracker serving the plan's project: %+v", created)
+	}
+	rec := httptest.NewRecorder()
+	create("beta").WriteResponse(rec, runtime.JSONProducer())
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("creating an issue in a tracker that doesn't serve the plan's project got %v", rec.Code)
+	}
+	rt.IssueTrackers = rt.IssueTrackers[:1]
+	rec = httptest.NewRecorder()
+	create("").WriteResponse(rec, runtime.JSONProducer())
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("creating an issue when no tracker serves the plan's project got %v", rec.Code)
+	}
+}
@@ -0,0 +1,230 @@
+package api
+
+import (
+	"context"
+	"sort"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+)
+
+// projectRole returns the user's role in the project, or nil if they aren't an owner or member
+func projectRole(p *models.Project, uid string) *models.ProjectMemberRole {
+	for _, m := range p.Members {
+		if m.UID != nil && *m.UID == uid {
+			return m.Role
+		}
+	}
+	return nil
+}
+
+func hasOwner(members []*models.ProjectMember) bool {
+	for _, m := range members {
+		if m.Role != nil && *m.Role == models.ProjectMemberRoleOwner {
+			return true
+		}
+	}
+	return false
+}
+
+// canContribute reports whether the user can edit the project and create revisions of its plans.
+// Security admins can contribute to any project. Otherwise the user needs the edit permission, from their roles and
+// their API token's scopes, and once the project has members, to be one of them.
+func (rt *Runtime) canContribute(u *models.User, p *models.Project) bool {
+	if rt.Allowed(u, AdminPermission) {
+		return true
+	}
+	if !rt.Allowed(u, EditPermission) {
+		return false
+	}
+	return len(p.Members) == 0 || projectRole(p, u.UID) != nil
+}
+
+// canManage reports whether the user can manage the project's members and delete it.
+// Security admins can manage any project. Otherwise the user needs the delete permission, from their roles and
+// their API token's scopes, and once the project has an owner, to be one of them.
+func (rt *Runtime) canManage(u *models.User, p *models.Project) bool {
+	if rt.Allowed(u, AdminPermission) {
+		return true
+	}
+	if !rt.Allowed(u, DeletePermission) {
+		return false
+	}
+	if !hasOwner(p.Members) {
+		return true
+	}
+	role := projectRole(p, u.UID)
+	return role != nil && *role == models.ProjectMemberRoleOwner
+}
+
+// checkContributor returns a non-zero HTTP status code and a message if the user can't contribute to every one of the projects,
+// see canContribute. The user always needs the edit permission; projects that don't exist are ignored.
+func (rt *Runtime) checkContributor(ctx context.Context, u *models.User, projectIDs []string) (int, string) {
+	if !rt.Allowed(u, EditPermission) && !rt.Allowed(u, AdminPermission) {
+		return 403, forbidden(EditPermission)
+	}
+	for _, id := range projectIDs {
+		p, ok, err := rt.Store.GetProject(ctx, id)
+		if err != nil {
+			return 500, "error retrieving project " + id
+		}
+		if ok && !rt.canContribute(u, p) {
+			return 403, "only the owners and members of project '" + *p.Attributes.Name + "' can change its plans"
+		}
+	}
+	return 0, ""
+}
+
+// sortMineFirst orders projects the user is an owner or member of before the others, otherwise preserving their order
+func sortMineFirst(projects []*models.Project, uid string) {
+	sort.SliceStable(projects, func(i, j int) bool {
+		return projectRole(projects[i], uid) != nil && projectRole(projects[j], uid) == nil
+	})
+}
+
+// NewListProjectMembersHandler creates a handler
+func NewListProjectMembersHandler(rt *Runtime) operations.ListProjectMembersHandler {
+	return &listProjectMembersHandlerImp{rt: rt}
+}
+
+type listProjectMembersHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listProjectMembersHandlerImp) Handle(params operations.ListProjectMembersParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListProjectMembersDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	proj, found, err := h.rt.Store.GetProject(params.HTTPRequest.Context(), params.ID)
+	if err != nil {
+		return fail(500, "error retrieving project")
+	}
+	if !found {
+		return fail(404, "project not found")
+	}
+	return &operations.ListProjectMembersOK{Payload: proj.Members}
+}
+
+// NewSetProjectMemberHandler creates a handler
+func NewSetProjectMemberHandler(rt *Runtime) operations.SetProjectMemberHandler {
+	return &setProjectMemberHandlerImp{rt: rt}
+}
+
+type setProjectMemberHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *setProjectMemberHandlerImp) Handle(params operations.SetProjectMemberParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.SetProjectMemberDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	logger := log.WithContext(ctx)
+
+	proj, found, err := h.rt.Store.GetProject(ctx, params.ID)
+	if err != nil {
+		return fail(500, "error retrieving project")
+	}
+	if !found {
+		return fail(404, "project "+params.ID+" doesn't exist")
+	}
+	if !h.rt.canManage(principal, proj) {
+		return fail(403, "only the project's owners can manage its members")
+	}
+
+	user := models.User{UID: params.UID}
+	if err = h.rt.Store.GetUserData(ctx, &user); err != nil {
+		return fail(500, "error retrieving user")
+	}
+	if user.LocalData == nil {
+		return fail(404, "user not found - they need to have logged in at least once")
+	}
+
+	uid := params.UID
+	member := &models.ProjectMember{UID: &uid, Role: params.Body.Role, Name: user.LocalData.Name, Email: user.LocalData.Email}
+	members := []*models.ProjectMember{}
+	replaced := false
+	for _, m := range proj.Members {
+		if m.UID != nil && *m.UID == uid {
+			m = member
+			replaced = true
+		}
+		members = append(members, m)
+	}
+	if !replaced {
+		members = append(members, member)
+	}
+	if hasOwner(proj.Members) && !hasOwner(members) {
+		return fail(400, "a project must keep at least one owner")
+	}
+
+	if err = h.rt.Store.SetProjectMembers(ctx, params.ID, members); err != nil {
+		return fail(500, err.Error())
+	}
+	logger.WithFields(log.Fields{"project": params.ID, "user": uid, "role": *params.Body.Role, "by": principal.UID}).Info("Set project member")
+	h.rt.audit(params.HTTPRequest, principal, "project.member.set", params.ID, proj.Members, members)
+	return &operations.SetProjectMemberOK{}
+}
+
+// NewRemoveProjectMemberHandler creates a handler
+func NewRemoveProjectMemberHandler(rt *Runtime) operations.RemoveProjectMemberHandler {
+	return &removeProjectMemberHandlerImp{rt: rt}
+}
+
+type removeProjectMemberHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *removeProjectMemberHandlerImp) Handle(params operations.RemoveProjectMemberParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.RemoveProjectMemberDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	logger := log.WithContext(ctx)
+
+	proj, found, err := h.rt.Store.GetProject(ctx, params.ID)
+	if err != nil {
+		return fail(500, "error retrieving project")
+	}
+	if !found {
+		return fail(404, "project "+params.ID+" doesn't exist")
+	}
+	if !h.rt.canManage(principal, proj) {
+		return fail(403, "only the project's owners can manage its members")
+	}
+
+	members := []*models.ProjectMember{}
+	for _, m := range proj.Members {
+		if m.UID == nil || *m.UID != params.UID {
+			members = append(members, m)
+		}
+	}
+	if len(members) == len(proj.Members) {
+		return fail(404, "user "+params.UID+" isn't a member of the project")
+	}
+	if hasOwner(proj.Members) && !hasOwner(members) {
+		return fail(400, "a project must keep at least one owner")
+	}
+
+	if err = h.rt.Store.SetProjectMembers(ctx, params.ID, members); err != nil {
+		return fail(500, err.Error())
+	}
+	if err = h.rt.Store.DeleteSubscription(ctx, params.UID, params.ID); err != nil {
+		logger.WithFields(log.Fields{"project": params.ID, "user": params.UID, "error": err}).Error("Failed to delete removed member's subscription")
+	}
+	logger.WithFields(log.Fields{"project": params.ID, "user": params.UID, "by": principal.UID}).Info("Removed project member")
+	h.rt.audit(params.HTTPRequest, principal, "project.member.remove", params.ID, proj.Members, members)
+	return &operations.RemoveProjectMemberNoContent{}
+}
@@ -0,0 +1,174 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+func member(uid string, role models.ProjectMemberRole) *models.ProjectMember {
+	return &models.ProjectMember{UID: &uid, Role: &role}
+}
+
+func TestProjectMembership(t *testing.T) {
+	rt := &Runtime{DefaultRoles: models.Roles{models.RoleProjectContributor}}
+	owned := &models.Project{Members: []*models.ProjectMember{
+		member("owner", models.ProjectMemberRoleOwner),
+		member("member", models.ProjectMemberRoleMember),
+	}}
+	unowned := &models.Project{Members: []*models.ProjectMember{}}
+
+	cases := []struct {
+		uid        string
+		roles      models.Roles
+		project    *models.Project
+		contribute bool
+		manage     bool
+	}{
+		{"owner", nil, owned, true, false}, // owners also need a role that allows deleting projects
+		{"owner", models.Roles{models.RoleProjectOwner}, owned, true, true},
+		{"member", nil, owned, true, false},
+		{"member", models.Roles{models.RoleViewer}, owned, false, false}, // membership doesn't extend the user's role
+		{"owner", models.Roles{models.RoleViewer}, owned, false, false},
+		{"other", nil, owned, false, false},
+		{"other", models.Roles{models.RoleProjectOwner}, owned, false, false},
+		{"other", models.Roles{models.RoleSecurityAdmin}, owned, true, true},
+		{"other", nil, unowned, true, false}, // projects without members fall back to roles
+		{"other", models.Roles{models.RoleProjectOwner}, unowned, true, true},
+		{"other", models.Roles{models.RoleViewer}, unowned, false, false},
+	}
+
+	for _, c := range cases {
+		u := &models.User{UID: c.uid, Roles: c.roles}
+		if got := rt.canContribute(u, c.project); got != c.contribute {
+			t.Errorf("canContribute(%v %v, %v members) == %v, want %v", c.uid, c.roles, len(c.project.Members), got, c.contribute)
+		}
+		if got := rt.canManage(u, c.project); got != c.manage {
+			t.Errorf("canManage(%v %v, %v members) == %v, want %v", c.uid, c.roles, len(c.project.Members), got, c.manage)
+		}
+	}
+}
+
+func TestProjectMembershipTokenScopes(t *testing.T) {
+	rt := &Runtime{}
+	owned := &models.Project{Members: []*models.ProjectMember{member("owner", models.ProjectMemberRoleOwner)}}
+	owner := func(scopes ...string) *models.User {
+		return &models.User{UID: "owner", Roles: models.Roles{models.RoleProjectOwner}, APITokenID: "t1", TokenScopes: scopes}
+	}
+
+	if rt.canContribute(owner("read"), owned) || rt.canManage(owner("read"), owned) {
+		t.Error("a read-scoped token could change the project")
+	}
+	if !rt.canContribute(owner("read", "edit"), owned) || rt.canManage(owner("read", "edit"), owned) {
+		t.Error("an edit-scoped token should contribute to the project, but not manage it")
+	}
+	if !rt.canManage(owner("delete"), owned) {
+		t.Error("a delete-scoped token couldn't manage the owner's project")
+	}
+}
+
+func TestCheckContributor(t *testing.T) {
+	st := newMemStore()
+	st.projects = []*models.Project{testProject("alpha", "", nil)} // owned by u-alpha
+	rt := &Runtime{Store: st, DefaultRoles: models.Roles{models.RoleProjectContributor}}
+	ctx := context.Background()
+
+	cases := []struct {
+		name     string
+		user     *models.User
+		projects []string
+		code     int
+	}{
+		{"owner", &models.User{UID: "u-alpha"}, []string{"alpha"}, 0},
+		{"non-member", &models.User{UID: "other"}, []string{"alpha"}, 403},
+		{"viewer owner", &models.User{UID: "u-alpha", Roles: models.Roles{models.RoleViewer}}, []string{"alpha"}, 403},
+		{"read-scoped token", &models.User{UID: "u-alpha", APITokenID: "t1", TokenScopes: []string{"read"}}, []string{"alpha"}, 403},
+		{"edit-scoped token", &models.User{UID: "u-alpha", APITokenID: "t1", TokenScopes: []string{"read", "edit"}}, []string{"alpha"}, 0},
+		{"new project", &models.User{UID: "other"}, []string{"missing"}, 0},
+		{"viewer, new project", &models.User{UID: "other", Roles: models.Roles{models.RoleViewer}}, []string{"missing"}, 403},
+		{"admin", &models.User{UID: "other", Roles: models.Roles{models.RoleSecurityAdmin}}, []string{"alpha"}, 0},
+	}
+	for _, c := range cases {
+		if code, msg := rt.checkContributor(ctx, c.user, c.projects); code != c.code {
+			t.Errorf("%v: got %v (%v), want %v", c.name, code, msg, c.code)
+		}
+	}
+}
+
+func TestSortMineFirst(t *testing.T) {
+	ps := []*models.Project{
+		{ID: "a"},
+		{ID: "b", Members: []*models.ProjectMember{member("me", models.ProjectMemberRoleMember)}},
+		{ID: "c"},
+		{ID: "d", Members: []*models.ProjectMember{member("me", models.ProjectMemberRoleOwner)}},
+	}
+	sortMineFirst(ps, "me")
+	got := ""
+	for _, p := range ps {
+		got += p.ID
+	}
+	if got != "bdac" {
+		t.Errorf("sortMineFirst ordered projects %v, want bdac", got)
+	}
+}
+
+func TestRemoveProjectMember(t *testing.T) {
+	st := newMemStore()
+	legacy := &models.Project{ID: "legacy", Attributes: &models.ProjectDetails{}, Members: []*models.ProjectMember{
+		member("u1", models.ProjectMemberRoleMember), member("u2", models.ProjectMemberRoleMember),
+	}}
+	st.projects = []*models.Project{testProject("alpha", "", nil), legacy} // alpha is owned by u-alpha
+	rt := &Runtime{Store: st, DefaultRoles: models.Roles{models.RoleProjectOwner}}
+	h := NewRemoveProjectMemberHandler(rt)
+
+	remove := func(u string, project string, uid string) int {
+		params := operations.RemoveProjectMemberParams{ID: project, UID: uid,
+			HTTPRequest: httptest.NewRequest(http.MethodDelete, "/v1alpha1/project/"+project+"/member/"+uid, nil)}
+		rec := httptest.NewRecorder()
+		h.Handle(params, &models.User{UID: u}).WriteResponse(rec, runtime.JSONProducer())
+		return rec.Code
+	}
+
+	if code := remove("u-alpha", "alpha", "u-alpha"); code != 400 {
+		t.Errorf("the project's only owner was removed: %v", code)
+	}
+	if code := remove("u1", "legacy", "u2"); code != 204 || len(legacy.Members) != 1 {
+		t.Errorf("couldn't remove a member of a project that has never had an owner: %v", code)
+	}
+}
+
+func TestDeletePlanNeedsManager(t *testing.T) {
+	st := newMemStore()
+	st.projects = []*models.Project{testProject("alpha", "", nil, "plan-a"), testProject("beta", "", nil, "plan-a")}
+	st.plans["plan-a"] = singleRevision(&lib.Plan{Details: lib.PlanDetails{Projects: []string{"alpha", "beta"}, Date: "2021-06-01"}})
+	rt := &Runtime{Store: st, DefaultRoles: models.Roles{models.RoleProjectOwner}}
+	h := NewDeletePlanHandler(rt)
+
+	del := func(uid string, planID string) int {
+		params := operations.DeletePlanParams{ID: planID, HTTPRequest: httptest.NewRequest(http.MethodDelete, "/v1alpha1/plan/"+planID, nil)}
+		rec := httptest.NewRecorder()
+		h.Handle(params, &models.User{UID: uid}).WriteResponse(rec, runtime.JSONProducer())
+		return rec.Code
+	}
+
+	if code := del("u-alpha", "plan-a"); code != 403 || st.plans["plan-a"] == nil {
+		t.Errorf("an owner of only one of the plan's projects deleted it: %v", code)
+	}
+	if code := del("u-alpha", "missing"); code != 404 {
+		t.Errorf("deleting a missing plan returned %v", code)
+	}
+	if code := del("other", "plan-a"); code != 403 {
+		t.Errorf("a non-member deleted the plan: %v", code)
+	}
+	st.projects[1].Members = append(st.projects[1].Members, member("u-alpha", models.ProjectMemberRoleOwner))
+	if code := del("u-alpha", "plan-a"); code != 204 || st.plans["plan-a"] != nil {
+		t.Errorf("the owner of all the plan's projects couldn't delete it: %v", code)
+	}
+}
@@ -0,0 +1,298 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+	"github.com/ThalesGroup/besec/store"
+)
+
+// memStore is an in-memory store for tests. Each test fills in the data it needs; calling a method it doesn't
+// implement panics, through the nil embedded Store.
+type memStore struct {
+	store.Store
+	messages      map[string]store.OutboxMessage
+	alerted       []string // users whose creation alert has been sent
+	webhooks      []*store.Webhook
+	projects      []*models.Project
+	units         []*models.OrgUnit
+	plans         map[string][]lib.ChainedRevision // each plan's revisions, earliest first
+	reviews       map[string]*models.PlanReview    // keyed on revision ID
+	practices     []lib.Practice                   // the same for every version
+	subscriptions []*store.Subscription
+	users         map[string]*models.LocalUserData
+	reminders     map[string]time.Time
+	digests       map[string]time.Time
+	policy        *lib.CommitPolicy
+	audit         []*store.AuditEvent
+}
+
+func newMemStore() *memStore {
+	return &memStore{
+		messages:  map[string]store.OutboxMessage{},
+		plans:     map[string][]lib.ChainedRevision{},
+		reviews:   map[string]*models.PlanReview{},
+		users:     map[string]*models.LocalUserData{},
+		reminders: map[string]time.Time{},
+		digests:   map[string]time.Time{},
+		policy:    &lib.CommitPolicy{Rules: []lib.CommitRule{}},
+	}
+}
+
+// testProject returns a project owned by u-<id>, whose address is <id>@example.com
+func testProject(id string, unit string, cadence *int64, plans ...string) *models.Project {
+	owner, uid := models.ProjectMemberRoleOwner, "u-"+id
+	return &models.Project{
+		ID:         id,
+		Attributes: &models.ProjectDetails{Name: &id, OrgUnit: unit, CadenceDays: cadence},
+		Members:    []*models.ProjectMember{{UID: &uid, Email: id + "@example.com", Role: &owner}},
+		Plans:      plans,
+	}
+}
+
+// singleRevision returns the revisions of a plan that only has one
+func singleRevision(p *lib.Plan) []lib.ChainedRevision {
+	return []lib.ChainedRevision{{ID: "r1", Plan: p}}
+}
+
+func (s *memStore) ListProjects(ctx context.Context) ([]*models.Project, error) {
+	return s.projects, nil
+}
+
+func (s *memStore) GetProject(ctx context.Context, id string) (*models.Project, bool, error) {
+	for _, p := range s.projects {
+		if p.ID == id {
+			return p, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (s *memStore) SetProjectMembers(ctx context.Context, id string, members []*models.ProjectMember) error {
+	for _, p := range s.projects {
+		if p.ID == id {
+			p.Members = members
+		}
+	}
+	return nil
+}
+
+func (s *memStore) ListOrgUnits(ctx context.Context) ([]*models.OrgUnit, error) {
+	return s.units, nil
+}
+
+func (s *memStore) ListPlanRevisionIDs(ctx context.Context, id string) ([]string, error) {
+	ids := []string{}
+	for _, rev := range s.plans[id] {
+		ids = append(ids, rev.ID)
+	}
+	return ids, nil
+}
+
+func (s *memStore) GetPlan(ctx context.Context, id string) (*models.Plan, bool, error) {
+	revs, ok := s.plans[id]
+	if !ok || len(revs) == 0 {
+		return nil, false, nil
+	}
+	details := revs[len(revs)-1].Plan.Details
+	return &models.Plan{ID: id, Attributes: &details}, true, nil
+}
+
+func (s *memStore) DeletePlan(ctx context.Context, id string) error {
+	delete(s.plans, id)
+	return nil
+}
+
+// copyPlan copies a plan's responses, as the caller may change them afterwards
+func copyPlan(p *lib.Plan) *lib.Plan {
+	c := *p
+	c.Responses.PracticeResponses = map[string]lib.PracticeResponse{}
+	for practiceID, pr := range p.Responses.PracticeResponses {
+		tasks := map[string]lib.TaskResponse{}
+		for taskID, t := range pr.Tasks {
+			tasks[taskID] = t
+		}
+		c.Responses.PracticeResponses[practiceID] = lib.PracticeResponse{Practice: pr.Practice, Tasks: tasks}
+	}
+	return &c
+}
+
+func (s *memStore) CreatePlanRevision(ctx context.Context, id string, p *lib.Plan, user *models.User, review *models.PlanReview) (string, error) {
+	revID := fmt.Sprintf("r%v", len(s.plans[id])+1)
+	s.plans[id] = append(s.plans[id], lib.ChainedRevision{ID: revID, AuthorUID: user.UID, Plan: copyPlan(p)})
+	if review != nil {
+		s.reviews[revID] = review
+	}
+	return revID, nil
+}
+
+func (s *memStore) CreatePlan(ctx context.Context, p *lib.Plan, user *models.User, review *models.PlanReview) (string, string, error) {
+	id := fmt.Sprintf("plan-%v", len(s.plans)+1)
+	revID, err := s.CreatePlanRevision(ctx, id, p, user, review)
+	return id, revID, err
+}
+
+func (s *memStore) RecordAuditEvent(ctx context.Context, e *store.AuditEvent) (string, error) {
+	s.audit = append(s.audit, e)
+	return fmt.Sprint(len(s.audit)), nil
+}
+
+func (s *memStore) GetPlanRevision(ctx context.Context, planID string, revID string) (*lib.Plan, bool, error) {
+	for _, rev := range s.plans[planID] {
+		if rev.ID == revID {
+			return copyPlan(rev.Plan), true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (s *memStore) GetPlanRevisionChain(ctx context.Context, id string) ([]lib.ChainedRevision, string, bool, error) {
+	revs, ok := s.plans[id]
+	copied := make([]lib.ChainedRevision, len(revs))
+	for i, rev := range revs {
+		copied[i] = rev
+		copied[i].Plan = copyPlan(rev.Plan)
+	}
+	return copied, "", ok, nil
+}
+
+// GetPlanVersions uses each revision's AuthorUID as its author's name too
+func (s *memStore) GetPlanVersions(ctx context.Context, id string) ([]*models.RevisionVersion, error) {
+	versions := []*models.RevisionVersion{}
+	for _, rev := range s.plans[id] {
+		revID, uid := rev.ID, rev.AuthorUID
+		versions = append(versions, &models.RevisionVersion{PlanID: id, RevID: &revID, Review: s.reviews[revID],
+			Version: &models.Version{Author: &models.VersionAuthor{UID: &uid, Name: &uid}, Time: strfmt.DateTime(rev.Time)}})
+	}
+	return versions, nil
+}
+
+func (s *memStore) UpdatePlanReview(ctx context.Context, planID string, revID string, update func(review *models.PlanReview) error) (*models.PlanReview, error) {
+	var review *models.PlanReview
+	if existing := s.reviews[revID]; existing != nil {
+		copied := *existing
+		copied.Comments = append([]*models.ReviewComment{}, existing.Comments...)
+		review = &copied
+	}
+	if err := update(review); err != nil {
+		return nil, err
+	}
+	s.reviews[revID] = review
+	return review, nil
+}
+
+func (s *memStore) GetPractices(ctx context.Context, version string) ([]lib.Practice, error) {
+	return s.practices, nil
+}
+
+func (s *memStore) GetCommitPolicy(ctx context.Context) (*lib.CommitPolicy, error) {
+	return s.policy, nil
+}
+
+func (s *memStore) UserCreationAlertSent(ctx context.Context, uid string) error {
+	s.alerted = append(s.alerted, uid)
+	return nil
+}
+
+func (s *memStore) ListProjectSubscriptions(ctx context.Context, projectID string) ([]*store.Subscription, error) {
+	subs := []*store.Subscription{}
+	for _, sub := range s.subscriptions {
+		if sub.ProjectID == projectID {
+			subs = append(subs, sub)
+		}
+	}
+	return subs, nil
+}
+
+func (s *memStore) ListUserSubscriptions(ctx context.Context, uid string) ([]*store.Subscription, error) {
+	subs := []*store.Subscription{}
+	for _, sub := range s.subscriptions {
+		if sub.UID == uid {
+			subs = append(subs, sub)
+		}
+	}
+	return subs, nil
+}
+
+func (s *memStore) DeleteSubscription(ctx context.Context, uid string, projectID string) error {
+	subs := []*store.Subscription{}
+	for _, sub := range s.subscriptions {
+		if sub.UID != uid || sub.ProjectID != projectID {
+			subs = append(subs, sub)
+		}
+	}
+	s.subscriptions = subs
+	return nil
+}
+
+func (s *memStore) GetUserData(ctx context.Context, u *models.User) error {
+	u.LookedUp = true
+	u.LocalData = s.users[u.UID]
+	if u.LocalData != nil {
+		u.ManuallyAuthorized = u.LocalData.ManuallyAuthorized
+		u.Roles = u.LocalData.Roles
+		u.Deactivated = u.LocalData.Deactivated
+	}
+	return nil
+}
+
+func (s *memStore) ListWebhooks(ctx context.Context) ([]*store.Webhook, error) {
+	return s.webhooks, nil
+}
+
+func (s *memStore) GetWebhook(ctx context.Context, id string) (*store.Webhook, bool, error) {
+	for _, w := range s.webhooks {
+		if w.ID == id {
+			return w, true, nil
+		}
+	}
+	return nil, false, nil
+}
+
+func (s *memStore) EnqueueNotification(ctx context.Context, m *store.OutboxMessage, window time.Duration) (bool, error) {
+	if existing, ok := s.messages[m.ID]; ok && m.Created.Sub(existing.Created) < window {
+		return false, nil
+	}
+	s.messages[m.ID] = *m
+	return true, nil
+}
+
+func (s *memStore) ClaimDueNotifications(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*store.OutboxMessage, error) {
+	claimed := []*store.OutboxMessage{}
+	for id, m := range s.messages {
+		if m.Status == store.OutboxPending && !m.NextAttempt.IsZero() && !m.NextAttempt.After(now) && len(claimed) < limit {
+			m.NextAttempt = now.Add(lease)
+			s.messages[id] = m
+			claimed = append(claimed, &m)
+		}
+	}
+	return claimed, nil
+}
+
+func (s *memStore) UpdateNotification(ctx context.Context, m *store.OutboxMessage) error {
+	s.messages[m.ID] = *m
+	return nil
+}
+
+func (s *memStore) ListReminders(ctx context.Context) (map[string]time.Time, error) {
+	return s.reminders, nil
+}
+
+func (s *memStore) RecordReminder(ctx context.Context, key string, when time.Time) error {
+	s.reminders[key] = when
+	return nil
+}
+
+func (s *memStore) LastDigest(ctx context.Context, period string) (time.Time, error) {
+	return s.digests[period], nil
+}
+
+func (s *memStore) RecordDigest(ctx context.Context, period string, end time.Time) error {
+	s.digests[period] = end
+	return nil
+}
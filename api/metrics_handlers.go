@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// latestCommittedRevision returns the most recent committed revision of the plan, or nil if it has never been committed.
+// If approvedOnly is set, revisions that are awaiting review or have had changes requested are skipped; revisions
+// committed without a review, before reviews were enabled, count as approved.
+func latestCommittedRevision(ctx context.Context, rt *Runtime, planID string, approvedOnly bool) (*lib.Plan, error) {
+	revIDs, err := rt.Store.ListPlanRevisionIDs(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	reviews := map[string]*models.PlanReview{}
+	if approvedOnly {
+		versions, err := rt.Store.GetPlanVersions(ctx, planID)
+		if err != nil {
+			return nil, err
+		}
+		for _, v := range versions {
+			reviews[*v.RevID] = v.Review
+		}
+	}
+	for i := len(revIDs) - 1; i >= 0; i-- {
+		if review := reviews[revIDs[i]]; review != nil && *review.State != models.PlanReviewStateApproved {
+			continue
+		}
+		rev, found, err := rt.Store.GetPlanRevision(ctx, planID, revIDs[i])
+		if err != nil {
+			return nil, err
+		}
+		if found && rev.Details.Committed {
+			return rev, nil
+		}
+	}
+	return nil, nil
+}
+
+// latestCommittedPlans returns the most recently dated committed revision of each project's plans, only considering
+// approved revisions if approvedOnly is set. Projects whose plans have never been committed are omitted.
+func latestCommittedPlans(ctx context.Context, rt *Runtime, projects []*models.Project, approvedOnly bool) (map[string]*lib.Plan, error) {
+	revisions := map[string]*lib.Plan{} // plans can belong to several projects, so only look each one up once
+	latest := map[string]*lib.Plan{}
+	for _, p := range projects {
+		for _, planID := range p.Plans {
+			rev, seen := revisions[planID]
+			if !seen {
+				var err error
+				if rev, err = latestCommittedRevision(ctx, rt, planID, approvedOnly); err != nil {
+					return nil, err
+				}
+				revisions[planID] = rev
+			}
+			if rev != nil && (latest[p.ID] == nil || rev.Details.Date >= latest[p.ID].Details.Date) {
+				latest[p.ID] = rev
+			}
+		}
+	}
+	return latest, nil
+}
+
+// projectMaturity returns the maturity levels of each project, taken from the most recently dated committed revision of its plans,
+// only considering approved revisions if approvedOnly is set. Projects whose plans have never been committed are omitted.
+func projectMaturity(ctx context.Context, rt *Runtime, projects []*models.Project, approvedOnly bool) (map[string]map[string]int, error) {
+	latest, err := latestCommittedPlans(ctx, rt, projects, approvedOnly)
+	if err != nil {
+		return nil, err
+	}
+	levels := map[string]map[string]int{}
+	for id, rev := range latest {
+		levels[id] = rev.Details.Maturity
+	}
+	return levels, nil
+}
+
+// NewGetMaturityMetricsHandler creates a handler
+func NewGetMaturityMetricsHandler(rt *Runtime) operations.GetMaturityMetricsHandler {
+	return &getMaturityMetricsHandlerImp{rt: rt}
+}
+
+type getMaturityMetricsHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *getMaturityMetricsHandlerImp) Handle(params operations.GetMaturityMetricsParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.GetMaturityMetricsDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+
+	ctx := params.HTTPRequest.Context()
+	logger := log.WithContext(ctx)
+
+	tree, err := loadOrgTree(ctx, h.rt)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	root := ""
+	if params.OrgUnit != nil && *params.OrgUnit != "" {
+		root = *params.OrgUnit
+		if _, ok := tree.units[root]; !ok {
+			return fail(404, "org unit "+root+" doesn't exist")
+		}
+	}
+
+	allProjects, err := h.rt.Store.ListProjects(ctx)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	filter := projectFilter{tags: params.Tag, productType: params.ProductType, internetFacing: params.InternetFacing, dataClassification: params.DataClassification, tech: params.Tech}
+	projects := []*models.Project{}
+	projectUnits := map[string]string{}
+	for _, p := range filter.filter(allProjects) {
+		unit := p.Attributes.OrgUnit
+		if _, ok := tree.units[unit]; ok && (root == "" || tree.within(unit, root)) {
+			projects = append(projects, p)
+			projectUnits[p.ID] = unit
+		}
+	}
+
+	// with plan reviews enabled, only plans a security reviewer has approved count towards the org's maturity
+	levels, err := projectMaturity(ctx, h.rt, projects, h.rt.PlanReviews)
+	if err != nil {
+		logger.WithField("error", err).Error("GetMaturityMetrics Handler: error retrieving plan revisions")
+		return fail(500, "error retrieving plans")
+	}
+	rollUp := lib.RollUpMaturity(tree.parents, projectUnits, levels)
+
+	metrics := []*models.OrgUnitMaturity{}
+	for _, id := range tree.depthFirst() {
+		if root != "" && !tree.within(id, root) {
+			continue
+		}
+		r := rollUp[id]
+		practices := make(map[string]models.PracticeMaturity, len(r.Practices))
+		for practice, m := range r.Practices {
+			median, min, count := m.Median, int64(m.Min), int64(m.Projects)
+			practices[practice] = models.PracticeMaturity{Median: &median, Min: &min, Projects: &count}
+		}
+		unitID := id
+		count := int64(r.Projects)
+		metrics = append(metrics, &models.OrgUnitMaturity{
+			OrgUnit:   &unitID,
+			Name:      tree.units[id].Attributes.Name,
+			Parent:    tree.units[id].Attributes.Parent,
+			Projects:  &count,
+			Practices: practices,
+		})
+	}
+	return &operations.GetMaturityMetricsOK{Payload: metrics}
+}
@@ -0,0 +1,174 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AcceptedRisk accepted risk
+//
+// swagger:model acceptedRisk
+type AcceptedRisk struct {
+
+	// Whether the acceptance has been approved; only approved acceptances count toward maturity
+	Approved bool `json:"approved,omitempty"`
+
+	// Who approved the acceptance, empty if it hasn't been approved
+	// Required: true
+	Approver *string `json:"approver"`
+
+	// Whether the accepted task counted toward the project's maturity, as its practice's policy allows
+	Counted bool `json:"counted,omitempty"`
+
+	// Whether the acceptance has expired, so it's due for review
+	Expired bool `json:"expired,omitempty"`
+
+	// expires
+	// Required: true
+	Expires *string `json:"expires"`
+
+	// justification
+	Justification string `json:"justification,omitempty"`
+
+	// The practice's name
+	Practice string `json:"practice,omitempty"`
+
+	// practice Id
+	// Required: true
+	PracticeID *string `json:"practiceId"`
+
+	// The project's name
+	// Required: true
+	Project *string `json:"project"`
+
+	// project Id
+	// Required: true
+	ProjectID *string `json:"projectId"`
+
+	// task Id
+	// Required: true
+	TaskID *string `json:"taskId"`
+
+	// title
+	Title string `json:"title,omitempty"`
+}
+
+// Validate validates this accepted risk
+func (m *AcceptedRisk) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateApprover(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpires(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePracticeID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProject(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProjectID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTaskID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AcceptedRisk) validateApprover(formats strfmt.Registry) error {
+
+	if err := validate.Required("approver", "body", m.Approver); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AcceptedRisk) validateExpires(formats strfmt.Registry) error {
+
+	if err := validate.Required("expires", "body", m.Expires); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AcceptedRisk) validatePracticeID(formats strfmt.Registry) error {
+
+	if err := validate.Required("practiceId", "body", m.PracticeID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AcceptedRisk) validateProject(formats strfmt.Registry) error {
+
+	if err := validate.Required("project", "body", m.Project); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AcceptedRisk) validateProjectID(formats strfmt.Registry) error {
+
+	if err := validate.Required("projectId", "body", m.ProjectID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AcceptedRisk) validateTaskID(formats strfmt.Registry) error {
+
+	if err := validate.Required("taskId", "body", m.TaskID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this accepted risk based on context it is used
+func (m *AcceptedRisk) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AcceptedRisk) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AcceptedRisk) UnmarshalBinary(b []byte) error {
+	var res AcceptedRisk
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,53 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// AccessDecision access decision
+//
+// swagger:model accessDecision
+type AccessDecision struct {
+
+	// Approve the request even though the user's email isn't from a trusted domain
+	Force bool `json:"force,omitempty"`
+
+	// An explanation of the decision for the user
+	Reason string `json:"reason,omitempty"`
+}
+
+// Validate validates this access decision
+func (m *AccessDecision) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this access decision based on context it is used
+func (m *AccessDecision) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AccessDecision) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AccessDecision) UnmarshalBinary(b []byte) error {
+	var res AccessDecision
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,226 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AccessRequest access request
+//
+// swagger:model accessRequest
+type AccessRequest struct {
+
+	// created
+	// Required: true
+	// Format: date-time
+	Created *strfmt.DateTime `json:"created"`
+
+	// decided
+	// Format: date-time
+	Decided *strfmt.DateTime `json:"decided,omitempty"`
+
+	// The UID of the admin that approved or denied the request
+	DecidedBy string `json:"decidedBy,omitempty"`
+
+	// decided by name
+	DecidedByName string `json:"decidedByName,omitempty"`
+
+	// email
+	Email string `json:"email,omitempty"`
+
+	// justification
+	// Required: true
+	Justification *string `json:"justification"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// The identity provider the user signed in with
+	Provider string `json:"provider,omitempty"`
+
+	// The admin's explanation of the decision
+	Reason string `json:"reason,omitempty"`
+
+	// status
+	// Required: true
+	// Enum: [pending approved denied]
+	Status *string `json:"status"`
+
+	// Whether the user's email is from one of the deployment's trusted domains
+	// Read Only: true
+	TrustedDomain *bool `json:"trustedDomain,omitempty"`
+
+	// uid
+	// Required: true
+	UID *string `json:"uid"`
+}
+
+// Validate validates this access request
+func (m *AccessRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreated(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDecided(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateJustification(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AccessRequest) validateCreated(formats strfmt.Registry) error {
+
+	if err := validate.Required("created", "body", m.Created); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created", "body", "date-time", m.Created.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AccessRequest) validateDecided(formats strfmt.Registry) error {
+	if swag.IsZero(m.Decided) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("decided", "body", "date-time", m.Decided.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AccessRequest) validateJustification(formats strfmt.Registry) error {
+
+	if err := validate.Required("justification", "body", m.Justification); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var accessRequestTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","approved","denied"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		accessRequestTypeStatusPropEnum = append(accessRequestTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// AccessRequestStatusPending captures enum value "pending"
+	AccessRequestStatusPending string = "pending"
+
+	// AccessRequestStatusApproved captures enum value "approved"
+	AccessRequestStatusApproved string = "approved"
+
+	// AccessRequestStatusDenied captures enum value "denied"
+	AccessRequestStatusDenied string = "denied"
+)
+
+// prop value enum
+func (m *AccessRequest) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, accessRequestTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AccessRequest) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AccessRequest) validateUID(formats strfmt.Registry) error {
+
+	if err := validate.Required("uid", "body", m.UID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this access request based on the context it is used
+func (m *AccessRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateTrustedDomain(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AccessRequest) contextValidateTrustedDomain(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "trustedDomain", "body", m.TrustedDomain); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AccessRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AccessRequest) UnmarshalBinary(b []byte) error {
+	var res AccessRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
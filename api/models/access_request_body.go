@@ -0,0 +1,76 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AccessRequestBody access request body
+//
+// swagger:model accessRequestBody
+type AccessRequestBody struct {
+
+	// Why the user needs access
+	// Required: true
+	// Min Length: 1
+	Justification *string `json:"justification"`
+}
+
+// Validate validates this access request body
+func (m *AccessRequestBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateJustification(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AccessRequestBody) validateJustification(formats strfmt.Registry) error {
+
+	if err := validate.Required("justification", "body", m.Justification); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("justification", "body", *m.Justification, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this access request body based on context it is used
+func (m *AccessRequestBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AccessRequestBody) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AccessRequestBody) UnmarshalBinary(b []byte) error {
+	var res AccessRequestBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
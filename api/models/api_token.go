@@ -0,0 +1,293 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APIToken api token
+//
+// swagger:model apiToken
+type APIToken struct {
+
+	// created
+	// Required: true
+	// Format: date-time
+	Created *strfmt.DateTime `json:"created"`
+
+	// The UID of the user that created the token
+	CreatedBy string `json:"createdBy,omitempty"`
+
+	// expires
+	// Format: date-time
+	Expires *strfmt.DateTime `json:"expires,omitempty"`
+
+	// id
+	// Required: true
+	// Read Only: true
+	ID string `json:"id"`
+
+	// last used
+	// Format: date-time
+	LastUsed *strfmt.DateTime `json:"lastUsed,omitempty"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// The UID of the user or service account the token acts as
+	// Required: true
+	Owner *string `json:"owner"`
+
+	// owner name
+	OwnerName string `json:"ownerName,omitempty"`
+
+	// The start of the secret, to help identify the token
+	Prefix string `json:"prefix,omitempty"`
+
+	// The roles of a service account token
+	Roles Roles `json:"roles,omitempty"`
+
+	// scopes
+	// Required: true
+	Scopes []APITokenScope `json:"scopes"`
+
+	// True if the owner is a service account
+	ServiceAccount bool `json:"serviceAccount,omitempty"`
+}
+
+// Validate validates this api token
+func (m *APIToken) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCreated(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateExpires(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateLastUsed(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOwner(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoles(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIToken) validateCreated(formats strfmt.Registry) error {
+
+	if err := validate.Required("created", "body", m.Created); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created", "body", "date-time", m.Created.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIToken) validateExpires(formats strfmt.Registry) error {
+	if swag.IsZero(m.Expires) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("expires", "body", "date-time", m.Expires.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIToken) validateID(formats strfmt.Registry) error {
+
+	if err := validate.RequiredString("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIToken) validateLastUsed(formats strfmt.Registry) error {
+	if swag.IsZero(m.LastUsed) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("lastUsed", "body", "date-time", m.LastUsed.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIToken) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIToken) validateOwner(formats strfmt.Registry) error {
+
+	if err := validate.Required("owner", "body", m.Owner); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIToken) validateRoles(formats strfmt.Registry) error {
+	if swag.IsZero(m.Roles) { // not required
+		return nil
+	}
+
+	if err := m.Roles.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("roles")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("roles")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIToken) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Scopes); i++ {
+
+		if err := m.Scopes[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("scopes" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("scopes" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api token based on the context it is used
+func (m *APIToken) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateID(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateRoles(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateScopes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APIToken) contextValidateID(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "id", "body", string(m.ID)); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIToken) contextValidateRoles(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Roles.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("roles")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("roles")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *APIToken) contextValidateScopes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Scopes); i++ {
+
+		if err := m.Scopes[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("scopes" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("scopes" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APIToken) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APIToken) UnmarshalBinary(b []byte) error {
+	var res APIToken
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
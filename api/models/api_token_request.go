@@ -0,0 +1,223 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// APITokenRequest api token request
+//
+// swagger:model apiTokenRequest
+type APITokenRequest struct {
+
+	// The number of days until the token expires. If unset, it doesn't expire.
+	// Minimum: 1
+	ExpiresInDays int64 `json:"expiresInDays,omitempty"`
+
+	// What the token is used for
+	// Required: true
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// The roles of the service account; required for service account tokens
+	Roles Roles `json:"roles,omitempty"`
+
+	// scopes
+	// Required: true
+	// Min Items: 1
+	Scopes []APITokenScope `json:"scopes"`
+
+	// Create the token for this service account rather than the caller
+	// Pattern: ^[a-zA-Z0-9_-]+$
+	ServiceAccount string `json:"serviceAccount,omitempty"`
+}
+
+// Validate validates this api token request
+func (m *APITokenRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateExpiresInDays(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoles(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateScopes(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateServiceAccount(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APITokenRequest) validateExpiresInDays(formats strfmt.Registry) error {
+	if swag.IsZero(m.ExpiresInDays) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("expiresInDays", "body", m.ExpiresInDays, 1, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APITokenRequest) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", *m.Name, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *APITokenRequest) validateRoles(formats strfmt.Registry) error {
+	if swag.IsZero(m.Roles) { // not required
+		return nil
+	}
+
+	if err := m.Roles.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("roles")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("roles")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *APITokenRequest) validateScopes(formats strfmt.Registry) error {
+
+	if err := validate.Required("scopes", "body", m.Scopes); err != nil {
+		return err
+	}
+
+	iScopesSize := int64(len(m.Scopes))
+
+	if err := validate.MinItems("scopes", "body", iScopesSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Scopes); i++ {
+
+		if err := m.Scopes[i].Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("scopes" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("scopes" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *APITokenRequest) validateServiceAccount(formats strfmt.Registry) error {
+	if swag.IsZero(m.ServiceAccount) { // not required
+		return nil
+	}
+
+	if err := validate.Pattern("serviceAccount", "body", m.ServiceAccount, `^[a-zA-Z0-9_-]+$`); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this api token request based on the context it is used
+func (m *APITokenRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRoles(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateScopes(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *APITokenRequest) contextValidateRoles(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Roles.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("roles")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("roles")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *APITokenRequest) contextValidateScopes(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Scopes); i++ {
+
+		if err := m.Scopes[i].ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("scopes" + "." + strconv.Itoa(i))
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("scopes" + "." + strconv.Itoa(i))
+			}
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *APITokenRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *APITokenRequest) UnmarshalBinary(b []byte) error {
+	var res APITokenRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
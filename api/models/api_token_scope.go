@@ -0,0 +1,90 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// APITokenScope A permission that an API token may exercise, if its owner's roles also allow it
+//
+// swagger:model apiTokenScope
+type APITokenScope string
+
+func NewAPITokenScope(value APITokenScope) *APITokenScope {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated APITokenScope.
+func (m APITokenScope) Pointer() *APITokenScope {
+	return &m
+}
+
+const (
+
+	// APITokenScopeRead captures enum value "read"
+	APITokenScopeRead APITokenScope = "read"
+
+	// APITokenScopeEdit captures enum value "edit"
+	APITokenScopeEdit APITokenScope = "edit"
+
+	// APITokenScopeDelete captures enum value "delete"
+	APITokenScopeDelete APITokenScope = "delete"
+
+	// APITokenScopeAdmin captures enum value "admin"
+	APITokenScopeAdmin APITokenScope = "admin"
+
+	// APITokenScopeAudit captures enum value "audit"
+	APITokenScopeAudit APITokenScope = "audit"
+
+	// APITokenScopeReview captures enum value "review"
+	APITokenScopeReview APITokenScope = "review"
+)
+
+// for schema
+var apiTokenScopeEnum []interface{}
+
+func init() {
+	var res []APITokenScope
+	if err := json.Unmarshal([]byte(`["read","edit","delete","admin","audit","review"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		apiTokenScopeEnum = append(apiTokenScopeEnum, v)
+	}
+}
+
+func (m APITokenScope) validateAPITokenScopeEnum(path, location string, value APITokenScope) error {
+	if err := validate.EnumCase(path, location, value, apiTokenScopeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this api token scope
+func (m APITokenScope) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateAPITokenScopeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this api token scope based on context it is used
+func (m APITokenScope) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,145 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// AuditEvent A record of a change, who made it, and when
+//
+// swagger:model auditEvent
+type AuditEvent struct {
+
+	// action
+	// Required: true
+	Action *string `json:"action"`
+
+	// The UID of the user or service account, or who ran the CLI command
+	// Required: true
+	Actor *string `json:"actor"`
+
+	// actor name
+	ActorName string `json:"actorName,omitempty"`
+
+	// A JSON summary of the target after the change
+	After string `json:"after,omitempty"`
+
+	// A JSON summary of the target before the change
+	Before string `json:"before,omitempty"`
+
+	// id
+	// Required: true
+	ID *string `json:"id"`
+
+	// The trace ID of the API request, to correlate with the server logs
+	RequestID string `json:"requestId,omitempty"`
+
+	// target Id
+	TargetID string `json:"targetId,omitempty"`
+
+	// target type
+	TargetType string `json:"targetType,omitempty"`
+
+	// time
+	// Required: true
+	// Format: date-time
+	Time *strfmt.DateTime `json:"time"`
+}
+
+// Validate validates this audit event
+func (m *AuditEvent) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAction(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateActor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *AuditEvent) validateAction(formats strfmt.Registry) error {
+
+	if err := validate.Required("action", "body", m.Action); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateActor(formats strfmt.Registry) error {
+
+	if err := validate.Required("actor", "body", m.Actor); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *AuditEvent) validateTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("time", "body", m.Time); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("time", "body", "date-time", m.Time.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this audit event based on context it is used
+func (m *AuditEvent) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *AuditEvent) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *AuditEvent) UnmarshalBinary(b []byte) error {
+	var res AuditEvent
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
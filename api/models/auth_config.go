@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"encoding/json"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -22,6 +23,11 @@ type AuthConfig struct {
 	// emulator Url
 	EmulatorURL string `json:"emulatorUrl,omitempty"`
 
+	// How users sign in. With firebase (the default), the UI uses FirebaseUI with the configured providers. With oidc, clients sign in with the oidc issuer (e.g. using the authorization code flow with PKCE), and send its ID token to the API.
+	//
+	// Enum: [firebase oidc]
+	Flow string `json:"flow,omitempty"`
+
 	// gcp auth domain
 	// Required: true
 	GcpAuthDomain *string `json:"gcpAuthDomain"`
@@ -30,6 +36,9 @@ type AuthConfig struct {
 	// Required: true
 	GcpPublicAPIKey *string `json:"gcpPublicApiKey"`
 
+	// oidc
+	Oidc *OidcConfig `json:"oidc,omitempty"`
+
 	// providers
 	// Required: true
 	Providers AuthProviders `json:"providers"`
@@ -39,6 +48,10 @@ type AuthConfig struct {
 func (m *AuthConfig) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateFlow(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateGcpAuthDomain(formats); err != nil {
 		res = append(res, err)
 	}
@@ -47,6 +60,10 @@ func (m *AuthConfig) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateOidc(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateProviders(formats); err != nil {
 		res = append(res, err)
 	}
@@ -57,6 +74,48 @@ func (m *AuthConfig) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+var authConfigTypeFlowPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["firebase","oidc"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		authConfigTypeFlowPropEnum = append(authConfigTypeFlowPropEnum, v)
+	}
+}
+
+const (
+
+	// AuthConfigFlowFirebase captures enum value "firebase"
+	AuthConfigFlowFirebase string = "firebase"
+
+	// AuthConfigFlowOidc captures enum value "oidc"
+	AuthConfigFlowOidc string = "oidc"
+)
+
+// prop value enum
+func (m *AuthConfig) validateFlowEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, authConfigTypeFlowPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *AuthConfig) validateFlow(formats strfmt.Registry) error {
+	if swag.IsZero(m.Flow) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateFlowEnum("flow", "body", m.Flow); err != nil {
+		return err
+	}
+
+	return nil
+}
+
 func (m *AuthConfig) validateGcpAuthDomain(formats strfmt.Registry) error {
 
 	if err := validate.Required("gcpAuthDomain", "body", m.GcpAuthDomain); err != nil {
@@ -75,6 +134,25 @@ func (m *AuthConfig) validateGcpPublicAPIKey(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *AuthConfig) validateOidc(formats strfmt.Registry) error {
+	if swag.IsZero(m.Oidc) { // not required
+		return nil
+	}
+
+	if m.Oidc != nil {
+		if err := m.Oidc.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("oidc")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("oidc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *AuthConfig) validateProviders(formats strfmt.Registry) error {
 
 	if err := validate.Required("providers", "body", m.Providers); err != nil {
@@ -97,6 +175,10 @@ func (m *AuthConfig) validateProviders(formats strfmt.Registry) error {
 func (m *AuthConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateOidc(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateProviders(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -107,6 +189,22 @@ func (m *AuthConfig) ContextValidate(ctx context.Context, formats strfmt.Registr
 	return nil
 }
 
+func (m *AuthConfig) contextValidateOidc(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Oidc != nil {
+		if err := m.Oidc.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("oidc")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("oidc")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *AuthConfig) contextValidateProviders(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := m.Providers.ContextValidate(ctx, formats); err != nil {
@@ -0,0 +1,143 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// CurrentUser current user
+//
+// swagger:model currentUser
+type CurrentUser struct {
+
+	// email
+	Email string `json:"email,omitempty"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// The permissions granted by the user's roles
+	// Required: true
+	Permissions []string `json:"permissions"`
+
+	// The user's effective roles, including any defaults
+	// Required: true
+	Roles Roles `json:"roles"`
+
+	// uid
+	// Required: true
+	UID *string `json:"uid"`
+}
+
+// Validate validates this current user
+func (m *CurrentUser) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePermissions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoles(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CurrentUser) validatePermissions(formats strfmt.Registry) error {
+
+	if err := validate.Required("permissions", "body", m.Permissions); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *CurrentUser) validateRoles(formats strfmt.Registry) error {
+
+	if err := validate.Required("roles", "body", m.Roles); err != nil {
+		return err
+	}
+
+	if err := m.Roles.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("roles")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("roles")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *CurrentUser) validateUID(formats strfmt.Registry) error {
+
+	if err := validate.Required("uid", "body", m.UID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this current user based on the context it is used
+func (m *CurrentUser) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRoles(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *CurrentUser) contextValidateRoles(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Roles.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("roles")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("roles")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *CurrentUser) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *CurrentUser) UnmarshalBinary(b []byte) error {
+	var res CurrentUser
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
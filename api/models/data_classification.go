@@ -0,0 +1,84 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// DataClassification The most sensitive class of data the project handles
+//
+// swagger:model dataClassification
+type DataClassification string
+
+func NewDataClassification(value DataClassification) *DataClassification {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated DataClassification.
+func (m DataClassification) Pointer() *DataClassification {
+	return &m
+}
+
+const (
+
+	// DataClassificationPublic captures enum value "public"
+	DataClassificationPublic DataClassification = "public"
+
+	// DataClassificationInternal captures enum value "internal"
+	DataClassificationInternal DataClassification = "internal"
+
+	// DataClassificationConfidential captures enum value "confidential"
+	DataClassificationConfidential DataClassification = "confidential"
+
+	// DataClassificationRestricted captures enum value "restricted"
+	DataClassificationRestricted DataClassification = "restricted"
+)
+
+// for schema
+var dataClassificationEnum []interface{}
+
+func init() {
+	var res []DataClassification
+	if err := json.Unmarshal([]byte(`["public","internal","confidential","restricted"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		dataClassificationEnum = append(dataClassificationEnum, v)
+	}
+}
+
+func (m DataClassification) validateDataClassificationEnum(path, location string, value DataClassification) error {
+	if err := validate.EnumCase(path, location, value, dataClassificationEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this data classification
+func (m DataClassification) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateDataClassificationEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this data classification based on context it is used
+func (m DataClassification) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,212 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// DsseEnvelope A DSSE envelope, see https://github.com/secure-systems-lab/dsse
+//
+// swagger:model dsseEnvelope
+type DsseEnvelope struct {
+
+	// The base64 encoded payload
+	// Required: true
+	Payload *string `json:"payload"`
+
+	// payload type
+	// Required: true
+	PayloadType *string `json:"payloadType"`
+
+	// signatures
+	// Required: true
+	Signatures []*DsseEnvelopeSignaturesItems0 `json:"signatures"`
+}
+
+// Validate validates this dsse envelope
+func (m *DsseEnvelope) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePayload(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePayloadType(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateSignatures(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DsseEnvelope) validatePayload(formats strfmt.Registry) error {
+
+	if err := validate.Required("payload", "body", m.Payload); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DsseEnvelope) validatePayloadType(formats strfmt.Registry) error {
+
+	if err := validate.Required("payloadType", "body", m.PayloadType); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *DsseEnvelope) validateSignatures(formats strfmt.Registry) error {
+
+	if err := validate.Required("signatures", "body", m.Signatures); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Signatures); i++ {
+		if swag.IsZero(m.Signatures[i]) { // not required
+			continue
+		}
+
+		if m.Signatures[i] != nil {
+			if err := m.Signatures[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("signatures" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("signatures" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// ContextValidate validate this dsse envelope based on the context it is used
+func (m *DsseEnvelope) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateSignatures(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DsseEnvelope) contextValidateSignatures(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Signatures); i++ {
+
+		if m.Signatures[i] != nil {
+			if err := m.Signatures[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("signatures" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("signatures" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DsseEnvelope) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DsseEnvelope) UnmarshalBinary(b []byte) error {
+	var res DsseEnvelope
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
+
+// DsseEnvelopeSignaturesItems0 dsse envelope signatures items0
+//
+// swagger:model DsseEnvelopeSignaturesItems0
+type DsseEnvelopeSignaturesItems0 struct {
+
+	// keyid
+	Keyid string `json:"keyid,omitempty"`
+
+	// The base64 encoded signature
+	// Required: true
+	Sig *string `json:"sig"`
+}
+
+// Validate validates this dsse envelope signatures items0
+func (m *DsseEnvelopeSignaturesItems0) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSig(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *DsseEnvelopeSignaturesItems0) validateSig(formats strfmt.Registry) error {
+
+	if err := validate.Required("sig", "body", m.Sig); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this dsse envelope signatures items0 based on context it is used
+func (m *DsseEnvelopeSignaturesItems0) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *DsseEnvelopeSignaturesItems0) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *DsseEnvelopeSignaturesItems0) UnmarshalBinary(b []byte) error {
+	var res DsseEnvelopeSignaturesItems0
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -8,6 +8,7 @@ package models
 import (
 	"context"
 
+	"github.com/ThalesGroup/besec/lib"
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
 	"github.com/go-openapi/swag"
@@ -25,6 +26,9 @@ type Error struct {
 	// message
 	// Required: true
 	Message *string `json:"message"`
+
+	// The reasons a plan can't be committed, when that's the error
+	Violations []*lib.PolicyViolation `json:"violations,omitempty"`
 }
 
 // Validate validates this error
@@ -35,6 +39,10 @@ func (m *Error) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateViolations(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -50,6 +58,14 @@ func (m *Error) validateMessage(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Error) validateViolations(formats strfmt.Registry) error {
+	if swag.IsZero(m.Violations) { // not required
+		return nil
+	}
+
+	return nil
+}
+
 // ContextValidate validates this error based on context it is used
 func (m *Error) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	return nil
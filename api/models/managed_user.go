@@ -0,0 +1,159 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ManagedUser A user, as seen by security admins managing access
+//
+// swagger:model managedUser
+type ManagedUser struct {
+
+	// Deactivated users don't have access, even if their provider or an access rule would grant it
+	Deactivated bool `json:"deactivated,omitempty"`
+
+	// Whether the user's account is disabled in the identity platform
+	Disabled bool `json:"disabled,omitempty"`
+
+	// email
+	Email string `json:"email,omitempty"`
+
+	// email verified
+	EmailVerified bool `json:"emailVerified,omitempty"`
+
+	// The user's ID in the identity provider that provisioned them over SCIM
+	ExternalID string `json:"externalId,omitempty"`
+
+	// manually authorized
+	// Required: true
+	ManuallyAuthorized *bool `json:"manuallyAuthorized"`
+
+	// name
+	Name string `json:"name,omitempty"`
+
+	// The identity provider the user signs in with, "multiple" if they have linked several, or empty if it isn't known
+	Provider string `json:"provider,omitempty"`
+
+	// roles
+	Roles Roles `json:"roles,omitempty"`
+
+	// Whether the user's email address is from one of the deployment's trusted domains
+	TrustedDomain bool `json:"trustedDomain,omitempty"`
+
+	// uid
+	// Required: true
+	UID *string `json:"uid"`
+}
+
+// Validate validates this managed user
+func (m *ManagedUser) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateManuallyAuthorized(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRoles(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateUID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ManagedUser) validateManuallyAuthorized(formats strfmt.Registry) error {
+
+	if err := validate.Required("manuallyAuthorized", "body", m.ManuallyAuthorized); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ManagedUser) validateRoles(formats strfmt.Registry) error {
+	if swag.IsZero(m.Roles) { // not required
+		return nil
+	}
+
+	if err := m.Roles.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("roles")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("roles")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ManagedUser) validateUID(formats strfmt.Registry) error {
+
+	if err := validate.Required("uid", "body", m.UID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this managed user based on the context it is used
+func (m *ManagedUser) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateRoles(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ManagedUser) contextValidateRoles(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.Roles.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("roles")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("roles")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ManagedUser) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ManagedUser) UnmarshalBinary(b []byte) error {
+	var res ManagedUser
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
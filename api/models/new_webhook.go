@@ -0,0 +1,125 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewWebhook new webhook
+//
+// swagger:model newWebhook
+type NewWebhook struct {
+
+	// The key for the HMAC-SHA256 signature in each request's X-BeSec-Signature header. It can't be retrieved again.
+	//
+	// Required: true
+	Secret *string `json:"secret"`
+
+	// webhook
+	// Required: true
+	Webhook *Webhook `json:"webhook"`
+}
+
+// Validate validates this new webhook
+func (m *NewWebhook) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateSecret(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateWebhook(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NewWebhook) validateSecret(formats strfmt.Registry) error {
+
+	if err := validate.Required("secret", "body", m.Secret); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *NewWebhook) validateWebhook(formats strfmt.Registry) error {
+
+	if err := validate.Required("webhook", "body", m.Webhook); err != nil {
+		return err
+	}
+
+	if m.Webhook != nil {
+		if err := m.Webhook.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("webhook")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("webhook")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this new webhook based on the context it is used
+func (m *NewWebhook) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateWebhook(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *NewWebhook) contextValidateWebhook(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Webhook != nil {
+		if err := m.Webhook.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("webhook")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("webhook")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *NewWebhook) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *NewWebhook) UnmarshalBinary(b []byte) error {
+	var res NewWebhook
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
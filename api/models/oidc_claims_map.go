@@ -0,0 +1,65 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// OidcClaimsMap The ID token claims that hold each user attribute, where they differ from the standard claims
+//
+// swagger:model oidcClaimsMap
+type OidcClaimsMap struct {
+
+	// department
+	Department string `json:"department,omitempty"`
+
+	// Defaults to email
+	Email string `json:"email,omitempty"`
+
+	// Defaults to email_verified
+	EmailVerified string `json:"emailVerified,omitempty"`
+
+	// Defaults to name
+	Name string `json:"name,omitempty"`
+
+	// Defaults to picture
+	PictureURL string `json:"pictureURL,omitempty"`
+
+	// Defaults to sub
+	UID string `json:"uid,omitempty"`
+}
+
+// Validate validates this oidc claims map
+func (m *OidcClaimsMap) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this oidc claims map based on context it is used
+func (m *OidcClaimsMap) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OidcClaimsMap) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OidcClaimsMap) UnmarshalBinary(b []byte) error {
+	var res OidcClaimsMap
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,154 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OidcConfig A standard OpenID Connect identity provider, such as Keycloak
+//
+// swagger:model oidcConfig
+type OidcConfig struct {
+
+	// The audience ID tokens must be issued for. Defaults to the client ID.
+	Audience string `json:"audience,omitempty"`
+
+	// claims
+	Claims *OidcClaimsMap `json:"claims,omitempty"`
+
+	// The client ID the UI uses to sign in
+	// Required: true
+	ClientID *string `json:"clientId"`
+
+	// Identifies the provider in user records. Defaults to "oidc".
+	ID string `json:"id,omitempty"`
+
+	// The issuer URL. The provider's configuration is discovered from <issuer>/.well-known/openid-configuration
+	// Required: true
+	Issuer *string `json:"issuer"`
+
+	// The name shown on the sign in button
+	ProviderName string `json:"providerName,omitempty"`
+
+	// The scopes the UI requests. Defaults to openid, email and profile.
+	Scopes []string `json:"scopes"`
+
+	// True if every user from this provider has access
+	Whitelisted bool `json:"whitelisted,omitempty"`
+}
+
+// Validate validates this oidc config
+func (m *OidcConfig) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateClaims(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateClientID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateIssuer(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OidcConfig) validateClaims(formats strfmt.Registry) error {
+	if swag.IsZero(m.Claims) { // not required
+		return nil
+	}
+
+	if m.Claims != nil {
+		if err := m.Claims.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("claims")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("claims")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *OidcConfig) validateClientID(formats strfmt.Registry) error {
+
+	if err := validate.Required("clientId", "body", m.ClientID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OidcConfig) validateIssuer(formats strfmt.Registry) error {
+
+	if err := validate.Required("issuer", "body", m.Issuer); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this oidc config based on the context it is used
+func (m *OidcConfig) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateClaims(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OidcConfig) contextValidateClaims(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Claims != nil {
+		if err := m.Claims.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("claims")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("claims")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OidcConfig) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OidcConfig) UnmarshalBinary(b []byte) error {
+	var res OidcConfig
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
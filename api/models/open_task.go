@@ -0,0 +1,227 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OpenTask open task
+//
+// swagger:model openTask
+type OpenTask struct {
+
+	// assignee
+	Assignee string `json:"assignee,omitempty"`
+
+	// issues
+	Issues []string `json:"issues"`
+
+	// Whether the target date has passed
+	Overdue bool `json:"overdue,omitempty"`
+
+	// plan Id
+	// Required: true
+	PlanID *string `json:"planId"`
+
+	// The practice's name
+	Practice string `json:"practice,omitempty"`
+
+	// practice Id
+	// Required: true
+	PracticeID *string `json:"practiceId"`
+
+	// The project's name
+	// Required: true
+	Project *string `json:"project"`
+
+	// project Id
+	// Required: true
+	ProjectID *string `json:"projectId"`
+
+	// The plan revision the task's details come from
+	// Required: true
+	RevisionID *string `json:"revisionId"`
+
+	// status
+	// Required: true
+	// Enum: [planned in-progress]
+	Status *string `json:"status"`
+
+	// target date
+	TargetDate string `json:"targetDate,omitempty"`
+
+	// task Id
+	// Required: true
+	TaskID *string `json:"taskId"`
+
+	// title
+	Title string `json:"title,omitempty"`
+}
+
+// Validate validates this open task
+func (m *OpenTask) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validatePlanID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePracticeID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProject(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProjectID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRevisionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTaskID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OpenTask) validatePlanID(formats strfmt.Registry) error {
+
+	if err := validate.Required("planId", "body", m.PlanID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OpenTask) validatePracticeID(formats strfmt.Registry) error {
+
+	if err := validate.Required("practiceId", "body", m.PracticeID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OpenTask) validateProject(formats strfmt.Registry) error {
+
+	if err := validate.Required("project", "body", m.Project); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OpenTask) validateProjectID(formats strfmt.Registry) error {
+
+	if err := validate.Required("projectId", "body", m.ProjectID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OpenTask) validateRevisionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("revisionId", "body", m.RevisionID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var openTaskTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["planned","in-progress"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		openTaskTypeStatusPropEnum = append(openTaskTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// OpenTaskStatusPlanned captures enum value "planned"
+	OpenTaskStatusPlanned string = "planned"
+
+	// OpenTaskStatusInDashProgress captures enum value "in-progress"
+	OpenTaskStatusInDashProgress string = "in-progress"
+)
+
+// prop value enum
+func (m *OpenTask) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, openTaskTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *OpenTask) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OpenTask) validateTaskID(formats strfmt.Registry) error {
+
+	if err := validate.Required("taskId", "body", m.TaskID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this open task based on context it is used
+func (m *OpenTask) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OpenTask) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OpenTask) UnmarshalBinary(b []byte) error {
+	var res OpenTask
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OrgUnitDetails org unit details
+//
+// swagger:model orgUnitDetails
+type OrgUnitDetails struct {
+
+	// How often, in days, projects in the unit and the units below it should commit a new plan, unless they set their own
+	// Minimum: 0
+	CadenceDays *int64 `json:"cadenceDays,omitempty"`
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// The UIDs of the unit's security leads, who can manage it and the units below it
+	Leads []string `json:"leads"`
+
+	// name
+	// Required: true
+	// Min Length: 1
+	Name *string `json:"name"`
+
+	// The ID of the parent org unit. Top-level units don't have a parent.
+	Parent string `json:"parent,omitempty"`
+}
+
+// Validate validates this org unit details
+func (m *OrgUnitDetails) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCadenceDays(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OrgUnitDetails) validateCadenceDays(formats strfmt.Registry) error {
+	if swag.IsZero(m.CadenceDays) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("cadenceDays", "body", *m.CadenceDays, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OrgUnitDetails) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("name", "body", *m.Name, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this org unit details based on context it is used
+func (m *OrgUnitDetails) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OrgUnitDetails) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OrgUnitDetails) UnmarshalBinary(b []byte) error {
+	var res OrgUnitDetails
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
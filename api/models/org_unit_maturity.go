@@ -0,0 +1,171 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OrgUnitMaturity The maturity of the projects in an org unit and all of its descendants
+//
+// swagger:model orgUnitMaturity
+type OrgUnitMaturity struct {
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// The org unit's ID
+	// Required: true
+	OrgUnit *string `json:"orgUnit"`
+
+	// parent
+	Parent string `json:"parent,omitempty"`
+
+	// Keyed on practice ID. Only practices with a calculated maturity in at least one project are present.
+	// Required: true
+	Practices map[string]PracticeMaturity `json:"practices"`
+
+	// The number of projects in the unit and its descendants
+	// Required: true
+	Projects *int64 `json:"projects"`
+}
+
+// Validate validates this org unit maturity
+func (m *OrgUnitMaturity) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateOrgUnit(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePractices(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProjects(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OrgUnitMaturity) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OrgUnitMaturity) validateOrgUnit(formats strfmt.Registry) error {
+
+	if err := validate.Required("orgUnit", "body", m.OrgUnit); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OrgUnitMaturity) validatePractices(formats strfmt.Registry) error {
+
+	if err := validate.Required("practices", "body", m.Practices); err != nil {
+		return err
+	}
+
+	for k := range m.Practices {
+
+		if err := validate.Required("practices"+"."+k, "body", m.Practices[k]); err != nil {
+			return err
+		}
+		if val, ok := m.Practices[k]; ok {
+			if err := val.Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("practices" + "." + k)
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("practices" + "." + k)
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *OrgUnitMaturity) validateProjects(formats strfmt.Registry) error {
+
+	if err := validate.Required("projects", "body", m.Projects); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this org unit maturity based on the context it is used
+func (m *OrgUnitMaturity) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidatePractices(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OrgUnitMaturity) contextValidatePractices(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.Required("practices", "body", m.Practices); err != nil {
+		return err
+	}
+
+	for k := range m.Practices {
+
+		if val, ok := m.Practices[k]; ok {
+			if err := val.ContextValidate(ctx, formats); err != nil {
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OrgUnitMaturity) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OrgUnitMaturity) UnmarshalBinary(b []byte) error {
+	var res OrgUnitMaturity
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,252 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OutboxMessage A notification to be delivered to a channel
+//
+// swagger:model outboxMessage
+type OutboxMessage struct {
+
+	// attempts
+	// Required: true
+	Attempts *int64 `json:"attempts"`
+
+	// channel
+	// Required: true
+	Channel *string `json:"channel"`
+
+	// created
+	// Required: true
+	// Format: date-time
+	Created *strfmt.DateTime `json:"created"`
+
+	// delivered
+	// Format: date-time
+	Delivered *strfmt.DateTime `json:"delivered,omitempty"`
+
+	// event
+	// Required: true
+	Event *string `json:"event"`
+
+	// id
+	// Required: true
+	ID *string `json:"id"`
+
+	// Notifications with the same key are only sent to a channel once an hour
+	Key string `json:"key,omitempty"`
+
+	// last error
+	LastError string `json:"lastError,omitempty"`
+
+	// When delivery will next be attempted, if it's pending
+	// Format: date-time
+	NextAttempt *strfmt.DateTime `json:"nextAttempt,omitempty"`
+
+	// status
+	// Required: true
+	// Enum: [pending delivered failed]
+	Status *string `json:"status"`
+
+	// subject
+	Subject string `json:"subject,omitempty"`
+
+	// title
+	Title string `json:"title,omitempty"`
+}
+
+// Validate validates this outbox message
+func (m *OutboxMessage) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAttempts(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateChannel(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateCreated(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDelivered(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateEvent(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateNextAttempt(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateStatus(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OutboxMessage) validateAttempts(formats strfmt.Registry) error {
+
+	if err := validate.Required("attempts", "body", m.Attempts); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OutboxMessage) validateChannel(formats strfmt.Registry) error {
+
+	if err := validate.Required("channel", "body", m.Channel); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OutboxMessage) validateCreated(formats strfmt.Registry) error {
+
+	if err := validate.Required("created", "body", m.Created); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("created", "body", "date-time", m.Created.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OutboxMessage) validateDelivered(formats strfmt.Registry) error {
+	if swag.IsZero(m.Delivered) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("delivered", "body", "date-time", m.Delivered.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OutboxMessage) validateEvent(formats strfmt.Registry) error {
+
+	if err := validate.Required("event", "body", m.Event); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OutboxMessage) validateID(formats strfmt.Registry) error {
+
+	if err := validate.Required("id", "body", m.ID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OutboxMessage) validateNextAttempt(formats strfmt.Registry) error {
+	if swag.IsZero(m.NextAttempt) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("nextAttempt", "body", "date-time", m.NextAttempt.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var outboxMessageTypeStatusPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["pending","delivered","failed"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		outboxMessageTypeStatusPropEnum = append(outboxMessageTypeStatusPropEnum, v)
+	}
+}
+
+const (
+
+	// OutboxMessageStatusPending captures enum value "pending"
+	OutboxMessageStatusPending string = "pending"
+
+	// OutboxMessageStatusDelivered captures enum value "delivered"
+	OutboxMessageStatusDelivered string = "delivered"
+
+	// OutboxMessageStatusFailed captures enum value "failed"
+	OutboxMessageStatusFailed string = "failed"
+)
+
+// prop value enum
+func (m *OutboxMessage) validateStatusEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, outboxMessageTypeStatusPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *OutboxMessage) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.Required("status", "body", m.Status); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStatusEnum("status", "body", *m.Status); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this outbox message based on context it is used
+func (m *OutboxMessage) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OutboxMessage) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OutboxMessage) UnmarshalBinary(b []byte) error {
+	var res OutboxMessage
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
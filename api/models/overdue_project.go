@@ -0,0 +1,120 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// OverdueProject overdue project
+//
+// swagger:model overdueProject
+type OverdueProject struct {
+
+	// cadence days
+	// Required: true
+	CadenceDays *int64 `json:"cadenceDays"`
+
+	// days overdue
+	DaysOverdue int64 `json:"daysOverdue,omitempty"`
+
+	// The date a new plan was due (YYYY-MM-DD), not set if the project has never committed one
+	Due string `json:"due,omitempty"`
+
+	// The date of the project's latest committed plan, not set if it has never committed one
+	LastCommitted string `json:"lastCommitted,omitempty"`
+
+	// name
+	// Required: true
+	Name *string `json:"name"`
+
+	// org unit
+	OrgUnit string `json:"orgUnit,omitempty"`
+
+	// The email addresses of the project's owners
+	Owners []string `json:"owners"`
+
+	// project Id
+	// Required: true
+	ProjectID *string `json:"projectId"`
+}
+
+// Validate validates this overdue project
+func (m *OverdueProject) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCadenceDays(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateName(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProjectID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *OverdueProject) validateCadenceDays(formats strfmt.Registry) error {
+
+	if err := validate.Required("cadenceDays", "body", m.CadenceDays); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OverdueProject) validateName(formats strfmt.Registry) error {
+
+	if err := validate.Required("name", "body", m.Name); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *OverdueProject) validateProjectID(formats strfmt.Registry) error {
+
+	if err := validate.Required("projectId", "body", m.ProjectID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this overdue project based on context it is used
+func (m *OverdueProject) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *OverdueProject) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *OverdueProject) UnmarshalBinary(b []byte) error {
+	var res OverdueProject
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PlanChain The integrity of a plan's revision history. Each revision includes a hash of its content and of the previous revision, so editing, removing or reordering revisions after they were created is detected.
+//
+//
+// swagger:model planChain
+type PlanChain struct {
+
+	// The hash of the latest revision. Record it to detect the whole history later being rewritten.
+	Head string `json:"head,omitempty"`
+
+	// True if every revision matches its hash and follows the one before it
+	// Required: true
+	Intact *bool `json:"intact"`
+
+	// problems
+	// Required: true
+	Problems []string `json:"problems"`
+
+	// The number of revisions checked
+	// Required: true
+	Revisions *int64 `json:"revisions"`
+
+	// The number of revisions created before hashing was introduced, which can't be checked
+	UnchainedRevisions int64 `json:"unchainedRevisions,omitempty"`
+}
+
+// Validate validates this plan chain
+func (m *PlanChain) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateIntact(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProblems(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRevisions(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PlanChain) validateIntact(formats strfmt.Registry) error {
+
+	if err := validate.Required("intact", "body", m.Intact); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PlanChain) validateProblems(formats strfmt.Registry) error {
+
+	if err := validate.Required("problems", "body", m.Problems); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PlanChain) validateRevisions(formats strfmt.Registry) error {
+
+	if err := validate.Required("revisions", "body", m.Revisions); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this plan chain based on context it is used
+func (m *PlanChain) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PlanChain) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PlanChain) UnmarshalBinary(b []byte) error {
+	var res PlanChain
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
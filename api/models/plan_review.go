@@ -0,0 +1,202 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PlanReview The review of a committed revision. Revisions committed while reviews were disabled don't have one.
+//
+//
+// swagger:model planReview
+type PlanReview struct {
+
+	// The review's history, earliest first
+	// Required: true
+	Comments []*ReviewComment `json:"comments"`
+
+	// When the revision was last approved or had changes requested
+	// Format: date-time
+	Reviewed strfmt.DateTime `json:"reviewed,omitempty"`
+
+	// The name of whoever last approved the revision or requested changes
+	Reviewer string `json:"reviewer,omitempty"`
+
+	// reviewer Uid
+	ReviewerUID string `json:"reviewerUid,omitempty"`
+
+	// state
+	// Required: true
+	// Enum: [submitted approved changes-requested]
+	State *string `json:"state"`
+}
+
+// Validate validates this plan review
+func (m *PlanReview) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateComments(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReviewed(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateState(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PlanReview) validateComments(formats strfmt.Registry) error {
+
+	if err := validate.Required("comments", "body", m.Comments); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Comments); i++ {
+		if swag.IsZero(m.Comments[i]) { // not required
+			continue
+		}
+
+		if m.Comments[i] != nil {
+			if err := m.Comments[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("comments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("comments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+func (m *PlanReview) validateReviewed(formats strfmt.Registry) error {
+	if swag.IsZero(m.Reviewed) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("reviewed", "body", "date-time", m.Reviewed.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var planReviewTypeStatePropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["submitted","approved","changes-requested"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		planReviewTypeStatePropEnum = append(planReviewTypeStatePropEnum, v)
+	}
+}
+
+const (
+
+	// PlanReviewStateSubmitted captures enum value "submitted"
+	PlanReviewStateSubmitted string = "submitted"
+
+	// PlanReviewStateApproved captures enum value "approved"
+	PlanReviewStateApproved string = "approved"
+
+	// PlanReviewStateChangesDashRequested captures enum value "changes-requested"
+	PlanReviewStateChangesDashRequested string = "changes-requested"
+)
+
+// prop value enum
+func (m *PlanReview) validateStateEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, planReviewTypeStatePropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *PlanReview) validateState(formats strfmt.Registry) error {
+
+	if err := validate.Required("state", "body", m.State); err != nil {
+		return err
+	}
+
+	// value enum
+	if err := m.validateStateEnum("state", "body", *m.State); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this plan review based on the context it is used
+func (m *PlanReview) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateComments(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PlanReview) contextValidateComments(ctx context.Context, formats strfmt.Registry) error {
+
+	for i := 0; i < len(m.Comments); i++ {
+
+		if m.Comments[i] != nil {
+			if err := m.Comments[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("comments" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("comments" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PlanReview) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PlanReview) UnmarshalBinary(b []byte) error {
+	var res PlanReview
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
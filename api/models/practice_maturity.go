@@ -0,0 +1,105 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// PracticeMaturity practice maturity
+//
+// swagger:model practiceMaturity
+type PracticeMaturity struct {
+
+	// median
+	// Required: true
+	Median *float64 `json:"median"`
+
+	// min
+	// Required: true
+	Min *int64 `json:"min"`
+
+	// The number of projects with a calculated maturity for this practice
+	// Required: true
+	Projects *int64 `json:"projects"`
+}
+
+// Validate validates this practice maturity
+func (m *PracticeMaturity) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateMedian(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateMin(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProjects(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *PracticeMaturity) validateMedian(formats strfmt.Registry) error {
+
+	if err := validate.Required("median", "body", m.Median); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PracticeMaturity) validateMin(formats strfmt.Registry) error {
+
+	if err := validate.Required("min", "body", m.Min); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *PracticeMaturity) validateProjects(formats strfmt.Registry) error {
+
+	if err := validate.Required("projects", "body", m.Projects); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this practice maturity based on context it is used
+func (m *PracticeMaturity) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *PracticeMaturity) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *PracticeMaturity) UnmarshalBinary(b []byte) error {
+	var res PracticeMaturity
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,93 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// ProductType What kind of product the project delivers
+//
+// swagger:model productType
+type ProductType string
+
+func NewProductType(value ProductType) *ProductType {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated ProductType.
+func (m ProductType) Pointer() *ProductType {
+	return &m
+}
+
+const (
+
+	// ProductTypeSaas captures enum value "saas"
+	ProductTypeSaas ProductType = "saas"
+
+	// ProductTypeOnPremises captures enum value "onPremises"
+	ProductTypeOnPremises ProductType = "onPremises"
+
+	// ProductTypeDesktop captures enum value "desktop"
+	ProductTypeDesktop ProductType = "desktop"
+
+	// ProductTypeMobile captures enum value "mobile"
+	ProductTypeMobile ProductType = "mobile"
+
+	// ProductTypeEmbedded captures enum value "embedded"
+	ProductTypeEmbedded ProductType = "embedded"
+
+	// ProductTypeLibrary captures enum value "library"
+	ProductTypeLibrary ProductType = "library"
+
+	// ProductTypeInternalTool captures enum value "internalTool"
+	ProductTypeInternalTool ProductType = "internalTool"
+)
+
+// for schema
+var productTypeEnum []interface{}
+
+func init() {
+	var res []ProductType
+	if err := json.Unmarshal([]byte(`["saas","onPremises","desktop","mobile","embedded","library","internalTool"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		productTypeEnum = append(productTypeEnum, v)
+	}
+}
+
+func (m ProductType) validateProductTypeEnum(path, location string, value ProductType) error {
+	if err := validate.EnumCase(path, location, value, productTypeEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this product type
+func (m ProductType) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateProductTypeEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this product type based on context it is used
+func (m ProductType) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -7,6 +7,7 @@ package models
 
 import (
 	"context"
+	"strconv"
 
 	"github.com/go-openapi/errors"
 	"github.com/go-openapi/strfmt"
@@ -28,6 +29,10 @@ type Project struct {
 	// Read Only: true
 	ID string `json:"id"`
 
+	// The project's owners and members. Only they can create revisions of the project's plans.
+	// Read Only: true
+	Members []*ProjectMember `json:"members"`
+
 	// The plan IDs associated with this project
 	// Required: true
 	// Read Only: true
@@ -46,6 +51,10 @@ func (m *Project) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateMembers(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validatePlans(formats); err != nil {
 		res = append(res, err)
 	}
@@ -85,6 +94,32 @@ func (m *Project) validateID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *Project) validateMembers(formats strfmt.Registry) error {
+	if swag.IsZero(m.Members) { // not required
+		return nil
+	}
+
+	for i := 0; i < len(m.Members); i++ {
+		if swag.IsZero(m.Members[i]) { // not required
+			continue
+		}
+
+		if m.Members[i] != nil {
+			if err := m.Members[i].Validate(formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("members" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("members" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Project) validatePlans(formats strfmt.Registry) error {
 
 	if err := validate.Required("plans", "body", m.Plans); err != nil {
@@ -106,6 +141,10 @@ func (m *Project) ContextValidate(ctx context.Context, formats strfmt.Registry)
 		res = append(res, err)
 	}
 
+	if err := m.contextValidateMembers(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidatePlans(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -141,6 +180,30 @@ func (m *Project) contextValidateID(ctx context.Context, formats strfmt.Registry
 	return nil
 }
 
+func (m *Project) contextValidateMembers(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := validate.ReadOnly(ctx, "members", "body", []*ProjectMember(m.Members)); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Members); i++ {
+
+		if m.Members[i] != nil {
+			if err := m.Members[i].ContextValidate(ctx, formats); err != nil {
+				if ve, ok := err.(*errors.Validation); ok {
+					return ve.ValidateName("members" + "." + strconv.Itoa(i))
+				} else if ce, ok := err.(*errors.CompositeError); ok {
+					return ce.ValidateName("members" + "." + strconv.Itoa(i))
+				}
+				return err
+			}
+		}
+
+	}
+
+	return nil
+}
+
 func (m *Project) contextValidatePlans(ctx context.Context, formats strfmt.Registry) error {
 
 	if err := validate.ReadOnly(ctx, "plans", "body", []string(m.Plans)); err != nil {
@@ -19,29 +19,93 @@ import (
 // swagger:model projectDetails
 type ProjectDetails struct {
 
+	// How often, in days, the project should commit a new plan; 0 if it shouldn't be tracked. If not set, the cadence of its org unit, or the nearest unit above that has one, applies, and otherwise the server's default.
+	//
+	// Minimum: 0
+	CadenceDays *int64 `json:"cadenceDays,omitempty"`
+
+	// data classification
+	DataClassification DataClassification `json:"dataClassification,omitempty"`
+
 	// Further information about the project, for example what teams and products are considered in scope
 	Description string `json:"description,omitempty"`
 
+	// Whether any part of the project is reachable from the internet
+	InternetFacing bool `json:"internetFacing,omitempty"`
+
 	// short name
 	// Required: true
 	// Min Length: 1
 	Name *string `json:"name"`
+
+	// The ID of the org unit the project belongs to
+	OrgUnit string `json:"orgUnit,omitempty"`
+
+	// product type
+	ProductType ProductType `json:"productType,omitempty"`
+
+	// Free-form labels for the project
+	Tags []string `json:"tags"`
+
+	// The languages, frameworks and platforms the project uses
+	TechStack []string `json:"techStack"`
 }
 
 // Validate validates this project details
 func (m *ProjectDetails) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.validateCadenceDays(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDataClassification(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateName(formats); err != nil {
 		res = append(res, err)
 	}
 
+	if err := m.validateProductType(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
 
+func (m *ProjectDetails) validateCadenceDays(formats strfmt.Registry) error {
+	if swag.IsZero(m.CadenceDays) { // not required
+		return nil
+	}
+
+	if err := validate.MinimumInt("cadenceDays", "body", *m.CadenceDays, 0, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProjectDetails) validateDataClassification(formats strfmt.Registry) error {
+	if swag.IsZero(m.DataClassification) { // not required
+		return nil
+	}
+
+	if err := m.DataClassification.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("dataClassification")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("dataClassification")
+		}
+		return err
+	}
+
+	return nil
+}
+
 func (m *ProjectDetails) validateName(formats strfmt.Registry) error {
 
 	if err := validate.Required("name", "body", m.Name); err != nil {
@@ -55,8 +119,66 @@ func (m *ProjectDetails) validateName(formats strfmt.Registry) error {
 	return nil
 }
 
-// ContextValidate validates this project details based on context it is used
+func (m *ProjectDetails) validateProductType(formats strfmt.Registry) error {
+	if swag.IsZero(m.ProductType) { // not required
+		return nil
+	}
+
+	if err := m.ProductType.Validate(formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("productType")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("productType")
+		}
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this project details based on the context it is used
 func (m *ProjectDetails) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateDataClassification(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.contextValidateProductType(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ProjectDetails) contextValidateDataClassification(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.DataClassification.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("dataClassification")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("dataClassification")
+		}
+		return err
+	}
+
+	return nil
+}
+
+func (m *ProjectDetails) contextValidateProductType(ctx context.Context, formats strfmt.Registry) error {
+
+	if err := m.ProductType.ContextValidate(ctx, formats); err != nil {
+		if ve, ok := err.(*errors.Validation); ok {
+			return ve.ValidateName("productType")
+		} else if ce, ok := err.(*errors.CompositeError); ok {
+			return ce.ValidateName("productType")
+		}
+		return err
+	}
+
 	return nil
 }
 
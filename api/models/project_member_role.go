@@ -0,0 +1,78 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// ProjectMemberRole Owners are responsible for the project, and can manage its members and delete it. Members can contribute to its plans.
+//
+// swagger:model projectMemberRole
+type ProjectMemberRole string
+
+func NewProjectMemberRole(value ProjectMemberRole) *ProjectMemberRole {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated ProjectMemberRole.
+func (m ProjectMemberRole) Pointer() *ProjectMemberRole {
+	return &m
+}
+
+const (
+
+	// ProjectMemberRoleOwner captures enum value "owner"
+	ProjectMemberRoleOwner ProjectMemberRole = "owner"
+
+	// ProjectMemberRoleMember captures enum value "member"
+	ProjectMemberRoleMember ProjectMemberRole = "member"
+)
+
+// for schema
+var projectMemberRoleEnum []interface{}
+
+func init() {
+	var res []ProjectMemberRole
+	if err := json.Unmarshal([]byte(`["owner","member"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		projectMemberRoleEnum = append(projectMemberRoleEnum, v)
+	}
+}
+
+func (m ProjectMemberRole) validateProjectMemberRoleEnum(path, location string, value ProjectMemberRole) error {
+	if err := validate.EnumCase(path, location, value, projectMemberRoleEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this project member role
+func (m ProjectMemberRole) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateProjectMemberRoleEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this project member role based on context it is used
+func (m ProjectMemberRole) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
@@ -0,0 +1,164 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReviewComment review comment
+//
+// swagger:model reviewComment
+type ReviewComment struct {
+
+	// author
+	// Required: true
+	Author *string `json:"author"`
+
+	// author Uid
+	// Required: true
+	AuthorUID *string `json:"authorUid"`
+
+	// The decision made with the comment, if any
+	// Enum: [approved changes-requested]
+	Decision string `json:"decision,omitempty"`
+
+	// text
+	Text string `json:"text,omitempty"`
+
+	// time
+	// Required: true
+	// Format: date-time
+	Time *strfmt.DateTime `json:"time"`
+}
+
+// Validate validates this review comment
+func (m *ReviewComment) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateAuthor(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateAuthorUID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDecision(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateTime(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReviewComment) validateAuthor(formats strfmt.Registry) error {
+
+	if err := validate.Required("author", "body", m.Author); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReviewComment) validateAuthorUID(formats strfmt.Registry) error {
+
+	if err := validate.Required("authorUid", "body", m.AuthorUID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+var reviewCommentTypeDecisionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["approved","changes-requested"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		reviewCommentTypeDecisionPropEnum = append(reviewCommentTypeDecisionPropEnum, v)
+	}
+}
+
+const (
+
+	// ReviewCommentDecisionApproved captures enum value "approved"
+	ReviewCommentDecisionApproved string = "approved"
+
+	// ReviewCommentDecisionChangesDashRequested captures enum value "changes-requested"
+	ReviewCommentDecisionChangesDashRequested string = "changes-requested"
+)
+
+// prop value enum
+func (m *ReviewComment) validateDecisionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, reviewCommentTypeDecisionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (m *ReviewComment) validateDecision(formats strfmt.Registry) error {
+	if swag.IsZero(m.Decision) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := m.validateDecisionEnum("decision", "body", m.Decision); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReviewComment) validateTime(formats strfmt.Registry) error {
+
+	if err := validate.Required("time", "body", m.Time); err != nil {
+		return err
+	}
+
+	if err := validate.FormatOf("time", "body", "date-time", m.Time.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this review comment based on context it is used
+func (m *ReviewComment) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReviewComment) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReviewComment) UnmarshalBinary(b []byte) error {
+	var res ReviewComment
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,198 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// ReviewSummary review summary
+//
+// swagger:model reviewSummary
+type ReviewSummary struct {
+
+	// Who committed the revision
+	Author string `json:"author,omitempty"`
+
+	// committed
+	// Format: date-time
+	Committed strfmt.DateTime `json:"committed,omitempty"`
+
+	// The plan's date
+	// Required: true
+	Date *string `json:"date"`
+
+	// plan Id
+	// Required: true
+	PlanID *string `json:"planId"`
+
+	// The names of the plan's projects
+	// Required: true
+	Projects *string `json:"projects"`
+
+	// review
+	// Required: true
+	Review *PlanReview `json:"review"`
+
+	// revision Id
+	// Required: true
+	RevisionID *string `json:"revisionId"`
+}
+
+// Validate validates this review summary
+func (m *ReviewSummary) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateCommitted(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateDate(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validatePlanID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateProjects(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateReview(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateRevisionID(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReviewSummary) validateCommitted(formats strfmt.Registry) error {
+	if swag.IsZero(m.Committed) { // not required
+		return nil
+	}
+
+	if err := validate.FormatOf("committed", "body", "date-time", m.Committed.String(), formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReviewSummary) validateDate(formats strfmt.Registry) error {
+
+	if err := validate.Required("date", "body", m.Date); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReviewSummary) validatePlanID(formats strfmt.Registry) error {
+
+	if err := validate.Required("planId", "body", m.PlanID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReviewSummary) validateProjects(formats strfmt.Registry) error {
+
+	if err := validate.Required("projects", "body", m.Projects); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+func (m *ReviewSummary) validateReview(formats strfmt.Registry) error {
+
+	if err := validate.Required("review", "body", m.Review); err != nil {
+		return err
+	}
+
+	if m.Review != nil {
+		if err := m.Review.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("review")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("review")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+func (m *ReviewSummary) validateRevisionID(formats strfmt.Registry) error {
+
+	if err := validate.Required("revisionId", "body", m.RevisionID); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validate this review summary based on the context it is used
+func (m *ReviewSummary) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.contextValidateReview(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *ReviewSummary) contextValidateReview(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Review != nil {
+		if err := m.Review.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("review")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("review")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *ReviewSummary) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *ReviewSummary) UnmarshalBinary(b []byte) error {
+	var res ReviewSummary
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
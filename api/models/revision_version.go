@@ -19,6 +19,9 @@ import (
 // swagger:model revisionVersion
 type RevisionVersion struct {
 
+	// The hash of this revision, covering its content and the hash of the previous revision. Empty for revisions created before hashing was introduced.
+	Hash string `json:"hash,omitempty"`
+
 	// Plan this version is associated with
 	PlanID string `json:"planId,omitempty"`
 
@@ -26,6 +29,9 @@ type RevisionVersion struct {
 	// Required: true
 	RevID *string `json:"revId"`
 
+	// review
+	Review *PlanReview `json:"review,omitempty"`
+
 	// version
 	// Required: true
 	Version *Version `json:"version"`
@@ -39,6 +45,10 @@ func (m *RevisionVersion) Validate(formats strfmt.Registry) error {
 		res = append(res, err)
 	}
 
+	if err := m.validateReview(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.validateVersion(formats); err != nil {
 		res = append(res, err)
 	}
@@ -58,6 +68,25 @@ func (m *RevisionVersion) validateRevID(formats strfmt.Registry) error {
 	return nil
 }
 
+func (m *RevisionVersion) validateReview(formats strfmt.Registry) error {
+	if swag.IsZero(m.Review) { // not required
+		return nil
+	}
+
+	if m.Review != nil {
+		if err := m.Review.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("review")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("review")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *RevisionVersion) validateVersion(formats strfmt.Registry) error {
 
 	if err := validate.Required("version", "body", m.Version); err != nil {
@@ -82,6 +111,10 @@ func (m *RevisionVersion) validateVersion(formats strfmt.Registry) error {
 func (m *RevisionVersion) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := m.contextValidateReview(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := m.contextValidateVersion(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -92,6 +125,22 @@ func (m *RevisionVersion) ContextValidate(ctx context.Context, formats strfmt.Re
 	return nil
 }
 
+func (m *RevisionVersion) contextValidateReview(ctx context.Context, formats strfmt.Registry) error {
+
+	if m.Review != nil {
+		if err := m.Review.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("review")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("review")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (m *RevisionVersion) contextValidateVersion(ctx context.Context, formats strfmt.Registry) error {
 
 	if m.Version != nil {
@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"encoding/json"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// Role A role grants a set of permissions:
+//   - viewer: view projects, plans and practices
+//   - projectContributor: as viewer, and create and update projects and plans
+//   - projectOwner: as projectContributor, and delete projects and plans
+//   - securityAdmin: everything, including managing users
+//   - auditor: as viewer, and view audit information
+//   - securityReviewer: as viewer, and review committed plans
+//
+// swagger:model role
+type Role string
+
+func NewRole(value Role) *Role {
+	return &value
+}
+
+// Pointer returns a pointer to a freshly-allocated Role.
+func (m Role) Pointer() *Role {
+	return &m
+}
+
+const (
+
+	// RoleViewer captures enum value "viewer"
+	RoleViewer Role = "viewer"
+
+	// RoleProjectContributor captures enum value "projectContributor"
+	RoleProjectContributor Role = "projectContributor"
+
+	// RoleProjectOwner captures enum value "projectOwner"
+	RoleProjectOwner Role = "projectOwner"
+
+	// RoleSecurityAdmin captures enum value "securityAdmin"
+	RoleSecurityAdmin Role = "securityAdmin"
+
+	// RoleAuditor captures enum value "auditor"
+	RoleAuditor Role = "auditor"
+
+	// RoleSecurityReviewer captures enum value "securityReviewer"
+	RoleSecurityReviewer Role = "securityReviewer"
+)
+
+// for schema
+var roleEnum []interface{}
+
+func init() {
+	var res []Role
+	if err := json.Unmarshal([]byte(`["viewer","projectContributor","projectOwner","securityAdmin","auditor","securityReviewer"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		roleEnum = append(roleEnum, v)
+	}
+}
+
+func (m Role) validateRoleEnum(path, location string, value Role) error {
+	if err := validate.EnumCase(path, location, value, roleEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+// Validate validates this role
+func (m Role) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	// value enum
+	if err := m.validateRoleEnum("", "body", m); err != nil {
+		return err
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// ContextValidate validates this role based on context it is used
+func (m Role) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
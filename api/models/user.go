@@ -23,7 +23,15 @@ type User struct {
 
 	// Additional data
 	ManuallyAuthorized bool
-	CreationAlertSent  bool // whether a notification has been sent about this user requesting access or logging in
+	CreationAlertSent  bool  // whether a notification has been sent about this user requesting access or logging in
+	Roles              Roles // roles explicitly granted to this user; if empty, the roles from access rules or the deployment's default roles apply
+	RuleAccess         bool  // whether an access rule grants this user access
+	RuleRoles          Roles // roles granted by the access rules this user matches
+	Deactivated        bool  // deactivated users don't have access, even if their provider or an access rule would grant it
+
+	// Set when the user authenticated with an API token rather than an ID token
+	APITokenID  string
+	TokenScopes []string // the permissions the token may exercise, on top of the restrictions of the user's roles
 
 	LookedUp  bool // Whether a lookup has been made for this user yet. If true, LocalData==nil means this user has no local data
 	LocalData *LocalUserData
@@ -36,10 +44,16 @@ type User struct {
 type LocalUserData struct {
 	ManuallyAuthorized bool
 	CreationAlertSent  bool
+	Roles              Roles
 
 	// Tokens for users authenticated by SAML IDPs don't populate these fields, so we need to manually capture them from the token for use when we don't have a token to hand (admin operations)
-	Name  string
-	Email string
+	Name     string
+	Email    string
+	Provider string
+
+	// Set for users provisioned by an identity provider over SCIM
+	ExternalID  string
+	Deactivated bool
 }
 
 func (u *User) String() string {
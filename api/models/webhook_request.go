@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"strconv"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookRequest webhook request
+//
+// swagger:model webhookRequest
+type WebhookRequest struct {
+
+	// description
+	Description string `json:"description,omitempty"`
+
+	// The event types to send, e.g. plan.committed, project.* or *
+	// Required: true
+	// Min Items: 1
+	Events []string `json:"events"`
+
+	// An http or https URL to POST events to
+	// Required: true
+	// Min Length: 1
+	URL *string `json:"url"`
+}
+
+// Validate validates this webhook request
+func (m *WebhookRequest) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateEvents(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if err := m.validateURL(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookRequest) validateEvents(formats strfmt.Registry) error {
+
+	if err := validate.Required("events", "body", m.Events); err != nil {
+		return err
+	}
+
+	iEventsSize := int64(len(m.Events))
+
+	if err := validate.MinItems("events", "body", iEventsSize, 1); err != nil {
+		return err
+	}
+
+	for i := 0; i < len(m.Events); i++ {
+
+		if err := validate.MinLength("events"+"."+strconv.Itoa(i), "body", m.Events[i], 1); err != nil {
+			return err
+		}
+
+	}
+
+	return nil
+}
+
+func (m *WebhookRequest) validateURL(formats strfmt.Registry) error {
+
+	if err := validate.Required("url", "body", m.URL); err != nil {
+		return err
+	}
+
+	if err := validate.MinLength("url", "body", *m.URL, 1); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this webhook request based on context it is used
+func (m *WebhookRequest) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WebhookRequest) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WebhookRequest) UnmarshalBinary(b []byte) error {
+	var res WebhookRequest
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package models
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// WebhookTestResult webhook test result
+//
+// swagger:model webhookTestResult
+type WebhookTestResult struct {
+
+	// delivered
+	// Required: true
+	Delivered *bool `json:"delivered"`
+
+	// error
+	Error string `json:"error,omitempty"`
+
+	// The HTTP status of the webhook's response, if it responded
+	StatusCode int64 `json:"statusCode,omitempty"`
+}
+
+// Validate validates this webhook test result
+func (m *WebhookTestResult) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := m.validateDelivered(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (m *WebhookTestResult) validateDelivered(formats strfmt.Registry) error {
+
+	if err := validate.Required("delivered", "body", m.Delivered); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this webhook test result based on context it is used
+func (m *WebhookTestResult) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (m *WebhookTestResult) MarshalBinary() ([]byte, error) {
+	if m == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(m)
+}
+
+// UnmarshalBinary interface implementation
+func (m *WebhookTestResult) UnmarshalBinary(b []byte) error {
+	var res WebhookTestResult
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*m = res
+	return nil
+}
@@ -0,0 +1,319 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"mime"
+	"net"
+	"net/http"
+	"net/smtp"
+	"strconv"
+	"strings"
+	"text/template"
+	"time"
+)
+
+// NotificationChannelConfig configures a notification channel.
+// Templates are Go text/templates executed with a Notification; the json function encodes a value as JSON.
+type NotificationChannelConfig struct {
+	Name     string
+	Type     string            // slack, teams, webhook or email
+	URL      string            // the webhook URL, for slack, teams and webhook channels
+	URLName  string            // the name of the database config entry holding the URL, if it isn't set directly
+	Headers  map[string]string // extra HTTP headers for webhook channels, e.g. for authentication
+	SMTPHost string            // the mail server, for email channels
+	SMTPPort int               // defaults to 587
+	Username string            // for authenticating to the mail server, if required
+	Password string
+	// PasswordName is the name of the database config entry holding the password, if it isn't set directly
+	PasswordName string
+	From         string
+	To           []string
+	Template     string // replaces the default message template (or email body template)
+	Subject      string // replaces the default email subject template
+}
+
+// Default message templates
+const (
+	// see https://api.slack.com/tools/block-kit-builder for the format
+	defaultSlackTemplate = `{
+	"blocks": [
+		{
+			"type": "section",
+			"text": {
+				"type": "mrkdwn",
+				"text": {{json (printf "%s:\n*%s*" .Title .Subject)}}
+			}
+		}{{if .Fields}},
+		{
+			"type": "section",
+			"fields": [
+				{{- range $i, $f := .Fields}}{{if $i}},{{end}}
+				{
+					"type": "mrkdwn",
+					"text": {{json (printf "*%s:*\n%s" $f.Name $f.Value)}}
+				}
+				{{- end}}
+			]{{if .ImageURL}},
+			"accessory": {
+				"type": "image",
+				"image_url": {{json .ImageURL}},
+				"alt_text": "image"
+			}
+			{{- end}}
+		}{{end}}{{if .Link}},
+		{
+			"type": "section",
+			"text": {
+				"type": "mrkdwn",
+				"text": {{json .Link}}
+			}
+		}{{end}}
+	]
+}`
+	// see https://docs.microsoft.com/en-us/outlook/actionable-messages/message-card-reference
+	defaultTeamsTemplate = `{
+	"@type": "MessageCard",
+	"@context": "https://schema.org/extensions",
+	"summary": {{json .Title}},
+	"title": {{json .Title}},
+	"sections": [
+		{
+			"activityTitle": {{json .Subject}},
+			{{- if .ImageURL}}
+			"activityImage": {{json .ImageURL}},
+			{{- end}}
+			"facts": [
+				{{- range $i, $f := .Fields}}{{if $i}},{{end}}
+				{"name": {{json $f.Name}}, "value": {{json $f.Value}}}
+				{{- end}}
+			]
+		}
+	]{{if .Link}},
+	"potentialAction": [
+		{"@type": "OpenUri", "name": "Open", "targets": [{"os": "default", "uri": {{json .Link}}}]}
+	]{{end}}
+}`
+	defaultWebhookTemplate      = `{{json .}}`
+	defaultEmailSubjectTemplate = `[BeSec] {{.Title}}{{if .Subject}}: {{.Subject}}{{end}}`
+	defaultEmailTemplate        = `{{.Title}}{{if .Subject}}: {{.Subject}}{{end}}
+{{range .Fields}}
+{{.Name}}: {{.Value}}{{end}}
+{{if .Link}}
+{{.Link}}
+{{end}}`
+)
+
+func parseNotificationTemplate(channel string, text string) (*template.Template, error) {
+	t, err := template.New(channel).Funcs(template.FuncMap{
+		"json": func(v interface{}) (string, error) {
+			b, err := json.Marshal(v)
+			return string(b), err
+		},
+	}).Parse(text)
+	if err != nil {
+		return nil, fmt.Errorf("invalid template for notification channel %v: %v", channel, err)
+	}
+	return t, nil
+}
+
+func renderNotification(t *template.Template, n *Notification) ([]byte, error) {
+	buf := &bytes.Buffer{}
+	if err := t.Execute(buf, n); err != nil {
+		return nil, fmt.Errorf("error formatting notification: %v", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// NotificationText formats the notification as plain text, as in the body of an email
+func NotificationText(n *Notification) (string, error) {
+	t, err := parseNotificationTemplate("text", defaultEmailTemplate)
+	if err != nil {
+		return "", err
+	}
+	text, err := renderNotification(t, n)
+	return string(text), err
+}
+
+// NewNotifier creates a notifier from its configuration
+func NewNotifier(c NotificationChannelConfig) (Notifier, error) {
+	if c.Name == "" {
+		return nil, fmt.Errorf("notification channels must have a name")
+	}
+	switch c.Type {
+	case "slack":
+		return NewSlackNotifier(c.Name, c.URL, c.Template)
+	case "teams":
+		return NewTeamsNotifier(c.Name, c.URL, c.Template)
+	case "webhook":
+		return NewWebhookNotifier(c.Name, c.URL, c.Headers, c.Template)
+	case "email":
+		return NewEmailNotifier(c)
+	default:
+		return nil, fmt.Errorf("notification channel %v has unknown type '%v', it must be slack, teams, webhook or email", c.Name, c.Type)
+	}
+}
+
+// webhookNotifier posts a JSON message rendered from a template to a URL
+type webhookNotifier struct {
+	name    string
+	url     string
+	headers map[string]string
+	tmpl    *template.Template
+	client  *http.Client
+}
+
+func newWebhookNotifier(name string, url string, headers map[string]string, tmpl string, defaultTmpl string) (Notifier, error) {
+	if url == "" {
+		return nil, fmt.Errorf("notification channel %v doesn't have a URL", name)
+	}
+	if tmpl == "" {
+		tmpl = defaultTmpl
+	}
+	t, err := parseNotificationTemplate(name, tmpl)
+	if err != nil {
+		return nil, err
+	}
+	return &webhookNotifier{name: name, url: url, headers: headers, tmpl: t, client: &http.Client{Timeout: 30 * time.Second}}, nil
+}
+
+// NewSlackNotifier creates a notifier that posts Block Kit messages to a Slack incoming webhook.
+// If tmpl is empty the default template is used.
+func NewSlackNotifier(name string, url string, tmpl string) (Notifier, error) {
+	return newWebhookNotifier(name, url, nil, tmpl, defaultSlackTemplate)
+}
+
+// NewTeamsNotifier creates a notifier that posts message cards to a Microsoft Teams incoming webhook.
+// If tmpl is empty the default template is used.
+func NewTeamsNotifier(name string, url string, tmpl string) (Notifier, error) {
+	return newWebhookNotifier(name, url, nil, tmpl, defaultTeamsTemplate)
+}
+
+// NewWebhookNotifier creates a notifier that posts notifications to a URL, by default as the JSON encoding of the Notification
+func NewWebhookNotifier(name string, url string, headers map[string]string, tmpl string) (Notifier, error) {
+	return newWebhookNotifier(name, url, headers, tmpl, defaultWebhookTemplate)
+}
+
+func (w *webhookNotifier) Name() string {
+	return w.name
+}
+
+func (w *webhookNotifier) Notify(ctx context.Context, n *Notification) error {
+	body, err := renderNotification(w.tmpl, n)
+	if err != nil {
+		return err
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.url, bytes.NewReader(body))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+	for k, v := range w.headers {
+		req.Header.Set(k, v)
+	}
+	resp, err := w.client.Do(req)
+	if err != nil {
+		return fmt.Errorf("failed to post notification: %v", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := ioutil.ReadAll(resp.Body)
+		return fmt.Errorf("got error response %v: %s", resp.StatusCode, respBody)
+	}
+	return nil
+}
+
+// emailNotifier sends notifications as plain text emails over SMTP
+type emailNotifier struct {
+	name     string
+	addr     string
+	auth     smtp.Auth
+	from     string
+	to       []string // may be empty for a channel that's only used for subscriptions
+	subject  *template.Template
+	body     *template.Template
+	sendMail func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+// NewEmailNotifier creates a notifier that sends emails using the SMTP settings in the config
+func NewEmailNotifier(c NotificationChannelConfig) (Notifier, error) {
+	if c.SMTPHost == "" || c.From == "" {
+		return nil, fmt.Errorf("email notification channel %v needs an SMTP host and a from address", c.Name)
+	}
+	port := c.SMTPPort
+	if port == 0 {
+		port = 587
+	}
+	subject, body := c.Subject, c.Template
+	if subject == "" {
+		subject = defaultEmailSubjectTemplate
+	}
+	if body == "" {
+		body = defaultEmailTemplate
+	}
+	st, err := parseNotificationTemplate(c.Name, subject)
+	if err != nil {
+		return nil, err
+	}
+	bt, err := parseNotificationTemplate(c.Name, body)
+	if err != nil {
+		return nil, err
+	}
+
+	e := &emailNotifier{
+		name:     c.Name,
+		addr:     net.JoinHostPort(c.SMTPHost, strconv.Itoa(port)),
+		from:     c.From,
+		to:       c.To,
+		subject:  st,
+		body:     bt,
+		sendMail: smtp.SendMail,
+	}
+	if c.Username != "" {
+		e.auth = smtp.PlainAuth("", c.Username, c.Password, c.SMTPHost)
+	}
+	return e, nil
+}
+
+func (e *emailNotifier) Name() string {
+	return e.name
+}
+
+func (e *emailNotifier) Notify(ctx context.Context, n *Notification) error {
+	subject, err := renderNotification(e.subject, n)
+	if err != nil {
+		return err
+	}
+	body, err := renderNotification(e.body, n)
+	if err != nil {
+		return err
+	}
+	to := e.to
+	if len(n.Recipients) > 0 {
+		to = n.Recipients
+	}
+	if len(to) == 0 {
+		return fmt.Errorf("email notification channel %v doesn't have any addresses to send to", e.name)
+	}
+	return e.sendMail(e.addr, e.auth, e.from, to, e.message(to, string(subject), body, n.Time))
+}
+
+// message formats an email, with the headers protected against injection through the subject
+func (e *emailNotifier) message(to []string, subject string, body []byte, t time.Time) []byte {
+	subject = strings.Join(strings.Fields(subject), " ")
+	if t.IsZero() {
+		t = time.Now()
+	}
+	msg := &bytes.Buffer{}
+	fmt.Fprintf(msg, "From: %s\r\n", e.from)
+	fmt.Fprintf(msg, "To: %s\r\n", strings.Join(to, ", "))
+	fmt.Fprintf(msg, "Subject: %s\r\n", mime.QEncoding.Encode("utf-8", subject))
+	fmt.Fprintf(msg, "Date: %s\r\n", t.Format(time.RFC1123Z))
+	msg.WriteString("MIME-Version: 1.0\r\n")
+	msg.WriteString("Content-Type: text/plain; charset=UTF-8\r\n\r\n")
+	msg.WriteString(strings.ReplaceAll(string(body), "\n", "\r\n"))
+	return msg.Bytes()
+}
@@ -0,0 +1,467 @@
+package api
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/store"
+)
+
+// Notification is a message about something that happened, which is delivered to the channels its event is routed to
+type Notification struct {
+	Event    string              `json:"event"`   // the type of event, e.g. user.first-signin
+	Title    string              `json:"title"`   // what happened
+	Subject  string              `json:"subject"` // who or what it happened to
+	Fields   []NotificationField `json:"fields,omitempty"`
+	ImageURL string              `json:"imageUrl,omitempty"`
+	Link     string              `json:"link,omitempty"`
+	Time     time.Time           `json:"time"`
+	Projects []string            `json:"projects,omitempty"` // the IDs of the projects affected; users subscribed to them are notified
+	Data     interface{}         `json:"data,omitempty"`     // the change, for webhooks; e.g. the plan that was committed
+	// Recipients replaces the addresses configured for an email channel, for notifications sent to subscribers
+	Recipients []string `json:"recipients,omitempty"`
+	// Personal lists email addresses to notify through the subscriptions channel, as well as the subscribers
+	Personal []string `json:"-"`
+	Key      string   `json:"-"` // identifies duplicates of the notification; if empty, notifications with the same content are duplicates
+	UID      string   `json:"-"` // the user the notification is about, if any; delivery is recorded in their local data
+}
+
+// NotificationField is a named detail of a notification
+type NotificationField struct {
+	Name  string `json:"name"`
+	Value string `json:"value"`
+}
+
+// contentKey identifies notifications with the same content
+func (n *Notification) contentKey() string {
+	parts := []string{n.Event, n.Title, n.Subject, n.UID}
+	for _, f := range n.Fields {
+		parts = append(parts, f.Name+"="+f.Value)
+	}
+	return strings.Join(parts, "\x00")
+}
+
+// Notifier delivers notifications to a channel, such as a Slack webhook or a mailing list
+type Notifier interface {
+	// Name identifies the channel in the routing configuration
+	Name() string
+	// Notify delivers the notification
+	Notify(ctx context.Context, n *Notification) error
+}
+
+// NotificationRoute sends notifications for the matching events to the named channels
+type NotificationRoute struct {
+	Events   []string // event types; "*" matches every event, and e.g. "user.*" every user event
+	Channels []string
+}
+
+func (r *NotificationRoute) matches(event string) bool {
+	for _, e := range r.Events {
+		if e == "*" || e == event || (strings.HasSuffix(e, ".*") && strings.HasPrefix(event, strings.TrimSuffix(e, "*"))) {
+			return true
+		}
+	}
+	return false
+}
+
+// NotificationRouter decides which channels each notification is delivered to
+type NotificationRouter struct {
+	channels      map[string]Notifier
+	order         []string // channel names in the order they were configured
+	routes        []NotificationRoute
+	subscriptions string // the email channel that notifications are sent to subscribers through, if any
+}
+
+// NewNotificationRouter validates the routes against the channels. If there are no routes, every event goes to every channel
+// apart from the subscriptions channel. If subscriptions names an email channel, users can subscribe to projects' events
+// and are sent their notifications through it; it can be empty to disable subscriptions.
+func NewNotificationRouter(channels []Notifier, routes []NotificationRoute, subscriptions string) (*NotificationRouter, error) {
+	r := &NotificationRouter{channels: map[string]Notifier{}, routes: routes, subscriptions: subscriptions}
+	for _, c := range channels {
+		if _, dup := r.channels[c.Name()]; dup {
+			return nil, fmt.Errorf("there is more than one notification channel named %v", c.Name())
+		}
+		if strings.HasPrefix(c.Name(), webhookChannelPrefix) {
+			return nil, fmt.Errorf("notification channel names can't start with %v", webhookChannelPrefix)
+		}
+		r.channels[c.Name()] = c
+		r.order = append(r.order, c.Name())
+	}
+	if subscriptions != "" {
+		if _, ok := r.channels[subscriptions].(*emailNotifier); !ok {
+			return nil, fmt.Errorf("the subscriptions channel %v must be an email channel", subscriptions)
+		}
+	}
+	if len(routes) == 0 {
+		all := []string{}
+		for _, name := range r.order {
+			if name != subscriptions {
+				all = append(all, name)
+			}
+		}
+		r.routes = []NotificationRoute{{Events: []string{"*"}, Channels: all}}
+	}
+	for i, route := range r.routes {
+		if len(route.Events) == 0 {
+			return nil, fmt.Errorf("notification route #%d doesn't list any events", i+1)
+		}
+		for _, name := range route.Channels {
+			if _, ok := r.channels[name]; !ok {
+				return nil, fmt.Errorf("notification route #%d refers to unknown channel %v", i+1, name)
+			}
+		}
+	}
+	return r, nil
+}
+
+// Route returns the channels that notifications of the event should be delivered to
+func (r *NotificationRouter) Route(event string) []Notifier {
+	selected := map[string]bool{}
+	for _, route := range r.routes {
+		if route.matches(event) {
+			for _, name := range route.Channels {
+				selected[name] = true
+			}
+		}
+	}
+	notifiers := []Notifier{}
+	for _, name := range r.order {
+		if selected[name] {
+			notifiers = append(notifiers, r.channels[name])
+		}
+	}
+	return notifiers
+}
+
+// Channel returns the named channel, or nil if there isn't one
+func (r *NotificationRouter) Channel(name string) Notifier {
+	return r.channels[name]
+}
+
+// Empty is true if there are no channels to deliver notifications to
+func (r *NotificationRouter) Empty() bool {
+	return len(r.channels) == 0
+}
+
+// Subscriptions returns the channel that notifications are sent to subscribers through, or nil if users can't subscribe
+func (r *NotificationRouter) Subscriptions() Notifier {
+	if r.subscriptions == "" {
+		return nil
+	}
+	return r.channels[r.subscriptions]
+}
+
+// subscribed returns whether the subscription is to the event
+func subscribed(sub *store.Subscription, event string) bool {
+	if len(sub.Events) == 0 {
+		return true
+	}
+	route := NotificationRoute{Events: sub.Events}
+	return route.matches(event)
+}
+
+// Notifications are queued in an outbox in the store, one message per channel, and delivered by NotificationWorker.
+// Failed deliveries are retried with exponential backoff, so nothing is lost if a channel is unavailable or the server restarts.
+const (
+	notificationDedupWindow  = time.Hour        // notifications with the same key aren't sent to a channel more than once in this period
+	notificationPollInterval = 30 * time.Second // how often the worker looks for messages that are due, if it isn't woken sooner
+	notificationLease        = 5 * time.Minute  // how long a worker has to deliver a message before another may try
+	notificationBatch        = 50
+	maxNotificationAttempts  = 12 // over about 15 hours, see notificationBackoff
+	maxNotificationBackoff   = 6 * time.Hour
+	notificationRetention    = 30 * 24 * time.Hour // how long delivered and abandoned messages are kept in the outbox
+)
+
+// errUndeliverable is wrapped by errors delivering a message that retrying won't fix
+var errUndeliverable = errors.New("undeliverable")
+
+// notificationBackoff returns how long to wait before the next delivery attempt, after the given number of failed attempts
+func notificationBackoff(attempts int) time.Duration {
+	d := 30 * time.Second
+	for i := 1; i < attempts && d < maxNotificationBackoff; i++ {
+		d *= 2
+	}
+	if d > maxNotificationBackoff {
+		d = maxNotificationBackoff
+	}
+	return d
+}
+
+// outboxID identifies the outbox message for a notification with the key sent to the channel
+func outboxID(channel string, key string) string {
+	sum := sha256.Sum256([]byte(channel + "\x00" + key))
+	return hex.EncodeToString(sum[:16])
+}
+
+// notify queues the notification for delivery to the channels it is routed to and the users subscribed to it
+func (rt *Runtime) notify(n *Notification) {
+	if rt.Notifications == nil {
+		log.WithField("event", n.Event).Debug("Notifications aren't configured, not sending")
+		return
+	}
+	if err := QueueNotification(context.Background(), rt.Store, rt.Notifications, n); err != nil {
+		log.WithFields(log.Fields{"event": n.Event, "error": err}).Error("Failed to queue notification")
+	}
+
+	select {
+	case rt.notifyWake <- struct{}{}:
+	default: // the worker has already been woken
+	}
+}
+
+// QueueNotification adds the notification to the outbox for each channel it is routed to, each user subscribed to
+// one of its projects or listed in Personal, and each webhook registered for its event. A NotificationWorker delivers them, when it next checks the outbox.
+func QueueNotification(ctx context.Context, st store.Store, router *NotificationRouter, n *Notification) error {
+	logger := log.WithContext(ctx).WithField("event", n.Event)
+
+	if n.Time.IsZero() {
+		n.Time = time.Now().UTC()
+	}
+	key := n.Key
+	if key == "" {
+		key = n.contentKey()
+	}
+
+	var failed error
+	enqueue := func(channel string, key string, n *Notification) {
+		payload, err := json.Marshal(n)
+		if err != nil {
+			failed = fmt.Errorf("error encoding notification: %v", err)
+			return
+		}
+		m := &store.OutboxMessage{
+			ID:          outboxID(channel, key),
+			Key:         key,
+			Channel:     channel,
+			Event:       n.Event,
+			Payload:     string(payload),
+			UID:         n.UID,
+			Status:      store.OutboxPending,
+			NextAttempt: n.Time,
+			Created:     n.Time,
+		}
+		added, err := st.EnqueueNotification(ctx, m, notificationDedupWindow)
+		if err != nil {
+			failed = err
+		} else if !added {
+			logger.WithFields(log.Fields{"channel": channel, "key": key}).Debug("Duplicate notification, not sending")
+		}
+	}
+
+	for _, notifier := range router.Route(n.Event) {
+		enqueue(notifier.Name(), key, n)
+	}
+
+	if subs := router.Subscriptions(); subs != nil {
+		notified := map[string]bool{}
+		notifyPersonally := func(address string) {
+			if address == "" || notified[address] {
+				return
+			}
+			notified[address] = true
+			personal := *n
+			personal.Recipients = []string{address}
+			personal.UID = "" // it's about the project, not the recipient
+			enqueue(subs.Name(), key+"/"+address, &personal)
+		}
+		for _, address := range n.Personal {
+			notifyPersonally(address)
+		}
+		for _, projectID := range n.Projects {
+			subscriptions, err := st.ListProjectSubscriptions(ctx, projectID)
+			if err != nil {
+				failed = err
+				continue
+			}
+			for _, sub := range subscriptions {
+				if !subscribed(sub, n.Event) {
+					continue
+				}
+				active, err := subscriberActive(ctx, st, sub)
+				if err != nil {
+					failed = err
+				} else if active {
+					notifyPersonally(sub.Email)
+				} else {
+					logger.WithFields(log.Fields{"user": sub.UID, "project": projectID}).Debug("Subscriber is deactivated, not sending")
+				}
+			}
+		}
+	}
+
+	webhooks, err := st.ListWebhooks(ctx)
+	if err != nil {
+		return err
+	}
+	for _, w := range webhooks {
+		route := NotificationRoute{Events: w.Events}
+		if route.matches(n.Event) {
+			enqueue(webhookChannel(w.ID), key, n)
+		}
+	}
+	return failed
+}
+
+// NotificationWorker delivers the notifications in the outbox as they become due, until ctx is done
+func NotificationWorker(ctx context.Context, rt *Runtime) {
+	if rt.Notifications == nil {
+		log.Warn("Notifications are disabled, alerts won't be sent")
+		return
+	}
+
+	ticker := time.NewTicker(notificationPollInterval)
+	defer ticker.Stop()
+	for {
+		rt.deliverNotifications(ctx, time.Now().UTC())
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		case <-rt.notifyWake:
+		}
+	}
+}
+
+// deliverNotifications attempts to deliver every outbox message that is due at now
+func (rt *Runtime) deliverNotifications(ctx context.Context, now time.Time) {
+	for {
+		messages, err := rt.Store.ClaimDueNotifications(ctx, now, notificationLease, notificationBatch)
+		if err != nil {
+			log.WithField("error", err).Warn("Failed to retrieve notifications from the outbox")
+			return
+		}
+		for _, m := range messages {
+			rt.deliverNotification(ctx, m, now)
+		}
+		if len(messages) < notificationBatch {
+			return
+		}
+	}
+}
+
+func (rt *Runtime) deliverNotification(ctx context.Context, m *store.OutboxMessage, now time.Time) {
+	logger := log.WithContext(ctx).WithFields(log.Fields{"message": m.ID, "channel": m.Channel, "event": m.Event})
+
+	m.Attempts++
+	var err error
+	if id, ok := webhookID(m.Channel); ok {
+		err = rt.deliverWebhook(ctx, id, m)
+	} else if notifier := rt.Notifications.Channel(m.Channel); notifier != nil {
+		n := new(Notification)
+		if err = json.Unmarshal([]byte(m.Payload), n); err == nil {
+			n.UID = m.UID
+			err = notifier.Notify(ctx, n)
+		}
+	} else {
+		err = fmt.Errorf("%w: channel %v is no longer configured", errUndeliverable, m.Channel)
+	}
+
+	if err == nil {
+		m.Status = store.OutboxDelivered
+		m.Delivered = now
+		m.NextAttempt = time.Time{}
+		m.Expires = now.Add(notificationRetention)
+		m.LastError = ""
+		logger.Debug("Delivered notification")
+		if m.UID != "" {
+			if err = rt.Store.UserCreationAlertSent(ctx, m.UID); err != nil {
+				logger.WithFields(log.Fields{"user": m.UID, "error": err}).Warn("Failed to record successful alert")
+			}
+		}
+	} else {
+		m.LastError = err.Error()
+		if m.Attempts >= maxNotificationAttempts || errors.Is(err, errUndeliverable) {
+			m.Status = store.OutboxFailed
+			m.NextAttempt = time.Time{}
+			m.Expires = now.Add(notificationRetention)
+			logger.WithFields(log.Fields{"attempts": m.Attempts, "error": err}).Error("Giving up on notification")
+		} else {
+			m.NextAttempt = now.Add(notificationBackoff(m.Attempts))
+			logger.WithFields(log.Fields{"attempts": m.Attempts, "retry": m.NextAttempt, "error": err}).Warn("Failed to send notification, will retry")
+		}
+	}
+
+	if err = rt.Store.UpdateNotification(ctx, m); err != nil {
+		logger.WithField("error", err).Error("Failed to update notification in the outbox")
+	}
+}
+
+func outboxMessageModel(m *store.OutboxMessage) *models.OutboxMessage {
+	id, channel, event, status := m.ID, m.Channel, m.Event, m.Status
+	attempts := int64(m.Attempts)
+	created := strfmt.DateTime(m.Created)
+	om := &models.OutboxMessage{
+		ID:        &id,
+		Key:       m.Key,
+		Channel:   &channel,
+		Event:     &event,
+		Status:    &status,
+		Attempts:  &attempts,
+		LastError: m.LastError,
+		Created:   &created,
+	}
+	n := new(Notification)
+	if err := json.Unmarshal([]byte(m.Payload), n); err == nil {
+		om.Title = n.Title
+		om.Subject = n.Subject
+	}
+	if m.Status == store.OutboxPending {
+		next := strfmt.DateTime(m.NextAttempt)
+		om.NextAttempt = &next
+	}
+	if !m.Delivered.IsZero() {
+		delivered := strfmt.DateTime(m.Delivered)
+		om.Delivered = &delivered
+	}
+	return om
+}
+
+// NewListNotificationsHandler creates a handler
+func NewListNotificationsHandler(rt *Runtime) operations.ListNotificationsHandler {
+	return &listNotificationsHandlerImp{rt: rt}
+}
+
+type listNotificationsHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listNotificationsHandlerImp) Handle(params operations.ListNotificationsParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListNotificationsDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+
+	status := ""
+	if params.Status != nil {
+		status = *params.Status
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+	messages, err := h.rt.Store.ListNotifications(params.HTTPRequest.Context(), store.OutboxQuery{Status: status, Limit: limit})
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	payload := make([]*models.OutboxMessage, len(messages))
+	for i, m := range messages {
+		payload[i] = outboxMessageModel(m)
+	}
+	return &operations.ListNotificationsOK{Payload: payload}
+}
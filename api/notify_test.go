@@ -0,0 +1,237 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"reflect"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/store"
+)
+
+type recordingNotifier struct {
+	name string
+	sent []*Notification
+}
+
+func (r *recordingNotifier) Name() string { return r.name }
+
+func (r *recordingNotifier) Notify(ctx context.Context, n *Notification) error {
+	r.sent = append(r.sent, n)
+	return nil
+}
+
+func routedNames(notifiers []Notifier) []string {
+	names := []string{}
+	for _, n := range notifiers {
+		names = append(names, n.Name())
+	}
+	return names
+}
+
+func TestNotificationRouter(t *testing.T) {
+	a, b := &recordingNotifier{name: "a"}, &recordingNotifier{name: "b"}
+
+	all, err := NewNotificationRouter([]Notifier{a, b}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if got := routedNames(all.Route(EventUserFirstSignIn)); !reflect.DeepEqual(got, []string{"a", "b"}) {
+		t.Errorf("without routes, got channels %v", got)
+	}
+
+	routed, err := NewNotificationRouter([]Notifier{a, b}, []NotificationRoute{
+		{Events: []string{"user.*"}, Channels: []string{"b"}},
+		{Events: []string{EventUserAccessRequest}, Channels: []string{"a", "b"}},
+	}, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	cases := map[string][]string{
+		EventUserFirstSignIn:   {"b"},
+		EventUserAccessRequest: {"a", "b"},
+		"plan.commit":          {},
+		"users.x":              {},
+	}
+	for event, want := range cases {
+		if got := routedNames(routed.Route(event)); !reflect.DeepEqual(got, want) {
+			t.Errorf("Route(%v) = %v, want %v", event, got, want)
+		}
+	}
+
+	if _, err = NewNotificationRouter([]Notifier{a}, []NotificationRoute{{Events: []string{"*"}, Channels: []string{"c"}}}, ""); err == nil {
+		t.Error("route to an unknown channel was accepted")
+	}
+	if _, err = NewNotificationRouter([]Notifier{a, a}, nil, ""); err == nil {
+		t.Error("duplicate channel names were accepted")
+	}
+}
+
+func testNotification() *Notification {
+	return &Notification{
+		Event:    EventUserAccessRequest,
+		Title:    `Access "requested"`,
+		Subject:  "Jane\nDoe",
+		Fields:   []NotificationField{{Name: "Email", Value: "jane@example.com"}, {Name: "UID", Value: `a\b`}},
+		ImageURL: "https://example.com/jane.png",
+	}
+}
+
+func TestDefaultTemplatesProduceJSON(t *testing.T) {
+	for _, typ := range []string{"slack", "teams", "webhook"} {
+		n, err := NewNotifier(NotificationChannelConfig{Name: typ, Type: typ, URL: "http://localhost"})
+		if err != nil {
+			t.Fatal(err)
+		}
+		for _, notification := range []*Notification{testNotification(), {Event: "x", Title: "no fields"}} {
+			body, err := renderNotification(n.(*webhookNotifier).tmpl, notification)
+			if err != nil {
+				t.Fatal(err)
+			}
+			if !json.Valid(body) {
+				t.Errorf("%v template produced invalid JSON:\n%s", typ, body)
+			}
+		}
+	}
+}
+
+func TestWebhookNotifier(t *testing.T) {
+	var got map[string]interface{}
+	var auth string
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		got = nil
+		_ = json.Unmarshal(body, &got)
+		auth = r.Header.Get("Authorization")
+	}))
+	defer srv.Close()
+
+	n, err := NewNotifier(NotificationChannelConfig{Name: "hook", Type: "webhook", URL: srv.URL, Headers: map[string]string{"Authorization": "Bearer x"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = n.Notify(context.Background(), testNotification()); err != nil {
+		t.Fatal(err)
+	}
+	if got["event"] != EventUserAccessRequest || got["subject"] != "Jane\nDoe" || auth != "Bearer x" {
+		t.Errorf("webhook received %v with authorization %q", got, auth)
+	}
+
+	custom, err := NewNotifier(NotificationChannelConfig{Name: "custom", Type: "webhook", URL: srv.URL, Template: `{"text": {{json .Title}}}`})
+	if err != nil {
+		t.Fatal(err)
+	}
+	if err = custom.Notify(context.Background(), testNotification()); err != nil {
+		t.Fatal(err)
+	}
+	if !reflect.DeepEqual(got, map[string]interface{}{"text": `Access "requested"`}) {
+		t.Errorf("custom template produced %v", got)
+	}
+
+	failing := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		w.WriteHeader(500)
+	}))
+	defer failing.Close()
+	f, _ := NewNotifier(NotificationChannelConfig{Name: "failing", Type: "slack", URL: failing.URL})
+	if err = f.Notify(context.Background(), testNotification()); err == nil {
+		t.Error("an error response wasn't reported")
+	}
+}
+
+func TestEmailNotifier(t *testing.T) {
+	n, err := NewNotifier(NotificationChannelConfig{Name: "mail", Type: "email", SMTPHost: "smtp.example.com", From: "besec@example.com", To: []string{"a@example.com", "b@example.com"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	var addr string
+	var msg []byte
+	n.(*emailNotifier).sendMail = func(a string, _ smtp.Auth, from string, to []string, m []byte) error {
+		addr, msg = a, m
+		return nil
+	}
+	if err = n.Notify(context.Background(), testNotification()); err != nil {
+		t.Fatal(err)
+	}
+	if addr != "smtp.example.com:587" {
+		t.Errorf("sent to %v", addr)
+	}
+	s := string(msg)
+	if !strings.Contains(s, "Subject: [BeSec] Access \"requested\": Jane Doe\r\n") || !strings.Contains(s, "Email: jane@example.com\r\n") {
+		t.Errorf("unexpected message:\n%v", s)
+	}
+
+	if _, err = NewNotifier(NotificationChannelConfig{Name: "mail", Type: "email", SMTPHost: "smtp.example.com"}); err == nil {
+		t.Error("email channel without addresses was accepted")
+	}
+}
+
+type flakyNotifier struct {
+	recordingNotifier
+	fail bool
+}
+
+func (f *flakyNotifier) Notify(ctx context.Context, n *Notification) error {
+	if f.fail {
+		return fmt.Errorf("unavailable")
+	}
+	return f.recordingNotifier.Notify(ctx, n)
+}
+
+func TestNotificationOutbox(t *testing.T) {
+	channel := &flakyNotifier{recordingNotifier: recordingNotifier{name: "slack"}, fail: true}
+	router, err := NewNotificationRouter([]Notifier{channel}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	st := newMemStore()
+	rt := &Runtime{Store: st, Notifications: router}
+
+	sendAlert(EventUserFirstSignIn, "First sign-in", rt, &models.User{UID: "u1", Name: "Jane"})
+	sendAlert(EventUserFirstSignIn, "First sign-in, again", rt, &models.User{UID: "u1", Name: "Jane"})
+	now := time.Now().UTC()
+	if len(st.messages) != 1 {
+		t.Fatalf("duplicate notification was queued: %v", st.messages)
+	}
+
+	rt.deliverNotifications(context.Background(), now)
+	for _, m := range st.messages {
+		if m.Status != store.OutboxPending || m.Attempts != 1 || m.LastError == "" || m.NextAttempt.Sub(now) != notificationBackoff(1) {
+			t.Errorf("failed delivery wasn't scheduled for retry: %+v", m)
+		}
+	}
+
+	channel.fail = false
+	rt.deliverNotifications(context.Background(), now.Add(notificationBackoff(1)/2))
+	if len(channel.sent) != 0 {
+		t.Error("notification was retried before it was due")
+	}
+	rt.deliverNotifications(context.Background(), now.Add(notificationBackoff(1)))
+	if len(channel.sent) != 1 || channel.sent[0].Title != "First sign-in" || channel.sent[0].UID != "u1" {
+		t.Errorf("notification wasn't delivered on retry: %v", channel.sent)
+	}
+	for _, m := range st.messages {
+		if m.Status != store.OutboxDelivered || !m.NextAttempt.IsZero() || m.Expires.Sub(m.Delivered) != notificationRetention {
+			t.Errorf("delivered notification wasn't finished: %+v", m)
+		}
+	}
+	if !reflect.DeepEqual(st.alerted, []string{"u1"}) {
+		t.Errorf("alert wasn't recorded for the user: %v", st.alerted)
+	}
+}
+
+func TestNotificationBackoff(t *testing.T) {
+	if notificationBackoff(1) != 30*time.Second || notificationBackoff(3) != 2*time.Minute {
+		t.Errorf("unexpected backoff: %v, %v", notificationBackoff(1), notificationBackoff(3))
+	}
+	if notificationBackoff(maxNotificationAttempts) != maxNotificationBackoff {
+		t.Errorf("backoff isn't capped: %v", notificationBackoff(maxNotificationAttempts))
+	}
+}
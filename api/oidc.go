@@ -0,0 +1,118 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/coreos/go-oidc/v3/oidc"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+const (
+	defaultOIDCProvider = "oidc"
+	tokenClockSkew      = time.Minute      // tolerance when checking token expiry
+	oidcRequestTimeout  = 10 * time.Second // timeout for discovery and JWKS requests
+)
+
+// oidcSigningAlgs are the signature algorithms accepted on ID tokens
+var oidcSigningAlgs = []string{oidc.RS256, oidc.RS384, oidc.RS512, oidc.ES256, oidc.ES384, oidc.ES512}
+
+// OIDCVerifier verifies ID tokens issued by a standard OpenID Connect provider
+type OIDCVerifier struct {
+	config   models.OidcConfig
+	provider string
+	verifier *oidc.IDTokenVerifier
+}
+
+// NewOIDCVerifier discovers the configuration of the provider's issuer. Its signing keys are fetched when first
+// needed, and refetched when a token is signed by a key that isn't known yet.
+func NewOIDCVerifier(ctx context.Context, config *models.OidcConfig) (*OIDCVerifier, error) {
+	if config == nil || config.Issuer == nil || config.ClientID == nil {
+		return nil, fmt.Errorf("the OIDC configuration must include an issuer and a client ID")
+	}
+	v := &OIDCVerifier{config: *config, provider: config.ID}
+	if v.provider == "" {
+		v.provider = defaultOIDCProvider
+	}
+	audience := config.Audience
+	if audience == "" {
+		audience = *config.ClientID
+	}
+
+	ctx = oidc.ClientContext(ctx, &http.Client{Timeout: oidcRequestTimeout})
+	provider, err := oidc.NewProvider(ctx, *config.Issuer)
+	if err != nil {
+		return nil, fmt.Errorf("OIDC discovery failed: %v", err)
+	}
+	v.verifier = provider.Verifier(&oidc.Config{
+		ClientID:             audience,
+		SupportedSigningAlgs: oidcSigningAlgs,
+		Now:                  func() time.Time { return time.Now().Add(-tokenClockSkew) },
+	})
+	return v, nil
+}
+
+// VerifyToken verifies the ID token's signature, issuer, audience and validity period, and creates the user it identifies
+func (v *OIDCVerifier) VerifyToken(ctx context.Context, idToken string) (*models.User, error) {
+	logger := log.WithContext(ctx)
+	token, err := v.verifier.Verify(ctx, idToken)
+	if err != nil {
+		logger.WithFields(log.Fields{"error": err}).Info("Error validating ID token")
+		return nil, fmt.Errorf("invalid ID token")
+	}
+	claims := map[string]interface{}{}
+	if err = token.Claims(&claims); err != nil {
+		logger.WithFields(log.Fields{"error": err}).Info("Error decoding ID token claims")
+		return nil, fmt.Errorf("invalid ID token")
+	}
+	return v.newUser(claims)
+}
+
+// newUser creates a User from the verified token's claims, using the configured claims mapping
+func (v *OIDCVerifier) newUser(claims map[string]interface{}) (*models.User, error) {
+	m := models.OidcClaimsMap{}
+	if v.config.Claims != nil {
+		m = *v.config.Claims
+	}
+	claim := func(name, def string) interface{} {
+		if name == "" {
+			name = def
+		}
+		return claims[name]
+	}
+
+	uid, _ := claim(m.UID, "sub").(string)
+	if uid == "" {
+		log.Error("uid missing from validated OIDC ID token")
+		return nil, fmt.Errorf("invalid ID token")
+	}
+	email, _ := claim(m.Email, "email").(string)
+	if email == "" {
+		log.Error("email missing from validated OIDC ID token - does the client request the email scope?")
+		return nil, fmt.Errorf("invalid ID token")
+	}
+	verified, _ := claim(m.EmailVerified, "email_verified").(bool)
+	name, _ := claim(m.Name, "name").(string)
+	picture, _ := claim(m.PictureURL, "picture").(string)
+	var department string
+	if m.Department != "" {
+		department, _ = claims[m.Department].(string)
+	}
+
+	return &models.User{
+			Claims:               claims,
+			UID:                  uid,
+			Provider:             v.provider,
+			DetailsNotInFirebase: true, // Firebase has no record of these users, so their details must be kept in the store
+			Email:                email,
+			EmailVerified:        verified,
+			Name:                 name,
+			PictureURL:           picture,
+			Department:           department,
+		},
+		nil
+}
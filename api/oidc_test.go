@@ -0,0 +1,215 @@
+package api
+
+import (
+	"context"
+	"crypto"
+	"crypto/ecdsa"
+	"crypto/elliptic"
+	"crypto/rand"
+	"crypto/rsa"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/json"
+	"math/big"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// mockIssuer is a minimal OIDC provider, serving discovery and JWKS documents and signing tokens
+type mockIssuer struct {
+	srv        *httptest.Server
+	keys       map[string]crypto.Signer // keys published in the JWKS
+	jwksServed int
+}
+
+func newMockIssuer(t *testing.T) *mockIssuer {
+	m := &mockIssuer{keys: map[string]crypto.Signer{}}
+	mux := http.NewServeMux()
+	mux.HandleFunc("/.well-known/openid-configuration", func(w http.ResponseWriter, r *http.Request) {
+		_ = json.NewEncoder(w).Encode(map[string]string{"issuer": m.srv.URL, "jwks_uri": m.srv.URL + "/jwks"})
+	})
+	mux.HandleFunc("/jwks", func(w http.ResponseWriter, r *http.Request) {
+		m.jwksServed++
+		keys := []map[string]string{}
+		enc := func(b []byte) string { return base64.RawURLEncoding.EncodeToString(b) }
+		for kid, k := range m.keys {
+			switch pub := k.Public().(type) {
+			case *rsa.PublicKey:
+				keys = append(keys, map[string]string{"kty": "RSA", "kid": kid, "use": "sig", "n": enc(pub.N.Bytes()), "e": enc(big.NewInt(int64(pub.E)).Bytes())})
+			case *ecdsa.PublicKey:
+				keys = append(keys, map[string]string{"kty": "EC", "kid": kid, "crv": "P-256", "x": enc(pub.X.Bytes()), "y": enc(pub.Y.Bytes())})
+			}
+		}
+		_ = json.NewEncoder(w).Encode(map[string]interface{}{"keys": keys})
+	})
+	m.srv = httptest.NewServer(mux)
+	t.Cleanup(m.srv.Close)
+	return m
+}
+
+func (m *mockIssuer) addRSAKey(t *testing.T, kid string) {
+	k, err := rsa.GenerateKey(rand.Reader, 2048)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.keys[kid] = k
+}
+
+func (m *mockIssuer) addECKey(t *testing.T, kid string) {
+	k, err := ecdsa.GenerateKey(elliptic.P256(), rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	m.keys[kid] = k
+}
+
+// sign creates a token with the given claims, signed by the key with ID kid
+func (m *mockIssuer) sign(t *testing.T, kid string, claims map[string]interface{}) string {
+	enc := base64.RawURLEncoding
+	alg := "RS256"
+	if _, ok := m.keys[kid].(*ecdsa.PrivateKey); ok {
+		alg = "ES256"
+	}
+	header, _ := json.Marshal(map[string]string{"alg": alg, "kid": kid, "typ": "JWT"})
+	payload, _ := json.Marshal(claims)
+	input := enc.EncodeToString(header) + "." + enc.EncodeToString(payload)
+	digest := sha256.Sum256([]byte(input))
+
+	var sig []byte
+	var err error
+	switch k := m.keys[kid].(type) {
+	case *rsa.PrivateKey:
+		sig, err = rsa.SignPKCS1v15(rand.Reader, k, crypto.SHA256, digest[:])
+	case *ecdsa.PrivateKey:
+		var r, s *big.Int
+		r, s, err = ecdsa.Sign(rand.Reader, k, digest[:])
+		sig = make([]byte, 64)
+		r.FillBytes(sig[:32])
+		s.FillBytes(sig[32:])
+	}
+	if err != nil {
+		t.Fatal(err)
+	}
+	return input + "." + enc.EncodeToString(sig)
+}
+
+func (m *mockIssuer) claims(overrides map[string]interface{}) map[string]interface{} {
+	c := map[string]interface{}{
+		"iss":            m.srv.URL,
+		"aud":            "besec-ui",
+		"sub":            "user-1",
+		"email":          "user@example.com",
+		"email_verified": true,
+		"name":           "Example User",
+		"exp":            time.Now().Add(time.Hour).Unix(),
+		"iat":            time.Now().Unix(),
+	}
+	for k, v := range overrides {
+		c[k] = v
+	}
+	return c
+}
+
+func TestOIDCVerifier(t *testing.T) {
+	issuer := newMockIssuer(t)
+	issuer.addRSAKey(t, "rsa")
+	issuer.addECKey(t, "ec")
+	clientID := "besec-ui"
+	v, err := NewOIDCVerifier(context.Background(), &models.OidcConfig{Issuer: &issuer.srv.URL, ClientID: &clientID})
+	if err != nil {
+		t.Fatalf("NewOIDCVerifier() failed: %v", err)
+	}
+
+	cases := []struct {
+		name    string
+		kid     string
+		claims  map[string]interface{}
+		wantErr bool
+	}{
+		{"valid RS256", "rsa", nil, false},
+		{"valid ES256", "ec", nil, false},
+		{"audience array", "rsa", map[string]interface{}{"aud": []string{"other", "besec-ui"}}, false},
+		{"wrong audience", "rsa", map[string]interface{}{"aud": "other"}, true},
+		{"wrong issuer", "rsa", map[string]interface{}{"iss": "https://evil.example.com"}, true},
+		{"expired", "rsa", map[string]interface{}{"exp": time.Now().Add(-time.Hour).Unix()}, true},
+		{"within clock skew", "rsa", map[string]interface{}{"exp": time.Now().Add(-10 * time.Second).Unix()}, false},
+		{"not yet valid", "rsa", map[string]interface{}{"nbf": time.Now().Add(time.Hour).Unix()}, true},
+		{"no email", "rsa", map[string]interface{}{"email": nil}, true},
+	}
+	for _, c := range cases {
+		u, err := v.VerifyToken(context.Background(), issuer.sign(t, c.kid, issuer.claims(c.claims)))
+		if (err != nil) != c.wantErr {
+			t.Errorf("%v: VerifyToken() error = %v, want error: %v", c.name, err, c.wantErr)
+		} else if err == nil && (u.UID != "user-1" || u.Email != "user@example.com" || !u.EmailVerified || u.Provider != "oidc") {
+			t.Errorf("%v: VerifyToken() returned unexpected user %+v", c.name, u)
+		}
+	}
+
+	// Tampering with the payload invalidates the signature
+	parts := strings.Split(issuer.sign(t, "rsa", issuer.claims(nil)), ".")
+	forged, _ := json.Marshal(issuer.claims(map[string]interface{}{"sub": "admin"}))
+	parts[1] = base64.RawURLEncoding.EncodeToString(forged)
+	if _, err := v.VerifyToken(context.Background(), strings.Join(parts, ".")); err == nil {
+		t.Error("VerifyToken() accepted a token with a modified payload")
+	}
+}
+
+func TestOIDCVerifierKeyRotation(t *testing.T) {
+	issuer := newMockIssuer(t)
+	issuer.addRSAKey(t, "old")
+	clientID := "besec-ui"
+	v, err := NewOIDCVerifier(context.Background(), &models.OidcConfig{Issuer: &issuer.srv.URL, ClientID: &clientID})
+	if err != nil {
+		t.Fatalf("NewOIDCVerifier() failed: %v", err)
+	}
+
+	if _, err := v.VerifyToken(context.Background(), issuer.sign(t, "old", issuer.claims(nil))); err != nil {
+		t.Fatalf("VerifyToken() failed: %v", err)
+	}
+
+	issuer.addRSAKey(t, "new")
+	if _, err := v.VerifyToken(context.Background(), issuer.sign(t, "new", issuer.claims(nil))); err != nil {
+		t.Errorf("VerifyToken() didn't refetch the keys for an unknown key ID: %v", err)
+	}
+	if issuer.jwksServed != 2 {
+		t.Errorf("JWKS fetched %v times, want 2", issuer.jwksServed)
+	}
+}
+
+func TestOIDCClaimsMapping(t *testing.T) {
+	issuer := newMockIssuer(t)
+	issuer.addRSAKey(t, "rsa")
+	clientID := "besec-ui"
+	config := &models.OidcConfig{
+		ID:       "keycloak",
+		Issuer:   &issuer.srv.URL,
+		ClientID: &clientID,
+		Audience: "besec-api",
+		Claims:   &models.OidcClaimsMap{UID: "oid", Email: "upn", Name: "display_name", Department: "dept"},
+	}
+	v, err := NewOIDCVerifier(context.Background(), config)
+	if err != nil {
+		t.Fatalf("NewOIDCVerifier() failed: %v", err)
+	}
+
+	claims := issuer.claims(map[string]interface{}{"aud": "besec-api", "oid": "abc", "upn": "someone@example.com", "display_name": "Some One", "dept": "Security"})
+	u, err := v.VerifyToken(context.Background(), issuer.sign(t, "rsa", claims))
+	if err != nil {
+		t.Fatalf("VerifyToken() failed: %v", err)
+	}
+	want := models.User{UID: "abc", Provider: "keycloak", Email: "someone@example.com", EmailVerified: true, Name: "Some One", Department: "Security", DetailsNotInFirebase: true}
+	if u.UID != want.UID || u.Provider != want.Provider || u.Email != want.Email || u.EmailVerified != want.EmailVerified ||
+		u.Name != want.Name || u.Department != want.Department || u.DetailsNotInFirebase != want.DetailsNotInFirebase {
+		t.Errorf("VerifyToken() = %+v, want %+v", u, want)
+	}
+
+	authConfig := NewExtendedAuthConfig(models.AuthConfig{Flow: models.AuthConfigFlowOidc, Oidc: &models.OidcConfig{ID: "keycloak", Whitelisted: true}})
+	if !authConfig.whitelisted(u.Provider) {
+		t.Error("Users from the whitelisted OIDC provider aren't authorized")
+	}
+}
@@ -0,0 +1,275 @@
+package api
+
+import (
+	"context"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// orgTree is the org unit hierarchy, as stored when it was loaded
+type orgTree struct {
+	units   map[string]*models.OrgUnit
+	parents map[string]string // keyed on unit ID, "" for top-level units
+}
+
+func loadOrgTree(ctx context.Context, rt *Runtime) (*orgTree, error) {
+	units, err := rt.Store.ListOrgUnits(ctx)
+	if err != nil {
+		return nil, err
+	}
+	t := &orgTree{units: map[string]*models.OrgUnit{}, parents: map[string]string{}}
+	for _, u := range units {
+		t.units[u.ID] = u
+		t.parents[u.ID] = u.Attributes.Parent
+	}
+	return t, nil
+}
+
+// within reports whether the unit is root or one of its descendants
+func (t *orgTree) within(id string, root string) bool {
+	if id == root {
+		return true
+	}
+	for _, a := range lib.Ancestors(t.parents, id) {
+		if a == root {
+			return true
+		}
+	}
+	return false
+}
+
+// leads reports whether the user is a lead of the unit or any of its ancestors
+func (t *orgTree) leads(uid string, id string) bool {
+	for _, unit := range append([]string{id}, lib.Ancestors(t.parents, id)...) {
+		if u, ok := t.units[unit]; ok {
+			for _, lead := range u.Attributes.Leads {
+				if lead == uid {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+// depthFirst returns the IDs of the units in the tree, each followed by its descendants, siblings ordered by name
+func (t *orgTree) depthFirst() []string {
+	return lib.DepthFirst(t.parents, func(a, b string) bool { return *t.units[a].Attributes.Name < *t.units[b].Attributes.Name })
+}
+
+// canManageOrgUnit reports whether the user can change the unit and create units below it.
+// Only security admins can manage the top of the tree (the empty ID).
+func (rt *Runtime) canManageOrgUnit(u *models.User, t *orgTree, id string) bool {
+	if rt.Allowed(u, AdminPermission) {
+		return true
+	}
+	return id != "" && t.leads(u.UID, id)
+}
+
+// checkOrgUnit returns a non-zero HTTP status code and a message if the org unit ID is set but doesn't exist
+func checkOrgUnit(ctx context.Context, rt *Runtime, id string) (int, string) {
+	if id == "" {
+		return 0, ""
+	}
+	_, found, err := rt.Store.GetOrgUnit(ctx, id)
+	if err != nil {
+		return 500, "error retrieving org unit"
+	}
+	if !found {
+		return 400, "org unit " + id + " doesn't exist"
+	}
+	return 0, ""
+}
+
+// NewListOrgUnitsHandler creates a handler
+func NewListOrgUnitsHandler(rt *Runtime) operations.ListOrgUnitsHandler {
+	return &listOrgUnitsHandlerImp{rt: rt}
+}
+
+type listOrgUnitsHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listOrgUnitsHandlerImp) Handle(params operations.ListOrgUnitsParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListOrgUnitsDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	units, err := h.rt.Store.ListOrgUnits(params.HTTPRequest.Context())
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	return &operations.ListOrgUnitsOK{Payload: units}
+}
+
+// NewGetOrgUnitHandler creates a handler
+func NewGetOrgUnitHandler(rt *Runtime) operations.GetOrgUnitHandler {
+	return &getOrgUnitHandlerImp{rt: rt}
+}
+
+type getOrgUnitHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *getOrgUnitHandlerImp) Handle(params operations.GetOrgUnitParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.GetOrgUnitDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	unit, found, err := h.rt.Store.GetOrgUnit(params.HTTPRequest.Context(), params.ID)
+	if err != nil {
+		return fail(500, "error retrieving org unit")
+	}
+	if !found {
+		return fail(404, "org unit not found")
+	}
+	return &operations.GetOrgUnitOK{Payload: unit}
+}
+
+// NewCreateOrgUnitHandler creates a handler
+func NewCreateOrgUnitHandler(rt *Runtime) operations.CreateOrgUnitHandler {
+	return &createOrgUnitHandlerImp{rt: rt}
+}
+
+type createOrgUnitHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *createOrgUnitHandlerImp) Handle(params operations.CreateOrgUnitParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.CreateOrgUnitDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	ctx := params.HTTPRequest.Context()
+
+	tree, err := loadOrgTree(ctx, h.rt)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	parent := params.Body.Parent
+	if _, ok := tree.units[parent]; parent != "" && !ok {
+		return fail(400, "parent org unit "+parent+" doesn't exist")
+	}
+	if !h.rt.canManageOrgUnit(principal, tree, parent) {
+		return fail(403, "only security admins and the leads of the parent unit can create org units here")
+	}
+
+	id, err := h.rt.Store.CreateOrgUnit(ctx, params.Body)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	h.rt.audit(params.HTTPRequest, principal, "orgunit.create", id, nil, params.Body)
+	return &operations.CreateOrgUnitCreated{Payload: id}
+}
+
+// NewUpdateOrgUnitHandler creates a handler
+func NewUpdateOrgUnitHandler(rt *Runtime) operations.UpdateOrgUnitHandler {
+	return &updateOrgUnitHandlerImp{rt: rt}
+}
+
+type updateOrgUnitHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *updateOrgUnitHandlerImp) Handle(params operations.UpdateOrgUnitParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.UpdateOrgUnitDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	ctx := params.HTTPRequest.Context()
+
+	tree, err := loadOrgTree(ctx, h.rt)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	orig, ok := tree.units[params.ID]
+	if !ok {
+		return fail(404, "org unit "+params.ID+" doesn't exist")
+	}
+	if !h.rt.canManageOrgUnit(principal, tree, params.ID) {
+		return fail(403, "only security admins and the leads of this unit or the units above it can change it")
+	}
+
+	if parent := params.Body.Parent; parent != orig.Attributes.Parent {
+		if _, ok := tree.units[parent]; parent != "" && !ok {
+			return fail(400, "parent org unit "+parent+" doesn't exist")
+		}
+		if parent != "" && tree.within(parent, params.ID) {
+			return fail(400, "an org unit can't be moved below itself")
+		}
+		if !h.rt.canManageOrgUnit(principal, tree, parent) {
+			return fail(403, "only security admins and the leads of the new parent unit can move org units there")
+		}
+	}
+
+	if err = h.rt.Store.UpdateOrgUnit(ctx, params.ID, params.Body); err != nil {
+		return fail(500, err.Error())
+	}
+	h.rt.audit(params.HTTPRequest, principal, "orgunit.update", params.ID, orig.Attributes, params.Body)
+	return &operations.UpdateOrgUnitOK{}
+}
+
+// NewDeleteOrgUnitHandler creates a handler
+func NewDeleteOrgUnitHandler(rt *Runtime) operations.DeleteOrgUnitHandler {
+	return &deleteOrgUnitHandlerImp{rt: rt}
+}
+
+type deleteOrgUnitHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *deleteOrgUnitHandlerImp) Handle(params operations.DeleteOrgUnitParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.DeleteOrgUnitDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	ctx := params.HTTPRequest.Context()
+
+	tree, err := loadOrgTree(ctx, h.rt)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	unit, ok := tree.units[params.ID]
+	if !ok {
+		return fail(404, "org unit "+params.ID+" doesn't exist")
+	}
+	if !h.rt.canManageOrgUnit(principal, tree, params.ID) {
+		return fail(403, "only security admins and the leads of this unit or the units above it can delete it")
+	}
+
+	for _, parent := range tree.parents {
+		if parent == params.ID {
+			return fail(400, "org units with child units can't be deleted")
+		}
+	}
+	projects, err := h.rt.Store.ListProjects(ctx)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	for _, p := range projects {
+		if p.Attributes.OrgUnit == params.ID {
+			return fail(400, "org units with projects can't be deleted - move project '"+*p.Attributes.Name+"' first")
+		}
+	}
+
+	if err = h.rt.Store.DeleteOrgUnit(ctx, params.ID); err != nil {
+		return fail(500, err.Error())
+	}
+	h.rt.audit(params.HTTPRequest, principal, "orgunit.delete", params.ID, unit.Attributes, nil)
+	return &operations.DeleteOrgUnitNoContent{}
+}
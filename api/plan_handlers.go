@@ -2,7 +2,6 @@ package api
 
 import (
 	"context"
-	"fmt"
 
 	"github.com/go-openapi/runtime/middleware"
 	log "github.com/sirupsen/logrus"
@@ -27,6 +26,10 @@ func (h *getPlanHandlerImp) Handle(params operations.GetPlanParams, principal *m
 		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
 	}
 
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+
 	ctx := params.HTTPRequest.Context()
 	logger := log.WithContext(ctx)
 
@@ -47,15 +50,42 @@ func (h *getPlanHandlerImp) Handle(params operations.GetPlanParams, principal *m
 		return fail(500, "couldn't retrieve revisions for plan")
 	}
 	body := operations.GetPlanOKBody{Plan: plan, LatestRevision: &revisions[len(revisions)-1]}
+	if params.VerifyChain != nil && *params.VerifyChain {
+		revs, head, _, err := h.rt.Store.GetPlanRevisionChain(ctx, params.ID)
+		if err != nil {
+			return fail(500, "couldn't retrieve revisions for plan")
+		}
+		body.Chain = planChainModel(lib.VerifyRevisionChain(revs, head))
+	}
 	return &operations.GetPlanOK{Payload: &body}
 }
 
-func makePlanFromReq(ctx context.Context, rt *Runtime, details *lib.PlanDetails, responses *lib.PlanResponses) (*lib.Plan, int, string) {
+func planChainModel(r lib.ChainReport) *models.PlanChain {
+	revisions := int64(r.Revisions)
+	return &models.PlanChain{
+		Intact:             &r.Intact,
+		Head:               r.Head,
+		Revisions:          &revisions,
+		UnchainedRevisions: int64(r.Unchained),
+		Problems:           r.Problems,
+	}
+}
+
+// makePlanFromReq builds and validates a plan from a request. previous is the responses of the plan's latest revision,
+// or nil for a new plan: its risk acceptances' approvals are carried over to acceptances with the same terms.
+func makePlanFromReq(ctx context.Context, rt *Runtime, details *lib.PlanDetails, responses *lib.PlanResponses, previous *lib.PlanResponses) (*lib.Plan, int, string) {
 	practices, err := rt.GetPractices(ctx, responses.PracticesVersion)
 	if err != nil {
 		return nil, 404, "Couldn't find specified practices version '" + responses.PracticesVersion + "'"
 	}
-	plan := lib.NewPlan(*details, *responses, practices)
+	project, err := projectContext(ctx, rt, details.Projects)
+	if err != nil {
+		return nil, 500, "error retrieving the plan's projects"
+	}
+	// Approved risk acceptances can count towards maturity, so their approvals are settled before it's calculated
+	responses.CarryRiskApprovals(previous)
+	plan := lib.NewPlan(*details, *responses, practices, project)
+	plan.Responses.ClearLinkedIssues() // they're fetched from the issue trackers when the plan is read
 
 	// Now we have practice information for the plan, do validation again
 	// The validation that go-swagger does prior to the handlers doesn't have this contextual information
@@ -82,24 +112,34 @@ func (h *createPlanHandlerImp) Handle(params operations.CreatePlanParams, princi
 		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
 	}
 
-	if params.Body.Details.Committed {
-		ready, issues := params.Body.Responses.ReadyToCommit()
-		if !ready {
-			return fail(500, fmt.Sprintf("cannot commit plan: %v", issues))
-		}
-	}
-
 	ctx := params.HTTPRequest.Context()
 
-	plan, code, msg := makePlanFromReq(ctx, h.rt, params.Body.Details, params.Body.Responses)
+	if code, msg := h.rt.checkContributor(ctx, principal, params.Body.Details.Projects); code != 0 {
+		return fail(code, msg)
+	}
+
+	// a new plan's risk acceptances haven't been approved
+	plan, code, msg := makePlanFromReq(ctx, h.rt, params.Body.Details, params.Body.Responses, nil)
 	if code != 0 {
 		return fail(code, msg)
 	}
+	if plan.Details.Committed {
+		if code, rejection := h.rt.commitRejection(ctx, plan); rejection != nil {
+			r := operations.CreatePlanDefault{}
+			return r.WithStatusCode(code).WithPayload(rejection)
+		}
+	}
 
-	id, revID, err := h.rt.Store.CreatePlan(ctx, plan, principal)
+	var before map[string]map[string]int
+	if plan.Details.Committed {
+		before = h.rt.currentMaturity(ctx, plan.Details.Projects)
+	}
+	id, revID, err := h.rt.Store.CreatePlan(ctx, plan, principal, h.rt.newReview(plan))
 	if err != nil {
 		return fail(500, err.Error())
 	}
+	h.rt.audit(params.HTTPRequest, principal, "plan.create", id, nil, planAuditSummary(revID, plan))
+	h.rt.planRevised(ctx, principal, id, revID, plan, before)
 	response := operations.CreatePlanCreatedBody{PlanID: &id, RevisionID: &revID}
 	return &operations.CreatePlanCreated{Payload: &response}
 }
@@ -114,12 +154,39 @@ type deletePlanHandlerImp struct {
 }
 
 func (h *deletePlanHandlerImp) Handle(params operations.DeletePlanParams, principal *models.User) middleware.Responder {
-	// We don't need to check if the plan exists first: if there are no associated revisions then the delete will fail
-	if err := h.rt.Store.DeletePlan(params.HTTPRequest.Context(), params.ID); err != nil {
+	fail := func(code int, msg string) middleware.Responder {
 		r := operations.DeletePlanDefault{}
-		msg := err.Error()
-		return r.WithStatusCode(500).WithPayload(&models.Error{Message: &msg})
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, DeletePermission) && !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(DeletePermission))
+	}
+
+	ctx := params.HTTPRequest.Context()
+	existing, found, err := h.rt.Store.GetPlan(ctx, params.ID)
+	if err != nil {
+		return fail(500, "error retrieving plan")
 	}
+	if !found {
+		return fail(404, "couldn't find plan "+params.ID)
+	}
+	// The user must manage every project the plan belongs to; projects that no longer exist are ignored
+	for _, id := range existing.Attributes.Projects {
+		p, found, err := h.rt.Store.GetProject(ctx, id)
+		if err != nil {
+			return fail(500, "error retrieving project "+id)
+		}
+		if found && !h.rt.canManage(principal, p) {
+			return fail(403, "only the owners of project "+id+" can delete its plans")
+		}
+	}
+
+	if err := h.rt.Store.DeletePlan(ctx, params.ID); err != nil {
+		return fail(500, err.Error())
+	}
+	h.rt.audit(params.HTTPRequest, principal, "plan.delete", params.ID, existing.Attributes, nil)
+	h.rt.planDeleted(ctx, principal, params.ID, existing.Attributes)
 	return &operations.DeletePlanNoContent{}
 }
 
@@ -141,7 +208,7 @@ func (h *createPlanRevisionHandlerImp) Handle(params operations.CreatePlanRevisi
 	ctx := params.HTTPRequest.Context()
 
 	// Check the plan exists
-	_, found, err := h.rt.Store.GetPlan(ctx, params.ID)
+	existing, found, err := h.rt.Store.GetPlan(ctx, params.ID)
 	if err != nil {
 		return fail(500, "error creating revision for plan "+params.ID)
 	}
@@ -149,22 +216,43 @@ func (h *createPlanRevisionHandlerImp) Handle(params operations.CreatePlanRevisi
 		return fail(404, "couldn't find plan "+params.ID)
 	}
 
-	if params.Body.Details.Committed {
-		ready, issues := params.Body.Responses.ReadyToCommit()
-		if !ready {
-			return fail(500, fmt.Sprintf("cannot commit plan: %v", issues))
-		}
+	// The user must be able to contribute to the projects the plan currently belongs to, and any it is being added to
+	projects := append(append([]string{}, existing.Attributes.Projects...), params.Body.Details.Projects...)
+	if code, msg := h.rt.checkContributor(ctx, principal, projects); code != 0 {
+		return fail(code, msg)
 	}
 
-	plan, code, msg := makePlanFromReq(ctx, h.rt, params.Body.Details, params.Body.Responses)
+	// Risk acceptances keep their approval from the latest revision only if their terms are unchanged
+	revIDs, err := h.rt.Store.ListPlanRevisionIDs(ctx, params.ID)
+	if err != nil || len(revIDs) == 0 {
+		return fail(500, "couldn't retrieve revisions for plan")
+	}
+	previous, found, err := h.rt.Store.GetPlanRevision(ctx, params.ID, revIDs[len(revIDs)-1])
+	if err != nil || !found || previous == nil {
+		return fail(500, "error retrieving plan")
+	}
+	plan, code, msg := makePlanFromReq(ctx, h.rt, params.Body.Details, params.Body.Responses, &previous.Responses)
 	if code != 0 {
 		return fail(code, msg)
 	}
+	if plan.Details.Committed {
+		if code, rejection := h.rt.commitRejection(ctx, plan); rejection != nil {
+			r := operations.CreatePlanRevisionDefault{}
+			return r.WithStatusCode(code).WithPayload(rejection)
+		}
+	}
 
-	revID, err := h.rt.Store.CreatePlanRevision(ctx, params.ID, plan, principal)
+	var before map[string]map[string]int
+	if plan.Details.Committed {
+		// projects the plan is removed from are included, as it no longer counts towards their maturity
+		before = h.rt.currentMaturity(ctx, projects)
+	}
+	revID, err := h.rt.Store.CreatePlanRevision(ctx, params.ID, plan, principal, h.rt.newReview(plan))
 	if err != nil {
 		return fail(500, err.Error())
 	}
+	h.rt.audit(params.HTTPRequest, principal, "plan.revise", params.ID, existing.Attributes, planAuditSummary(revID, plan))
+	h.rt.planRevised(ctx, principal, params.ID, revID, plan, before)
 	return &operations.CreatePlanRevisionOK{Payload: revID}
 }
 
@@ -183,6 +271,10 @@ func (h *getPlanVersionsHandlerImp) Handle(params operations.GetPlanVersionsPara
 		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
 	}
 
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+
 	ctx := params.HTTPRequest.Context()
 	logger := log.WithContext(ctx)
 
@@ -211,6 +303,10 @@ func (h *getPlanRevisionHandlerImp) Handle(params operations.GetPlanRevisionPara
 		r := operations.CreatePlanDefault{}
 		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
 	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
 	p, found, err := h.rt.Store.GetPlanRevision(params.HTTPRequest.Context(), params.ID, params.RevID)
 	if err != nil {
 		return fail(500, "error retrieving plan")
@@ -236,6 +332,10 @@ func (h *getPlanRevisionPracticeResponsesHandlerImp) Handle(params operations.Ge
 		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
 	}
 
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+
 	p, found, err := h.rt.Store.GetPlanRevision(params.HTTPRequest.Context(), params.ID, params.RevID)
 	if err != nil {
 		return fail(500, "error retrieving plan")
@@ -243,5 +343,11 @@ func (h *getPlanRevisionPracticeResponsesHandlerImp) Handle(params operations.Ge
 	if !found {
 		return fail(404, "plan not found")
 	}
+	if params.NextRevision != nil && *params.NextRevision {
+		p.Responses.OfferCompletedTasks()
+	}
+	if params.LinkedIssues != nil && *params.LinkedIssues {
+		h.rt.linkIssues(params.HTTPRequest.Context(), &p.Responses)
+	}
 	return &operations.GetPlanRevisionPracticeResponsesOK{Payload: &p.Responses}
 }
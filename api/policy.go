@@ -0,0 +1,95 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// commitRejection checks a plan that's being committed against the commit policy. It returns a status code and the error
+// to respond with if the plan can't be committed, listing the violations if it breaks the policy, or 0 and nil if it can.
+func (rt *Runtime) commitRejection(ctx context.Context, plan *lib.Plan) (int, *models.Error) {
+	policy, err := rt.Store.GetCommitPolicy(ctx)
+	if err != nil {
+		msg := "error retrieving the commit policy"
+		return 500, &models.Error{Message: &msg}
+	}
+	violations := plan.CommitViolations(*policy, time.Now().UTC().Format("2006-01-02"))
+	if len(violations) == 0 {
+		return 0, nil
+	}
+	messages := make([]string, len(violations))
+	payload := &models.Error{Violations: make([]*lib.PolicyViolation, len(violations))}
+	for i := range violations {
+		messages[i] = violations[i].Message
+		payload.Violations[i] = &violations[i]
+	}
+	msg := fmt.Sprintf("cannot commit plan: %v", messages)
+	payload.Message = &msg
+	return 400, payload
+}
+
+// NewGetCommitPolicyHandler creates a handler
+func NewGetCommitPolicyHandler(rt *Runtime) operations.GetCommitPolicyHandler {
+	return &getCommitPolicyHandlerImp{rt: rt}
+}
+
+type getCommitPolicyHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *getCommitPolicyHandlerImp) Handle(params operations.GetCommitPolicyParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.GetCommitPolicyDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	policy, err := h.rt.Store.GetCommitPolicy(params.HTTPRequest.Context())
+	if err != nil {
+		return fail(500, "error retrieving the commit policy")
+	}
+	return &operations.GetCommitPolicyOK{Payload: policy}
+}
+
+// NewSetCommitPolicyHandler creates a handler
+func NewSetCommitPolicyHandler(rt *Runtime) operations.SetCommitPolicyHandler {
+	return &setCommitPolicyHandlerImp{rt: rt}
+}
+
+type setCommitPolicyHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *setCommitPolicyHandlerImp) Handle(params operations.SetCommitPolicyParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.SetCommitPolicyDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+	ctx := params.HTTPRequest.Context()
+	policy := params.Body
+	if policy.Rules == nil {
+		policy.Rules = []lib.CommitRule{}
+	}
+	before, err := h.rt.Store.GetCommitPolicy(ctx)
+	if err != nil {
+		return fail(500, "error retrieving the commit policy")
+	}
+	if err = h.rt.Store.SetCommitPolicy(ctx, policy); err != nil {
+		return fail(500, "error saving the commit policy")
+	}
+	h.rt.audit(params.HTTPRequest, principal, "policy.update", "commit", before, policy)
+	return &operations.SetCommitPolicyOK{Payload: policy}
+}
@@ -0,0 +1,32 @@
+package api
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/ThalesGroup/besec/lib"
+)
+
+func TestCommitRejection(t *testing.T) {
+	st := newMemStore()
+	rt := &Runtime{Store: st}
+	ctx := context.Background()
+	plan := lib.NewPlan(lib.PlanDetails{Date: "2999-01-01", Projects: []string{"p"}, Committed: true}, lib.PlanResponses{}, []lib.Practice{}, nil)
+
+	if code, rejection := rt.commitRejection(ctx, &plan); code != 0 || rejection != nil {
+		t.Errorf("an empty policy rejected the plan with %v: %+v", code, rejection)
+	}
+
+	st.policy.Rules = []lib.CommitRule{lib.RuleNoFutureDate, lib.RulePriorityTask}
+	code, rejection := rt.commitRejection(ctx, &plan)
+	if code != 400 || rejection == nil {
+		t.Fatalf("expected the plan to be rejected, got %v", code)
+	}
+	if len(rejection.Violations) != 2 || rejection.Violations[0].Rule != lib.RuleNoFutureDate || rejection.Violations[1].Rule != lib.RulePriorityTask {
+		t.Errorf("unexpected violations: %+v", rejection.Violations)
+	}
+	if !strings.Contains(*rejection.Message, "in the future") {
+		t.Errorf("the message doesn't describe the violations: %v", *rejection.Message)
+	}
+}
@@ -18,12 +18,19 @@ type listPracticesVersionsHandlerImp struct {
 }
 
 func (h *listPracticesVersionsHandlerImp) Handle(params operations.ListPracticesVersionsParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListPracticesVersionsDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+
 	ctx := params.HTTPRequest.Context()
 	versions, err := h.rt.Store.ListPracticesVersions(ctx)
 	if err != nil {
-		r := operations.ListPracticesVersionsDefault{}
-		msg := "Error retrieving versions"
-		return r.WithStatusCode(500).WithPayload(&models.Error{Message: &msg})
+		return fail(500, "Error retrieving versions")
 	}
 	return &operations.ListPracticesVersionsOK{Payload: versions}
 }
@@ -43,6 +50,10 @@ func (h *getPracticesHandlerImp) Handle(params operations.GetPracticesParams, pr
 		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
 	}
 
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+
 	ctx := params.HTTPRequest.Context()
 
 	version := params.Version
@@ -0,0 +1,79 @@
+package api
+
+import (
+	"context"
+	"strings"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+func projectAttributes(d *models.ProjectDetails) lib.ProjectAttributes {
+	return lib.ProjectAttributes{
+		ProductType:        string(d.ProductType),
+		InternetFacing:     d.InternetFacing,
+		DataClassification: string(d.DataClassification),
+		Tags:               d.Tags,
+		TechStack:          d.TechStack,
+	}
+}
+
+// projectContext looks up the attributes of the projects, for evaluating practice conditions. Projects that don't exist are ignored.
+func projectContext(ctx context.Context, rt *Runtime, ids []string) (lib.ProjectContext, error) {
+	pc := lib.ProjectContext{}
+	for _, id := range ids {
+		p, found, err := rt.Store.GetProject(ctx, id)
+		if err != nil {
+			return nil, err
+		}
+		if found {
+			pc = append(pc, projectAttributes(p.Attributes))
+		}
+	}
+	return pc, nil
+}
+
+// projectFilter selects projects by their attributes. Unset fields match every project.
+type projectFilter struct {
+	tags               []string
+	productType        *string
+	internetFacing     *bool
+	dataClassification *string
+	tech               []string
+}
+
+// containsAll reports whether have includes every one of want, ignoring case
+func containsAll(have []string, want []string) bool {
+	for _, w := range want {
+		found := false
+		for _, h := range have {
+			if strings.EqualFold(h, w) {
+				found = true
+				break
+			}
+		}
+		if !found {
+			return false
+		}
+	}
+	return true
+}
+
+func (f projectFilter) matches(d *models.ProjectDetails) bool {
+	return containsAll(d.Tags, f.tags) &&
+		containsAll(d.TechStack, f.tech) &&
+		(f.productType == nil || string(d.ProductType) == *f.productType) &&
+		(f.internetFacing == nil || d.InternetFacing == *f.internetFacing) &&
+		(f.dataClassification == nil || string(d.DataClassification) == *f.dataClassification)
+}
+
+// filter returns the projects that match the filter
+func (f projectFilter) filter(projects []*models.Project) []*models.Project {
+	matching := []*models.Project{}
+	for _, p := range projects {
+		if f.matches(p.Attributes) {
+			matching = append(matching, p)
+		}
+	}
+	return matching
+}
@@ -20,12 +20,31 @@ type listProjectsHandlerImp struct {
 }
 
 func (h *listProjectsHandlerImp) Handle(params operations.ListProjectsParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListProjectsDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+
 	projects, err := h.rt.Store.ListProjects(params.HTTPRequest.Context())
 	if err != nil {
-		r := operations.GetProjectDefault{}
-		msg := err.Error()
-		return r.WithStatusCode(500).WithPayload(&models.Error{Message: &msg})
+		return fail(500, err.Error())
+	}
+	filter := projectFilter{tags: params.Tag, productType: params.ProductType, internetFacing: params.InternetFacing, dataClassification: params.DataClassification, tech: params.Tech}
+	projects = filter.filter(projects)
+	if params.Mine != nil && *params.Mine {
+		mine := []*models.Project{}
+		for _, p := range projects {
+			if projectRole(p, principal.UID) != nil {
+				mine = append(mine, p)
+			}
+		}
+		projects = mine
 	}
+	sortMineFirst(projects, principal.UID)
 	return &operations.ListProjectsOK{Payload: projects}
 }
 
@@ -43,6 +62,10 @@ func (h *getProjectHandlerImp) Handle(params operations.GetProjectParams, princi
 		r := operations.GetProjectDefault{}
 		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
 	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
 	proj, found, err := h.rt.Store.GetProject(params.HTTPRequest.Context(), params.ID)
 	if err != nil {
 		return fail(500, "error retrieving project")
@@ -81,6 +104,10 @@ func (h *createProjectHandlerImp) Handle(params operations.CreateProjectParams,
 		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
 	}
 
+	if !h.rt.Allowed(principal, EditPermission) {
+		return fail(403, forbidden(EditPermission))
+	}
+
 	ctx := params.HTTPRequest.Context()
 	logger := log.WithContext(ctx)
 
@@ -92,11 +119,21 @@ func (h *createProjectHandlerImp) Handle(params operations.CreateProjectParams,
 	if !unique {
 		return fail(400, "project names must be unique")
 	}
+	if code, msg := checkOrgUnit(ctx, h.rt, params.Body.OrgUnit); code != 0 {
+		return fail(code, msg)
+	}
 
-	id, err := h.rt.Store.CreateProject(ctx, params.Body)
+	// The creator becomes the project's first owner
+	uid := principal.UID
+	owner := models.ProjectMemberRoleOwner
+	members := []*models.ProjectMember{{UID: &uid, Role: &owner, Name: principal.Name, Email: principal.Email}}
+
+	id, err := h.rt.Store.CreateProject(ctx, params.Body, members)
 	if err != nil {
 		return fail(500, err.Error())
 	}
+	h.rt.audit(params.HTTPRequest, principal, "project.create", id, nil, params.Body)
+	h.rt.projectChanged(EventProjectCreated, "Project created", principal, id, nil, params.Body)
 	return &operations.CreateProjectCreated{Payload: id}
 }
 
@@ -125,6 +162,9 @@ func (h *updateProjectHandlerImp) Handle(params operations.UpdateProjectParams,
 	if !found {
 		return fail(404, "project "+params.ID+" doesn't exist")
 	}
+	if !h.rt.canContribute(principal, orig) {
+		return fail(403, "only the project's owners and members can edit it")
+	}
 
 	if *params.Body.Name != *orig.Attributes.Name {
 		if unique, nameErr := isUniqueName(ctx, params.Body.Name, h.rt); nameErr != nil {
@@ -135,9 +175,17 @@ func (h *updateProjectHandlerImp) Handle(params operations.UpdateProjectParams,
 		}
 	}
 
+	if params.Body.OrgUnit != orig.Attributes.OrgUnit {
+		if code, msg := checkOrgUnit(ctx, h.rt, params.Body.OrgUnit); code != 0 {
+			return fail(code, msg)
+		}
+	}
+
 	if err = h.rt.Store.UpdateProject(params.HTTPRequest.Context(), params.ID, params.Body); err != nil {
 		return fail(500, err.Error())
 	}
+	h.rt.audit(params.HTTPRequest, principal, "project.update", params.ID, orig.Attributes, params.Body)
+	h.rt.projectChanged(EventProjectUpdated, "Project updated", principal, params.ID, orig.Attributes, params.Body)
 	return &operations.UpdateProjectOK{}
 }
 
@@ -157,15 +205,24 @@ func (h *deleteProjectHandlerImp) Handle(params operations.DeleteProjectParams,
 	}
 
 	// Check it exists first, as delete succeeds even if it doesn't exist
-	_, found, err := h.rt.Store.GetProject(params.HTTPRequest.Context(), params.ID)
+	proj, found, err := h.rt.Store.GetProject(params.HTTPRequest.Context(), params.ID)
 	if err != nil {
 		return fail(500, "error checking project exists")
 	}
 	if !found {
 		return fail(404, "project "+params.ID+" doesn't exist")
 	}
+	if !h.rt.canManage(principal, proj) {
+		return fail(403, "only the project's owners can delete it")
+	}
 	if err := h.rt.Store.DeleteProject(params.HTTPRequest.Context(), params.ID); err != nil {
 		return fail(500, err.Error())
 	}
+	h.rt.audit(params.HTTPRequest, principal, "project.delete", params.ID, proj.Attributes, nil)
+	h.rt.projectChanged(EventProjectDeleted, "Project deleted", principal, params.ID, proj.Attributes, nil)
+	// Subscribers are told about the deletion, and not sent anything else
+	if err := deleteProjectSubscriptions(params.HTTPRequest.Context(), h.rt.Store, params.ID); err != nil {
+		log.WithContext(params.HTTPRequest.Context()).WithFields(log.Fields{"project": params.ID, "error": err}).Error("Failed to delete the deleted project's subscriptions")
+	}
 	return &operations.DeleteProjectNoContent{}
 }
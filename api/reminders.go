@@ -0,0 +1,208 @@
+package api
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// EventProjectOverdue is sent to a project's owners, and its subscribers, when it hasn't committed a plan as often as its cadence requires
+const EventProjectOverdue = "project.overdue"
+
+// ReminderConfig configures how often projects are expected to be assessed, and how they're chased when they're overdue
+type ReminderConfig struct {
+	DefaultCadence int           // days between plans, for projects without a cadence of their own or from their org unit; 0 if they aren't tracked
+	Repeat         time.Duration // how long to wait before reminding the owners of a project that's still overdue again
+}
+
+// overdueProjects returns the projects whose latest committed plan is older than their cadence, most overdue first.
+// Projects that have never committed a plan come first.
+func (rt *Runtime) overdueProjects(ctx context.Context, now time.Time) ([]*models.OverdueProject, error) {
+	projects, err := rt.Store.ListProjects(ctx)
+	if err != nil {
+		return nil, err
+	}
+	tree, err := loadOrgTree(ctx, rt)
+	if err != nil {
+		return nil, err
+	}
+	unitCadences := map[string]int{}
+	for id, u := range tree.units {
+		if u.Attributes.CadenceDays != nil {
+			unitCadences[id] = int(*u.Attributes.CadenceDays)
+		}
+	}
+
+	tracked := []*models.Project{}
+	cadences := map[string]int{}
+	for _, p := range projects {
+		var own *int
+		if p.Attributes.CadenceDays != nil {
+			c := int(*p.Attributes.CadenceDays)
+			own = &c
+		}
+		if c := lib.Cadence(own, p.Attributes.OrgUnit, tree.parents, unitCadences, rt.Reminders.DefaultCadence); c > 0 {
+			tracked = append(tracked, p)
+			cadences[p.ID] = c
+		}
+	}
+	latest, err := latestCommittedPlans(ctx, rt, tracked, rt.PlanReviews)
+	if err != nil {
+		return nil, err
+	}
+
+	overdue := []*models.OverdueProject{}
+	for _, p := range tracked {
+		id, name, cadence := p.ID, *p.Attributes.Name, int64(cadences[p.ID])
+		o := &models.OverdueProject{ProjectID: &id, Name: &name, OrgUnit: p.Attributes.OrgUnit, CadenceDays: &cadence, Owners: []string{}}
+		if plan, ok := latest[p.ID]; ok {
+			due, err := lib.AssessmentDue(plan.Details.Date, cadences[p.ID])
+			if err != nil {
+				log.WithContext(ctx).WithFields(log.Fields{"project": p.ID, "error": err}).Warn("Can't tell whether the project is overdue")
+				continue
+			}
+			days := lib.DaysOverdue(due, now)
+			if days <= 0 {
+				continue // not overdue until the day after it's due
+			}
+			o.LastCommitted = plan.Details.Date
+			o.Due = due.Format("2006-01-02")
+			o.DaysOverdue = int64(days)
+		}
+		for _, m := range p.Members {
+			if m.Role != nil && *m.Role == models.ProjectMemberRoleOwner && m.Email != "" {
+				o.Owners = append(o.Owners, m.Email)
+			}
+		}
+		overdue = append(overdue, o)
+	}
+	sort.SliceStable(overdue, func(i, j int) bool {
+		a, b := overdue[i], overdue[j]
+		if (a.Due == "") != (b.Due == "") {
+			return a.Due == ""
+		}
+		if a.DaysOverdue != b.DaysOverdue {
+			return a.DaysOverdue > b.DaysOverdue
+		}
+		return *a.Name < *b.Name
+	})
+	return overdue, nil
+}
+
+// SendReminders notifies the owners of overdue projects, unless they were reminded less than the repeat interval ago
+// and the project has committed no plans since. It returns the number of projects reminded about.
+func SendReminders(ctx context.Context, rt *Runtime, now time.Time) (int, error) {
+	overdue, err := rt.overdueProjects(ctx, now)
+	if err != nil {
+		return 0, err
+	}
+	reminded, err := rt.Store.ListReminders(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	sent := 0
+	for _, o := range overdue {
+		last := reminded[*o.ProjectID]
+		if !last.IsZero() && now.Sub(last) < rt.Reminders.Repeat && (o.Due == "" || last.Format("2006-01-02") >= o.Due) {
+			continue
+		}
+		n := &Notification{
+			Event:    EventProjectOverdue,
+			Title:    "A new plan is due",
+			Subject:  *o.Name,
+			Fields:   []NotificationField{{Name: "Cadence", Value: strconv.FormatInt(*o.CadenceDays, 10) + " days"}},
+			Projects: []string{*o.ProjectID},
+			Personal: o.Owners,
+			Data:     o,
+			Key:      EventProjectOverdue + "/" + *o.ProjectID + "/" + now.Format("2006-01-02"),
+		}
+		if o.Due == "" {
+			n.Fields = append(n.Fields, NotificationField{Name: "Last committed plan", Value: "never"})
+		} else {
+			n.Fields = append(n.Fields,
+				NotificationField{Name: "Last committed plan", Value: o.LastCommitted},
+				NotificationField{Name: "Due", Value: o.Due},
+				NotificationField{Name: "Days overdue", Value: strconv.FormatInt(o.DaysOverdue, 10)})
+		}
+		rt.notify(n)
+		if err = rt.Store.RecordReminder(ctx, *o.ProjectID, now); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// ReminderScheduler sends reminders about overdue projects and expired risk acceptances every interval, until ctx is done
+func ReminderScheduler(ctx context.Context, rt *Runtime, interval time.Duration) {
+	ticker := time.NewTicker(interval)
+	defer ticker.Stop()
+	for {
+		if sent, err := SendReminders(ctx, rt, time.Now().UTC()); err != nil {
+			log.WithField("error", err).Error("Failed to send reminders about overdue projects")
+		} else if sent > 0 {
+			log.WithField("projects", sent).Info("Sent reminders about overdue projects")
+		}
+		if sent, err := SendRiskAcceptanceReminders(ctx, rt, time.Now().UTC()); err != nil {
+			log.WithField("error", err).Error("Failed to send reminders about expired risk acceptances")
+		} else if sent > 0 {
+			log.WithField("acceptances", sent).Info("Sent reminders about expired risk acceptances")
+		}
+		select {
+		case <-ctx.Done():
+			return
+		case <-ticker.C:
+		}
+	}
+}
+
+// NewListOverdueProjectsHandler creates a handler
+func NewListOverdueProjectsHandler(rt *Runtime) operations.ListOverdueProjectsHandler {
+	return &listOverdueProjectsHandlerImp{rt: rt}
+}
+
+type listOverdueProjectsHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listOverdueProjectsHandlerImp) Handle(params operations.ListOverdueProjectsParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListOverdueProjectsDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	ctx := params.HTTPRequest.Context()
+	overdue, err := h.rt.overdueProjects(ctx, time.Now().UTC())
+	if err != nil {
+		return fail(500, "error finding overdue projects")
+	}
+	if params.OrgUnit != nil {
+		tree, err := loadOrgTree(ctx, h.rt)
+		if err != nil {
+			return fail(500, "error retrieving org units")
+		}
+		if _, ok := tree.units[*params.OrgUnit]; !ok {
+			return fail(404, "org unit not found")
+		}
+		selected := []*models.OverdueProject{}
+		for _, o := range overdue {
+			if o.OrgUnit != "" && tree.within(o.OrgUnit, *params.OrgUnit) {
+				selected = append(selected, o)
+			}
+		}
+		overdue = selected
+	}
+	return &operations.ListOverdueProjectsOK{Payload: overdue}
+}
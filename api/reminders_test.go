@@ -0,0 +1,106 @@
+package api
+
+import (
+	"context"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+func TestOverdueProjects(t *testing.T) {
+	days := func(d int64) *int64 { return &d }
+	st := newMemStore()
+	st.projects = []*models.Project{
+		testProject("fresh", "", nil, "plan-fresh"),
+		testProject("stale", "", nil, "plan-stale"),
+		testProject("never", "", nil),
+		testProject("untracked", "", days(0)),
+		testProject("slow", "team", nil, "plan-stale"),
+		testProject("older", "", days(30), "plan-older"),
+		testProject("today", "", nil, "plan-today"),
+	}
+	st.units = []*models.OrgUnit{
+		{ID: "division", Attributes: &models.OrgUnitDetails{CadenceDays: days(365)}},
+		{ID: "team", Attributes: &models.OrgUnitDetails{Parent: "division"}},
+	}
+	st.plans["plan-fresh"] = singleRevision(&lib.Plan{Details: lib.PlanDetails{Date: "2021-05-01", Committed: true}})
+	st.plans["plan-stale"] = singleRevision(&lib.Plan{Details: lib.PlanDetails{Date: "2021-01-01", Committed: true}})
+	st.plans["plan-older"] = singleRevision(&lib.Plan{Details: lib.PlanDetails{Date: "2020-12-01", Committed: true}})
+	st.plans["plan-today"] = singleRevision(&lib.Plan{Details: lib.PlanDetails{Date: "2021-03-03", Committed: true}}) // due on 2021-06-01
+	rt := &Runtime{Store: st, Reminders: ReminderConfig{DefaultCadence: 90, Repeat: 7 * 24 * time.Hour}}
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	overdue, err := rt.overdueProjects(context.Background(), now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	got := []string{}
+	for _, o := range overdue {
+		got = append(got, *o.ProjectID)
+	}
+	if want := []string{"never", "older", "stale"}; !reflect.DeepEqual(got, want) {
+		t.Fatalf("got overdue projects %v, want %v", got, want)
+	}
+	if o := overdue[2]; o.Due != "2021-04-01" || o.DaysOverdue != 61 || !reflect.DeepEqual(o.Owners, []string{"stale@example.com"}) {
+		t.Errorf("unexpected details for the stale project: %+v", o)
+	}
+
+	// a project due today isn't overdue until tomorrow
+	if overdue, err = rt.overdueProjects(context.Background(), now.AddDate(0, 0, 1)); err != nil {
+		t.Fatal(err)
+	}
+	found := false
+	for _, o := range overdue {
+		if *o.ProjectID == "today" {
+			found = o.DaysOverdue == 1
+		}
+	}
+	if !found {
+		t.Errorf("the project due yesterday wasn't one day overdue: %v", overdue)
+	}
+}
+
+func TestSendReminders(t *testing.T) {
+	st := newMemStore()
+	st.projects = []*models.Project{testProject("stale", "", nil, "plan-stale")}
+	stale := &lib.Plan{Details: lib.PlanDetails{Date: "2021-01-01", Committed: true}}
+	st.plans["plan-stale"] = singleRevision(stale)
+	router, err := NewNotificationRouter([]Notifier{&recordingNotifier{name: "slack"}}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &Runtime{Store: st, Notifications: router, notifyWake: make(chan struct{}, 1),
+		Reminders: ReminderConfig{DefaultCadence: 90, Repeat: 7 * 24 * time.Hour}}
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	for i, c := range []struct {
+		at   time.Time
+		sent int
+	}{
+		{now, 1},
+		{now.Add(24 * time.Hour), 0}, // reminded recently
+		{now.Add(8 * 24 * time.Hour), 1},
+	} {
+		sent, err := SendReminders(ctx, rt, c.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sent != c.sent {
+			t.Errorf("%v: sent %v reminders, want %v", i, sent, c.sent)
+		}
+	}
+	if len(st.messages) != 2 {
+		t.Errorf("expected two queued notifications, got %v", st.messages)
+	}
+
+	// A newer plan that has since become overdue is reminded about straight away
+	st.reminders["stale"] = now
+	stale.Details.Date = "2021-03-07" // due on 2021-06-05
+	if sent, _ := SendReminders(ctx, rt, now.Add(5*24*time.Hour)); sent != 1 {
+		t.Errorf("wasn't reminded about a newly overdue plan")
+	}
+}
@@ -34,15 +34,15 @@ func init() {
   },
   "basePath": "/v1alpha1",
   "paths": {
-    "/auth": {
+    "/access-request": {
       "get": {
-        "security": [],
-        "operationId": "getAuthConfig",
+        "description": "The caller's request for access, if they have made one. Users that don't yet have access can use this.",
+        "operationId": "getMyAccessRequest",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/authConfig"
+              "$ref": "#/definitions/accessRequest"
             }
           },
           "default": {
@@ -53,49 +53,24 @@ func init() {
           }
         }
       },
-      "post": {
-        "description": "Used to trigger one-time events like requesting access. Clients should hit this once after obtaining an ID token, and can use or ignore the response.",
-        "operationId": "loggedIn",
-        "responses": {
-          "200": {
-            "description": "OK"
-          },
-          "default": {
-            "description": "No access, access requested, invalid ID token, or internal error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
-        }
-      }
-    },
-    "/plan": {
-      "post": {
-        "operationId": "createPlan",
+      "put": {
+        "description": "Ask the security team for access, explaining why it's needed. Users that don't yet have access can use this. A pending request's justification is replaced; a denied request is reopened.\n",
+        "operationId": "requestAccess",
         "parameters": [
           {
-            "$ref": "#/parameters/createRevision"
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/accessRequestBody"
+            }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Created",
+          "200": {
+            "description": "OK",
             "schema": {
-              "type": "object",
-              "required": [
-                "planId",
-                "revisionId"
-              ],
-              "properties": {
-                "planId": {
-                  "description": "The ID of the plan",
-                  "type": "string"
-                },
-                "revisionId": {
-                  "description": "The revision ID of the initial revision",
-                  "type": "string"
-                }
-              }
+              "$ref": "#/definitions/accessRequest"
             }
           },
           "default": {
@@ -107,28 +82,31 @@ func init() {
         }
       }
     },
-    "/plan/{id}": {
+    "/access-requests": {
       "get": {
-        "operationId": "getPlan",
+        "description": "Requests for access, most recent first",
+        "operationId": "listAccessRequests",
+        "parameters": [
+          {
+            "enum": [
+              "pending",
+              "approved",
+              "denied"
+            ],
+            "type": "string",
+            "description": "Only list requests with this status",
+            "name": "status",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "type": "object",
-              "required": [
-                "plan",
-                "latestRevision"
-              ],
-              "properties": {
-                "latestRevision": {
-                  "description": "The ID of the latest revision of this plan",
-                  "type": "string"
-                },
-                "plan": {
-                  "$ref": "#/definitions/plan"
-                }
-              },
-              "additionalProperties": false
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/accessRequest"
+              }
             }
           },
           "default": {
@@ -138,36 +116,27 @@ func init() {
             }
           }
         }
-      },
+      }
+    },
+    "/access-requests/{uid}/approve": {
       "post": {
-        "operationId": "createPlanRevision",
+        "description": "Grant the user access, by manually authorizing them",
+        "operationId": "approveAccessRequest",
         "parameters": [
           {
-            "$ref": "#/parameters/createRevision"
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/accessDecision"
+            }
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "description": "The ID of the new revision",
-              "type": "string"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/accessRequest"
             }
-          }
-        }
-      },
-      "delete": {
-        "description": "Delete this plan and all of the revisions associated with it",
-        "operationId": "deletePlan",
-        "responses": {
-          "204": {
-            "description": "Deleted"
           },
           "default": {
             "description": "error",
@@ -180,53 +149,29 @@ func init() {
       "parameters": [
         {
           "type": "string",
-          "name": "id",
+          "name": "uid",
           "in": "path",
           "required": true
         }
       ]
     },
-    "/plan/{id}/revision/{revId}": {
-      "get": {
-        "operationId": "getPlanRevision",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/planDetails"
-            }
-          },
-          "default": {
-            "description": "error",
+    "/access-requests/{uid}/deny": {
+      "post": {
+        "operationId": "denyAccessRequest",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
             "schema": {
-              "$ref": "#/definitions/error"
+              "$ref": "#/definitions/accessDecision"
             }
           }
-        }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "name": "id",
-          "in": "path",
-          "required": true
-        },
-        {
-          "type": "string",
-          "name": "revId",
-          "in": "path",
-          "required": true
-        }
-      ]
-    },
-    "/plan/{id}/revision/{revId}/responses": {
-      "get": {
-        "operationId": "getPlanRevisionPracticeResponses",
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/practiceResponses"
+              "$ref": "#/definitions/accessRequest"
             }
           },
           "default": {
@@ -240,29 +185,68 @@ func init() {
       "parameters": [
         {
           "type": "string",
-          "name": "id",
-          "in": "path",
-          "required": true
-        },
-        {
-          "type": "string",
-          "name": "revId",
+          "name": "uid",
           "in": "path",
           "required": true
         }
       ]
     },
-    "/plan/{id}/versions": {
+    "/audit": {
       "get": {
-        "operationId": "getPlanVersions",
+        "description": "The audit log of changes made through the API and the CLI, most recent first. Requires the audit permission.\n",
+        "operationId": "listAuditEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The UID of the user or service account that made the changes",
+            "name": "actor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "e.g. project.delete",
+            "name": "action",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "e.g. project",
+            "name": "targetType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "targetId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of events to return, 100 if not set",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "description": "all of the versions for this plan, in order from oldest to latest",
               "type": "array",
               "items": {
-                "$ref": "#/definitions/revisionVersion"
+                "$ref": "#/definitions/auditEvent"
               }
             }
           },
@@ -273,28 +257,17 @@ func init() {
             }
           }
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
+      }
     },
-    "/practices": {
+    "/auth": {
       "get": {
-        "operationId": "listPracticesVersions",
+        "security": [],
+        "operationId": "getAuthConfig",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "description": "All of the versions, from oldest to newest",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
+              "$ref": "#/definitions/authConfig"
             }
           },
           "default": {
@@ -304,47 +277,32 @@ func init() {
             }
           }
         }
-      }
-    },
-    "/practices/{version}": {
-      "get": {
-        "operationId": "getPractices",
+      },
+      "post": {
+        "description": "Used to trigger one-time events like requesting access. Clients should hit this once after obtaining an ID token, and can use or ignore the response.",
+        "operationId": "loggedIn",
         "responses": {
           "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/gotPractices"
-            }
+            "description": "OK"
           },
           "default": {
-            "description": "error",
+            "description": "No access, access requested, invalid ID token, or internal error",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The version, or the special value \"latest\".",
-          "name": "version",
-          "in": "path",
-          "required": true
-        }
-      ]
+      }
     },
-    "/project": {
+    "/commit-policy": {
       "get": {
-        "operationId": "listProjects",
+        "description": "Get the rules, besides every applicable question being answered, that plans must meet to be committed",
+        "operationId": "getCommitPolicy",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/project"
-              }
+              "$ref": "#/definitions/commitPolicy"
             }
           },
           "default": {
@@ -355,23 +313,24 @@ func init() {
           }
         }
       },
-      "post": {
-        "operationId": "createProject",
+      "put": {
+        "description": "Replace the rules plans must meet to be committed. Revisions that are already committed aren't affected.",
+        "operationId": "setCommitPolicy",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "$ref": "#/definitions/projectDetails"
+              "$ref": "#/definitions/commitPolicy"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Created",
+          "200": {
+            "description": "OK",
             "schema": {
-              "description": "The ID of the project",
-              "type": "string"
+              "$ref": "#/definitions/commitPolicy"
             }
           },
           "default": {
@@ -383,14 +342,15 @@ func init() {
         }
       }
     },
-    "/project/{id}": {
+    "/me": {
       "get": {
-        "operationId": "getProject",
+        "description": "The authenticated user, and what they are allowed to do",
+        "operationId": "getCurrentUser",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/project"
+              "$ref": "#/definitions/currentUser"
             }
           },
           "default": {
@@ -400,21 +360,182 @@ func init() {
             }
           }
         }
-      },
-      "put": {
-        "operationId": "updateProject",
+      }
+    },
+    "/metrics/maturity": {
+      "get": {
+        "description": "Roll the maturity of each project up the org unit tree. Each project's maturity is taken from the most recent committed revision of its plans, and each org unit summarises the projects in it and all of its descendants.\n",
+        "operationId": "getMaturityMetrics",
         "parameters": [
           {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/projectDetails"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK"
+            "type": "string",
+            "description": "Only report on this org unit and its descendants",
+            "name": "orgUnit",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/tagFilter"
+          },
+          {
+            "$ref": "#/parameters/productTypeFilter"
+          },
+          {
+            "$ref": "#/parameters/internetFacingFilter"
+          },
+          {
+            "$ref": "#/parameters/dataClassificationFilter"
+          },
+          {
+            "$ref": "#/parameters/techFilter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/orgUnitMaturity"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/notifications": {
+      "get": {
+        "description": "The notification outbox: notifications waiting to be delivered to each channel, and those recently delivered or given up on, most recently created first. Requires the admin permission.\n",
+        "operationId": "listNotifications",
+        "parameters": [
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of messages to return, 100 if not set",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/outboxMessage"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/orgunit": {
+      "get": {
+        "operationId": "listOrgUnits",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/orgUnit"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create an org unit. Top-level units can only be created by security admins; other units by the leads of any of their ancestors.",
+        "operationId": "createOrgUnit",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/orgUnitDetails"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "description": "The ID of the org unit",
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/orgunit/{id}": {
+      "get": {
+        "operationId": "getOrgUnit",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/orgUnit"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update an org unit. Only security admins and the leads of the unit or any of its ancestors can change it.",
+        "operationId": "updateOrgUnit",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/orgUnitDetails"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
           },
           "default": {
             "description": "error",
@@ -425,7 +546,8 @@ func init() {
         }
       },
       "delete": {
-        "operationId": "deleteProject",
+        "description": "Delete an org unit. It can't have any child units or projects.",
+        "operationId": "deleteOrgUnit",
         "responses": {
           "204": {
             "description": "Deleted"
@@ -446,644 +568,5160 @@ func init() {
           "required": true
         }
       ]
-    }
-  },
-  "definitions": {
-    "answer": {
-      "type": "object",
-      "required": [
-        "answer"
-      ],
-      "properties": {
-        "answer": {
-          "type": "string",
-          "enum": [
-            "Yes",
-            "No",
-            "N/A",
-            "Unanswered"
-          ]
-        },
-        "notes": {
-          "description": "Caveats; additions; or explanation of why - in particular why the answer is N/A.",
-          "type": "string"
-        }
-      },
-      "additionalProperties": false,
-      "x-go-type": {
-        "import": {
-          "package": "github.com/ThalesGroup/besec/lib"
-        },
-        "type": "Answer"
-      }
     },
-    "authConfig": {
-      "description": "Authentication configuration for the deployment",
-      "type": "object",
-      "required": [
-        "providers",
-        "gcpPublicApiKey",
-        "gcpAuthDomain"
-      ],
-      "properties": {
-        "emulatorUrl": {
-          "type": "string"
-        },
-        "gcpAuthDomain": {
-          "type": "string"
-        },
-        "gcpPublicApiKey": {
-          "type": "string"
-        },
-        "providers": {
-          "$ref": "#/definitions/authProviders"
+    "/overdue-projects": {
+      "get": {
+        "description": "Projects whose latest committed plan is older than their cadence, or that have never committed one, most overdue first. Projects without a cadence aren't included.\n",
+        "operationId": "listOverdueProjects",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only include projects in this org unit or the units below it",
+            "name": "orgUnit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/overdueProject"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "authProvider": {
-      "type": "object",
-      "required": [
-        "id"
-      ],
-      "properties": {
-        "id": {
-          "description": "The Google Identity Platform provider ID",
-          "type": "string",
-          "example": "google.com"
-        },
-        "samlClaims": {
-          "$ref": "#/definitions/samlProviderClaimsMap"
-        },
-        "signInOptions": {
-          "$ref": "#/definitions/signInOptions"
-        },
-        "whitelisted": {
-          "description": "True if every user from this provider has access",
-          "type": "boolean"
+    "/plan": {
+      "post": {
+        "description": "Create a plan with its first revision. A committed revision must meet the commit policy, otherwise the error lists the violations.\n",
+        "operationId": "createPlan",
+        "parameters": [
+          {
+            "$ref": "#/parameters/createRevision"
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "type": "object",
+              "required": [
+                "planId",
+                "revisionId"
+              ],
+              "properties": {
+                "planId": {
+                  "description": "The ID of the plan",
+                  "type": "string"
+                },
+                "revisionId": {
+                  "description": "The revision ID of the initial revision",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "authProviders": {
-      "description": "The authentication provider configuration, in the order they are shown",
+    "/plan/{id}": {
+      "get": {
+        "operationId": "getPlan",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Check the integrity of the plan's revision history, reporting it in the chain property",
+            "name": "verifyChain",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "required": [
+                "plan",
+                "latestRevision"
+              ],
+              "properties": {
+                "chain": {
+                  "$ref": "#/definitions/planChain"
+                },
+                "latestRevision": {
+                  "description": "The ID of the latest revision of this plan",
+                  "type": "string"
+                },
+                "plan": {
+                  "$ref": "#/definitions/plan"
+                }
+              },
+              "additionalProperties": false
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a new revision of the plan. A committed revision must meet the commit policy, otherwise the error lists the violations.\n",
+        "operationId": "createPlanRevision",
+        "parameters": [
+          {
+            "$ref": "#/parameters/createRevision"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "description": "The ID of the new revision",
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete this plan and all of the revisions associated with it",
+        "operationId": "deletePlan",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/practice/{practiceId}/task/{taskId}/issue": {
+      "post": {
+        "description": "Creates an issue for a prioritised task in the plan's latest revision, from the task's title and description, and links it to the task in a new revision of the plan. The new revision keeps the review state of the revision it's based on.\n",
+        "operationId": "createTaskIssue",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "tracker": {
+                  "description": "The name of the issue tracker to use, if more than one is configured. Defaults to the first tracker that serves the plan's projects.",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/linkedIssue"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "practiceId",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "taskId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/practice/{practiceId}/task/{taskId}/risk-acceptance/approval": {
+      "post": {
+        "description": "Approves the task's risk acceptance in the plan's latest revision, recording the approver in a new revision of the plan. This needs the review or admin permission, and approvers can't approve acceptances they wrote. The new revision's maturity includes the approval, and it keeps the review state of the revision it's based on.\n",
+        "operationId": "approveRiskAcceptance",
+        "responses": {
+          "201": {
+            "description": "Approved",
+            "schema": {
+              "description": "The ID of the new revision",
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "practiceId",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "taskId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/revision/{revId}": {
+      "get": {
+        "operationId": "getPlanRevision",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/planDetails"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "revId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/revision/{revId}/attestation": {
+      "get": {
+        "description": "Export a committed plan revision as an in-toto statement, signed in a DSSE envelope with the server's attestation key. The statement includes the plan's projects, date, practices version, maturity, and a digest of the full responses.\n",
+        "operationId": "getPlanRevisionAttestation",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dsseEnvelope"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "revId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/revision/{revId}/responses": {
+      "get": {
+        "operationId": "getPlanRevisionPracticeResponses",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Prepare the responses as the starting point for a new revision, answering Yes to the questions of tasks marked as done",
+            "name": "nextRevision",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Fetch the title and state of each task's issues from the configured issue trackers",
+            "name": "linkedIssues",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/practiceResponses"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "revId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/revision/{revId}/review": {
+      "post": {
+        "description": "Approve a committed revision that's been submitted for review, or request changes to it, optionally with a comment; this needs the review permission, and reviewers can't review their own revisions. Without a decision, just add a comment, which the plan's contributors can also do.\n",
+        "operationId": "reviewPlanRevision",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "comment": {
+                  "type": "string"
+                },
+                "decision": {
+                  "type": "string",
+                  "enum": [
+                    "approved",
+                    "changes-requested"
+                  ]
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/planReview"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "revId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/versions": {
+      "get": {
+        "operationId": "getPlanVersions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "description": "all of the versions for this plan, in order from oldest to latest",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/revisionVersion"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/practices": {
+      "get": {
+        "operationId": "listPracticesVersions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "description": "All of the versions, from oldest to newest",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/practices/{version}": {
+      "get": {
+        "operationId": "getPractices",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/gotPractices"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The version, or the special value \"latest\".",
+          "name": "version",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/project": {
+      "get": {
+        "description": "List projects, with those the user is an owner or member of first",
+        "operationId": "listProjects",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Only list projects the user is an owner or member of",
+            "name": "mine",
+            "in": "query"
+          },
+          {
+            "$ref": "#/parameters/tagFilter"
+          },
+          {
+            "$ref": "#/parameters/productTypeFilter"
+          },
+          {
+            "$ref": "#/parameters/internetFacingFilter"
+          },
+          {
+            "$ref": "#/parameters/dataClassificationFilter"
+          },
+          {
+            "$ref": "#/parameters/techFilter"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/project"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "operationId": "createProject",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/projectDetails"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "description": "The ID of the project",
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/project/{id}": {
+      "get": {
+        "operationId": "getProject",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/project"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "operationId": "updateProject",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/projectDetails"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "operationId": "deleteProject",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/project/{id}/members": {
+      "get": {
+        "operationId": "listProjectMembers",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/projectMember"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/project/{id}/members/{uid}": {
+      "put": {
+        "description": "Add a user to the project, or change their role in it. Only project owners and security admins can manage members.",
+        "operationId": "setProjectMember",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "role"
+              ],
+              "properties": {
+                "role": {
+                  "$ref": "#/definitions/projectMemberRole"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "operationId": "removeProjectMember",
+        "responses": {
+          "204": {
+            "description": "Removed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/project/{id}/subscription": {
+      "get": {
+        "description": "Get the current user's subscription to the project's events",
+        "operationId": "getProjectSubscription",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/subscription"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Notify the current user, by email, of the project's events. Replaces any existing subscription.",
+        "operationId": "subscribeToProject",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "events": {
+                  "description": "The event types to be notified of, e.g. plan.committed or project.*; every event if empty",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/subscription"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "operationId": "unsubscribeFromProject",
+        "responses": {
+          "204": {
+            "description": "Unsubscribed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/reviews": {
+      "get": {
+        "description": "Lists the plans whose latest committed revision is in the review state, submitted by default, oldest first. Use this as a reviewer's queue.\n",
+        "operationId": "listPlanReviews",
+        "parameters": [
+          {
+            "enum": [
+              "submitted",
+              "approved",
+              "changes-requested"
+            ],
+            "type": "string",
+            "name": "state",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/reviewSummary"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/risk-acceptances": {
+      "get": {
+        "description": "Lists the risk acceptances in each project's latest committed plan, soonest expiry first",
+        "operationId": "listRiskAcceptances",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only list the project's risk acceptances",
+            "name": "project",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only list acceptances that have expired (true) or haven't (false)",
+            "name": "expired",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/acceptedRisk"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tasks": {
+      "get": {
+        "description": "Lists the prioritised tasks that are planned or in progress, from the latest revision of each project's most recent plan, soonest target date first",
+        "operationId": "listOpenTasks",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only list the project's tasks",
+            "name": "project",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list tasks assigned to this person",
+            "name": "assignee",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "planned",
+              "in-progress"
+            ],
+            "type": "string",
+            "description": "Only list tasks with this status",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/openTask"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tokens": {
+      "get": {
+        "description": "The caller's API tokens. Security admins can list every token, including service account tokens.",
+        "operationId": "listApiTokens",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "List every user's and service account's tokens, not just the caller's",
+            "name": "all",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/apiToken"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a long-lived API token. Without a service account, the token acts as the caller, limited to its scopes. Only security admins can create service account tokens. The secret is only returned here; only a hash of it is kept.\n",
+        "operationId": "createApiToken",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiTokenRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/newApiToken"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/tokens/{id}": {
+      "delete": {
+        "description": "Revoke an API token. Users can revoke their own tokens, security admins can revoke any token.",
+        "operationId": "revokeApiToken",
+        "responses": {
+          "204": {
+            "description": "Revoked"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/user": {
+      "get": {
+        "description": "The users known to BeSec, in UID order. Only security admins can list users. Pass the nextPageToken of one page as the pageToken of the request for the next.\n",
+        "operationId": "listUsers",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only include users who signed in with this identity provider",
+            "name": "provider",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only include users whose email address is from this domain",
+            "name": "domain",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only include users that are (or are not) manually authorized",
+            "name": "authorized",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of users to return, 100 if not set",
+            "name": "pageSize",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "pageToken",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/managedUserList"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/user/{uid}": {
+      "get": {
+        "operationId": "getUser",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/managedUser"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Remove the user from the identity platform and delete their local data and API tokens. They can sign in again if their provider is whitelisted or an access rule matches them.\n",
+        "operationId": "removeUser",
+        "responses": {
+          "204": {
+            "description": "Removed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/user/{uid}/authorize": {
+      "post": {
+        "description": "Manually authorize the user, approving their pending access request if they have one",
+        "operationId": "authorizeUser",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/accessDecision"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/managedUser"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/user/{uid}/deauthorize": {
+      "post": {
+        "description": "Remove the user's manual authorization",
+        "operationId": "deauthorizeUser",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/managedUser"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/user/{uid}/roles": {
+      "get": {
+        "description": "The roles explicitly granted to a user. Authorized users without any explicit roles get the deployment's default roles.",
+        "operationId": "getUserRoles",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/roles"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Replace the roles explicitly granted to a user. An empty list reverts the user to the default roles.",
+        "operationId": "setUserRoles",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/roles"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks": {
+      "get": {
+        "description": "The registered outbound webhooks. Requires the admin permission.",
+        "operationId": "listWebhooks",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhook"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Register an outbound webhook, which is sent the events it subscribes to as signed JSON. Requires the admin permission. The signing secret is only returned here.\n",
+        "operationId": "createWebhook",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhookRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/newWebhook"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{id}": {
+      "delete": {
+        "description": "Remove an outbound webhook. Requires the admin permission.",
+        "operationId": "deleteWebhook",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/{id}/deliveries": {
+      "get": {
+        "description": "The webhook's recent deliveries from the outbox, most recent first. Requires the admin permission.",
+        "operationId": "listWebhookDeliveries",
+        "parameters": [
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of deliveries to return, 100 if not set",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/outboxMessage"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/{id}/test": {
+      "post": {
+        "description": "Send a webhook.test event to the webhook straight away, and report the outcome. Requires the admin permission.",
+        "operationId": "testWebhook",
+        "responses": {
+          "200": {
+            "description": "The test was sent, see the result for whether it was delivered",
+            "schema": {
+              "$ref": "#/definitions/webhookTestResult"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "acceptedRisk": {
+      "type": "object",
+      "required": [
+        "projectId",
+        "project",
+        "practiceId",
+        "taskId",
+        "approver",
+        "expires"
+      ],
+      "properties": {
+        "approved": {
+          "description": "Whether the acceptance has been approved; only approved acceptances count toward maturity",
+          "type": "boolean"
+        },
+        "approver": {
+          "description": "Who approved the acceptance, empty if it hasn't been approved",
+          "type": "string"
+        },
+        "counted": {
+          "description": "Whether the accepted task counted toward the project's maturity, as its practice's policy allows",
+          "type": "boolean"
+        },
+        "expired": {
+          "description": "Whether the acceptance has expired, so it's due for review",
+          "type": "boolean"
+        },
+        "expires": {
+          "type": "string"
+        },
+        "justification": {
+          "type": "string"
+        },
+        "practice": {
+          "description": "The practice's name",
+          "type": "string"
+        },
+        "practiceId": {
+          "type": "string"
+        },
+        "project": {
+          "description": "The project's name",
+          "type": "string"
+        },
+        "projectId": {
+          "type": "string"
+        },
+        "taskId": {
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        }
+      }
+    },
+    "accessDecision": {
+      "type": "object",
+      "properties": {
+        "force": {
+          "description": "Approve the request even though the user's email isn't from a trusted domain",
+          "type": "boolean"
+        },
+        "reason": {
+          "description": "An explanation of the decision for the user",
+          "type": "string"
+        }
+      }
+    },
+    "accessRequest": {
+      "type": "object",
+      "required": [
+        "uid",
+        "status",
+        "justification",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "decided": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "decidedBy": {
+          "description": "The UID of the admin that approved or denied the request",
+          "type": "string"
+        },
+        "decidedByName": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string"
+        },
+        "justification": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "provider": {
+          "description": "The identity provider the user signed in with",
+          "type": "string"
+        },
+        "reason": {
+          "description": "The admin's explanation of the decision",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "approved",
+            "denied"
+          ]
+        },
+        "trustedDomain": {
+          "description": "Whether the user's email is from one of the deployment's trusted domains",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "uid": {
+          "type": "string"
+        }
+      }
+    },
+    "accessRequestBody": {
+      "type": "object",
+      "required": [
+        "justification"
+      ],
+      "properties": {
+        "justification": {
+          "description": "Why the user needs access",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "answer": {
+      "type": "object",
+      "required": [
+        "answer"
+      ],
+      "properties": {
+        "answer": {
+          "type": "string",
+          "enum": [
+            "Yes",
+            "No",
+            "N/A",
+            "Unanswered"
+          ]
+        },
+        "notes": {
+          "description": "Caveats; additions; or explanation of why - in particular why the answer is N/A.",
+          "type": "string"
+        }
+      },
+      "additionalProperties": false,
+      "x-go-type": {
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "Answer"
+      }
+    },
+    "apiToken": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "owner",
+        "scopes",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "createdBy": {
+          "description": "The UID of the user that created the token",
+          "type": "string"
+        },
+        "expires": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "readOnly": true
+        },
+        "lastUsed": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "description": "The UID of the user or service account the token acts as",
+          "type": "string"
+        },
+        "ownerName": {
+          "type": "string"
+        },
+        "prefix": {
+          "description": "The start of the secret, to help identify the token",
+          "type": "string"
+        },
+        "roles": {
+          "description": "The roles of a service account token",
+          "$ref": "#/definitions/roles"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiTokenScope"
+          }
+        },
+        "serviceAccount": {
+          "description": "True if the owner is a service account",
+          "type": "boolean"
+        }
+      }
+    },
+    "apiTokenRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "scopes"
+      ],
+      "properties": {
+        "expiresInDays": {
+          "description": "The number of days until the token expires. If unset, it doesn't expire.",
+          "type": "integer",
+          "minimum": 1
+        },
+        "name": {
+          "description": "What the token is used for",
+          "type": "string",
+          "minLength": 1
+        },
+        "roles": {
+          "description": "The roles of the service account; required for service account tokens",
+          "$ref": "#/definitions/roles"
+        },
+        "scopes": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/apiTokenScope"
+          }
+        },
+        "serviceAccount": {
+          "description": "Create the token for this service account rather than the caller",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9_-]+$"
+        }
+      }
+    },
+    "apiTokenScope": {
+      "description": "A permission that an API token may exercise, if its owner's roles also allow it",
+      "type": "string",
+      "enum": [
+        "read",
+        "edit",
+        "delete",
+        "admin",
+        "audit",
+        "review"
+      ]
+    },
+    "auditEvent": {
+      "description": "A record of a change, who made it, and when",
+      "type": "object",
+      "required": [
+        "id",
+        "time",
+        "actor",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "actor": {
+          "description": "The UID of the user or service account, or who ran the CLI command",
+          "type": "string"
+        },
+        "actorName": {
+          "type": "string"
+        },
+        "after": {
+          "description": "A JSON summary of the target after the change",
+          "type": "string"
+        },
+        "before": {
+          "description": "A JSON summary of the target before the change",
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "requestId": {
+          "description": "The trace ID of the API request, to correlate with the server logs",
+          "type": "string"
+        },
+        "targetId": {
+          "type": "string"
+        },
+        "targetType": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "authConfig": {
+      "description": "Authentication configuration for the deployment",
+      "type": "object",
+      "required": [
+        "providers",
+        "gcpPublicApiKey",
+        "gcpAuthDomain"
+      ],
+      "properties": {
+        "emulatorUrl": {
+          "type": "string"
+        },
+        "flow": {
+          "description": "How users sign in. With firebase (the default), the UI uses FirebaseUI with the configured providers. With oidc, clients sign in with the oidc issuer (e.g. using the authorization code flow with PKCE), and send its ID token to the API.\n",
+          "type": "string",
+          "enum": [
+            "firebase",
+            "oidc"
+          ]
+        },
+        "gcpAuthDomain": {
+          "type": "string"
+        },
+        "gcpPublicApiKey": {
+          "type": "string"
+        },
+        "oidc": {
+          "$ref": "#/definitions/oidcConfig"
+        },
+        "providers": {
+          "$ref": "#/definitions/authProviders"
+        }
+      }
+    },
+    "authProvider": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "description": "The Google Identity Platform provider ID",
+          "type": "string",
+          "example": "google.com"
+        },
+        "samlClaims": {
+          "$ref": "#/definitions/samlProviderClaimsMap"
+        },
+        "signInOptions": {
+          "$ref": "#/definitions/signInOptions"
+        },
+        "whitelisted": {
+          "description": "True if every user from this provider has access",
+          "type": "boolean"
+        }
+      }
+    },
+    "authProviders": {
+      "description": "The authentication provider configuration, in the order they are shown",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/authProvider"
+      }
+    },
+    "commitPolicy": {
+      "type": "object",
+      "required": [
+        "rules"
+      ],
+      "properties": {
+        "rules": {
+          "description": "The rules enforced when a plan is committed. complete - applicable practices have every question answered, which is always enforced; na-notes - N/A answers have notes; target-level-issues - tasks answered No at a practice's target level, the one above its current maturity, have an issue unless their risk is accepted; priority-task - at least one task is prioritised; no-future-date - the plan isn't dated in the future.\n",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "complete",
+              "na-notes",
+              "target-level-issues",
+              "priority-task",
+              "no-future-date"
+            ]
+          }
+        }
+      },
+      "x-go-type": {
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "CommitPolicy"
+      }
+    },
+    "currentUser": {
+      "type": "object",
+      "required": [
+        "uid",
+        "roles",
+        "permissions"
+      ],
+      "properties": {
+        "email": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "description": "The permissions granted by the user's roles",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "roles": {
+          "description": "The user's effective roles, including any defaults",
+          "$ref": "#/definitions/roles"
+        },
+        "uid": {
+          "type": "string"
+        }
+      }
+    },
+    "dataClassification": {
+      "description": "The most sensitive class of data the project handles",
+      "type": "string",
+      "enum": [
+        "public",
+        "internal",
+        "confidential",
+        "restricted"
+      ]
+    },
+    "dsseEnvelope": {
+      "description": "A DSSE envelope, see https://github.com/secure-systems-lab/dsse",
+      "type": "object",
+      "required": [
+        "payloadType",
+        "payload",
+        "signatures"
+      ],
+      "properties": {
+        "payload": {
+          "description": "The base64 encoded payload",
+          "type": "string"
+        },
+        "payloadType": {
+          "type": "string"
+        },
+        "signatures": {
+          "type": "array",
+          "items": {
+            "type": "object",
+            "required": [
+              "sig"
+            ],
+            "properties": {
+              "keyid": {
+                "type": "string"
+              },
+              "sig": {
+                "description": "The base64 encoded signature",
+                "type": "string"
+              }
+            },
+            "additionalProperties": false
+          }
+        }
+      },
+      "additionalProperties": false
+    },
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "message": {
+          "type": "string"
+        },
+        "violations": {
+          "description": "The reasons a plan can't be committed, when that's the error",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyViolation"
+          },
+          "x-omitempty": true
+        }
+      }
+    },
+    "gotPractices": {
+      "type": "object",
+      "required": [
+        "version",
+        "practices"
+      ],
+      "properties": {
+        "practices": {
+          "description": "The practices indexed by their ID",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/practice"
+          }
+        },
+        "version": {
+          "type": "string"
+        }
+      }
+    },
+    "linkedIssue": {
+      "type": "object",
+      "required": [
+        "url",
+        "closed"
+      ],
+      "properties": {
+        "closed": {
+          "type": "boolean"
+        },
+        "error": {
+          "description": "Why the issue couldn't be fetched",
+          "type": "string"
+        },
+        "state": {
+          "description": "The issue's state, as named by its tracker",
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        },
+        "tracker": {
+          "description": "The name of the issue tracker, empty if none of them handle the URL",
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
+        }
+      },
+      "x-go-type": {
+        "hints": {
+          "noValidation": true
+        },
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "LinkedIssue"
+      }
+    },
+    "managedUser": {
+      "description": "A user, as seen by security admins managing access",
+      "type": "object",
+      "required": [
+        "uid",
+        "manuallyAuthorized"
+      ],
+      "properties": {
+        "deactivated": {
+          "description": "Deactivated users don't have access, even if their provider or an access rule would grant it",
+          "type": "boolean"
+        },
+        "disabled": {
+          "description": "Whether the user's account is disabled in the identity platform",
+          "type": "boolean"
+        },
+        "email": {
+          "type": "string"
+        },
+        "emailVerified": {
+          "type": "boolean"
+        },
+        "externalId": {
+          "description": "The user's ID in the identity provider that provisioned them over SCIM",
+          "type": "string"
+        },
+        "manuallyAuthorized": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "provider": {
+          "description": "The identity provider the user signs in with, \"multiple\" if they have linked several, or empty if it isn't known",
+          "type": "string"
+        },
+        "roles": {
+          "$ref": "#/definitions/roles"
+        },
+        "trustedDomain": {
+          "description": "Whether the user's email address is from one of the deployment's trusted domains",
+          "type": "boolean"
+        },
+        "uid": {
+          "type": "string"
+        }
+      }
+    },
+    "managedUserList": {
+      "type": "object",
+      "required": [
+        "users"
+      ],
+      "properties": {
+        "nextPageToken": {
+          "description": "Set if there are more users to list",
+          "type": "string"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/managedUser"
+          }
+        }
+      }
+    },
+    "newApiToken": {
+      "type": "object",
+      "required": [
+        "token",
+        "secret"
+      ],
+      "properties": {
+        "secret": {
+          "description": "The bearer token to authenticate with. It can't be retrieved again.",
+          "type": "string"
+        },
+        "token": {
+          "$ref": "#/definitions/apiToken"
+        }
+      }
+    },
+    "newWebhook": {
+      "type": "object",
+      "required": [
+        "webhook",
+        "secret"
+      ],
+      "properties": {
+        "secret": {
+          "description": "The key for the HMAC-SHA256 signature in each request's X-BeSec-Signature header. It can't be retrieved again.\n",
+          "type": "string"
+        },
+        "webhook": {
+          "$ref": "#/definitions/webhook"
+        }
+      }
+    },
+    "oidcClaimsMap": {
+      "description": "The ID token claims that hold each user attribute, where they differ from the standard claims",
+      "type": "object",
+      "properties": {
+        "department": {
+          "type": "string"
+        },
+        "email": {
+          "description": "Defaults to email",
+          "type": "string"
+        },
+        "emailVerified": {
+          "description": "Defaults to email_verified",
+          "type": "string"
+        },
+        "name": {
+          "description": "Defaults to name",
+          "type": "string"
+        },
+        "pictureURL": {
+          "description": "Defaults to picture",
+          "type": "string"
+        },
+        "uid": {
+          "description": "Defaults to sub",
+          "type": "string"
+        }
+      }
+    },
+    "oidcConfig": {
+      "description": "A standard OpenID Connect identity provider, such as Keycloak",
+      "type": "object",
+      "required": [
+        "issuer",
+        "clientId"
+      ],
+      "properties": {
+        "audience": {
+          "description": "The audience ID tokens must be issued for. Defaults to the client ID.",
+          "type": "string"
+        },
+        "claims": {
+          "$ref": "#/definitions/oidcClaimsMap"
+        },
+        "clientId": {
+          "description": "The client ID the UI uses to sign in",
+          "type": "string"
+        },
+        "id": {
+          "description": "Identifies the provider in user records. Defaults to \"oidc\".",
+          "type": "string"
+        },
+        "issuer": {
+          "description": "The issuer URL. The provider's configuration is discovered from \u003cissuer\u003e/.well-known/openid-configuration",
+          "type": "string"
+        },
+        "providerName": {
+          "description": "The name shown on the sign in button",
+          "type": "string"
+        },
+        "scopes": {
+          "description": "The scopes the UI requests. Defaults to openid, email and profile.",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "whitelisted": {
+          "description": "True if every user from this provider has access",
+          "type": "boolean"
+        }
+      }
+    },
+    "openTask": {
+      "type": "object",
+      "required": [
+        "projectId",
+        "project",
+        "planId",
+        "revisionId",
+        "practiceId",
+        "taskId",
+        "status"
+      ],
+      "properties": {
+        "assignee": {
+          "type": "string"
+        },
+        "issues": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "overdue": {
+          "description": "Whether the target date has passed",
+          "type": "boolean"
+        },
+        "planId": {
+          "type": "string"
+        },
+        "practice": {
+          "description": "The practice's name",
+          "type": "string"
+        },
+        "practiceId": {
+          "type": "string"
+        },
+        "project": {
+          "description": "The project's name",
+          "type": "string"
+        },
+        "projectId": {
+          "type": "string"
+        },
+        "revisionId": {
+          "description": "The plan revision the task's details come from",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "planned",
+            "in-progress"
+          ]
+        },
+        "targetDate": {
+          "type": "string"
+        },
+        "taskId": {
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        }
+      }
+    },
+    "orgUnit": {
+      "description": "Org units, such as business units and product lines, form a tree that projects belong to",
+      "type": "object",
+      "required": [
+        "id",
+        "attributes"
+      ],
+      "properties": {
+        "attributes": {
+          "$ref": "#/definitions/orgUnitDetails"
+        },
+        "id": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "orgUnitDetails": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "cadenceDays": {
+          "description": "How often, in days, projects in the unit and the units below it should commit a new plan, unless they set their own",
+          "type": "integer"
+        },
+        "description": {
+          "type": "string"
+        },
+        "leads": {
+          "description": "The UIDs of the unit's security leads, who can manage it and the units below it",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
+          "type": "string",
+          "minLength": 1
+        },
+        "parent": {
+          "description": "The ID of the parent org unit. Top-level units don't have a parent.",
+          "type": "string"
+        }
+      }
+    },
+    "orgUnitMaturity": {
+      "description": "The maturity of the projects in an org unit and all of its descendants",
+      "type": "object",
+      "required": [
+        "orgUnit",
+        "name",
+        "projects",
+        "practices"
+      ],
+      "properties": {
+        "name": {
+          "type": "string"
+        },
+        "orgUnit": {
+          "description": "The org unit's ID",
+          "type": "string"
+        },
+        "parent": {
+          "type": "string"
+        },
+        "practices": {
+          "description": "Keyed on practice ID. Only practices with a calculated maturity in at least one project are present.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/practiceMaturity"
+          }
+        },
+        "projects": {
+          "description": "The number of projects in the unit and its descendants",
+          "type": "integer"
+        }
+      }
+    },
+    "outboxMessage": {
+      "description": "A notification to be delivered to a channel",
+      "type": "object",
+      "required": [
+        "id",
+        "channel",
+        "event",
+        "status",
+        "attempts",
+        "created"
+      ],
+      "properties": {
+        "attempts": {
+          "type": "integer"
+        },
+        "channel": {
+          "type": "string"
+        },
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "delivered": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "event": {
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "key": {
+          "description": "Notifications with the same key are only sent to a channel once an hour",
+          "type": "string"
+        },
+        "lastError": {
+          "type": "string"
+        },
+        "nextAttempt": {
+          "description": "When delivery will next be attempted, if it's pending",
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ]
+        },
+        "subject": {
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        }
+      }
+    },
+    "overdueProject": {
+      "type": "object",
+      "required": [
+        "projectId",
+        "name",
+        "cadenceDays"
+      ],
+      "properties": {
+        "cadenceDays": {
+          "type": "integer"
+        },
+        "daysOverdue": {
+          "type": "integer"
+        },
+        "due": {
+          "description": "The date a new plan was due (YYYY-MM-DD), not set if the project has never committed one",
+          "type": "string"
+        },
+        "lastCommitted": {
+          "description": "The date of the project's latest committed plan, not set if it has never committed one",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "orgUnit": {
+          "type": "string"
+        },
+        "owners": {
+          "description": "The email addresses of the project's owners",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "projectId": {
+          "type": "string"
+        }
+      }
+    },
+    "plan": {
+      "description": "The plan with the details from its latest revision",
+      "type": "object",
+      "required": [
+        "id",
+        "attributes"
+      ],
+      "properties": {
+        "attributes": {
+          "$ref": "#/definitions/planDetails"
+        },
+        "id": {
+          "type": "string",
+          "readOnly": true
+        }
+      }
+    },
+    "planChain": {
+      "description": "The integrity of a plan's revision history. Each revision includes a hash of its content and of the previous revision, so editing, removing or reordering revisions after they were created is detected.\n",
+      "type": "object",
+      "required": [
+        "intact",
+        "revisions",
+        "problems"
+      ],
+      "properties": {
+        "head": {
+          "description": "The hash of the latest revision. Record it to detect the whole history later being rewritten.",
+          "type": "string"
+        },
+        "intact": {
+          "description": "True if every revision matches its hash and follows the one before it",
+          "type": "boolean"
+        },
+        "problems": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "revisions": {
+          "description": "The number of revisions checked",
+          "type": "integer"
+        },
+        "unchainedRevisions": {
+          "description": "The number of revisions created before hashing was introduced, which can't be checked",
+          "type": "integer"
+        }
+      },
+      "additionalProperties": false
+    },
+    "planDetails": {
+      "description": "The high level part of a plan, excluding the individual answers",
+      "type": "object",
+      "required": [
+        "date",
+        "committed",
+        "projects",
+        "maturity"
+      ],
+      "properties": {
+        "committed": {
+          "description": "When a plan is (believed to be) finished it is committed",
+          "type": "boolean",
+          "default": false
+        },
+        "date": {
+          "description": "The date this plan applies to (ISO short format)",
+          "type": "string",
+          "pattern": "^[0-9]{4}-[0-9]{2}-[0-9]{2}$"
+        },
+        "maturity": {
+          "description": "The calculated maturity level for each of the plan's practices",
+          "type": "object",
+          "additionalProperties": {
+            "description": "The calculated maturity for a practice of this plan",
+            "type": "integer"
+          },
+          "readOnly": true
+        },
+        "notes": {
+          "description": "Optional notes about this plan, for example further clarification on the project/team context.",
+          "type": "string"
+        },
+        "projects": {
+          "description": "The IDs of the projects to which this plan applies",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "minItems": 1
+          }
+        }
+      },
+      "x-go-type": {
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "PlanDetails"
+      }
+    },
+    "planReview": {
+      "description": "The review of a committed revision. Revisions committed while reviews were disabled don't have one.\n",
+      "type": "object",
+      "required": [
+        "state",
+        "comments"
+      ],
+      "properties": {
+        "comments": {
+          "description": "The review's history, earliest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/reviewComment"
+          }
+        },
+        "reviewed": {
+          "description": "When the revision was last approved or had changes requested",
+          "type": "string",
+          "format": "date-time"
+        },
+        "reviewer": {
+          "description": "The name of whoever last approved the revision or requested changes",
+          "type": "string"
+        },
+        "reviewerUid": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "submitted",
+            "approved",
+            "changes-requested"
+          ]
+        }
+      }
+    },
+    "policyViolation": {
+      "type": "object",
+      "required": [
+        "rule",
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string"
+        },
+        "practice": {
+          "type": "string"
+        },
+        "question": {
+          "type": "string"
+        },
+        "rule": {
+          "type": "string"
+        },
+        "task": {
+          "type": "string"
+        }
+      },
+      "x-go-type": {
+        "hints": {
+          "noValidation": true
+        },
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "PolicyViolation"
+      }
+    },
+    "practice": {
+      "description": "The API representation of a practice, a specification of tasks to perform. Note this is not identical to the file representation of a practice - see schema.json for that.",
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "tasks"
+      ],
+      "properties": {
+        "condition": {
+          "description": "If a practice has qualifying questions, then it will also need a condition explaining how to interpret the answers to those questions.\nIf the practice always applies, then don't specify this.\nThe syntax is a boolean expression, formed of question ids, !, \u0026\u0026, || and (brackets).",
+          "type": "string"
+        },
+        "id": {
+          "description": "The internal practice name. Use camelCase.",
+          "type": "string",
+          "pattern": "^[a-z]+[a-zA-Z0-9]*$"
+        },
+        "level0": {
+          "description": "A description of a project that doesn't meet level 1 of the practice",
+          "type": "object",
+          "required": [
+            "short"
+          ],
+          "properties": {
+            "long": {
+              "description": "An optional fuller explanation",
+              "type": "string"
+            },
+            "short": {
+              "description": "short should be written so it can fill in the sentence \"This project \u003cshort\u003e.\"",
+              "type": "string"
+            }
+          },
+          "additionalProperties": false
+        },
+        "name": {
+          "description": "The user-facing name of this practice",
+          "type": "string"
+        },
+        "notes": {
+          "description": "Optional user-facing notes about this practice, for example to explain any terminology used in the questions.",
+          "type": "string"
+        },
+        "page": {
+          "description": "A page describing this practice in more detail, or providing additional guidance.",
+          "type": "string",
+          "format": "uri"
+        },
+        "questions": {
+          "description": "A list of qualifying questions, that determine whether this practice applies at all to the project.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "description": "A qualifying question. You must specify an id for each question to be able to refer to it in the condition. If N/A is allowed as an answer, any answer of N/A will render a practice as not applicable, as the practice's condition become unevaluable.",
+            "$ref": "#/definitions/question"
+          }
+        },
+        "riskAcceptance": {
+          "description": "Whether tasks answered No whose risk has been formally accepted, and the acceptance hadn't expired by the plan's date, count toward this practice's maturity level. Practices without a policy ignore risk acceptances.\n",
+          "type": "string",
+          "enum": [
+            "ignored",
+            "counted"
+          ]
+        },
+        "tasks": {
+          "description": "The core of the practice - this is the list of things teams need to do.\nThe order matters - what is likely to be a more important task should come before a less important task.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/task"
+          }
+        }
+      },
+      "additionalProperties": false,
+      "x-go-type": {
+        "$comment": "Used by go-swagger to direct code generation to extend the existing type",
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "Practice"
+      }
+    },
+    "practiceMaturity": {
+      "type": "object",
+      "required": [
+        "median",
+        "min",
+        "projects"
+      ],
+      "properties": {
+        "median": {
+          "type": "number"
+        },
+        "min": {
+          "type": "integer"
+        },
+        "projects": {
+          "description": "The number of projects with a calculated maturity for this practice",
+          "type": "integer"
+        }
+      }
+    },
+    "practiceResponse": {
+      "type": "object",
+      "required": [
+        "tasks"
+      ],
+      "properties": {
+        "practice": {
+          "description": "Responses to the practice-level questions, keyed on question ID",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/answer"
+          }
+        },
+        "tasks": {
+          "description": "Responses to each task, keyed on task ID",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/taskResponse"
+          }
+        }
+      },
+      "additionalProperties": false,
+      "x-go-type": {
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "PracticeResponse"
+      }
+    },
+    "practiceResponses": {
+      "type": "object",
+      "required": [
+        "practicesVersion",
+        "practiceResponses"
+      ],
+      "properties": {
+        "practiceResponses": {
+          "description": "The responses to each of the practice questions, keyed on practice id",
+          "type": "object",
+          "additionalProperties": {
+            "description": "A response to a practice's questions",
+            "$ref": "#/definitions/practiceResponse"
+          }
+        },
+        "practicesVersion": {
+          "description": "The version of the practices used for this response",
+          "type": "string"
+        }
+      },
+      "x-go-type": {
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "PlanResponses"
+      }
+    },
+    "productType": {
+      "description": "What kind of product the project delivers",
+      "type": "string",
+      "enum": [
+        "saas",
+        "onPremises",
+        "desktop",
+        "mobile",
+        "embedded",
+        "library",
+        "internalTool"
+      ]
+    },
+    "project": {
+      "description": "Projects are containers for plans",
+      "type": "object",
+      "required": [
+        "id",
+        "attributes",
+        "plans"
+      ],
+      "properties": {
+        "attributes": {
+          "$ref": "#/definitions/projectDetails"
+        },
+        "id": {
+          "type": "string",
+          "readOnly": true
+        },
+        "members": {
+          "description": "The project's owners and members. Only they can create revisions of the project's plans.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/projectMember"
+          },
+          "readOnly": true
+        },
+        "plans": {
+          "description": "The plan IDs associated with this project",
+          "type": "array",
+          "items": {
+            "type": "string"
+          },
+          "readOnly": true
+        }
+      }
+    },
+    "projectDetails": {
+      "type": "object",
+      "required": [
+        "name"
+      ],
+      "properties": {
+        "cadenceDays": {
+          "description": "How often, in days, the project should commit a new plan; 0 if it shouldn't be tracked. If not set, the cadence of its org unit, or the nearest unit above that has one, applies, and otherwise the server's default.\n",
+          "type": "integer"
+        },
+        "dataClassification": {
+          "$ref": "#/definitions/dataClassification"
+        },
+        "description": {
+          "description": "Further information about the project, for example what teams and products are considered in scope",
+          "type": "string"
+        },
+        "internetFacing": {
+          "description": "Whether any part of the project is reachable from the internet",
+          "type": "boolean"
+        },
+        "name": {
+          "description": "short name",
+          "type": "string",
+          "minLength": 1
+        },
+        "orgUnit": {
+          "description": "The ID of the org unit the project belongs to",
+          "type": "string"
+        },
+        "productType": {
+          "$ref": "#/definitions/productType"
+        },
+        "tags": {
+          "description": "Free-form labels for the project",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "techStack": {
+          "description": "The languages, frameworks and platforms the project uses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "projectMember": {
+      "type": "object",
+      "required": [
+        "uid",
+        "role"
+      ],
+      "properties": {
+        "email": {
+          "type": "string"
+        },
+        "name": {
+          "description": "The user's display name when they were added",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/projectMemberRole"
+        },
+        "uid": {
+          "type": "string"
+        }
+      }
+    },
+    "projectMemberRole": {
+      "description": "Owners are responsible for the project, and can manage its members and delete it. Members can contribute to its plans.",
+      "type": "string",
+      "enum": [
+        "owner",
+        "member"
+      ]
+    },
+    "question": {
+      "type": "object",
+      "required": [
+        "text"
+      ],
+      "properties": {
+        "answers": {
+          "description": "A set of allowed answers.",
+          "type": "array",
+          "default": [
+            "Yes",
+            "No"
+          ],
+          "items": {
+            "description": "An allowed answer"
+          }
+        },
+        "id": {
+          "description": "A reference to be able to refer to the question.\nCan be omitted if there is only one question in a task, in which case the question ID defaults to the task ID.\nMust be unique within a practice.",
+          "type": "string",
+          "pattern": "^[a-z]+[a-zA-Z0-9]*$"
+        },
+        "na": {
+          "description": "Whether to allow N/A as an answer.",
+          "type": "boolean",
+          "default": true
+        },
+        "text": {
+          "description": "The question to ask",
+          "type": "string"
+        }
+      },
+      "additionalProperties": false
+    },
+    "reviewComment": {
+      "type": "object",
+      "required": [
+        "author",
+        "authorUid",
+        "time"
+      ],
+      "properties": {
+        "author": {
+          "type": "string"
+        },
+        "authorUid": {
+          "type": "string"
+        },
+        "decision": {
+          "description": "The decision made with the comment, if any",
+          "type": "string",
+          "enum": [
+            "approved",
+            "changes-requested"
+          ]
+        },
+        "text": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "reviewSummary": {
+      "type": "object",
+      "required": [
+        "planId",
+        "revisionId",
+        "projects",
+        "date",
+        "review"
+      ],
+      "properties": {
+        "author": {
+          "description": "Who committed the revision",
+          "type": "string"
+        },
+        "committed": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "date": {
+          "description": "The plan's date",
+          "type": "string"
+        },
+        "planId": {
+          "type": "string"
+        },
+        "projects": {
+          "description": "The names of the plan's projects",
+          "type": "string"
+        },
+        "review": {
+          "$ref": "#/definitions/planReview"
+        },
+        "revisionId": {
+          "type": "string"
+        }
+      }
+    },
+    "revisionVersion": {
+      "type": "object",
+      "required": [
+        "revId",
+        "version"
+      ],
+      "properties": {
+        "hash": {
+          "description": "The hash of this revision, covering its content and the hash of the previous revision. Empty for revisions created before hashing was introduced.",
+          "type": "string"
+        },
+        "planId": {
+          "description": "Plan this version is associated with",
+          "type": "string"
+        },
+        "revId": {
+          "description": "revision ID of this version",
+          "type": "string"
+        },
+        "review": {
+          "$ref": "#/definitions/planReview"
+        },
+        "version": {
+          "$ref": "#/definitions/version"
+        }
+      },
+      "additionalProperties": false
+    },
+    "riskAcceptance": {
+      "description": "A decision not to do a task for now, accepting the risk until it expires, when it should be reviewed",
+      "type": "object",
+      "required": [
+        "justification",
+        "expires"
+      ],
+      "properties": {
+        "approver": {
+          "description": "Who approved the acceptance, reminded when it expires if it's an email address. This is set when the acceptance is approved, and removed if its justification or expiry change; any value given in a revision is ignored.\n",
+          "type": "string"
+        },
+        "approverUid": {
+          "description": "The approver's user ID, set when the acceptance is approved",
+          "type": "string"
+        },
+        "expires": {
+          "description": "When the acceptance should be reviewed (ISO short format)",
+          "type": "string",
+          "pattern": "^[0-9]{4}-[0-9]{2}-[0-9]{2}$"
+        },
+        "justification": {
+          "description": "Why the risk of not doing the task is acceptable",
+          "type": "string"
+        }
+      },
+      "x-go-type": {
+        "hints": {
+          "noValidation": true
+        },
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "RiskAcceptance"
+      }
+    },
+    "role": {
+      "description": "A role grants a set of permissions:\n  - viewer: view projects, plans and practices\n  - projectContributor: as viewer, and create and update projects and plans\n  - projectOwner: as projectContributor, and delete projects and plans\n  - securityAdmin: everything, including managing users\n  - auditor: as viewer, and view audit information\n  - securityReviewer: as viewer, and review committed plans",
+      "type": "string",
+      "enum": [
+        "viewer",
+        "projectContributor",
+        "projectOwner",
+        "securityAdmin",
+        "auditor",
+        "securityReviewer"
+      ]
+    },
+    "roles": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/role"
+      }
+    },
+    "samlProviderClaimsMap": {
+      "description": "A mapping from SAML claims to fields used within the app. Presence indicates this provider is a SAML provider.",
+      "type": "object",
+      "required": [
+        "email"
+      ],
+      "properties": {
+        "department": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string"
+        },
+        "firstName": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "pictureURL": {
+          "type": "string"
+        },
+        "surname": {
+          "type": "string"
+        }
+      }
+    },
+    "signInOptions": {
+      "description": "Firebase signin options, original type defined at firebaseui.auth.Config['signInOptions']",
+      "type": "object",
+      "properties": {
+        "buttonColor": {
+          "type": "string",
+          "example": "#5DBFD4"
+        },
+        "iconUrl": {
+          "type": "string"
+        },
+        "providerName": {
+          "description": "User facing name for this option",
+          "type": "string"
+        }
+      }
+    },
+    "subscription": {
+      "description": "A user's request to be notified of a project's events",
+      "type": "object",
+      "required": [
+        "projectId",
+        "email",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "email": {
+          "description": "Where the notifications are sent",
+          "type": "string"
+        },
+        "events": {
+          "description": "The event types the user is notified of; every event if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "projectId": {
+          "type": "string"
+        }
+      }
+    },
+    "task": {
+      "description": "A self-contained description of an activity that will improve product security.",
+      "type": "object",
+      "required": [
+        "id",
+        "title",
+        "description",
+        "level",
+        "questions"
+      ],
+      "properties": {
+        "description": {
+          "description": "The full definition of what the task is. Try to include *why* this is a useful thing to do.",
+          "type": "string"
+        },
+        "id": {
+          "description": "A reference for the task, unique within the practice, not visible to users. Use camelCase.",
+          "type": "string",
+          "pattern": "^[a-z]+[a-zA-Z0-9]*$"
+        },
+        "level": {
+          "description": "If a team is performing all of the tasks of a given level, their maturity rating for this practice is considered to be at that level.\nLevel 5 is reserved for teams going beyond the activities described in the practice.\nIf there are less than four levels, the order they should be introduced is: 4, 1, 2, 3.",
+          "type": "integer",
+          "maximum": 4,
+          "minimum": 1
+        },
+        "questions": {
+          "description": "Questions to determine whether or not the team already does this task.\nIf the team answer yes to all of these questions, then we assume this task is being performed.",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "description": "A Yes/No question to determine if the team is already doing this task.",
+            "$ref": "#/definitions/question"
+          }
+        },
+        "title": {
+          "description": "A short summary of the task. Try and use the imperative tense.",
+          "type": "string"
+        }
+      },
+      "additionalProperties": false
+    },
+    "taskResponse": {
+      "description": "The answers to a task's questions and additional data related to planning and execution.",
+      "type": "object",
+      "required": [
+        "answers"
+      ],
+      "properties": {
+        "answers": {
+          "description": "Responses to the task questions, keyed on task ID",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/answer"
+          }
+        },
+        "assignee": {
+          "description": "Who is responsible for implementing this task, if it's a priority",
+          "type": "string"
+        },
+        "issues": {
+          "type": "array",
+          "items": {
+            "description": "An issue URL that is being used to track and implement (a part of) this task",
+            "type": "string"
+          }
+        },
+        "linkedIssues": {
+          "description": "The state of each of the task's issues in its issue tracker, if requested. Not stored.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/linkedIssue"
+          },
+          "readOnly": true
+        },
+        "priority": {
+          "description": "Whether this task has been chosen as a priority to work on",
+          "type": "boolean"
+        },
+        "reassess": {
+          "description": "Whether every linked issue is closed but the task isn't answered Yes, so it should be re-assessed. Only set when linked issues are requested.",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "references": {
+          "description": "A description of how/where to find the current implementation of this task",
+          "type": "string"
+        },
+        "riskAcceptance": {
+          "$ref": "#/definitions/riskAcceptance"
+        },
+        "status": {
+          "description": "The progress of work on this task. A prioritised task without a status is planned.",
+          "type": "string",
+          "enum": [
+            "planned",
+            "in-progress",
+            "done",
+            "dropped"
+          ]
+        },
+        "targetDate": {
+          "description": "When this task should be done by (ISO short format)",
+          "type": "string",
+          "pattern": "^[0-9]{4}-[0-9]{2}-[0-9]{2}$"
+        }
+      },
+      "additionalProperties": false,
+      "x-go-type": {
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "TaskResponse"
+      }
+    },
+    "version": {
+      "type": "object",
+      "required": [
+        "time",
+        "author"
+      ],
+      "properties": {
+        "author": {
+          "type": "object",
+          "required": [
+            "name",
+            "uid"
+          ],
+          "properties": {
+            "name": {
+              "description": "The user's display name",
+              "type": "string"
+            },
+            "pictureUrl": {
+              "description": "A profile image",
+              "type": "string"
+            },
+            "uid": {
+              "description": "A site-wide unique ID",
+              "type": "string"
+            }
+          },
+          "additionalProperties": false,
+          "readOnly": true
+        },
+        "time": {
+          "description": "The time this version was created",
+          "type": "string",
+          "format": "date-time",
+          "readOnly": true
+        }
+      },
+      "additionalProperties": false
+    },
+    "webhook": {
+      "description": "An outbound webhook",
+      "type": "object",
+      "required": [
+        "id",
+        "url",
+        "events",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "events": {
+          "description": "The event types sent to the webhook, e.g. plan.committed, project.* or *",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "string",
+          "readOnly": true
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "webhookRequest": {
+      "type": "object",
+      "required": [
+        "url",
+        "events"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "events": {
+          "description": "The event types to send, e.g. plan.committed, project.* or *",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "url": {
+          "description": "An http or https URL to POST events to",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "webhookTestResult": {
+      "type": "object",
+      "required": [
+        "delivered"
+      ],
+      "properties": {
+        "delivered": {
+          "type": "boolean"
+        },
+        "error": {
+          "type": "string"
+        },
+        "statusCode": {
+          "description": "The HTTP status of the webhook's response, if it responded",
+          "type": "integer"
+        }
+      }
+    }
+  },
+  "parameters": {
+    "createRevision": {
+      "name": "body",
+      "in": "body",
+      "schema": {
+        "type": "object",
+        "required": [
+          "details",
+          "responses"
+        ],
+        "properties": {
+          "details": {
+            "$ref": "#/definitions/planDetails"
+          },
+          "responses": {
+            "$ref": "#/definitions/practiceResponses"
+          }
+        }
+      }
+    },
+    "dataClassificationFilter": {
+      "enum": [
+        "public",
+        "internal",
+        "confidential",
+        "restricted"
+      ],
+      "type": "string",
+      "description": "Only include projects handling data of this classification",
+      "name": "dataClassification",
+      "in": "query"
+    },
+    "internetFacingFilter": {
+      "type": "boolean",
+      "description": "Only include projects that are (or aren't) internet facing",
+      "name": "internetFacing",
+      "in": "query"
+    },
+    "productTypeFilter": {
+      "enum": [
+        "saas",
+        "onPremises",
+        "desktop",
+        "mobile",
+        "embedded",
+        "library",
+        "internalTool"
+      ],
+      "type": "string",
+      "description": "Only include projects of this product type",
+      "name": "productType",
+      "in": "query"
+    },
+    "tagFilter": {
       "type": "array",
       "items": {
-        "$ref": "#/definitions/authProvider"
+        "type": "string"
+      },
+      "collectionFormat": "multi",
+      "description": "Only include projects with all of these tags",
+      "name": "tag",
+      "in": "query"
+    },
+    "techFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "collectionFormat": "multi",
+      "description": "Only include projects using all of these technologies",
+      "name": "tech",
+      "in": "query"
+    }
+  },
+  "securityDefinitions": {
+    "key": {
+      "type": "apiKey",
+      "name": "Authorization",
+      "in": "header"
+    }
+  },
+  "security": [
+    {
+      "key": []
+    }
+  ]
+}`))
+	FlatSwaggerJSON = json.RawMessage([]byte(`{
+  "consumes": [
+    "application/json"
+  ],
+  "produces": [
+    "application/json"
+  ],
+  "schemes": [
+    "http"
+  ],
+  "swagger": "2.0",
+  "info": {
+    "title": "BeSec",
+    "version": "v1alpha1"
+  },
+  "basePath": "/v1alpha1",
+  "paths": {
+    "/access-request": {
+      "get": {
+        "description": "The caller's request for access, if they have made one. Users that don't yet have access can use this.",
+        "operationId": "getMyAccessRequest",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/accessRequest"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Ask the security team for access, explaining why it's needed. Users that don't yet have access can use this. A pending request's justification is replaced; a denied request is reopened.\n",
+        "operationId": "requestAccess",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/accessRequestBody"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/accessRequest"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/access-requests": {
+      "get": {
+        "description": "Requests for access, most recent first",
+        "operationId": "listAccessRequests",
+        "parameters": [
+          {
+            "enum": [
+              "pending",
+              "approved",
+              "denied"
+            ],
+            "type": "string",
+            "description": "Only list requests with this status",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/accessRequest"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/access-requests/{uid}/approve": {
+      "post": {
+        "description": "Grant the user access, by manually authorizing them",
+        "operationId": "approveAccessRequest",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/accessDecision"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/accessRequest"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/access-requests/{uid}/deny": {
+      "post": {
+        "operationId": "denyAccessRequest",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/accessDecision"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/accessRequest"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/audit": {
+      "get": {
+        "description": "The audit log of changes made through the API and the CLI, most recent first. Requires the audit permission.\n",
+        "operationId": "listAuditEvents",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "The UID of the user or service account that made the changes",
+            "name": "actor",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "e.g. project.delete",
+            "name": "action",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "e.g. project",
+            "name": "targetType",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "targetId",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "since",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "format": "date-time",
+            "name": "until",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of events to return, 100 if not set",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/auditEvent"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/auth": {
+      "get": {
+        "security": [],
+        "operationId": "getAuthConfig",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/authConfig"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Used to trigger one-time events like requesting access. Clients should hit this once after obtaining an ID token, and can use or ignore the response.",
+        "operationId": "loggedIn",
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "No access, access requested, invalid ID token, or internal error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/commit-policy": {
+      "get": {
+        "description": "Get the rules, besides every applicable question being answered, that plans must meet to be committed",
+        "operationId": "getCommitPolicy",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/commitPolicy"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Replace the rules plans must meet to be committed. Revisions that are already committed aren't affected.",
+        "operationId": "setCommitPolicy",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/commitPolicy"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/commitPolicy"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/me": {
+      "get": {
+        "description": "The authenticated user, and what they are allowed to do",
+        "operationId": "getCurrentUser",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/currentUser"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/metrics/maturity": {
+      "get": {
+        "description": "Roll the maturity of each project up the org unit tree. Each project's maturity is taken from the most recent committed revision of its plans, and each org unit summarises the projects in it and all of its descendants.\n",
+        "operationId": "getMaturityMetrics",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only report on this org unit and its descendants",
+            "name": "orgUnit",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only include projects with all of these tags",
+            "name": "tag",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "saas",
+              "onPremises",
+              "desktop",
+              "mobile",
+              "embedded",
+              "library",
+              "internalTool"
+            ],
+            "type": "string",
+            "description": "Only include projects of this product type",
+            "name": "productType",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only include projects that are (or aren't) internet facing",
+            "name": "internetFacing",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "public",
+              "internal",
+              "confidential",
+              "restricted"
+            ],
+            "type": "string",
+            "description": "Only include projects handling data of this classification",
+            "name": "dataClassification",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only include projects using all of these technologies",
+            "name": "tech",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/orgUnitMaturity"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/notifications": {
+      "get": {
+        "description": "The notification outbox: notifications waiting to be delivered to each channel, and those recently delivered or given up on, most recently created first. Requires the admin permission.\n",
+        "operationId": "listNotifications",
+        "parameters": [
+          {
+            "enum": [
+              "pending",
+              "delivered",
+              "failed"
+            ],
+            "type": "string",
+            "name": "status",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of messages to return, 100 if not set",
+            "name": "limit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/outboxMessage"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/orgunit": {
+      "get": {
+        "operationId": "listOrgUnits",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/orgUnit"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create an org unit. Top-level units can only be created by security admins; other units by the leads of any of their ancestors.",
+        "operationId": "createOrgUnit",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/orgUnitDetails"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "description": "The ID of the org unit",
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/orgunit/{id}": {
+      "get": {
+        "operationId": "getOrgUnit",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/orgUnit"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "put": {
+        "description": "Update an org unit. Only security admins and the leads of the unit or any of its ancestors can change it.",
+        "operationId": "updateOrgUnit",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/orgUnitDetails"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete an org unit. It can't have any child units or projects.",
+        "operationId": "deleteOrgUnit",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/overdue-projects": {
+      "get": {
+        "description": "Projects whose latest committed plan is older than their cadence, or that have never committed one, most overdue first. Projects without a cadence aren't included.\n",
+        "operationId": "listOverdueProjects",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only include projects in this org unit or the units below it",
+            "name": "orgUnit",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/overdueProject"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/plan": {
+      "post": {
+        "description": "Create a plan with its first revision. A committed revision must meet the commit policy, otherwise the error lists the violations.\n",
+        "operationId": "createPlan",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "required": [
+                "details",
+                "responses"
+              ],
+              "properties": {
+                "details": {
+                  "$ref": "#/definitions/planDetails"
+                },
+                "responses": {
+                  "$ref": "#/definitions/practiceResponses"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "type": "object",
+              "required": [
+                "planId",
+                "revisionId"
+              ],
+              "properties": {
+                "planId": {
+                  "description": "The ID of the plan",
+                  "type": "string"
+                },
+                "revisionId": {
+                  "description": "The revision ID of the initial revision",
+                  "type": "string"
+                }
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
-    "error": {
-      "type": "object",
-      "required": [
-        "message"
-      ],
-      "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int64"
+    "/plan/{id}": {
+      "get": {
+        "operationId": "getPlan",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Check the integrity of the plan's revision history, reporting it in the chain property",
+            "name": "verifyChain",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "object",
+              "required": [
+                "plan",
+                "latestRevision"
+              ],
+              "properties": {
+                "chain": {
+                  "$ref": "#/definitions/planChain"
+                },
+                "latestRevision": {
+                  "description": "The ID of the latest revision of this plan",
+                  "type": "string"
+                },
+                "plan": {
+                  "$ref": "#/definitions/plan"
+                }
+              },
+              "additionalProperties": false
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Create a new revision of the plan. A committed revision must meet the commit policy, otherwise the error lists the violations.\n",
+        "operationId": "createPlanRevision",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "required": [
+                "details",
+                "responses"
+              ],
+              "properties": {
+                "details": {
+                  "$ref": "#/definitions/planDetails"
+                },
+                "responses": {
+                  "$ref": "#/definitions/practiceResponses"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "description": "The ID of the new revision",
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "delete": {
+        "description": "Delete this plan and all of the revisions associated with it",
+        "operationId": "deletePlan",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/practice/{practiceId}/task/{taskId}/issue": {
+      "post": {
+        "description": "Creates an issue for a prioritised task in the plan's latest revision, from the task's title and description, and links it to the task in a new revision of the plan. The new revision keeps the review state of the revision it's based on.\n",
+        "operationId": "createTaskIssue",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "type": "object",
+              "properties": {
+                "tracker": {
+                  "description": "The name of the issue tracker to use, if more than one is configured. Defaults to the first tracker that serves the plan's projects.",
+                  "type": "string"
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/linkedIssue"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "practiceId",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "taskId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/practice/{practiceId}/task/{taskId}/risk-acceptance/approval": {
+      "post": {
+        "description": "Approves the task's risk acceptance in the plan's latest revision, recording the approver in a new revision of the plan. This needs the review or admin permission, and approvers can't approve acceptances they wrote. The new revision's maturity includes the approval, and it keeps the review state of the revision it's based on.\n",
+        "operationId": "approveRiskAcceptance",
+        "responses": {
+          "201": {
+            "description": "Approved",
+            "schema": {
+              "description": "The ID of the new revision",
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "practiceId",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "taskId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/revision/{revId}": {
+      "get": {
+        "operationId": "getPlanRevision",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/planDetails"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "revId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/revision/{revId}/attestation": {
+      "get": {
+        "description": "Export a committed plan revision as an in-toto statement, signed in a DSSE envelope with the server's attestation key. The statement includes the plan's projects, date, practices version, maturity, and a digest of the full responses.\n",
+        "operationId": "getPlanRevisionAttestation",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/dsseEnvelope"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "revId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/revision/{revId}/responses": {
+      "get": {
+        "operationId": "getPlanRevisionPracticeResponses",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "Prepare the responses as the starting point for a new revision, answering Yes to the questions of tasks marked as done",
+            "name": "nextRevision",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Fetch the title and state of each task's issues from the configured issue trackers",
+            "name": "linkedIssues",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/practiceResponses"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
         },
-        "message": {
-          "type": "string"
+        {
+          "type": "string",
+          "name": "revId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/revision/{revId}/review": {
+      "post": {
+        "description": "Approve a committed revision that's been submitted for review, or request changes to it, optionally with a comment; this needs the review permission, and reviewers can't review their own revisions. Without a decision, just add a comment, which the plan's contributors can also do.\n",
+        "operationId": "reviewPlanRevision",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "comment": {
+                  "type": "string"
+                },
+                "decision": {
+                  "type": "string",
+                  "enum": [
+                    "approved",
+                    "changes-requested"
+                  ]
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/planReview"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        },
+        {
+          "type": "string",
+          "name": "revId",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/plan/{id}/versions": {
+      "get": {
+        "operationId": "getPlanVersions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "description": "all of the versions for this plan, in order from oldest to latest",
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/revisionVersion"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/practices": {
+      "get": {
+        "operationId": "listPracticesVersions",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "description": "All of the versions, from oldest to newest",
+              "type": "array",
+              "items": {
+                "type": "string"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/practices/{version}": {
+      "get": {
+        "operationId": "getPractices",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/gotPractices"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "description": "The version, or the special value \"latest\".",
+          "name": "version",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/project": {
+      "get": {
+        "description": "List projects, with those the user is an owner or member of first",
+        "operationId": "listProjects",
+        "parameters": [
+          {
+            "type": "boolean",
+            "default": false,
+            "description": "Only list projects the user is an owner or member of",
+            "name": "mine",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only include projects with all of these tags",
+            "name": "tag",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "saas",
+              "onPremises",
+              "desktop",
+              "mobile",
+              "embedded",
+              "library",
+              "internalTool"
+            ],
+            "type": "string",
+            "description": "Only include projects of this product type",
+            "name": "productType",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only include projects that are (or aren't) internet facing",
+            "name": "internetFacing",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "public",
+              "internal",
+              "confidential",
+              "restricted"
+            ],
+            "type": "string",
+            "description": "Only include projects handling data of this classification",
+            "name": "dataClassification",
+            "in": "query"
+          },
+          {
+            "type": "array",
+            "items": {
+              "type": "string"
+            },
+            "collectionFormat": "multi",
+            "description": "Only include projects using all of these technologies",
+            "name": "tech",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/project"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "operationId": "createProject",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/projectDetails"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "description": "The ID of the project",
+              "type": "string"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "gotPractices": {
-      "type": "object",
-      "required": [
-        "version",
-        "practices"
-      ],
-      "properties": {
-        "practices": {
-          "description": "The practices indexed by their ID",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/practice"
+    "/project/{id}": {
+      "get": {
+        "operationId": "getProject",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/project"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "version": {
-          "type": "string"
         }
-      }
-    },
-    "plan": {
-      "description": "The plan with the details from its latest revision",
-      "type": "object",
-      "required": [
-        "id",
-        "attributes"
-      ],
-      "properties": {
-        "attributes": {
-          "$ref": "#/definitions/planDetails"
-        },
-        "id": {
-          "type": "string",
-          "readOnly": true
+      },
+      "put": {
+        "operationId": "updateProject",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "schema": {
+              "$ref": "#/definitions/projectDetails"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
-      }
-    },
-    "planDetails": {
-      "description": "The high level part of a plan, excluding the individual answers",
-      "type": "object",
-      "required": [
-        "date",
-        "committed",
-        "projects",
-        "maturity"
-      ],
-      "properties": {
-        "committed": {
-          "description": "When a plan is (believed to be) finished it is committed",
-          "type": "boolean",
-          "default": false
-        },
-        "date": {
-          "description": "The date this plan applies to (ISO short format)",
-          "type": "string",
-          "pattern": "^[0-9]{4}-[0-9]{2}-[0-9]{2}$"
-        },
-        "maturity": {
-          "description": "The calculated maturity level for each of the plan's practices",
-          "type": "object",
-          "additionalProperties": {
-            "description": "The calculated maturity for a practice of this plan",
-            "type": "integer"
+      },
+      "delete": {
+        "operationId": "deleteProject",
+        "responses": {
+          "204": {
+            "description": "Deleted"
           },
-          "readOnly": true
-        },
-        "notes": {
-          "description": "Optional notes about this plan, for example further clarification on the project/team context.",
-          "type": "string"
-        },
-        "projects": {
-          "description": "The IDs of the projects to which this plan applies",
-          "type": "array",
-          "items": {
-            "type": "string",
-            "minItems": 1
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
-      "x-go-type": {
-        "import": {
-          "package": "github.com/ThalesGroup/besec/lib"
-        },
-        "type": "PlanDetails"
-      }
-    },
-    "practice": {
-      "description": "The API representation of a practice, a specification of tasks to perform. Note this is not identical to the file representation of a practice - see schema.json for that.",
-      "type": "object",
-      "required": [
-        "id",
-        "name",
-        "tasks"
-      ],
-      "properties": {
-        "condition": {
-          "description": "If a practice has qualifying questions, then it will also need a condition explaining how to interpret the answers to those questions.\nIf the practice always applies, then don't specify this.\nThe syntax is a boolean expression, formed of question ids, !, \u0026\u0026, || and (brackets).",
-          "type": "string"
-        },
-        "id": {
-          "description": "The internal practice name. Use camelCase.",
+      "parameters": [
+        {
           "type": "string",
-          "pattern": "^[a-z]+[a-zA-Z0-9]*$"
-        },
-        "level0": {
-          "description": "A description of a project that doesn't meet level 1 of the practice",
-          "type": "object",
-          "required": [
-            "short"
-          ],
-          "properties": {
-            "long": {
-              "description": "An optional fuller explanation",
-              "type": "string"
-            },
-            "short": {
-              "description": "short should be written so it can fill in the sentence \"This project \u003cshort\u003e.\"",
-              "type": "string"
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/project/{id}/members": {
+      "get": {
+        "operationId": "listProjectMembers",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/projectMember"
+              }
             }
           },
-          "additionalProperties": false
-        },
-        "name": {
-          "description": "The user-facing name of this practice",
-          "type": "string"
-        },
-        "notes": {
-          "description": "Optional user-facing notes about this practice, for example to explain any terminology used in the questions.",
-          "type": "string"
-        },
-        "page": {
-          "description": "A page describing this practice in more detail, or providing additional guidance.",
-          "type": "string",
-          "format": "uri"
-        },
-        "questions": {
-          "description": "A list of qualifying questions, that determine whether this practice applies at all to the project.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "description": "A qualifying question. You must specify an id for each question to be able to refer to it in the condition. If N/A is allowed as an answer, any answer of N/A will render a practice as not applicable, as the practice's condition become unevaluable.",
-            "$ref": "#/definitions/question"
-          }
-        },
-        "tasks": {
-          "description": "The core of the practice - this is the list of things teams need to do.\nThe order matters - what is likely to be a more important task should come before a less important task.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "$ref": "#/definitions/task"
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
-      "additionalProperties": false,
-      "x-go-type": {
-        "$comment": "Used by go-swagger to direct code generation to extend the existing type",
-        "import": {
-          "package": "github.com/ThalesGroup/besec/lib"
-        },
-        "type": "Practice"
-      }
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "practiceResponse": {
-      "type": "object",
-      "required": [
-        "tasks"
-      ],
-      "properties": {
-        "practice": {
-          "description": "Responses to the practice-level questions, keyed on question ID",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/answer"
+    "/project/{id}/members/{uid}": {
+      "put": {
+        "description": "Add a user to the project, or change their role in it. Only project owners and security admins can manage members.",
+        "operationId": "setProjectMember",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "required": [
+                "role"
+              ],
+              "properties": {
+                "role": {
+                  "$ref": "#/definitions/projectMemberRole"
+                }
+              }
+            }
           }
-        },
-        "tasks": {
-          "description": "Responses to each task, keyed on task ID",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/taskResponse"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       },
-      "additionalProperties": false,
-      "x-go-type": {
-        "import": {
-          "package": "github.com/ThalesGroup/besec/lib"
-        },
-        "type": "PracticeResponse"
-      }
-    },
-    "practiceResponses": {
-      "type": "object",
-      "required": [
-        "practicesVersion",
-        "practiceResponses"
-      ],
-      "properties": {
-        "practiceResponses": {
-          "description": "The responses to each of the practice questions, keyed on practice id",
-          "type": "object",
-          "additionalProperties": {
-            "description": "A response to a practice's questions",
-            "$ref": "#/definitions/practiceResponse"
+      "delete": {
+        "operationId": "removeProjectMember",
+        "responses": {
+          "204": {
+            "description": "Removed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "practicesVersion": {
-          "description": "The version of the practices used for this response",
-          "type": "string"
         }
       },
-      "x-go-type": {
-        "import": {
-          "package": "github.com/ThalesGroup/besec/lib"
-        },
-        "type": "PlanResponses"
-      }
-    },
-    "project": {
-      "description": "Projects are containers for plans",
-      "type": "object",
-      "required": [
-        "id",
-        "attributes",
-        "plans"
-      ],
-      "properties": {
-        "attributes": {
-          "$ref": "#/definitions/projectDetails"
-        },
-        "id": {
+      "parameters": [
+        {
           "type": "string",
-          "readOnly": true
-        },
-        "plans": {
-          "description": "The plan IDs associated with this project",
-          "type": "array",
-          "items": {
-            "type": "string"
-          },
-          "readOnly": true
-        }
-      }
-    },
-    "projectDetails": {
-      "type": "object",
-      "required": [
-        "name"
-      ],
-      "properties": {
-        "description": {
-          "description": "Further information about the project, for example what teams and products are considered in scope",
-          "type": "string"
+          "name": "id",
+          "in": "path",
+          "required": true
         },
-        "name": {
-          "description": "short name",
+        {
           "type": "string",
-          "minLength": 1
+          "name": "uid",
+          "in": "path",
+          "required": true
         }
-      }
+      ]
     },
-    "question": {
-      "type": "object",
-      "required": [
-        "text"
-      ],
-      "properties": {
-        "answers": {
-          "description": "A set of allowed answers.",
-          "type": "array",
-          "default": [
-            "Yes",
-            "No"
-          ],
-          "items": {
-            "description": "An allowed answer"
+    "/project/{id}/subscription": {
+      "get": {
+        "description": "Get the current user's subscription to the project's events",
+        "operationId": "getProjectSubscription",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/subscription"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "id": {
-          "description": "A reference to be able to refer to the question.\nCan be omitted if there is only one question in a task, in which case the question ID defaults to the task ID.\nMust be unique within a practice.",
-          "type": "string",
-          "pattern": "^[a-z]+[a-zA-Z0-9]*$"
-        },
-        "na": {
-          "description": "Whether to allow N/A as an answer.",
-          "type": "boolean",
-          "default": true
-        },
-        "text": {
-          "description": "The question to ask",
-          "type": "string"
         }
       },
-      "additionalProperties": false
-    },
-    "revisionVersion": {
-      "type": "object",
-      "required": [
-        "revId",
-        "version"
-      ],
-      "properties": {
-        "planId": {
-          "description": "Plan this version is associated with",
-          "type": "string"
-        },
-        "revId": {
-          "description": "revision ID of this version",
-          "type": "string"
-        },
-        "version": {
-          "$ref": "#/definitions/version"
+      "put": {
+        "description": "Notify the current user, by email, of the project's events. Replaces any existing subscription.",
+        "operationId": "subscribeToProject",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "type": "object",
+              "properties": {
+                "events": {
+                  "description": "The event types to be notified of, e.g. plan.committed or project.*; every event if empty",
+                  "type": "array",
+                  "items": {
+                    "type": "string"
+                  }
+                }
+              }
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/subscription"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       },
-      "additionalProperties": false
+      "delete": {
+        "operationId": "unsubscribeFromProject",
+        "responses": {
+          "204": {
+            "description": "Unsubscribed"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "samlProviderClaimsMap": {
-      "description": "A mapping from SAML claims to fields used within the app. Presence indicates this provider is a SAML provider.",
-      "type": "object",
-      "required": [
-        "email"
-      ],
-      "properties": {
-        "department": {
-          "type": "string"
-        },
-        "email": {
-          "type": "string"
-        },
-        "firstName": {
-          "type": "string"
-        },
-        "name": {
-          "type": "string"
-        },
-        "pictureURL": {
-          "type": "string"
-        },
-        "surname": {
-          "type": "string"
+    "/reviews": {
+      "get": {
+        "description": "Lists the plans whose latest committed revision is in the review state, submitted by default, oldest first. Use this as a reviewer's queue.\n",
+        "operationId": "listPlanReviews",
+        "parameters": [
+          {
+            "enum": [
+              "submitted",
+              "approved",
+              "changes-requested"
+            ],
+            "type": "string",
+            "name": "state",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/reviewSummary"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "signInOptions": {
-      "description": "Firebase signin options, original type defined at firebaseui.auth.Config['signInOptions']",
-      "type": "object",
-      "properties": {
-        "buttonColor": {
-          "type": "string",
-          "example": "#5DBFD4"
-        },
-        "iconUrl": {
-          "type": "string"
-        },
-        "providerName": {
-          "description": "User facing name for this option",
-          "type": "string"
+    "/risk-acceptances": {
+      "get": {
+        "description": "Lists the risk acceptances in each project's latest committed plan, soonest expiry first",
+        "operationId": "listRiskAcceptances",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only list the project's risk acceptances",
+            "name": "project",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only list acceptances that have expired (true) or haven't (false)",
+            "name": "expired",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/acceptedRisk"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       }
     },
-    "task": {
-      "description": "A self-contained description of an activity that will improve product security.",
-      "type": "object",
-      "required": [
-        "id",
-        "title",
-        "description",
-        "level",
-        "questions"
-      ],
-      "properties": {
-        "description": {
-          "description": "The full definition of what the task is. Try to include *why* this is a useful thing to do.",
-          "type": "string"
-        },
-        "id": {
-          "description": "A reference for the task, unique within the practice, not visible to users. Use camelCase.",
-          "type": "string",
-          "pattern": "^[a-z]+[a-zA-Z0-9]*$"
-        },
-        "level": {
-          "description": "If a team is performing all of the tasks of a given level, their maturity rating for this practice is considered to be at that level.\nLevel 5 is reserved for teams going beyond the activities described in the practice.\nIf there are less than four levels, the order they should be introduced is: 4, 1, 2, 3.",
-          "type": "integer",
-          "maximum": 4,
-          "minimum": 1
-        },
-        "questions": {
-          "description": "Questions to determine whether or not the team already does this task.\nIf the team answer yes to all of these questions, then we assume this task is being performed.",
-          "type": "array",
-          "minItems": 1,
-          "items": {
-            "description": "A Yes/No question to determine if the team is already doing this task.",
-            "$ref": "#/definitions/question"
+    "/tasks": {
+      "get": {
+        "description": "Lists the prioritised tasks that are planned or in progress, from the latest revision of each project's most recent plan, soonest target date first",
+        "operationId": "listOpenTasks",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only list the project's tasks",
+            "name": "project",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only list tasks assigned to this person",
+            "name": "assignee",
+            "in": "query"
+          },
+          {
+            "enum": [
+              "planned",
+              "in-progress"
+            ],
+            "type": "string",
+            "description": "Only list tasks with this status",
+            "name": "status",
+            "in": "query"
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/openTask"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "title": {
-          "description": "A short summary of the task. Try and use the imperative tense.",
-          "type": "string"
         }
-      },
-      "additionalProperties": false
+      }
     },
-    "taskResponse": {
-      "description": "The answers to a task's questions and additional data related to planning and execution.",
-      "type": "object",
-      "required": [
-        "answers"
-      ],
-      "properties": {
-        "answers": {
-          "description": "Responses to the task questions, keyed on task ID",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/answer"
+    "/tokens": {
+      "get": {
+        "description": "The caller's API tokens. Security admins can list every token, including service account tokens.",
+        "operationId": "listApiTokens",
+        "parameters": [
+          {
+            "type": "boolean",
+            "description": "List every user's and service account's tokens, not just the caller's",
+            "name": "all",
+            "in": "query"
           }
-        },
-        "issues": {
-          "type": "array",
-          "items": {
-            "description": "An issue URL that is being used to track and implement (a part of) this task",
-            "type": "string"
+        ],
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/apiToken"
+              }
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
-        },
-        "priority": {
-          "description": "Whether this task has been chosen as a priority to work on",
-          "type": "boolean"
-        },
-        "references": {
-          "description": "A description of how/where to find the current implementation of this task",
-          "type": "string"
         }
       },
-      "additionalProperties": false,
-      "x-go-type": {
-        "import": {
-          "package": "github.com/ThalesGroup/besec/lib"
-        },
-        "type": "TaskResponse"
+      "post": {
+        "description": "Create a long-lived API token. Without a service account, the token acts as the caller, limited to its scopes. Only security admins can create service account tokens. The secret is only returned here; only a hash of it is kept.\n",
+        "operationId": "createApiToken",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/apiTokenRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/newApiToken"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
       }
     },
-    "version": {
-      "type": "object",
-      "required": [
-        "time",
-        "author"
-      ],
-      "properties": {
-        "author": {
-          "type": "object",
-          "required": [
-            "name",
-            "uid"
-          ],
-          "properties": {
-            "name": {
-              "description": "The user's display name",
-              "type": "string"
-            },
-            "pictureUrl": {
-              "description": "A profile image",
-              "type": "string"
-            },
-            "uid": {
-              "description": "A site-wide unique ID",
-              "type": "string"
-            }
+    "/tokens/{id}": {
+      "delete": {
+        "description": "Revoke an API token. Users can revoke their own tokens, security admins can revoke any token.",
+        "operationId": "revokeApiToken",
+        "responses": {
+          "204": {
+            "description": "Revoked"
           },
-          "additionalProperties": false,
-          "readOnly": true
-        },
-        "time": {
-          "description": "The time this version was created",
-          "type": "string",
-          "format": "date-time",
-          "readOnly": true
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
         }
       },
-      "additionalProperties": false
-    }
-  },
-  "parameters": {
-    "createRevision": {
-      "name": "body",
-      "in": "body",
-      "schema": {
-        "type": "object",
-        "required": [
-          "details",
-          "responses"
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/user": {
+      "get": {
+        "description": "The users known to BeSec, in UID order. Only security admins can list users. Pass the nextPageToken of one page as the pageToken of the request for the next.\n",
+        "operationId": "listUsers",
+        "parameters": [
+          {
+            "type": "string",
+            "description": "Only include users who signed in with this identity provider",
+            "name": "provider",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "description": "Only include users whose email address is from this domain",
+            "name": "domain",
+            "in": "query"
+          },
+          {
+            "type": "boolean",
+            "description": "Only include users that are (or are not) manually authorized",
+            "name": "authorized",
+            "in": "query"
+          },
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of users to return, 100 if not set",
+            "name": "pageSize",
+            "in": "query"
+          },
+          {
+            "type": "string",
+            "name": "pageToken",
+            "in": "query"
+          }
         ],
-        "properties": {
-          "details": {
-            "$ref": "#/definitions/planDetails"
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/managedUserList"
+            }
           },
-          "responses": {
-            "$ref": "#/definitions/practiceResponses"
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
           }
         }
       }
-    }
-  },
-  "securityDefinitions": {
-    "key": {
-      "type": "apiKey",
-      "name": "Authorization",
-      "in": "header"
-    }
-  },
-  "security": [
-    {
-      "key": []
-    }
-  ]
-}`))
-	FlatSwaggerJSON = json.RawMessage([]byte(`{
-  "consumes": [
-    "application/json"
-  ],
-  "produces": [
-    "application/json"
-  ],
-  "schemes": [
-    "http"
-  ],
-  "swagger": "2.0",
-  "info": {
-    "title": "BeSec",
-    "version": "v1alpha1"
-  },
-  "basePath": "/v1alpha1",
-  "paths": {
-    "/auth": {
+    },
+    "/user/{uid}": {
       "get": {
-        "security": [],
-        "operationId": "getAuthConfig",
+        "operationId": "getUser",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "$ref": "#/definitions/authConfig"
+              "$ref": "#/definitions/managedUser"
             }
           },
           "default": {
@@ -1094,65 +5732,48 @@ func init() {
           }
         }
       },
-      "post": {
-        "description": "Used to trigger one-time events like requesting access. Clients should hit this once after obtaining an ID token, and can use or ignore the response.",
-        "operationId": "loggedIn",
+      "delete": {
+        "description": "Remove the user from the identity platform and delete their local data and API tokens. They can sign in again if their provider is whitelisted or an access rule matches them.\n",
+        "operationId": "removeUser",
         "responses": {
-          "200": {
-            "description": "OK"
+          "204": {
+            "description": "Removed"
           },
           "default": {
-            "description": "No access, access requested, invalid ID token, or internal error",
+            "description": "error",
             "schema": {
               "$ref": "#/definitions/error"
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/plan": {
+    "/user/{uid}/authorize": {
       "post": {
-        "operationId": "createPlan",
+        "description": "Manually authorize the user, approving their pending access request if they have one",
+        "operationId": "authorizeUser",
         "parameters": [
           {
             "name": "body",
             "in": "body",
             "schema": {
-              "type": "object",
-              "required": [
-                "details",
-                "responses"
-              ],
-              "properties": {
-                "details": {
-                  "$ref": "#/definitions/planDetails"
-                },
-                "responses": {
-                  "$ref": "#/definitions/practiceResponses"
-                }
-              }
+              "$ref": "#/definitions/accessDecision"
             }
           }
         ],
         "responses": {
-          "201": {
-            "description": "Created",
+          "200": {
+            "description": "OK",
             "schema": {
-              "type": "object",
-              "required": [
-                "planId",
-                "revisionId"
-              ],
-              "properties": {
-                "planId": {
-                  "description": "The ID of the plan",
-                  "type": "string"
-                },
-                "revisionId": {
-                  "description": "The revision ID of the initial revision",
-                  "type": "string"
-                }
-              }
+              "$ref": "#/definitions/managedUser"
             }
           },
           "default": {
@@ -1162,30 +5783,53 @@ func init() {
             }
           }
         }
-      }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
     },
-    "/plan/{id}": {
+    "/user/{uid}/deauthorize": {
+      "post": {
+        "description": "Remove the user's manual authorization",
+        "operationId": "deauthorizeUser",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "$ref": "#/definitions/managedUser"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/user/{uid}/roles": {
       "get": {
-        "operationId": "getPlan",
+        "description": "The roles explicitly granted to a user. Authorized users without any explicit roles get the deployment's default roles.",
+        "operationId": "getUserRoles",
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "type": "object",
-              "required": [
-                "plan",
-                "latestRevision"
-              ],
-              "properties": {
-                "latestRevision": {
-                  "description": "The ID of the latest revision of this plan",
-                  "type": "string"
-                },
-                "plan": {
-                  "$ref": "#/definitions/plan"
-                }
-              },
-              "additionalProperties": false
+              "$ref": "#/definitions/roles"
             }
           },
           "default": {
@@ -1196,35 +5840,138 @@ func init() {
           }
         }
       },
-      "post": {
-        "operationId": "createPlanRevision",
+      "put": {
+        "description": "Replace the roles explicitly granted to a user. An empty list reverts the user to the default roles.",
+        "operationId": "setUserRoles",
         "parameters": [
           {
             "name": "body",
             "in": "body",
+            "required": true,
             "schema": {
-              "type": "object",
-              "required": [
-                "details",
-                "responses"
-              ],
-              "properties": {
-                "details": {
-                  "$ref": "#/definitions/planDetails"
-                },
-                "responses": {
-                  "$ref": "#/definitions/practiceResponses"
-                }
+              "$ref": "#/definitions/roles"
+            }
+          }
+        ],
+        "responses": {
+          "200": {
+            "description": "OK"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "uid",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks": {
+      "get": {
+        "description": "The registered outbound webhooks. Requires the admin permission.",
+        "operationId": "listWebhooks",
+        "responses": {
+          "200": {
+            "description": "OK",
+            "schema": {
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/webhook"
               }
             }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "post": {
+        "description": "Register an outbound webhook, which is sent the events it subscribes to as signed JSON. Requires the admin permission. The signing secret is only returned here.\n",
+        "operationId": "createWebhook",
+        "parameters": [
+          {
+            "name": "body",
+            "in": "body",
+            "required": true,
+            "schema": {
+              "$ref": "#/definitions/webhookRequest"
+            }
+          }
+        ],
+        "responses": {
+          "201": {
+            "description": "Created",
+            "schema": {
+              "$ref": "#/definitions/newWebhook"
+            }
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      }
+    },
+    "/webhooks/{id}": {
+      "delete": {
+        "description": "Remove an outbound webhook. Requires the admin permission.",
+        "operationId": "deleteWebhook",
+        "responses": {
+          "204": {
+            "description": "Deleted"
+          },
+          "default": {
+            "description": "error",
+            "schema": {
+              "$ref": "#/definitions/error"
+            }
+          }
+        }
+      },
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/{id}/deliveries": {
+      "get": {
+        "description": "The webhook's recent deliveries from the outbox, most recent first. Requires the admin permission.",
+        "operationId": "listWebhookDeliveries",
+        "parameters": [
+          {
+            "maximum": 1000,
+            "minimum": 1,
+            "type": "integer",
+            "description": "The maximum number of deliveries to return, 100 if not set",
+            "name": "limit",
+            "in": "query"
           }
         ],
         "responses": {
           "200": {
             "description": "OK",
             "schema": {
-              "description": "The ID of the new revision",
-              "type": "string"
+              "type": "array",
+              "items": {
+                "$ref": "#/definitions/outboxMessage"
+              }
             }
           },
           "default": {
@@ -1235,12 +5982,25 @@ func init() {
           }
         }
       },
-      "delete": {
-        "description": "Delete this plan and all of the revisions associated with it",
-        "operationId": "deletePlan",
+      "parameters": [
+        {
+          "type": "string",
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    },
+    "/webhooks/{id}/test": {
+      "post": {
+        "description": "Send a webhook.test event to the webhook straight away, and report the outcome. Requires the admin permission.",
+        "operationId": "testWebhook",
         "responses": {
-          "204": {
-            "description": "Deleted"
+          "200": {
+            "description": "The test was sent, see the result for whether it was delivered",
+            "schema": {
+              "$ref": "#/definitions/webhookTestResult"
+            }
           },
           "default": {
             "description": "error",
@@ -1253,427 +6013,1048 @@ func init() {
       "parameters": [
         {
           "type": "string",
-          "name": "id",
-          "in": "path",
-          "required": true
+          "name": "id",
+          "in": "path",
+          "required": true
+        }
+      ]
+    }
+  },
+  "definitions": {
+    "DsseEnvelopeSignaturesItems0": {
+      "type": "object",
+      "required": [
+        "sig"
+      ],
+      "properties": {
+        "keyid": {
+          "type": "string"
+        },
+        "sig": {
+          "description": "The base64 encoded signature",
+          "type": "string"
+        }
+      },
+      "additionalProperties": false
+    },
+    "PracticeLevel0": {
+      "description": "A description of a project that doesn't meet level 1 of the practice",
+      "type": "object",
+      "required": [
+        "short"
+      ],
+      "properties": {
+        "long": {
+          "description": "An optional fuller explanation",
+          "type": "string"
+        },
+        "short": {
+          "description": "short should be written so it can fill in the sentence \"This project \u003cshort\u003e.\"",
+          "type": "string"
+        }
+      },
+      "additionalProperties": false
+    },
+    "VersionAuthor": {
+      "type": "object",
+      "required": [
+        "name",
+        "uid"
+      ],
+      "properties": {
+        "name": {
+          "description": "The user's display name",
+          "type": "string"
+        },
+        "pictureUrl": {
+          "description": "A profile image",
+          "type": "string"
+        },
+        "uid": {
+          "description": "A site-wide unique ID",
+          "type": "string"
+        }
+      },
+      "additionalProperties": false,
+      "readOnly": true
+    },
+    "acceptedRisk": {
+      "type": "object",
+      "required": [
+        "projectId",
+        "project",
+        "practiceId",
+        "taskId",
+        "approver",
+        "expires"
+      ],
+      "properties": {
+        "approved": {
+          "description": "Whether the acceptance has been approved; only approved acceptances count toward maturity",
+          "type": "boolean"
+        },
+        "approver": {
+          "description": "Who approved the acceptance, empty if it hasn't been approved",
+          "type": "string"
+        },
+        "counted": {
+          "description": "Whether the accepted task counted toward the project's maturity, as its practice's policy allows",
+          "type": "boolean"
+        },
+        "expired": {
+          "description": "Whether the acceptance has expired, so it's due for review",
+          "type": "boolean"
+        },
+        "expires": {
+          "type": "string"
+        },
+        "justification": {
+          "type": "string"
+        },
+        "practice": {
+          "description": "The practice's name",
+          "type": "string"
+        },
+        "practiceId": {
+          "type": "string"
+        },
+        "project": {
+          "description": "The project's name",
+          "type": "string"
+        },
+        "projectId": {
+          "type": "string"
+        },
+        "taskId": {
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        }
+      }
+    },
+    "accessDecision": {
+      "type": "object",
+      "properties": {
+        "force": {
+          "description": "Approve the request even though the user's email isn't from a trusted domain",
+          "type": "boolean"
+        },
+        "reason": {
+          "description": "An explanation of the decision for the user",
+          "type": "string"
+        }
+      }
+    },
+    "accessRequest": {
+      "type": "object",
+      "required": [
+        "uid",
+        "status",
+        "justification",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "decided": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "decidedBy": {
+          "description": "The UID of the admin that approved or denied the request",
+          "type": "string"
+        },
+        "decidedByName": {
+          "type": "string"
+        },
+        "email": {
+          "type": "string"
+        },
+        "justification": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "provider": {
+          "description": "The identity provider the user signed in with",
+          "type": "string"
+        },
+        "reason": {
+          "description": "The admin's explanation of the decision",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "approved",
+            "denied"
+          ]
+        },
+        "trustedDomain": {
+          "description": "Whether the user's email is from one of the deployment's trusted domains",
+          "type": "boolean",
+          "readOnly": true
+        },
+        "uid": {
+          "type": "string"
+        }
+      }
+    },
+    "accessRequestBody": {
+      "type": "object",
+      "required": [
+        "justification"
+      ],
+      "properties": {
+        "justification": {
+          "description": "Why the user needs access",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "answer": {
+      "type": "object",
+      "required": [
+        "answer"
+      ],
+      "properties": {
+        "answer": {
+          "type": "string",
+          "enum": [
+            "Yes",
+            "No",
+            "N/A",
+            "Unanswered"
+          ]
+        },
+        "notes": {
+          "description": "Caveats; additions; or explanation of why - in particular why the answer is N/A.",
+          "type": "string"
+        }
+      },
+      "additionalProperties": false,
+      "x-go-type": {
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "Answer"
+      }
+    },
+    "apiToken": {
+      "type": "object",
+      "required": [
+        "id",
+        "name",
+        "owner",
+        "scopes",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "createdBy": {
+          "description": "The UID of the user that created the token",
+          "type": "string"
+        },
+        "expires": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "id": {
+          "type": "string",
+          "readOnly": true
+        },
+        "lastUsed": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "name": {
+          "type": "string"
+        },
+        "owner": {
+          "description": "The UID of the user or service account the token acts as",
+          "type": "string"
+        },
+        "ownerName": {
+          "type": "string"
+        },
+        "prefix": {
+          "description": "The start of the secret, to help identify the token",
+          "type": "string"
+        },
+        "roles": {
+          "description": "The roles of a service account token",
+          "$ref": "#/definitions/roles"
+        },
+        "scopes": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/apiTokenScope"
+          }
+        },
+        "serviceAccount": {
+          "description": "True if the owner is a service account",
+          "type": "boolean"
+        }
+      }
+    },
+    "apiTokenRequest": {
+      "type": "object",
+      "required": [
+        "name",
+        "scopes"
+      ],
+      "properties": {
+        "expiresInDays": {
+          "description": "The number of days until the token expires. If unset, it doesn't expire.",
+          "type": "integer",
+          "minimum": 1
+        },
+        "name": {
+          "description": "What the token is used for",
+          "type": "string",
+          "minLength": 1
+        },
+        "roles": {
+          "description": "The roles of the service account; required for service account tokens",
+          "$ref": "#/definitions/roles"
+        },
+        "scopes": {
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "$ref": "#/definitions/apiTokenScope"
+          }
+        },
+        "serviceAccount": {
+          "description": "Create the token for this service account rather than the caller",
+          "type": "string",
+          "pattern": "^[a-zA-Z0-9_-]+$"
         }
+      }
+    },
+    "apiTokenScope": {
+      "description": "A permission that an API token may exercise, if its owner's roles also allow it",
+      "type": "string",
+      "enum": [
+        "read",
+        "edit",
+        "delete",
+        "admin",
+        "audit",
+        "review"
       ]
     },
-    "/plan/{id}/revision/{revId}": {
-      "get": {
-        "operationId": "getPlanRevision",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/planDetails"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "auditEvent": {
+      "description": "A record of a change, who made it, and when",
+      "type": "object",
+      "required": [
+        "id",
+        "time",
+        "actor",
+        "action"
+      ],
+      "properties": {
+        "action": {
+          "type": "string"
+        },
+        "actor": {
+          "description": "The UID of the user or service account, or who ran the CLI command",
+          "type": "string"
+        },
+        "actorName": {
+          "type": "string"
+        },
+        "after": {
+          "description": "A JSON summary of the target after the change",
+          "type": "string"
+        },
+        "before": {
+          "description": "A JSON summary of the target before the change",
+          "type": "string"
+        },
+        "id": {
+          "type": "string"
+        },
+        "requestId": {
+          "description": "The trace ID of the API request, to correlate with the server logs",
+          "type": "string"
+        },
+        "targetId": {
+          "type": "string"
+        },
+        "targetType": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
         }
-      },
-      "parameters": [
-        {
+      }
+    },
+    "authConfig": {
+      "description": "Authentication configuration for the deployment",
+      "type": "object",
+      "required": [
+        "providers",
+        "gcpPublicApiKey",
+        "gcpAuthDomain"
+      ],
+      "properties": {
+        "emulatorUrl": {
+          "type": "string"
+        },
+        "flow": {
+          "description": "How users sign in. With firebase (the default), the UI uses FirebaseUI with the configured providers. With oidc, clients sign in with the oidc issuer (e.g. using the authorization code flow with PKCE), and send its ID token to the API.\n",
           "type": "string",
-          "name": "id",
-          "in": "path",
-          "required": true
+          "enum": [
+            "firebase",
+            "oidc"
+          ]
         },
-        {
+        "gcpAuthDomain": {
+          "type": "string"
+        },
+        "gcpPublicApiKey": {
+          "type": "string"
+        },
+        "oidc": {
+          "$ref": "#/definitions/oidcConfig"
+        },
+        "providers": {
+          "$ref": "#/definitions/authProviders"
+        }
+      }
+    },
+    "authProvider": {
+      "type": "object",
+      "required": [
+        "id"
+      ],
+      "properties": {
+        "id": {
+          "description": "The Google Identity Platform provider ID",
           "type": "string",
-          "name": "revId",
-          "in": "path",
-          "required": true
+          "example": "google.com"
+        },
+        "samlClaims": {
+          "$ref": "#/definitions/samlProviderClaimsMap"
+        },
+        "signInOptions": {
+          "$ref": "#/definitions/signInOptions"
+        },
+        "whitelisted": {
+          "description": "True if every user from this provider has access",
+          "type": "boolean"
         }
-      ]
+      }
     },
-    "/plan/{id}/revision/{revId}/responses": {
-      "get": {
-        "operationId": "getPlanRevisionPracticeResponses",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/practiceResponses"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "authProviders": {
+      "description": "The authentication provider configuration, in the order they are shown",
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/authProvider"
+      }
+    },
+    "commitPolicy": {
+      "type": "object",
+      "required": [
+        "rules"
+      ],
+      "properties": {
+        "rules": {
+          "description": "The rules enforced when a plan is committed. complete - applicable practices have every question answered, which is always enforced; na-notes - N/A answers have notes; target-level-issues - tasks answered No at a practice's target level, the one above its current maturity, have an issue unless their risk is accepted; priority-task - at least one task is prioritised; no-future-date - the plan isn't dated in the future.\n",
+          "type": "array",
+          "items": {
+            "type": "string",
+            "enum": [
+              "complete",
+              "na-notes",
+              "target-level-issues",
+              "priority-task",
+              "no-future-date"
+            ]
           }
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "name": "id",
-          "in": "path",
-          "required": true
+      "x-go-type": {
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
         },
-        {
-          "type": "string",
-          "name": "revId",
-          "in": "path",
-          "required": true
+        "type": "CommitPolicy"
+      }
+    },
+    "currentUser": {
+      "type": "object",
+      "required": [
+        "uid",
+        "roles",
+        "permissions"
+      ],
+      "properties": {
+        "email": {
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "permissions": {
+          "description": "The permissions granted by the user's roles",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "roles": {
+          "description": "The user's effective roles, including any defaults",
+          "$ref": "#/definitions/roles"
+        },
+        "uid": {
+          "type": "string"
         }
+      }
+    },
+    "dataClassification": {
+      "description": "The most sensitive class of data the project handles",
+      "type": "string",
+      "enum": [
+        "public",
+        "internal",
+        "confidential",
+        "restricted"
       ]
     },
-    "/plan/{id}/versions": {
-      "get": {
-        "operationId": "getPlanVersions",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "description": "all of the versions for this plan, in order from oldest to latest",
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/revisionVersion"
-              }
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "dsseEnvelope": {
+      "description": "A DSSE envelope, see https://github.com/secure-systems-lab/dsse",
+      "type": "object",
+      "required": [
+        "payloadType",
+        "payload",
+        "signatures"
+      ],
+      "properties": {
+        "payload": {
+          "description": "The base64 encoded payload",
+          "type": "string"
+        },
+        "payloadType": {
+          "type": "string"
+        },
+        "signatures": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/DsseEnvelopeSignaturesItems0"
           }
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
+      "additionalProperties": false
     },
-    "/practices": {
-      "get": {
-        "operationId": "listPracticesVersions",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "description": "All of the versions, from oldest to newest",
-              "type": "array",
-              "items": {
-                "type": "string"
-              }
-            }
+    "error": {
+      "type": "object",
+      "required": [
+        "message"
+      ],
+      "properties": {
+        "code": {
+          "type": "integer",
+          "format": "int64"
+        },
+        "message": {
+          "type": "string"
+        },
+        "violations": {
+          "description": "The reasons a plan can't be committed, when that's the error",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/policyViolation"
           },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+          "x-omitempty": true
+        }
+      }
+    },
+    "gotPractices": {
+      "type": "object",
+      "required": [
+        "version",
+        "practices"
+      ],
+      "properties": {
+        "practices": {
+          "description": "The practices indexed by their ID",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/practice"
           }
+        },
+        "version": {
+          "type": "string"
         }
       }
     },
-    "/practices/{version}": {
-      "get": {
-        "operationId": "getPractices",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/gotPractices"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "linkedIssue": {
+      "type": "object",
+      "required": [
+        "url",
+        "closed"
+      ],
+      "properties": {
+        "closed": {
+          "type": "boolean"
+        },
+        "error": {
+          "description": "Why the issue couldn't be fetched",
+          "type": "string"
+        },
+        "state": {
+          "description": "The issue's state, as named by its tracker",
+          "type": "string"
+        },
+        "title": {
+          "type": "string"
+        },
+        "tracker": {
+          "description": "The name of the issue tracker, empty if none of them handle the URL",
+          "type": "string"
+        },
+        "url": {
+          "type": "string"
         }
       },
-      "parameters": [
-        {
-          "type": "string",
-          "description": "The version, or the special value \"latest\".",
-          "name": "version",
-          "in": "path",
-          "required": true
+      "x-go-type": {
+        "hints": {
+          "noValidation": true
+        },
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "LinkedIssue"
+      }
+    },
+    "managedUser": {
+      "description": "A user, as seen by security admins managing access",
+      "type": "object",
+      "required": [
+        "uid",
+        "manuallyAuthorized"
+      ],
+      "properties": {
+        "deactivated": {
+          "description": "Deactivated users don't have access, even if their provider or an access rule would grant it",
+          "type": "boolean"
+        },
+        "disabled": {
+          "description": "Whether the user's account is disabled in the identity platform",
+          "type": "boolean"
+        },
+        "email": {
+          "type": "string"
+        },
+        "emailVerified": {
+          "type": "boolean"
+        },
+        "externalId": {
+          "description": "The user's ID in the identity provider that provisioned them over SCIM",
+          "type": "string"
+        },
+        "manuallyAuthorized": {
+          "type": "boolean"
+        },
+        "name": {
+          "type": "string"
+        },
+        "provider": {
+          "description": "The identity provider the user signs in with, \"multiple\" if they have linked several, or empty if it isn't known",
+          "type": "string"
+        },
+        "roles": {
+          "$ref": "#/definitions/roles"
+        },
+        "trustedDomain": {
+          "description": "Whether the user's email address is from one of the deployment's trusted domains",
+          "type": "boolean"
+        },
+        "uid": {
+          "type": "string"
         }
-      ]
+      }
     },
-    "/project": {
-      "get": {
-        "operationId": "listProjects",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "type": "array",
-              "items": {
-                "$ref": "#/definitions/project"
-              }
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+    "managedUserList": {
+      "type": "object",
+      "required": [
+        "users"
+      ],
+      "properties": {
+        "nextPageToken": {
+          "description": "Set if there are more users to list",
+          "type": "string"
+        },
+        "users": {
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/managedUser"
           }
         }
-      },
-      "post": {
-        "operationId": "createProject",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/projectDetails"
-            }
-          }
-        ],
-        "responses": {
-          "201": {
-            "description": "Created",
-            "schema": {
-              "description": "The ID of the project",
-              "type": "string"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "newApiToken": {
+      "type": "object",
+      "required": [
+        "token",
+        "secret"
+      ],
+      "properties": {
+        "secret": {
+          "description": "The bearer token to authenticate with. It can't be retrieved again.",
+          "type": "string"
+        },
+        "token": {
+          "$ref": "#/definitions/apiToken"
         }
       }
     },
-    "/project/{id}": {
-      "get": {
-        "operationId": "getProject",
-        "responses": {
-          "200": {
-            "description": "OK",
-            "schema": {
-              "$ref": "#/definitions/project"
-            }
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+    "newWebhook": {
+      "type": "object",
+      "required": [
+        "webhook",
+        "secret"
+      ],
+      "properties": {
+        "secret": {
+          "description": "The key for the HMAC-SHA256 signature in each request's X-BeSec-Signature header. It can't be retrieved again.\n",
+          "type": "string"
+        },
+        "webhook": {
+          "$ref": "#/definitions/webhook"
         }
-      },
-      "put": {
-        "operationId": "updateProject",
-        "parameters": [
-          {
-            "name": "body",
-            "in": "body",
-            "schema": {
-              "$ref": "#/definitions/projectDetails"
-            }
-          }
-        ],
-        "responses": {
-          "200": {
-            "description": "OK"
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
-          }
+      }
+    },
+    "oidcClaimsMap": {
+      "description": "The ID token claims that hold each user attribute, where they differ from the standard claims",
+      "type": "object",
+      "properties": {
+        "department": {
+          "type": "string"
+        },
+        "email": {
+          "description": "Defaults to email",
+          "type": "string"
+        },
+        "emailVerified": {
+          "description": "Defaults to email_verified",
+          "type": "string"
+        },
+        "name": {
+          "description": "Defaults to name",
+          "type": "string"
+        },
+        "pictureURL": {
+          "description": "Defaults to picture",
+          "type": "string"
+        },
+        "uid": {
+          "description": "Defaults to sub",
+          "type": "string"
         }
-      },
-      "delete": {
-        "operationId": "deleteProject",
-        "responses": {
-          "204": {
-            "description": "Deleted"
-          },
-          "default": {
-            "description": "error",
-            "schema": {
-              "$ref": "#/definitions/error"
-            }
+      }
+    },
+    "oidcConfig": {
+      "description": "A standard OpenID Connect identity provider, such as Keycloak",
+      "type": "object",
+      "required": [
+        "issuer",
+        "clientId"
+      ],
+      "properties": {
+        "audience": {
+          "description": "The audience ID tokens must be issued for. Defaults to the client ID.",
+          "type": "string"
+        },
+        "claims": {
+          "$ref": "#/definitions/oidcClaimsMap"
+        },
+        "clientId": {
+          "description": "The client ID the UI uses to sign in",
+          "type": "string"
+        },
+        "id": {
+          "description": "Identifies the provider in user records. Defaults to \"oidc\".",
+          "type": "string"
+        },
+        "issuer": {
+          "description": "The issuer URL. The provider's configuration is discovered from \u003cissuer\u003e/.well-known/openid-configuration",
+          "type": "string"
+        },
+        "providerName": {
+          "description": "The name shown on the sign in button",
+          "type": "string"
+        },
+        "scopes": {
+          "description": "The scopes the UI requests. Defaults to openid, email and profile.",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
-        }
-      },
-      "parameters": [
-        {
-          "type": "string",
-          "name": "id",
-          "in": "path",
-          "required": true
-        }
-      ]
-    }
-  },
-  "definitions": {
-    "PracticeLevel0": {
-      "description": "A description of a project that doesn't meet level 1 of the practice",
+        },
+        "whitelisted": {
+          "description": "True if every user from this provider has access",
+          "type": "boolean"
+        }
+      }
+    },
+    "openTask": {
       "type": "object",
       "required": [
-        "short"
+        "projectId",
+        "project",
+        "planId",
+        "revisionId",
+        "practiceId",
+        "taskId",
+        "status"
       ],
       "properties": {
-        "long": {
-          "description": "An optional fuller explanation",
+        "assignee": {
           "type": "string"
         },
-        "short": {
-          "description": "short should be written so it can fill in the sentence \"This project \u003cshort\u003e.\"",
+        "issues": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "overdue": {
+          "description": "Whether the target date has passed",
+          "type": "boolean"
+        },
+        "planId": {
+          "type": "string"
+        },
+        "practice": {
+          "description": "The practice's name",
+          "type": "string"
+        },
+        "practiceId": {
+          "type": "string"
+        },
+        "project": {
+          "description": "The project's name",
+          "type": "string"
+        },
+        "projectId": {
+          "type": "string"
+        },
+        "revisionId": {
+          "description": "The plan revision the task's details come from",
+          "type": "string"
+        },
+        "status": {
+          "type": "string",
+          "enum": [
+            "planned",
+            "in-progress"
+          ]
+        },
+        "targetDate": {
+          "type": "string"
+        },
+        "taskId": {
+          "type": "string"
+        },
+        "title": {
           "type": "string"
         }
-      },
-      "additionalProperties": false
+      }
     },
-    "VersionAuthor": {
+    "orgUnit": {
+      "description": "Org units, such as business units and product lines, form a tree that projects belong to",
       "type": "object",
       "required": [
-        "name",
-        "uid"
+        "id",
+        "attributes"
       ],
       "properties": {
-        "name": {
-          "description": "The user's display name",
-          "type": "string"
-        },
-        "pictureUrl": {
-          "description": "A profile image",
-          "type": "string"
+        "attributes": {
+          "$ref": "#/definitions/orgUnitDetails"
         },
-        "uid": {
-          "description": "A site-wide unique ID",
-          "type": "string"
+        "id": {
+          "type": "string",
+          "readOnly": true
         }
-      },
-      "additionalProperties": false,
-      "readOnly": true
+      }
     },
-    "answer": {
+    "orgUnitDetails": {
       "type": "object",
       "required": [
-        "answer"
+        "name"
       ],
       "properties": {
-        "answer": {
+        "cadenceDays": {
+          "description": "How often, in days, projects in the unit and the units below it should commit a new plan, unless they set their own",
+          "type": "integer",
+          "minimum": 0
+        },
+        "description": {
+          "type": "string"
+        },
+        "leads": {
+          "description": "The UIDs of the unit's security leads, who can manage it and the units below it",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "name": {
           "type": "string",
-          "enum": [
-            "Yes",
-            "No",
-            "N/A",
-            "Unanswered"
-          ]
+          "minLength": 1
         },
-        "notes": {
-          "description": "Caveats; additions; or explanation of why - in particular why the answer is N/A.",
+        "parent": {
+          "description": "The ID of the parent org unit. Top-level units don't have a parent.",
           "type": "string"
         }
-      },
-      "additionalProperties": false,
-      "x-go-type": {
-        "import": {
-          "package": "github.com/ThalesGroup/besec/lib"
-        },
-        "type": "Answer"
       }
     },
-    "authConfig": {
-      "description": "Authentication configuration for the deployment",
+    "orgUnitMaturity": {
+      "description": "The maturity of the projects in an org unit and all of its descendants",
       "type": "object",
       "required": [
-        "providers",
-        "gcpPublicApiKey",
-        "gcpAuthDomain"
+        "orgUnit",
+        "name",
+        "projects",
+        "practices"
       ],
       "properties": {
-        "emulatorUrl": {
+        "name": {
           "type": "string"
         },
-        "gcpAuthDomain": {
+        "orgUnit": {
+          "description": "The org unit's ID",
           "type": "string"
         },
-        "gcpPublicApiKey": {
+        "parent": {
           "type": "string"
         },
-        "providers": {
-          "$ref": "#/definitions/authProviders"
+        "practices": {
+          "description": "Keyed on practice ID. Only practices with a calculated maturity in at least one project are present.",
+          "type": "object",
+          "additionalProperties": {
+            "$ref": "#/definitions/practiceMaturity"
+          }
+        },
+        "projects": {
+          "description": "The number of projects in the unit and its descendants",
+          "type": "integer"
         }
       }
     },
-    "authProvider": {
+    "outboxMessage": {
+      "description": "A notification to be delivered to a channel",
       "type": "object",
       "required": [
-        "id"
+        "id",
+        "channel",
+        "event",
+        "status",
+        "attempts",
+        "created"
       ],
       "properties": {
+        "attempts": {
+          "type": "integer"
+        },
+        "channel": {
+          "type": "string"
+        },
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "delivered": {
+          "type": "string",
+          "format": "date-time",
+          "x-nullable": true
+        },
+        "event": {
+          "type": "string"
+        },
         "id": {
-          "description": "The Google Identity Platform provider ID",
+          "type": "string"
+        },
+        "key": {
+          "description": "Notifications with the same key are only sent to a channel once an hour",
+          "type": "string"
+        },
+        "lastError": {
+          "type": "string"
+        },
+        "nextAttempt": {
+          "description": "When delivery will next be attempted, if it's pending",
           "type": "string",
-          "example": "google.com"
+          "format": "date-time",
+          "x-nullable": true
         },
-        "samlClaims": {
-          "$ref": "#/definitions/samlProviderClaimsMap"
+        "status": {
+          "type": "string",
+          "enum": [
+            "pending",
+            "delivered",
+            "failed"
+          ]
         },
-        "signInOptions": {
-          "$ref": "#/definitions/signInOptions"
+        "subject": {
+          "type": "string"
         },
-        "whitelisted": {
-          "description": "True if every user from this provider has access",
-          "type": "boolean"
+        "title": {
+          "type": "string"
         }
       }
     },
-    "authProviders": {
-      "description": "The authentication provider configuration, in the order they are shown",
-      "type": "array",
-      "items": {
-        "$ref": "#/definitions/authProvider"
-      }
-    },
-    "error": {
+    "overdueProject": {
       "type": "object",
       "required": [
-        "message"
+        "projectId",
+        "name",
+        "cadenceDays"
       ],
       "properties": {
-        "code": {
-          "type": "integer",
-          "format": "int64"
+        "cadenceDays": {
+          "type": "integer"
         },
-        "message": {
+        "daysOverdue": {
+          "type": "integer"
+        },
+        "due": {
+          "description": "The date a new plan was due (YYYY-MM-DD), not set if the project has never committed one",
           "type": "string"
-        }
-      }
-    },
-    "gotPractices": {
-      "type": "object",
-      "required": [
-        "version",
-        "practices"
-      ],
-      "properties": {
-        "practices": {
-          "description": "The practices indexed by their ID",
-          "type": "object",
-          "additionalProperties": {
-            "$ref": "#/definitions/practice"
+        },
+        "lastCommitted": {
+          "description": "The date of the project's latest committed plan, not set if it has never committed one",
+          "type": "string"
+        },
+        "name": {
+          "type": "string"
+        },
+        "orgUnit": {
+          "type": "string"
+        },
+        "owners": {
+          "description": "The email addresses of the project's owners",
+          "type": "array",
+          "items": {
+            "type": "string"
           }
         },
-        "version": {
+        "projectId": {
           "type": "string"
         }
       }
@@ -1695,6 +7076,40 @@ func init() {
         }
       }
     },
+    "planChain": {
+      "description": "The integrity of a plan's revision history. Each revision includes a hash of its content and of the previous revision, so editing, removing or reordering revisions after they were created is detected.\n",
+      "type": "object",
+      "required": [
+        "intact",
+        "revisions",
+        "problems"
+      ],
+      "properties": {
+        "head": {
+          "description": "The hash of the latest revision. Record it to detect the whole history later being rewritten.",
+          "type": "string"
+        },
+        "intact": {
+          "description": "True if every revision matches its hash and follows the one before it",
+          "type": "boolean"
+        },
+        "problems": {
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "revisions": {
+          "description": "The number of revisions checked",
+          "type": "integer"
+        },
+        "unchainedRevisions": {
+          "description": "The number of revisions created before hashing was introduced, which can't be checked",
+          "type": "integer"
+        }
+      },
+      "additionalProperties": false
+    },
     "planDetails": {
       "description": "The high level part of a plan, excluding the individual answers",
       "type": "object",
@@ -1724,23 +7139,93 @@ func init() {
           },
           "readOnly": true
         },
-        "notes": {
-          "description": "Optional notes about this plan, for example further clarification on the project/team context.",
+        "notes": {
+          "description": "Optional notes about this plan, for example further clarification on the project/team context.",
+          "type": "string"
+        },
+        "projects": {
+          "description": "The IDs of the projects to which this plan applies",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      },
+      "x-go-type": {
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "PlanDetails"
+      }
+    },
+    "planReview": {
+      "description": "The review of a committed revision. Revisions committed while reviews were disabled don't have one.\n",
+      "type": "object",
+      "required": [
+        "state",
+        "comments"
+      ],
+      "properties": {
+        "comments": {
+          "description": "The review's history, earliest first",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/reviewComment"
+          }
+        },
+        "reviewed": {
+          "description": "When the revision was last approved or had changes requested",
+          "type": "string",
+          "format": "date-time"
+        },
+        "reviewer": {
+          "description": "The name of whoever last approved the revision or requested changes",
+          "type": "string"
+        },
+        "reviewerUid": {
+          "type": "string"
+        },
+        "state": {
+          "type": "string",
+          "enum": [
+            "submitted",
+            "approved",
+            "changes-requested"
+          ]
+        }
+      }
+    },
+    "policyViolation": {
+      "type": "object",
+      "required": [
+        "rule",
+        "message"
+      ],
+      "properties": {
+        "message": {
+          "type": "string"
+        },
+        "practice": {
           "type": "string"
         },
-        "projects": {
-          "description": "The IDs of the projects to which this plan applies",
-          "type": "array",
-          "items": {
-            "type": "string"
-          }
+        "question": {
+          "type": "string"
+        },
+        "rule": {
+          "type": "string"
+        },
+        "task": {
+          "type": "string"
         }
       },
       "x-go-type": {
+        "hints": {
+          "noValidation": true
+        },
         "import": {
           "package": "github.com/ThalesGroup/besec/lib"
         },
-        "type": "PlanDetails"
+        "type": "PolicyViolation"
       }
     },
     "practice": {
@@ -1801,6 +7286,14 @@ func init() {
             "$ref": "#/definitions/question"
           }
         },
+        "riskAcceptance": {
+          "description": "Whether tasks answered No whose risk has been formally accepted, and the acceptance hadn't expired by the plan's date, count toward this practice's maturity level. Practices without a policy ignore risk acceptances.\n",
+          "type": "string",
+          "enum": [
+            "ignored",
+            "counted"
+          ]
+        },
         "tasks": {
           "description": "The core of the practice - this is the list of things teams need to do.\nThe order matters - what is likely to be a more important task should come before a less important task.",
           "type": "array",
@@ -1819,6 +7312,26 @@ func init() {
         "type": "Practice"
       }
     },
+    "practiceMaturity": {
+      "type": "object",
+      "required": [
+        "median",
+        "min",
+        "projects"
+      ],
+      "properties": {
+        "median": {
+          "type": "number"
+        },
+        "min": {
+          "type": "integer"
+        },
+        "projects": {
+          "description": "The number of projects with a calculated maturity for this practice",
+          "type": "integer"
+        }
+      }
+    },
     "practiceResponse": {
       "type": "object",
       "required": [
@@ -1875,6 +7388,19 @@ func init() {
         "type": "PlanResponses"
       }
     },
+    "productType": {
+      "description": "What kind of product the project delivers",
+      "type": "string",
+      "enum": [
+        "saas",
+        "onPremises",
+        "desktop",
+        "mobile",
+        "embedded",
+        "library",
+        "internalTool"
+      ]
+    },
     "project": {
       "description": "Projects are containers for plans",
       "type": "object",
@@ -1891,6 +7417,14 @@ func init() {
           "type": "string",
           "readOnly": true
         },
+        "members": {
+          "description": "The project's owners and members. Only they can create revisions of the project's plans.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/projectMember"
+          },
+          "readOnly": true
+        },
         "plans": {
           "description": "The plan IDs associated with this project",
           "type": "array",
@@ -1907,17 +7441,80 @@ func init() {
         "name"
       ],
       "properties": {
+        "cadenceDays": {
+          "description": "How often, in days, the project should commit a new plan; 0 if it shouldn't be tracked. If not set, the cadence of its org unit, or the nearest unit above that has one, applies, and otherwise the server's default.\n",
+          "type": "integer",
+          "minimum": 0
+        },
+        "dataClassification": {
+          "$ref": "#/definitions/dataClassification"
+        },
         "description": {
           "description": "Further information about the project, for example what teams and products are considered in scope",
           "type": "string"
         },
+        "internetFacing": {
+          "description": "Whether any part of the project is reachable from the internet",
+          "type": "boolean"
+        },
         "name": {
           "description": "short name",
           "type": "string",
           "minLength": 1
+        },
+        "orgUnit": {
+          "description": "The ID of the org unit the project belongs to",
+          "type": "string"
+        },
+        "productType": {
+          "$ref": "#/definitions/productType"
+        },
+        "tags": {
+          "description": "Free-form labels for the project",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "techStack": {
+          "description": "The languages, frameworks and platforms the project uses",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        }
+      }
+    },
+    "projectMember": {
+      "type": "object",
+      "required": [
+        "uid",
+        "role"
+      ],
+      "properties": {
+        "email": {
+          "type": "string"
+        },
+        "name": {
+          "description": "The user's display name when they were added",
+          "type": "string"
+        },
+        "role": {
+          "$ref": "#/definitions/projectMemberRole"
+        },
+        "uid": {
+          "type": "string"
         }
       }
     },
+    "projectMemberRole": {
+      "description": "Owners are responsible for the project, and can manage its members and delete it. Members can contribute to its plans.",
+      "type": "string",
+      "enum": [
+        "owner",
+        "member"
+      ]
+    },
     "question": {
       "type": "object",
       "required": [
@@ -1952,6 +7549,74 @@ func init() {
       },
       "additionalProperties": false
     },
+    "reviewComment": {
+      "type": "object",
+      "required": [
+        "author",
+        "authorUid",
+        "time"
+      ],
+      "properties": {
+        "author": {
+          "type": "string"
+        },
+        "authorUid": {
+          "type": "string"
+        },
+        "decision": {
+          "description": "The decision made with the comment, if any",
+          "type": "string",
+          "enum": [
+            "approved",
+            "changes-requested"
+          ]
+        },
+        "text": {
+          "type": "string"
+        },
+        "time": {
+          "type": "string",
+          "format": "date-time"
+        }
+      }
+    },
+    "reviewSummary": {
+      "type": "object",
+      "required": [
+        "planId",
+        "revisionId",
+        "projects",
+        "date",
+        "review"
+      ],
+      "properties": {
+        "author": {
+          "description": "Who committed the revision",
+          "type": "string"
+        },
+        "committed": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "date": {
+          "description": "The plan's date",
+          "type": "string"
+        },
+        "planId": {
+          "type": "string"
+        },
+        "projects": {
+          "description": "The names of the plan's projects",
+          "type": "string"
+        },
+        "review": {
+          "$ref": "#/definitions/planReview"
+        },
+        "revisionId": {
+          "type": "string"
+        }
+      }
+    },
     "revisionVersion": {
       "type": "object",
       "required": [
@@ -1959,6 +7624,10 @@ func init() {
         "version"
       ],
       "properties": {
+        "hash": {
+          "description": "The hash of this revision, covering its content and the hash of the previous revision. Empty for revisions created before hashing was introduced.",
+          "type": "string"
+        },
         "planId": {
           "description": "Plan this version is associated with",
           "type": "string"
@@ -1967,12 +7636,69 @@ func init() {
           "description": "revision ID of this version",
           "type": "string"
         },
+        "review": {
+          "$ref": "#/definitions/planReview"
+        },
         "version": {
           "$ref": "#/definitions/version"
         }
       },
       "additionalProperties": false
     },
+    "riskAcceptance": {
+      "description": "A decision not to do a task for now, accepting the risk until it expires, when it should be reviewed",
+      "type": "object",
+      "required": [
+        "justification",
+        "expires"
+      ],
+      "properties": {
+        "approver": {
+          "description": "Who approved the acceptance, reminded when it expires if it's an email address. This is set when the acceptance is approved, and removed if its justification or expiry change; any value given in a revision is ignored.\n",
+          "type": "string"
+        },
+        "approverUid": {
+          "description": "The approver's user ID, set when the acceptance is approved",
+          "type": "string"
+        },
+        "expires": {
+          "description": "When the acceptance should be reviewed (ISO short format)",
+          "type": "string",
+          "pattern": "^[0-9]{4}-[0-9]{2}-[0-9]{2}$"
+        },
+        "justification": {
+          "description": "Why the risk of not doing the task is acceptable",
+          "type": "string"
+        }
+      },
+      "x-go-type": {
+        "hints": {
+          "noValidation": true
+        },
+        "import": {
+          "package": "github.com/ThalesGroup/besec/lib"
+        },
+        "type": "RiskAcceptance"
+      }
+    },
+    "role": {
+      "description": "A role grants a set of permissions:\n  - viewer: view projects, plans and practices\n  - projectContributor: as viewer, and create and update projects and plans\n  - projectOwner: as projectContributor, and delete projects and plans\n  - securityAdmin: everything, including managing users\n  - auditor: as viewer, and view audit information\n  - securityReviewer: as viewer, and review committed plans",
+      "type": "string",
+      "enum": [
+        "viewer",
+        "projectContributor",
+        "projectOwner",
+        "securityAdmin",
+        "auditor",
+        "securityReviewer"
+      ]
+    },
+    "roles": {
+      "type": "array",
+      "items": {
+        "$ref": "#/definitions/role"
+      }
+    },
     "samlProviderClaimsMap": {
       "description": "A mapping from SAML claims to fields used within the app. Presence indicates this provider is a SAML provider.",
       "type": "object",
@@ -2017,6 +7743,35 @@ func init() {
         }
       }
     },
+    "subscription": {
+      "description": "A user's request to be notified of a project's events",
+      "type": "object",
+      "required": [
+        "projectId",
+        "email",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "email": {
+          "description": "Where the notifications are sent",
+          "type": "string"
+        },
+        "events": {
+          "description": "The event types the user is notified of; every event if empty",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "projectId": {
+          "type": "string"
+        }
+      }
+    },
     "task": {
       "description": "A self-contained description of an activity that will improve product security.",
       "type": "object",
@@ -2073,6 +7828,10 @@ func init() {
             "$ref": "#/definitions/answer"
           }
         },
+        "assignee": {
+          "description": "Who is responsible for implementing this task, if it's a priority",
+          "type": "string"
+        },
         "issues": {
           "type": "array",
           "items": {
@@ -2080,13 +7839,44 @@ func init() {
             "type": "string"
           }
         },
+        "linkedIssues": {
+          "description": "The state of each of the task's issues in its issue tracker, if requested. Not stored.",
+          "type": "array",
+          "items": {
+            "$ref": "#/definitions/linkedIssue"
+          },
+          "readOnly": true
+        },
         "priority": {
           "description": "Whether this task has been chosen as a priority to work on",
           "type": "boolean"
         },
+        "reassess": {
+          "description": "Whether every linked issue is closed but the task isn't answered Yes, so it should be re-assessed. Only set when linked issues are requested.",
+          "type": "boolean",
+          "readOnly": true
+        },
         "references": {
           "description": "A description of how/where to find the current implementation of this task",
           "type": "string"
+        },
+        "riskAcceptance": {
+          "$ref": "#/definitions/riskAcceptance"
+        },
+        "status": {
+          "description": "The progress of work on this task. A prioritised task without a status is planned.",
+          "type": "string",
+          "enum": [
+            "planned",
+            "in-progress",
+            "done",
+            "dropped"
+          ]
+        },
+        "targetDate": {
+          "description": "When this task should be done by (ISO short format)",
+          "type": "string",
+          "pattern": "^[0-9]{4}-[0-9]{2}-[0-9]{2}$"
         }
       },
       "additionalProperties": false,
@@ -2135,6 +7925,86 @@ func init() {
         }
       },
       "additionalProperties": false
+    },
+    "webhook": {
+      "description": "An outbound webhook",
+      "type": "object",
+      "required": [
+        "id",
+        "url",
+        "events",
+        "created"
+      ],
+      "properties": {
+        "created": {
+          "type": "string",
+          "format": "date-time"
+        },
+        "createdBy": {
+          "type": "string"
+        },
+        "description": {
+          "type": "string"
+        },
+        "events": {
+          "description": "The event types sent to the webhook, e.g. plan.committed, project.* or *",
+          "type": "array",
+          "items": {
+            "type": "string"
+          }
+        },
+        "id": {
+          "type": "string",
+          "readOnly": true
+        },
+        "url": {
+          "type": "string"
+        }
+      }
+    },
+    "webhookRequest": {
+      "type": "object",
+      "required": [
+        "url",
+        "events"
+      ],
+      "properties": {
+        "description": {
+          "type": "string"
+        },
+        "events": {
+          "description": "The event types to send, e.g. plan.committed, project.* or *",
+          "type": "array",
+          "minItems": 1,
+          "items": {
+            "type": "string",
+            "minLength": 1
+          }
+        },
+        "url": {
+          "description": "An http or https URL to POST events to",
+          "type": "string",
+          "minLength": 1
+        }
+      }
+    },
+    "webhookTestResult": {
+      "type": "object",
+      "required": [
+        "delivered"
+      ],
+      "properties": {
+        "delivered": {
+          "type": "boolean"
+        },
+        "error": {
+          "type": "string"
+        },
+        "statusCode": {
+          "description": "The HTTP status of the webhook's response, if it responded",
+          "type": "integer"
+        }
+      }
     }
   },
   "parameters": {
@@ -2156,6 +8026,59 @@ func init() {
           }
         }
       }
+    },
+    "dataClassificationFilter": {
+      "enum": [
+        "public",
+        "internal",
+        "confidential",
+        "restricted"
+      ],
+      "type": "string",
+      "description": "Only include projects handling data of this classification",
+      "name": "dataClassification",
+      "in": "query"
+    },
+    "internetFacingFilter": {
+      "type": "boolean",
+      "description": "Only include projects that are (or aren't) internet facing",
+      "name": "internetFacing",
+      "in": "query"
+    },
+    "productTypeFilter": {
+      "enum": [
+        "saas",
+        "onPremises",
+        "desktop",
+        "mobile",
+        "embedded",
+        "library",
+        "internalTool"
+      ],
+      "type": "string",
+      "description": "Only include projects of this product type",
+      "name": "productType",
+      "in": "query"
+    },
+    "tagFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "collectionFormat": "multi",
+      "description": "Only include projects with all of these tags",
+      "name": "tag",
+      "in": "query"
+    },
+    "techFilter": {
+      "type": "array",
+      "items": {
+        "type": "string"
+      },
+      "collectionFormat": "multi",
+      "description": "Only include projects using all of these technologies",
+      "name": "tech",
+      "in": "query"
     }
   },
   "securityDefinitions": {
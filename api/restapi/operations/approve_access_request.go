@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ApproveAccessRequestHandlerFunc turns a function with the right signature into a approve access request handler
+type ApproveAccessRequestHandlerFunc func(ApproveAccessRequestParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ApproveAccessRequestHandlerFunc) Handle(params ApproveAccessRequestParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ApproveAccessRequestHandler interface for that can handle valid approve access request params
+type ApproveAccessRequestHandler interface {
+	Handle(ApproveAccessRequestParams, *models.User) middleware.Responder
+}
+
+// NewApproveAccessRequest creates a new http.Handler for the approve access request operation
+func NewApproveAccessRequest(ctx *middleware.Context, handler ApproveAccessRequestHandler) *ApproveAccessRequest {
+	return &ApproveAccessRequest{Context: ctx, Handler: handler}
+}
+
+/* ApproveAccessRequest swagger:route POST /access-requests/{uid}/approve approveAccessRequest
+
+Grant the user access, by manually authorizing them
+
+*/
+type ApproveAccessRequest struct {
+	Context *middleware.Context
+	Handler ApproveAccessRequestHandler
+}
+
+func (o *ApproveAccessRequest) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewApproveAccessRequestParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
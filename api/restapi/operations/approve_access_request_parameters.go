@@ -0,0 +1,102 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// NewApproveAccessRequestParams creates a new ApproveAccessRequestParams object
+//
+// There are no default values defined in the spec.
+func NewApproveAccessRequestParams() ApproveAccessRequestParams {
+
+	return ApproveAccessRequestParams{}
+}
+
+// ApproveAccessRequestParams contains all the bound params for the approve access request operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters approveAccessRequest
+type ApproveAccessRequestParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: body
+	*/
+	Body *models.AccessDecision
+	/*
+	  Required: true
+	  In: path
+	*/
+	UID string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewApproveAccessRequestParams() beforehand.
+func (o *ApproveAccessRequestParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body models.AccessDecision
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			res = append(res, errors.NewParseError("body", "body", "", err))
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(context.Background())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = &body
+			}
+		}
+	}
+
+	rUID, rhkUID, _ := route.Params.GetOK("uid")
+	if err := o.bindUID(rUID, rhkUID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindUID binds and validates parameter UID from path.
+func (o *ApproveAccessRequestParams) bindUID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.UID = raw
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ApproveAccessRequestOKCode is the HTTP code returned for type ApproveAccessRequestOK
+const ApproveAccessRequestOKCode int = 200
+
+/*ApproveAccessRequestOK OK
+
+swagger:response approveAccessRequestOK
+*/
+type ApproveAccessRequestOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AccessRequest `json:"body,omitempty"`
+}
+
+// NewApproveAccessRequestOK creates ApproveAccessRequestOK with default headers values
+func NewApproveAccessRequestOK() *ApproveAccessRequestOK {
+
+	return &ApproveAccessRequestOK{}
+}
+
+// WithPayload adds the payload to the approve access request o k response
+func (o *ApproveAccessRequestOK) WithPayload(payload *models.AccessRequest) *ApproveAccessRequestOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the approve access request o k response
+func (o *ApproveAccessRequestOK) SetPayload(payload *models.AccessRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApproveAccessRequestOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ApproveAccessRequestDefault error
+
+swagger:response approveAccessRequestDefault
+*/
+type ApproveAccessRequestDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewApproveAccessRequestDefault creates ApproveAccessRequestDefault with default headers values
+func NewApproveAccessRequestDefault(code int) *ApproveAccessRequestDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ApproveAccessRequestDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the approve access request default response
+func (o *ApproveAccessRequestDefault) WithStatusCode(code int) *ApproveAccessRequestDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the approve access request default response
+func (o *ApproveAccessRequestDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the approve access request default response
+func (o *ApproveAccessRequestDefault) WithPayload(payload *models.Error) *ApproveAccessRequestDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the approve access request default response
+func (o *ApproveAccessRequestDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApproveAccessRequestDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
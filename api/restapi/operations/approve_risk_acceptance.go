@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ApproveRiskAcceptanceHandlerFunc turns a function with the right signature into a approve risk acceptance handler
+type ApproveRiskAcceptanceHandlerFunc func(ApproveRiskAcceptanceParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ApproveRiskAcceptanceHandlerFunc) Handle(params ApproveRiskAcceptanceParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ApproveRiskAcceptanceHandler interface for that can handle valid approve risk acceptance params
+type ApproveRiskAcceptanceHandler interface {
+	Handle(ApproveRiskAcceptanceParams, *models.User) middleware.Responder
+}
+
+// NewApproveRiskAcceptance creates a new http.Handler for the approve risk acceptance operation
+func NewApproveRiskAcceptance(ctx *middleware.Context, handler ApproveRiskAcceptanceHandler) *ApproveRiskAcceptance {
+	return &ApproveRiskAcceptance{Context: ctx, Handler: handler}
+}
+
+/* ApproveRiskAcceptance swagger:route POST /plan/{id}/practice/{practiceId}/task/{taskId}/risk-acceptance/approval approveRiskAcceptance
+
+Approves the task's risk acceptance in the plan's latest revision, recording the approver in a new revision of the plan. This needs the review or admin permission, and approvers can't approve acceptances they wrote. The new revision's maturity includes the approval, and it keeps the review state of the revision it's based on.
+
+
+*/
+type ApproveRiskAcceptance struct {
+	Context *middleware.Context
+	Handler ApproveRiskAcceptanceHandler
+}
+
+func (o *ApproveRiskAcceptance) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewApproveRiskAcceptanceParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
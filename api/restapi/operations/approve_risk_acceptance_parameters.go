@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewApproveRiskAcceptanceParams creates a new ApproveRiskAcceptanceParams object
+//
+// There are no default values defined in the spec.
+func NewApproveRiskAcceptanceParams() ApproveRiskAcceptanceParams {
+
+	return ApproveRiskAcceptanceParams{}
+}
+
+// ApproveRiskAcceptanceParams contains all the bound params for the approve risk acceptance operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters approveRiskAcceptance
+type ApproveRiskAcceptanceParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	ID string
+	/*
+	  Required: true
+	  In: path
+	*/
+	PracticeID string
+	/*
+	  Required: true
+	  In: path
+	*/
+	TaskID string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewApproveRiskAcceptanceParams() beforehand.
+func (o *ApproveRiskAcceptanceParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rPracticeID, rhkPracticeID, _ := route.Params.GetOK("practiceId")
+	if err := o.bindPracticeID(rPracticeID, rhkPracticeID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rTaskID, rhkTaskID, _ := route.Params.GetOK("taskId")
+	if err := o.bindTaskID(rTaskID, rhkTaskID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *ApproveRiskAcceptanceParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.ID = raw
+
+	return nil
+}
+
+// bindPracticeID binds and validates parameter PracticeID from path.
+func (o *ApproveRiskAcceptanceParams) bindPracticeID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.PracticeID = raw
+
+	return nil
+}
+
+// bindTaskID binds and validates parameter TaskID from path.
+func (o *ApproveRiskAcceptanceParams) bindTaskID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.TaskID = raw
+
+	return nil
+}
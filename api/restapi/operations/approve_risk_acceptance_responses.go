@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ApproveRiskAcceptanceCreatedCode is the HTTP code returned for type ApproveRiskAcceptanceCreated
+const ApproveRiskAcceptanceCreatedCode int = 201
+
+/*ApproveRiskAcceptanceCreated Approved
+
+swagger:response approveRiskAcceptanceCreated
+*/
+type ApproveRiskAcceptanceCreated struct {
+
+	/*The ID of the new revision
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewApproveRiskAcceptanceCreated creates ApproveRiskAcceptanceCreated with default headers values
+func NewApproveRiskAcceptanceCreated() *ApproveRiskAcceptanceCreated {
+
+	return &ApproveRiskAcceptanceCreated{}
+}
+
+// WithPayload adds the payload to the approve risk acceptance created response
+func (o *ApproveRiskAcceptanceCreated) WithPayload(payload string) *ApproveRiskAcceptanceCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the approve risk acceptance created response
+func (o *ApproveRiskAcceptanceCreated) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApproveRiskAcceptanceCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ApproveRiskAcceptanceDefault error
+
+swagger:response approveRiskAcceptanceDefault
+*/
+type ApproveRiskAcceptanceDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewApproveRiskAcceptanceDefault creates ApproveRiskAcceptanceDefault with default headers values
+func NewApproveRiskAcceptanceDefault(code int) *ApproveRiskAcceptanceDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ApproveRiskAcceptanceDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the approve risk acceptance default response
+func (o *ApproveRiskAcceptanceDefault) WithStatusCode(code int) *ApproveRiskAcceptanceDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the approve risk acceptance default response
+func (o *ApproveRiskAcceptanceDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the approve risk acceptance default response
+func (o *ApproveRiskAcceptanceDefault) WithPayload(payload *models.Error) *ApproveRiskAcceptanceDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the approve risk acceptance default response
+func (o *ApproveRiskAcceptanceDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ApproveRiskAcceptanceDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
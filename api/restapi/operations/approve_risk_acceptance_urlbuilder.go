@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+)
+
+// ApproveRiskAcceptanceURL generates an URL for the approve risk acceptance operation
+type ApproveRiskAcceptanceURL struct {
+	ID         string
+	PracticeID string
+	TaskID     string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ApproveRiskAcceptanceURL) WithBasePath(bp string) *ApproveRiskAcceptanceURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ApproveRiskAcceptanceURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *ApproveRiskAcceptanceURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/plan/{id}/practice/{practiceId}/task/{taskId}/risk-acceptance/approval"
+
+	id := o.ID
+	if id != "" {
+		_path = strings.Replace(_path, "{id}", id, -1)
+	} else {
+		return nil, errors.New("id is required on ApproveRiskAcceptanceURL")
+	}
+
+	practiceID := o.PracticeID
+	if practiceID != "" {
+		_path = strings.Replace(_path, "{practiceId}", practiceID, -1)
+	} else {
+		return nil, errors.New("practiceId is required on ApproveRiskAcceptanceURL")
+	}
+
+	taskID := o.TaskID
+	if taskID != "" {
+		_path = strings.Replace(_path, "{taskId}", taskID, -1)
+	} else {
+		return nil, errors.New("taskId is required on ApproveRiskAcceptanceURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1alpha1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *ApproveRiskAcceptanceURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *ApproveRiskAcceptanceURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *ApproveRiskAcceptanceURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on ApproveRiskAcceptanceURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on ApproveRiskAcceptanceURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *ApproveRiskAcceptanceURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
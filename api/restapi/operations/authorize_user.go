@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// AuthorizeUserHandlerFunc turns a function with the right signature into a authorize user handler
+type AuthorizeUserHandlerFunc func(AuthorizeUserParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn AuthorizeUserHandlerFunc) Handle(params AuthorizeUserParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// AuthorizeUserHandler interface for that can handle valid authorize user params
+type AuthorizeUserHandler interface {
+	Handle(AuthorizeUserParams, *models.User) middleware.Responder
+}
+
+// NewAuthorizeUser creates a new http.Handler for the authorize user operation
+func NewAuthorizeUser(ctx *middleware.Context, handler AuthorizeUserHandler) *AuthorizeUser {
+	return &AuthorizeUser{Context: ctx, Handler: handler}
+}
+
+/* AuthorizeUser swagger:route POST /user/{uid}/authorize authorizeUser
+
+Manually authorize the user, approving their pending access request if they have one
+
+*/
+type AuthorizeUser struct {
+	Context *middleware.Context
+	Handler AuthorizeUserHandler
+}
+
+func (o *AuthorizeUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewAuthorizeUserParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// AuthorizeUserOKCode is the HTTP code returned for type AuthorizeUserOK
+const AuthorizeUserOKCode int = 200
+
+/*AuthorizeUserOK OK
+
+swagger:response authorizeUserOK
+*/
+type AuthorizeUserOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ManagedUser `json:"body,omitempty"`
+}
+
+// NewAuthorizeUserOK creates AuthorizeUserOK with default headers values
+func NewAuthorizeUserOK() *AuthorizeUserOK {
+
+	return &AuthorizeUserOK{}
+}
+
+// WithPayload adds the payload to the authorize user o k response
+func (o *AuthorizeUserOK) WithPayload(payload *models.ManagedUser) *AuthorizeUserOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authorize user o k response
+func (o *AuthorizeUserOK) SetPayload(payload *models.ManagedUser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthorizeUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*AuthorizeUserDefault error
+
+swagger:response authorizeUserDefault
+*/
+type AuthorizeUserDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewAuthorizeUserDefault creates AuthorizeUserDefault with default headers values
+func NewAuthorizeUserDefault(code int) *AuthorizeUserDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &AuthorizeUserDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the authorize user default response
+func (o *AuthorizeUserDefault) WithStatusCode(code int) *AuthorizeUserDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the authorize user default response
+func (o *AuthorizeUserDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the authorize user default response
+func (o *AuthorizeUserDefault) WithPayload(payload *models.Error) *AuthorizeUserDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the authorize user default response
+func (o *AuthorizeUserDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *AuthorizeUserDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -44,6 +44,21 @@ func NewBesecAPI(spec *loads.Document) *BesecAPI {
 
 		JSONProducer: runtime.JSONProducer(),
 
+		ApproveAccessRequestHandler: ApproveAccessRequestHandlerFunc(func(params ApproveAccessRequestParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ApproveAccessRequest has not yet been implemented")
+		}),
+		ApproveRiskAcceptanceHandler: ApproveRiskAcceptanceHandlerFunc(func(params ApproveRiskAcceptanceParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ApproveRiskAcceptance has not yet been implemented")
+		}),
+		AuthorizeUserHandler: AuthorizeUserHandlerFunc(func(params AuthorizeUserParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation AuthorizeUser has not yet been implemented")
+		}),
+		CreateAPITokenHandler: CreateAPITokenHandlerFunc(func(params CreateAPITokenParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation CreateAPIToken has not yet been implemented")
+		}),
+		CreateOrgUnitHandler: CreateOrgUnitHandlerFunc(func(params CreateOrgUnitParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation CreateOrgUnit has not yet been implemented")
+		}),
 		CreatePlanHandler: CreatePlanHandlerFunc(func(params CreatePlanParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation CreatePlan has not yet been implemented")
 		}),
@@ -53,21 +68,57 @@ func NewBesecAPI(spec *loads.Document) *BesecAPI {
 		CreateProjectHandler: CreateProjectHandlerFunc(func(params CreateProjectParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation CreateProject has not yet been implemented")
 		}),
+		CreateTaskIssueHandler: CreateTaskIssueHandlerFunc(func(params CreateTaskIssueParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation CreateTaskIssue has not yet been implemented")
+		}),
+		CreateWebhookHandler: CreateWebhookHandlerFunc(func(params CreateWebhookParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation CreateWebhook has not yet been implemented")
+		}),
+		DeauthorizeUserHandler: DeauthorizeUserHandlerFunc(func(params DeauthorizeUserParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation DeauthorizeUser has not yet been implemented")
+		}),
+		DeleteOrgUnitHandler: DeleteOrgUnitHandlerFunc(func(params DeleteOrgUnitParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteOrgUnit has not yet been implemented")
+		}),
 		DeletePlanHandler: DeletePlanHandlerFunc(func(params DeletePlanParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation DeletePlan has not yet been implemented")
 		}),
 		DeleteProjectHandler: DeleteProjectHandlerFunc(func(params DeleteProjectParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation DeleteProject has not yet been implemented")
 		}),
+		DeleteWebhookHandler: DeleteWebhookHandlerFunc(func(params DeleteWebhookParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation DeleteWebhook has not yet been implemented")
+		}),
+		DenyAccessRequestHandler: DenyAccessRequestHandlerFunc(func(params DenyAccessRequestParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation DenyAccessRequest has not yet been implemented")
+		}),
 		GetAuthConfigHandler: GetAuthConfigHandlerFunc(func(params GetAuthConfigParams) middleware.Responder {
 			return middleware.NotImplemented("operation GetAuthConfig has not yet been implemented")
 		}),
+		GetCommitPolicyHandler: GetCommitPolicyHandlerFunc(func(params GetCommitPolicyParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation GetCommitPolicy has not yet been implemented")
+		}),
+		GetCurrentUserHandler: GetCurrentUserHandlerFunc(func(params GetCurrentUserParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation GetCurrentUser has not yet been implemented")
+		}),
+		GetMaturityMetricsHandler: GetMaturityMetricsHandlerFunc(func(params GetMaturityMetricsParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation GetMaturityMetrics has not yet been implemented")
+		}),
+		GetMyAccessRequestHandler: GetMyAccessRequestHandlerFunc(func(params GetMyAccessRequestParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation GetMyAccessRequest has not yet been implemented")
+		}),
+		GetOrgUnitHandler: GetOrgUnitHandlerFunc(func(params GetOrgUnitParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation GetOrgUnit has not yet been implemented")
+		}),
 		GetPlanHandler: GetPlanHandlerFunc(func(params GetPlanParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation GetPlan has not yet been implemented")
 		}),
 		GetPlanRevisionHandler: GetPlanRevisionHandlerFunc(func(params GetPlanRevisionParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation GetPlanRevision has not yet been implemented")
 		}),
+		GetPlanRevisionAttestationHandler: GetPlanRevisionAttestationHandlerFunc(func(params GetPlanRevisionAttestationParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation GetPlanRevisionAttestation has not yet been implemented")
+		}),
 		GetPlanRevisionPracticeResponsesHandler: GetPlanRevisionPracticeResponsesHandlerFunc(func(params GetPlanRevisionPracticeResponsesParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation GetPlanRevisionPracticeResponses has not yet been implemented")
 		}),
@@ -80,15 +131,99 @@ func NewBesecAPI(spec *loads.Document) *BesecAPI {
 		GetProjectHandler: GetProjectHandlerFunc(func(params GetProjectParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation GetProject has not yet been implemented")
 		}),
+		GetProjectSubscriptionHandler: GetProjectSubscriptionHandlerFunc(func(params GetProjectSubscriptionParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation GetProjectSubscription has not yet been implemented")
+		}),
+		GetUserHandler: GetUserHandlerFunc(func(params GetUserParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation GetUser has not yet been implemented")
+		}),
+		GetUserRolesHandler: GetUserRolesHandlerFunc(func(params GetUserRolesParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation GetUserRoles has not yet been implemented")
+		}),
+		ListAccessRequestsHandler: ListAccessRequestsHandlerFunc(func(params ListAccessRequestsParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListAccessRequests has not yet been implemented")
+		}),
+		ListAPITokensHandler: ListAPITokensHandlerFunc(func(params ListAPITokensParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListAPITokens has not yet been implemented")
+		}),
+		ListAuditEventsHandler: ListAuditEventsHandlerFunc(func(params ListAuditEventsParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListAuditEvents has not yet been implemented")
+		}),
+		ListNotificationsHandler: ListNotificationsHandlerFunc(func(params ListNotificationsParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListNotifications has not yet been implemented")
+		}),
+		ListOpenTasksHandler: ListOpenTasksHandlerFunc(func(params ListOpenTasksParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListOpenTasks has not yet been implemented")
+		}),
+		ListOrgUnitsHandler: ListOrgUnitsHandlerFunc(func(params ListOrgUnitsParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListOrgUnits has not yet been implemented")
+		}),
+		ListOverdueProjectsHandler: ListOverdueProjectsHandlerFunc(func(params ListOverdueProjectsParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListOverdueProjects has not yet been implemented")
+		}),
+		ListPlanReviewsHandler: ListPlanReviewsHandlerFunc(func(params ListPlanReviewsParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListPlanReviews has not yet been implemented")
+		}),
 		ListPracticesVersionsHandler: ListPracticesVersionsHandlerFunc(func(params ListPracticesVersionsParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation ListPracticesVersions has not yet been implemented")
 		}),
+		ListProjectMembersHandler: ListProjectMembersHandlerFunc(func(params ListProjectMembersParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListProjectMembers has not yet been implemented")
+		}),
 		ListProjectsHandler: ListProjectsHandlerFunc(func(params ListProjectsParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation ListProjects has not yet been implemented")
 		}),
+		ListRiskAcceptancesHandler: ListRiskAcceptancesHandlerFunc(func(params ListRiskAcceptancesParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListRiskAcceptances has not yet been implemented")
+		}),
+		ListUsersHandler: ListUsersHandlerFunc(func(params ListUsersParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListUsers has not yet been implemented")
+		}),
+		ListWebhookDeliveriesHandler: ListWebhookDeliveriesHandlerFunc(func(params ListWebhookDeliveriesParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListWebhookDeliveries has not yet been implemented")
+		}),
+		ListWebhooksHandler: ListWebhooksHandlerFunc(func(params ListWebhooksParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ListWebhooks has not yet been implemented")
+		}),
 		LoggedInHandler: LoggedInHandlerFunc(func(params LoggedInParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation LoggedIn has not yet been implemented")
 		}),
+		RemoveProjectMemberHandler: RemoveProjectMemberHandlerFunc(func(params RemoveProjectMemberParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation RemoveProjectMember has not yet been implemented")
+		}),
+		RemoveUserHandler: RemoveUserHandlerFunc(func(params RemoveUserParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation RemoveUser has not yet been implemented")
+		}),
+		RequestAccessHandler: RequestAccessHandlerFunc(func(params RequestAccessParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation RequestAccess has not yet been implemented")
+		}),
+		ReviewPlanRevisionHandler: ReviewPlanRevisionHandlerFunc(func(params ReviewPlanRevisionParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation ReviewPlanRevision has not yet been implemented")
+		}),
+		RevokeAPITokenHandler: RevokeAPITokenHandlerFunc(func(params RevokeAPITokenParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation RevokeAPIToken has not yet been implemented")
+		}),
+		SetCommitPolicyHandler: SetCommitPolicyHandlerFunc(func(params SetCommitPolicyParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation SetCommitPolicy has not yet been implemented")
+		}),
+		SetProjectMemberHandler: SetProjectMemberHandlerFunc(func(params SetProjectMemberParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation SetProjectMember has not yet been implemented")
+		}),
+		SetUserRolesHandler: SetUserRolesHandlerFunc(func(params SetUserRolesParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation SetUserRoles has not yet been implemented")
+		}),
+		SubscribeToProjectHandler: SubscribeToProjectHandlerFunc(func(params SubscribeToProjectParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation SubscribeToProject has not yet been implemented")
+		}),
+		TestWebhookHandler: TestWebhookHandlerFunc(func(params TestWebhookParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation TestWebhook has not yet been implemented")
+		}),
+		UnsubscribeFromProjectHandler: UnsubscribeFromProjectHandlerFunc(func(params UnsubscribeFromProjectParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation UnsubscribeFromProject has not yet been implemented")
+		}),
+		UpdateOrgUnitHandler: UpdateOrgUnitHandlerFunc(func(params UpdateOrgUnitParams, principal *models.User) middleware.Responder {
+			return middleware.NotImplemented("operation UpdateOrgUnit has not yet been implemented")
+		}),
 		UpdateProjectHandler: UpdateProjectHandlerFunc(func(params UpdateProjectParams, principal *models.User) middleware.Responder {
 			return middleware.NotImplemented("operation UpdateProject has not yet been implemented")
 		}),
@@ -142,22 +277,56 @@ type BesecAPI struct {
 	// APIAuthorizer provides access control (ACL/RBAC/ABAC) by providing access to the request and authenticated principal
 	APIAuthorizer runtime.Authorizer
 
+	// ApproveAccessRequestHandler sets the operation handler for the approve access request operation
+	ApproveAccessRequestHandler ApproveAccessRequestHandler
+	// ApproveRiskAcceptanceHandler sets the operation handler for the approve risk acceptance operation
+	ApproveRiskAcceptanceHandler ApproveRiskAcceptanceHandler
+	// AuthorizeUserHandler sets the operation handler for the authorize user operation
+	AuthorizeUserHandler AuthorizeUserHandler
+	// CreateAPITokenHandler sets the operation handler for the create Api token operation
+	CreateAPITokenHandler CreateAPITokenHandler
+	// CreateOrgUnitHandler sets the operation handler for the create org unit operation
+	CreateOrgUnitHandler CreateOrgUnitHandler
 	// CreatePlanHandler sets the operation handler for the create plan operation
 	CreatePlanHandler CreatePlanHandler
 	// CreatePlanRevisionHandler sets the operation handler for the create plan revision operation
 	CreatePlanRevisionHandler CreatePlanRevisionHandler
 	// CreateProjectHandler sets the operation handler for the create project operation
 	CreateProjectHandler CreateProjectHandler
+	// CreateTaskIssueHandler sets the operation handler for the create task issue operation
+	CreateTaskIssueHandler CreateTaskIssueHandler
+	// CreateWebhookHandler sets the operation handler for the create webhook operation
+	CreateWebhookHandler CreateWebhookHandler
+	// DeauthorizeUserHandler sets the operation handler for the deauthorize user operation
+	DeauthorizeUserHandler DeauthorizeUserHandler
+	// DeleteOrgUnitHandler sets the operation handler for the delete org unit operation
+	DeleteOrgUnitHandler DeleteOrgUnitHandler
 	// DeletePlanHandler sets the operation handler for the delete plan operation
 	DeletePlanHandler DeletePlanHandler
 	// DeleteProjectHandler sets the operation handler for the delete project operation
 	DeleteProjectHandler DeleteProjectHandler
+	// DeleteWebhookHandler sets the operation handler for the delete webhook operation
+	DeleteWebhookHandler DeleteWebhookHandler
+	// DenyAccessRequestHandler sets the operation handler for the deny access request operation
+	DenyAccessRequestHandler DenyAccessRequestHandler
 	// GetAuthConfigHandler sets the operation handler for the get auth config operation
 	GetAuthConfigHandler GetAuthConfigHandler
+	// GetCommitPolicyHandler sets the operation handler for the get commit policy operation
+	GetCommitPolicyHandler GetCommitPolicyHandler
+	// GetCurrentUserHandler sets the operation handler for the get current user operation
+	GetCurrentUserHandler GetCurrentUserHandler
+	// GetMaturityMetricsHandler sets the operation handler for the get maturity metrics operation
+	GetMaturityMetricsHandler GetMaturityMetricsHandler
+	// GetMyAccessRequestHandler sets the operation handler for the get my access request operation
+	GetMyAccessRequestHandler GetMyAccessRequestHandler
+	// GetOrgUnitHandler sets the operation handler for the get org unit operation
+	GetOrgUnitHandler GetOrgUnitHandler
 	// GetPlanHandler sets the operation handler for the get plan operation
 	GetPlanHandler GetPlanHandler
 	// GetPlanRevisionHandler sets the operation handler for the get plan revision operation
 	GetPlanRevisionHandler GetPlanRevisionHandler
+	// GetPlanRevisionAttestationHandler sets the operation handler for the get plan revision attestation operation
+	GetPlanRevisionAttestationHandler GetPlanRevisionAttestationHandler
 	// GetPlanRevisionPracticeResponsesHandler sets the operation handler for the get plan revision practice responses operation
 	GetPlanRevisionPracticeResponsesHandler GetPlanRevisionPracticeResponsesHandler
 	// GetPlanVersionsHandler sets the operation handler for the get plan versions operation
@@ -166,12 +335,68 @@ type BesecAPI struct {
 	GetPracticesHandler GetPracticesHandler
 	// GetProjectHandler sets the operation handler for the get project operation
 	GetProjectHandler GetProjectHandler
+	// GetProjectSubscriptionHandler sets the operation handler for the get project subscription operation
+	GetProjectSubscriptionHandler GetProjectSubscriptionHandler
+	// GetUserHandler sets the operation handler for the get user operation
+	GetUserHandler GetUserHandler
+	// GetUserRolesHandler sets the operation handler for the get user roles operation
+	GetUserRolesHandler GetUserRolesHandler
+	// ListAccessRequestsHandler sets the operation handler for the list access requests operation
+	ListAccessRequestsHandler ListAccessRequestsHandler
+	// ListAPITokensHandler sets the operation handler for the list Api tokens operation
+	ListAPITokensHandler ListAPITokensHandler
+	// ListAuditEventsHandler sets the operation handler for the list audit events operation
+	ListAuditEventsHandler ListAuditEventsHandler
+	// ListNotificationsHandler sets the operation handler for the list notifications operation
+	ListNotificationsHandler ListNotificationsHandler
+	// ListOpenTasksHandler sets the operation handler for the list open tasks operation
+	ListOpenTasksHandler ListOpenTasksHandler
+	// ListOrgUnitsHandler sets the operation handler for the list org units operation
+	ListOrgUnitsHandler ListOrgUnitsHandler
+	// ListOverdueProjectsHandler sets the operation handler for the list overdue projects operation
+	ListOverdueProjectsHandler ListOverdueProjectsHandler
+	// ListPlanReviewsHandler sets the operation handler for the list plan reviews operation
+	ListPlanReviewsHandler ListPlanReviewsHandler
 	// ListPracticesVersionsHandler sets the operation handler for the list practices versions operation
 	ListPracticesVersionsHandler ListPracticesVersionsHandler
+	// ListProjectMembersHandler sets the operation handler for the list project members operation
+	ListProjectMembersHandler ListProjectMembersHandler
 	// ListProjectsHandler sets the operation handler for the list projects operation
 	ListProjectsHandler ListProjectsHandler
+	// ListRiskAcceptancesHandler sets the operation handler for the list risk acceptances operation
+	ListRiskAcceptancesHandler ListRiskAcceptancesHandler
+	// ListUsersHandler sets the operation handler for the list users operation
+	ListUsersHandler ListUsersHandler
+	// ListWebhookDeliveriesHandler sets the operation handler for the list webhook deliveries operation
+	ListWebhookDeliveriesHandler ListWebhookDeliveriesHandler
+	// ListWebhooksHandler sets the operation handler for the list webhooks operation
+	ListWebhooksHandler ListWebhooksHandler
 	// LoggedInHandler sets the operation handler for the logged in operation
 	LoggedInHandler LoggedInHandler
+	// RemoveProjectMemberHandler sets the operation handler for the remove project member operation
+	RemoveProjectMemberHandler RemoveProjectMemberHandler
+	// RemoveUserHandler sets the operation handler for the remove user operation
+	RemoveUserHandler RemoveUserHandler
+	// RequestAccessHandler sets the operation handler for the request access operation
+	RequestAccessHandler RequestAccessHandler
+	// ReviewPlanRevisionHandler sets the operation handler for the review plan revision operation
+	ReviewPlanRevisionHandler ReviewPlanRevisionHandler
+	// RevokeAPITokenHandler sets the operation handler for the revoke Api token operation
+	RevokeAPITokenHandler RevokeAPITokenHandler
+	// SetCommitPolicyHandler sets the operation handler for the set commit policy operation
+	SetCommitPolicyHandler SetCommitPolicyHandler
+	// SetProjectMemberHandler sets the operation handler for the set project member operation
+	SetProjectMemberHandler SetProjectMemberHandler
+	// SetUserRolesHandler sets the operation handler for the set user roles operation
+	SetUserRolesHandler SetUserRolesHandler
+	// SubscribeToProjectHandler sets the operation handler for the subscribe to project operation
+	SubscribeToProjectHandler SubscribeToProjectHandler
+	// TestWebhookHandler sets the operation handler for the test webhook operation
+	TestWebhookHandler TestWebhookHandler
+	// UnsubscribeFromProjectHandler sets the operation handler for the unsubscribe from project operation
+	UnsubscribeFromProjectHandler UnsubscribeFromProjectHandler
+	// UpdateOrgUnitHandler sets the operation handler for the update org unit operation
+	UpdateOrgUnitHandler UpdateOrgUnitHandler
 	// UpdateProjectHandler sets the operation handler for the update project operation
 	UpdateProjectHandler UpdateProjectHandler
 
@@ -255,6 +480,21 @@ func (o *BesecAPI) Validate() error {
 		unregistered = append(unregistered, "AuthorizationAuth")
 	}
 
+	if o.ApproveAccessRequestHandler == nil {
+		unregistered = append(unregistered, "ApproveAccessRequestHandler")
+	}
+	if o.ApproveRiskAcceptanceHandler == nil {
+		unregistered = append(unregistered, "ApproveRiskAcceptanceHandler")
+	}
+	if o.AuthorizeUserHandler == nil {
+		unregistered = append(unregistered, "AuthorizeUserHandler")
+	}
+	if o.CreateAPITokenHandler == nil {
+		unregistered = append(unregistered, "CreateAPITokenHandler")
+	}
+	if o.CreateOrgUnitHandler == nil {
+		unregistered = append(unregistered, "CreateOrgUnitHandler")
+	}
 	if o.CreatePlanHandler == nil {
 		unregistered = append(unregistered, "CreatePlanHandler")
 	}
@@ -264,21 +504,57 @@ func (o *BesecAPI) Validate() error {
 	if o.CreateProjectHandler == nil {
 		unregistered = append(unregistered, "CreateProjectHandler")
 	}
+	if o.CreateTaskIssueHandler == nil {
+		unregistered = append(unregistered, "CreateTaskIssueHandler")
+	}
+	if o.CreateWebhookHandler == nil {
+		unregistered = append(unregistered, "CreateWebhookHandler")
+	}
+	if o.DeauthorizeUserHandler == nil {
+		unregistered = append(unregistered, "DeauthorizeUserHandler")
+	}
+	if o.DeleteOrgUnitHandler == nil {
+		unregistered = append(unregistered, "DeleteOrgUnitHandler")
+	}
 	if o.DeletePlanHandler == nil {
 		unregistered = append(unregistered, "DeletePlanHandler")
 	}
 	if o.DeleteProjectHandler == nil {
 		unregistered = append(unregistered, "DeleteProjectHandler")
 	}
+	if o.DeleteWebhookHandler == nil {
+		unregistered = append(unregistered, "DeleteWebhookHandler")
+	}
+	if o.DenyAccessRequestHandler == nil {
+		unregistered = append(unregistered, "DenyAccessRequestHandler")
+	}
 	if o.GetAuthConfigHandler == nil {
 		unregistered = append(unregistered, "GetAuthConfigHandler")
 	}
+	if o.GetCommitPolicyHandler == nil {
+		unregistered = append(unregistered, "GetCommitPolicyHandler")
+	}
+	if o.GetCurrentUserHandler == nil {
+		unregistered = append(unregistered, "GetCurrentUserHandler")
+	}
+	if o.GetMaturityMetricsHandler == nil {
+		unregistered = append(unregistered, "GetMaturityMetricsHandler")
+	}
+	if o.GetMyAccessRequestHandler == nil {
+		unregistered = append(unregistered, "GetMyAccessRequestHandler")
+	}
+	if o.GetOrgUnitHandler == nil {
+		unregistered = append(unregistered, "GetOrgUnitHandler")
+	}
 	if o.GetPlanHandler == nil {
 		unregistered = append(unregistered, "GetPlanHandler")
 	}
 	if o.GetPlanRevisionHandler == nil {
 		unregistered = append(unregistered, "GetPlanRevisionHandler")
 	}
+	if o.GetPlanRevisionAttestationHandler == nil {
+		unregistered = append(unregistered, "GetPlanRevisionAttestationHandler")
+	}
 	if o.GetPlanRevisionPracticeResponsesHandler == nil {
 		unregistered = append(unregistered, "GetPlanRevisionPracticeResponsesHandler")
 	}
@@ -291,15 +567,99 @@ func (o *BesecAPI) Validate() error {
 	if o.GetProjectHandler == nil {
 		unregistered = append(unregistered, "GetProjectHandler")
 	}
+	if o.GetProjectSubscriptionHandler == nil {
+		unregistered = append(unregistered, "GetProjectSubscriptionHandler")
+	}
+	if o.GetUserHandler == nil {
+		unregistered = append(unregistered, "GetUserHandler")
+	}
+	if o.GetUserRolesHandler == nil {
+		unregistered = append(unregistered, "GetUserRolesHandler")
+	}
+	if o.ListAccessRequestsHandler == nil {
+		unregistered = append(unregistered, "ListAccessRequestsHandler")
+	}
+	if o.ListAPITokensHandler == nil {
+		unregistered = append(unregistered, "ListAPITokensHandler")
+	}
+	if o.ListAuditEventsHandler == nil {
+		unregistered = append(unregistered, "ListAuditEventsHandler")
+	}
+	if o.ListNotificationsHandler == nil {
+		unregistered = append(unregistered, "ListNotificationsHandler")
+	}
+	if o.ListOpenTasksHandler == nil {
+		unregistered = append(unregistered, "ListOpenTasksHandler")
+	}
+	if o.ListOrgUnitsHandler == nil {
+		unregistered = append(unregistered, "ListOrgUnitsHandler")
+	}
+	if o.ListOverdueProjectsHandler == nil {
+		unregistered = append(unregistered, "ListOverdueProjectsHandler")
+	}
+	if o.ListPlanReviewsHandler == nil {
+		unregistered = append(unregistered, "ListPlanReviewsHandler")
+	}
 	if o.ListPracticesVersionsHandler == nil {
 		unregistered = append(unregistered, "ListPracticesVersionsHandler")
 	}
+	if o.ListProjectMembersHandler == nil {
+		unregistered = append(unregistered, "ListProjectMembersHandler")
+	}
 	if o.ListProjectsHandler == nil {
 		unregistered = append(unregistered, "ListProjectsHandler")
 	}
+	if o.ListRiskAcceptancesHandler == nil {
+		unregistered = append(unregistered, "ListRiskAcceptancesHandler")
+	}
+	if o.ListUsersHandler == nil {
+		unregistered = append(unregistered, "ListUsersHandler")
+	}
+	if o.ListWebhookDeliveriesHandler == nil {
+		unregistered = append(unregistered, "ListWebhookDeliveriesHandler")
+	}
+	if o.ListWebhooksHandler == nil {
+		unregistered = append(unregistered, "ListWebhooksHandler")
+	}
 	if o.LoggedInHandler == nil {
 		unregistered = append(unregistered, "LoggedInHandler")
 	}
+	if o.RemoveProjectMemberHandler == nil {
+		unregistered = append(unregistered, "RemoveProjectMemberHandler")
+	}
+	if o.RemoveUserHandler == nil {
+		unregistered = append(unregistered, "RemoveUserHandler")
+	}
+	if o.RequestAccessHandler == nil {
+		unregistered = append(unregistered, "RequestAccessHandler")
+	}
+	if o.ReviewPlanRevisionHandler == nil {
+		unregistered = append(unregistered, "ReviewPlanRevisionHandler")
+	}
+	if o.RevokeAPITokenHandler == nil {
+		unregistered = append(unregistered, "RevokeAPITokenHandler")
+	}
+	if o.SetCommitPolicyHandler == nil {
+		unregistered = append(unregistered, "SetCommitPolicyHandler")
+	}
+	if o.SetProjectMemberHandler == nil {
+		unregistered = append(unregistered, "SetProjectMemberHandler")
+	}
+	if o.SetUserRolesHandler == nil {
+		unregistered = append(unregistered, "SetUserRolesHandler")
+	}
+	if o.SubscribeToProjectHandler == nil {
+		unregistered = append(unregistered, "SubscribeToProjectHandler")
+	}
+	if o.TestWebhookHandler == nil {
+		unregistered = append(unregistered, "TestWebhookHandler")
+	}
+	if o.UnsubscribeFromProjectHandler == nil {
+		unregistered = append(unregistered, "UnsubscribeFromProjectHandler")
+	}
+	if o.UpdateOrgUnitHandler == nil {
+		unregistered = append(unregistered, "UpdateOrgUnitHandler")
+	}
 	if o.UpdateProjectHandler == nil {
 		unregistered = append(unregistered, "UpdateProjectHandler")
 	}
@@ -402,6 +762,26 @@ func (o *BesecAPI) initHandlerCache() {
 		o.handlers = make(map[string]map[string]http.Handler)
 	}
 
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/access-requests/{uid}/approve"] = NewApproveAccessRequest(o.context, o.ApproveAccessRequestHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/plan/{id}/practice/{practiceId}/task/{taskId}/risk-acceptance/approval"] = NewApproveRiskAcceptance(o.context, o.ApproveRiskAcceptanceHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/user/{uid}/authorize"] = NewAuthorizeUser(o.context, o.AuthorizeUserHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/tokens"] = NewCreateAPIToken(o.context, o.CreateAPITokenHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/orgunit"] = NewCreateOrgUnit(o.context, o.CreateOrgUnitHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
@@ -414,6 +794,22 @@ func (o *BesecAPI) initHandlerCache() {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/project"] = NewCreateProject(o.context, o.CreateProjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/plan/{id}/practice/{practiceId}/task/{taskId}/issue"] = NewCreateTaskIssue(o.context, o.CreateTaskIssueHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks"] = NewCreateWebhook(o.context, o.CreateWebhookHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/user/{uid}/deauthorize"] = NewDeauthorizeUser(o.context, o.DeauthorizeUserHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/orgunit/{id}"] = NewDeleteOrgUnit(o.context, o.DeleteOrgUnitHandler)
 	if o.handlers["DELETE"] == nil {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
@@ -422,6 +818,14 @@ func (o *BesecAPI) initHandlerCache() {
 		o.handlers["DELETE"] = make(map[string]http.Handler)
 	}
 	o.handlers["DELETE"]["/project/{id}"] = NewDeleteProject(o.context, o.DeleteProjectHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/webhooks/{id}"] = NewDeleteWebhook(o.context, o.DeleteWebhookHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/access-requests/{uid}/deny"] = NewDenyAccessRequest(o.context, o.DenyAccessRequestHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
@@ -429,6 +833,26 @@ func (o *BesecAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/commit-policy"] = NewGetCommitPolicy(o.context, o.GetCommitPolicyHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/me"] = NewGetCurrentUser(o.context, o.GetCurrentUserHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/metrics/maturity"] = NewGetMaturityMetrics(o.context, o.GetMaturityMetricsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/access-request"] = NewGetMyAccessRequest(o.context, o.GetMyAccessRequestHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orgunit/{id}"] = NewGetOrgUnit(o.context, o.GetOrgUnitHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/plan/{id}"] = NewGetPlan(o.context, o.GetPlanHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -437,6 +861,10 @@ func (o *BesecAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/plan/{id}/revision/{revId}/attestation"] = NewGetPlanRevisionAttestation(o.context, o.GetPlanRevisionAttestationHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/plan/{id}/revision/{revId}/responses"] = NewGetPlanRevisionPracticeResponses(o.context, o.GetPlanRevisionPracticeResponsesHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
@@ -453,15 +881,127 @@ func (o *BesecAPI) initHandlerCache() {
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/project/{id}/subscription"] = NewGetProjectSubscription(o.context, o.GetProjectSubscriptionHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/{uid}"] = NewGetUser(o.context, o.GetUserHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user/{uid}/roles"] = NewGetUserRoles(o.context, o.GetUserRolesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/access-requests"] = NewListAccessRequests(o.context, o.ListAccessRequestsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/tokens"] = NewListAPITokens(o.context, o.ListAPITokensHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/audit"] = NewListAuditEvents(o.context, o.ListAuditEventsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/notifications"] = NewListNotifications(o.context, o.ListNotificationsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/tasks"] = NewListOpenTasks(o.context, o.ListOpenTasksHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/orgunit"] = NewListOrgUnits(o.context, o.ListOrgUnitsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/overdue-projects"] = NewListOverdueProjects(o.context, o.ListOverdueProjectsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/reviews"] = NewListPlanReviews(o.context, o.ListPlanReviewsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/practices"] = NewListPracticesVersions(o.context, o.ListPracticesVersionsHandler)
 	if o.handlers["GET"] == nil {
 		o.handlers["GET"] = make(map[string]http.Handler)
 	}
+	o.handlers["GET"]["/project/{id}/members"] = NewListProjectMembers(o.context, o.ListProjectMembersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
 	o.handlers["GET"]["/project"] = NewListProjects(o.context, o.ListProjectsHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/risk-acceptances"] = NewListRiskAcceptances(o.context, o.ListRiskAcceptancesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/user"] = NewListUsers(o.context, o.ListUsersHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks/{id}/deliveries"] = NewListWebhookDeliveries(o.context, o.ListWebhookDeliveriesHandler)
+	if o.handlers["GET"] == nil {
+		o.handlers["GET"] = make(map[string]http.Handler)
+	}
+	o.handlers["GET"]["/webhooks"] = NewListWebhooks(o.context, o.ListWebhooksHandler)
 	if o.handlers["POST"] == nil {
 		o.handlers["POST"] = make(map[string]http.Handler)
 	}
 	o.handlers["POST"]["/auth"] = NewLoggedIn(o.context, o.LoggedInHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/project/{id}/members/{uid}"] = NewRemoveProjectMember(o.context, o.RemoveProjectMemberHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/user/{uid}"] = NewRemoveUser(o.context, o.RemoveUserHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/access-request"] = NewRequestAccess(o.context, o.RequestAccessHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/plan/{id}/revision/{revId}/review"] = NewReviewPlanRevision(o.context, o.ReviewPlanRevisionHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/tokens/{id}"] = NewRevokeAPIToken(o.context, o.RevokeAPITokenHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/commit-policy"] = NewSetCommitPolicy(o.context, o.SetCommitPolicyHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/project/{id}/members/{uid}"] = NewSetProjectMember(o.context, o.SetProjectMemberHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/user/{uid}/roles"] = NewSetUserRoles(o.context, o.SetUserRolesHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/project/{id}/subscription"] = NewSubscribeToProject(o.context, o.SubscribeToProjectHandler)
+	if o.handlers["POST"] == nil {
+		o.handlers["POST"] = make(map[string]http.Handler)
+	}
+	o.handlers["POST"]["/webhooks/{id}/test"] = NewTestWebhook(o.context, o.TestWebhookHandler)
+	if o.handlers["DELETE"] == nil {
+		o.handlers["DELETE"] = make(map[string]http.Handler)
+	}
+	o.handlers["DELETE"]["/project/{id}/subscription"] = NewUnsubscribeFromProject(o.context, o.UnsubscribeFromProjectHandler)
+	if o.handlers["PUT"] == nil {
+		o.handlers["PUT"] = make(map[string]http.Handler)
+	}
+	o.handlers["PUT"]["/orgunit/{id}"] = NewUpdateOrgUnit(o.context, o.UpdateOrgUnitHandler)
 	if o.handlers["PUT"] == nil {
 		o.handlers["PUT"] = make(map[string]http.Handler)
 	}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// CreateAPITokenHandlerFunc turns a function with the right signature into a create Api token handler
+type CreateAPITokenHandlerFunc func(CreateAPITokenParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateAPITokenHandlerFunc) Handle(params CreateAPITokenParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateAPITokenHandler interface for that can handle valid create Api token params
+type CreateAPITokenHandler interface {
+	Handle(CreateAPITokenParams, *models.User) middleware.Responder
+}
+
+// NewCreateAPIToken creates a new http.Handler for the create Api token operation
+func NewCreateAPIToken(ctx *middleware.Context, handler CreateAPITokenHandler) *CreateAPIToken {
+	return &CreateAPIToken{Context: ctx, Handler: handler}
+}
+
+/* CreateAPIToken swagger:route POST /tokens createApiToken
+
+Create a long-lived API token. Without a service account, the token acts as the caller, limited to its scopes. Only security admins can create service account tokens. The secret is only returned here; only a hash of it is kept.
+
+
+*/
+type CreateAPIToken struct {
+	Context *middleware.Context
+	Handler CreateAPITokenHandler
+}
+
+func (o *CreateAPIToken) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateAPITokenParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// CreateAPITokenCreatedCode is the HTTP code returned for type CreateAPITokenCreated
+const CreateAPITokenCreatedCode int = 201
+
+/*CreateAPITokenCreated Created
+
+swagger:response createApiTokenCreated
+*/
+type CreateAPITokenCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NewAPIToken `json:"body,omitempty"`
+}
+
+// NewCreateAPITokenCreated creates CreateAPITokenCreated with default headers values
+func NewCreateAPITokenCreated() *CreateAPITokenCreated {
+
+	return &CreateAPITokenCreated{}
+}
+
+// WithPayload adds the payload to the create Api token created response
+func (o *CreateAPITokenCreated) WithPayload(payload *models.NewAPIToken) *CreateAPITokenCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create Api token created response
+func (o *CreateAPITokenCreated) SetPayload(payload *models.NewAPIToken) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAPITokenCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateAPITokenDefault error
+
+swagger:response createApiTokenDefault
+*/
+type CreateAPITokenDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateAPITokenDefault creates CreateAPITokenDefault with default headers values
+func NewCreateAPITokenDefault(code int) *CreateAPITokenDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateAPITokenDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create Api token default response
+func (o *CreateAPITokenDefault) WithStatusCode(code int) *CreateAPITokenDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create Api token default response
+func (o *CreateAPITokenDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create Api token default response
+func (o *CreateAPITokenDefault) WithPayload(payload *models.Error) *CreateAPITokenDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create Api token default response
+func (o *CreateAPITokenDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateAPITokenDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// CreateOrgUnitHandlerFunc turns a function with the right signature into a create org unit handler
+type CreateOrgUnitHandlerFunc func(CreateOrgUnitParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateOrgUnitHandlerFunc) Handle(params CreateOrgUnitParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateOrgUnitHandler interface for that can handle valid create org unit params
+type CreateOrgUnitHandler interface {
+	Handle(CreateOrgUnitParams, *models.User) middleware.Responder
+}
+
+// NewCreateOrgUnit creates a new http.Handler for the create org unit operation
+func NewCreateOrgUnit(ctx *middleware.Context, handler CreateOrgUnitHandler) *CreateOrgUnit {
+	return &CreateOrgUnit{Context: ctx, Handler: handler}
+}
+
+/* CreateOrgUnit swagger:route POST /orgunit createOrgUnit
+
+Create an org unit. Top-level units can only be created by security admins; other units by the leads of any of their ancestors.
+
+*/
+type CreateOrgUnit struct {
+	Context *middleware.Context
+	Handler CreateOrgUnitHandler
+}
+
+func (o *CreateOrgUnit) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateOrgUnitParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
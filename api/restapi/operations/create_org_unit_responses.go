@@ -0,0 +1,114 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// CreateOrgUnitCreatedCode is the HTTP code returned for type CreateOrgUnitCreated
+const CreateOrgUnitCreatedCode int = 201
+
+/*CreateOrgUnitCreated Created
+
+swagger:response createOrgUnitCreated
+*/
+type CreateOrgUnitCreated struct {
+
+	/*The ID of the org unit
+	  In: Body
+	*/
+	Payload string `json:"body,omitempty"`
+}
+
+// NewCreateOrgUnitCreated creates CreateOrgUnitCreated with default headers values
+func NewCreateOrgUnitCreated() *CreateOrgUnitCreated {
+
+	return &CreateOrgUnitCreated{}
+}
+
+// WithPayload adds the payload to the create org unit created response
+func (o *CreateOrgUnitCreated) WithPayload(payload string) *CreateOrgUnitCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create org unit created response
+func (o *CreateOrgUnitCreated) SetPayload(payload string) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrgUnitCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	payload := o.Payload
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*CreateOrgUnitDefault error
+
+swagger:response createOrgUnitDefault
+*/
+type CreateOrgUnitDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateOrgUnitDefault creates CreateOrgUnitDefault with default headers values
+func NewCreateOrgUnitDefault(code int) *CreateOrgUnitDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateOrgUnitDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create org unit default response
+func (o *CreateOrgUnitDefault) WithStatusCode(code int) *CreateOrgUnitDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create org unit default response
+func (o *CreateOrgUnitDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create org unit default response
+func (o *CreateOrgUnitDefault) WithPayload(payload *models.Error) *CreateOrgUnitDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create org unit default response
+func (o *CreateOrgUnitDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateOrgUnitDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
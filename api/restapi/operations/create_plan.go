@@ -39,7 +39,8 @@ func NewCreatePlan(ctx *middleware.Context, handler CreatePlanHandler) *CreatePl
 
 /* CreatePlan swagger:route POST /plan createPlan
 
-CreatePlan create plan API
+Create a plan with its first revision. A committed revision must meet the commit policy, otherwise the error lists the violations.
+
 
 */
 type CreatePlan struct {
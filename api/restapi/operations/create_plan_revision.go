@@ -39,7 +39,8 @@ func NewCreatePlanRevision(ctx *middleware.Context, handler CreatePlanRevisionHa
 
 /* CreatePlanRevision swagger:route POST /plan/{id} createPlanRevision
 
-CreatePlanRevision create plan revision API
+Create a new revision of the plan. A committed revision must meet the commit policy, otherwise the error lists the violations.
+
 
 */
 type CreatePlanRevision struct {
@@ -0,0 +1,112 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// CreateTaskIssueHandlerFunc turns a function with the right signature into a create task issue handler
+type CreateTaskIssueHandlerFunc func(CreateTaskIssueParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn CreateTaskIssueHandlerFunc) Handle(params CreateTaskIssueParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// CreateTaskIssueHandler interface for that can handle valid create task issue params
+type CreateTaskIssueHandler interface {
+	Handle(CreateTaskIssueParams, *models.User) middleware.Responder
+}
+
+// NewCreateTaskIssue creates a new http.Handler for the create task issue operation
+func NewCreateTaskIssue(ctx *middleware.Context, handler CreateTaskIssueHandler) *CreateTaskIssue {
+	return &CreateTaskIssue{Context: ctx, Handler: handler}
+}
+
+/* CreateTaskIssue swagger:route POST /plan/{id}/practice/{practiceId}/task/{taskId}/issue createTaskIssue
+
+Creates an issue for a prioritised task in the plan's latest revision, from the task's title and description, and links it to the task in a new revision of the plan. The new revision keeps the review state of the revision it's based on.
+
+
+*/
+type CreateTaskIssue struct {
+	Context *middleware.Context
+	Handler CreateTaskIssueHandler
+}
+
+func (o *CreateTaskIssue) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewCreateTaskIssueParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// CreateTaskIssueBody create task issue body
+//
+// swagger:model CreateTaskIssueBody
+type CreateTaskIssueBody struct {
+
+	// The name of the issue tracker to use, if more than one is configured. Defaults to the first tracker that serves the plan's projects.
+	Tracker string `json:"tracker,omitempty"`
+}
+
+// Validate validates this create task issue body
+func (o *CreateTaskIssueBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this create task issue body based on context it is used
+func (o *CreateTaskIssueBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *CreateTaskIssueBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *CreateTaskIssueBody) UnmarshalBinary(b []byte) error {
+	var res CreateTaskIssueBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
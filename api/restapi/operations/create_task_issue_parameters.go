@@ -0,0 +1,148 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewCreateTaskIssueParams creates a new CreateTaskIssueParams object
+//
+// There are no default values defined in the spec.
+func NewCreateTaskIssueParams() CreateTaskIssueParams {
+
+	return CreateTaskIssueParams{}
+}
+
+// CreateTaskIssueParams contains all the bound params for the create task issue operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters createTaskIssue
+type CreateTaskIssueParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: body
+	*/
+	Body CreateTaskIssueBody
+	/*
+	  Required: true
+	  In: path
+	*/
+	ID string
+	/*
+	  Required: true
+	  In: path
+	*/
+	PracticeID string
+	/*
+	  Required: true
+	  In: path
+	*/
+	TaskID string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewCreateTaskIssueParams() beforehand.
+func (o *CreateTaskIssueParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	if runtime.HasBody(r) {
+		defer r.Body.Close()
+		var body CreateTaskIssueBody
+		if err := route.Consumer.Consume(r.Body, &body); err != nil {
+			res = append(res, errors.NewParseError("body", "body", "", err))
+		} else {
+			// validate body object
+			if err := body.Validate(route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			ctx := validate.WithOperationRequest(context.Background())
+			if err := body.ContextValidate(ctx, route.Formats); err != nil {
+				res = append(res, err)
+			}
+
+			if len(res) == 0 {
+				o.Body = body
+			}
+		}
+	}
+
+	rID, rhkID, _ := route.Params.GetOK("id")
+	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rPracticeID, rhkPracticeID, _ := route.Params.GetOK("practiceId")
+	if err := o.bindPracticeID(rPracticeID, rhkPracticeID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	rTaskID, rhkTaskID, _ := route.Params.GetOK("taskId")
+	if err := o.bindTaskID(rTaskID, rhkTaskID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindID binds and validates parameter ID from path.
+func (o *CreateTaskIssueParams) bindID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.ID = raw
+
+	return nil
+}
+
+// bindPracticeID binds and validates parameter PracticeID from path.
+func (o *CreateTaskIssueParams) bindPracticeID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.PracticeID = raw
+
+	return nil
+}
+
+// bindTaskID binds and validates parameter TaskID from path.
+func (o *CreateTaskIssueParams) bindTaskID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.TaskID = raw
+
+	return nil
+}
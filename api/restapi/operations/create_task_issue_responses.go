@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// CreateTaskIssueCreatedCode is the HTTP code returned for type CreateTaskIssueCreated
+const CreateTaskIssueCreatedCode int = 201
+
+/*CreateTaskIssueCreated Created
+
+swagger:response createTaskIssueCreated
+*/
+type CreateTaskIssueCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *lib.LinkedIssue `json:"body,omitempty"`
+}
+
+// NewCreateTaskIssueCreated creates CreateTaskIssueCreated with default headers values
+func NewCreateTaskIssueCreated() *CreateTaskIssueCreated {
+
+	return &CreateTaskIssueCreated{}
+}
+
+// WithPayload adds the payload to the create task issue created response
+func (o *CreateTaskIssueCreated) WithPayload(payload *lib.LinkedIssue) *CreateTaskIssueCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create task issue created response
+func (o *CreateTaskIssueCreated) SetPayload(payload *lib.LinkedIssue) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTaskIssueCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateTaskIssueDefault error
+
+swagger:response createTaskIssueDefault
+*/
+type CreateTaskIssueDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateTaskIssueDefault creates CreateTaskIssueDefault with default headers values
+func NewCreateTaskIssueDefault(code int) *CreateTaskIssueDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateTaskIssueDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create task issue default response
+func (o *CreateTaskIssueDefault) WithStatusCode(code int) *CreateTaskIssueDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create task issue default response
+func (o *CreateTaskIssueDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create task issue default response
+func (o *CreateTaskIssueDefault) WithPayload(payload *models.Error) *CreateTaskIssueDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create task issue default response
+func (o *CreateTaskIssueDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateTaskIssueDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
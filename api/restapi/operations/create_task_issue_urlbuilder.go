@@ -0,0 +1,115 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+	"strings"
+)
+
+// CreateTaskIssueURL generates an URL for the create task issue operation
+type CreateTaskIssueURL struct {
+	ID         string
+	PracticeID string
+	TaskID     string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *CreateTaskIssueURL) WithBasePath(bp string) *CreateTaskIssueURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *CreateTaskIssueURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *CreateTaskIssueURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/plan/{id}/practice/{practiceId}/task/{taskId}/issue"
+
+	id := o.ID
+	if id != "" {
+		_path = strings.Replace(_path, "{id}", id, -1)
+	} else {
+		return nil, errors.New("id is required on CreateTaskIssueURL")
+	}
+
+	practiceID := o.PracticeID
+	if practiceID != "" {
+		_path = strings.Replace(_path, "{practiceId}", practiceID, -1)
+	} else {
+		return nil, errors.New("practiceId is required on CreateTaskIssueURL")
+	}
+
+	taskID := o.TaskID
+	if taskID != "" {
+		_path = strings.Replace(_path, "{taskId}", taskID, -1)
+	} else {
+		return nil, errors.New("taskId is required on CreateTaskIssueURL")
+	}
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1alpha1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *CreateTaskIssueURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *CreateTaskIssueURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *CreateTaskIssueURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on CreateTaskIssueURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on CreateTaskIssueURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *CreateTaskIssueURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
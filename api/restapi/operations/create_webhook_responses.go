@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// CreateWebhookCreatedCode is the HTTP code returned for type CreateWebhookCreated
+const CreateWebhookCreatedCode int = 201
+
+/*CreateWebhookCreated Created
+
+swagger:response createWebhookCreated
+*/
+type CreateWebhookCreated struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.NewWebhook `json:"body,omitempty"`
+}
+
+// NewCreateWebhookCreated creates CreateWebhookCreated with default headers values
+func NewCreateWebhookCreated() *CreateWebhookCreated {
+
+	return &CreateWebhookCreated{}
+}
+
+// WithPayload adds the payload to the create webhook created response
+func (o *CreateWebhookCreated) WithPayload(payload *models.NewWebhook) *CreateWebhookCreated {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook created response
+func (o *CreateWebhookCreated) SetPayload(payload *models.NewWebhook) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookCreated) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(201)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*CreateWebhookDefault error
+
+swagger:response createWebhookDefault
+*/
+type CreateWebhookDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewCreateWebhookDefault creates CreateWebhookDefault with default headers values
+func NewCreateWebhookDefault(code int) *CreateWebhookDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &CreateWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the create webhook default response
+func (o *CreateWebhookDefault) WithStatusCode(code int) *CreateWebhookDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the create webhook default response
+func (o *CreateWebhookDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the create webhook default response
+func (o *CreateWebhookDefault) WithPayload(payload *models.Error) *CreateWebhookDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the create webhook default response
+func (o *CreateWebhookDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *CreateWebhookDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
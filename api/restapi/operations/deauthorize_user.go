@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DeauthorizeUserHandlerFunc turns a function with the right signature into a deauthorize user handler
+type DeauthorizeUserHandlerFunc func(DeauthorizeUserParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeauthorizeUserHandlerFunc) Handle(params DeauthorizeUserParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeauthorizeUserHandler interface for that can handle valid deauthorize user params
+type DeauthorizeUserHandler interface {
+	Handle(DeauthorizeUserParams, *models.User) middleware.Responder
+}
+
+// NewDeauthorizeUser creates a new http.Handler for the deauthorize user operation
+func NewDeauthorizeUser(ctx *middleware.Context, handler DeauthorizeUserHandler) *DeauthorizeUser {
+	return &DeauthorizeUser{Context: ctx, Handler: handler}
+}
+
+/* DeauthorizeUser swagger:route POST /user/{uid}/deauthorize deauthorizeUser
+
+Remove the user's manual authorization
+
+*/
+type DeauthorizeUser struct {
+	Context *middleware.Context
+	Handler DeauthorizeUserHandler
+}
+
+func (o *DeauthorizeUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeauthorizeUserParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewDeauthorizeUserParams creates a new DeauthorizeUserParams object
+//
+// There are no default values defined in the spec.
+func NewDeauthorizeUserParams() DeauthorizeUserParams {
+
+	return DeauthorizeUserParams{}
+}
+
+// DeauthorizeUserParams contains all the bound params for the deauthorize user operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters deauthorizeUser
+type DeauthorizeUserParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  Required: true
+	  In: path
+	*/
+	UID string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewDeauthorizeUserParams() beforehand.
+func (o *DeauthorizeUserParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	rUID, rhkUID, _ := route.Params.GetOK("uid")
+	if err := o.bindUID(rUID, rhkUID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindUID binds and validates parameter UID from path.
+func (o *DeauthorizeUserParams) bindUID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: true
+	// Parameter is provided by construction from the route
+	o.UID = raw
+
+	return nil
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DeauthorizeUserOKCode is the HTTP code returned for type DeauthorizeUserOK
+const DeauthorizeUserOKCode int = 200
+
+/*DeauthorizeUserOK OK
+
+swagger:response deauthorizeUserOK
+*/
+type DeauthorizeUserOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.ManagedUser `json:"body,omitempty"`
+}
+
+// NewDeauthorizeUserOK creates DeauthorizeUserOK with default headers values
+func NewDeauthorizeUserOK() *DeauthorizeUserOK {
+
+	return &DeauthorizeUserOK{}
+}
+
+// WithPayload adds the payload to the deauthorize user o k response
+func (o *DeauthorizeUserOK) WithPayload(payload *models.ManagedUser) *DeauthorizeUserOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deauthorize user o k response
+func (o *DeauthorizeUserOK) SetPayload(payload *models.ManagedUser) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeauthorizeUserOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DeauthorizeUserDefault error
+
+swagger:response deauthorizeUserDefault
+*/
+type DeauthorizeUserDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeauthorizeUserDefault creates DeauthorizeUserDefault with default headers values
+func NewDeauthorizeUserDefault(code int) *DeauthorizeUserDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeauthorizeUserDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the deauthorize user default response
+func (o *DeauthorizeUserDefault) WithStatusCode(code int) *DeauthorizeUserDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the deauthorize user default response
+func (o *DeauthorizeUserDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the deauthorize user default response
+func (o *DeauthorizeUserDefault) WithPayload(payload *models.Error) *DeauthorizeUserDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deauthorize user default response
+func (o *DeauthorizeUserDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeauthorizeUserDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DeleteOrgUnitHandlerFunc turns a function with the right signature into a delete org unit handler
+type DeleteOrgUnitHandlerFunc func(DeleteOrgUnitParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteOrgUnitHandlerFunc) Handle(params DeleteOrgUnitParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeleteOrgUnitHandler interface for that can handle valid delete org unit params
+type DeleteOrgUnitHandler interface {
+	Handle(DeleteOrgUnitParams, *models.User) middleware.Responder
+}
+
+// NewDeleteOrgUnit creates a new http.Handler for the delete org unit operation
+func NewDeleteOrgUnit(ctx *middleware.Context, handler DeleteOrgUnitHandler) *DeleteOrgUnit {
+	return &DeleteOrgUnit{Context: ctx, Handler: handler}
+}
+
+/* DeleteOrgUnit swagger:route DELETE /orgunit/{id} deleteOrgUnit
+
+Delete an org unit. It can't have any child units or projects.
+
+*/
+type DeleteOrgUnit struct {
+	Context *middleware.Context
+	Handler DeleteOrgUnitHandler
+}
+
+func (o *DeleteOrgUnit) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteOrgUnitParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DeleteOrgUnitNoContentCode is the HTTP code returned for type DeleteOrgUnitNoContent
+const DeleteOrgUnitNoContentCode int = 204
+
+/*DeleteOrgUnitNoContent Deleted
+
+swagger:response deleteOrgUnitNoContent
+*/
+type DeleteOrgUnitNoContent struct {
+}
+
+// NewDeleteOrgUnitNoContent creates DeleteOrgUnitNoContent with default headers values
+func NewDeleteOrgUnitNoContent() *DeleteOrgUnitNoContent {
+
+	return &DeleteOrgUnitNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteOrgUnitNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteOrgUnitDefault error
+
+swagger:response deleteOrgUnitDefault
+*/
+type DeleteOrgUnitDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteOrgUnitDefault creates DeleteOrgUnitDefault with default headers values
+func NewDeleteOrgUnitDefault(code int) *DeleteOrgUnitDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteOrgUnitDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete org unit default response
+func (o *DeleteOrgUnitDefault) WithStatusCode(code int) *DeleteOrgUnitDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete org unit default response
+func (o *DeleteOrgUnitDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete org unit default response
+func (o *DeleteOrgUnitDefault) WithPayload(payload *models.Error) *DeleteOrgUnitDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete org unit default response
+func (o *DeleteOrgUnitDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteOrgUnitDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
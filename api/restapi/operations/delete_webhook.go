@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DeleteWebhookHandlerFunc turns a function with the right signature into a delete webhook handler
+type DeleteWebhookHandlerFunc func(DeleteWebhookParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DeleteWebhookHandlerFunc) Handle(params DeleteWebhookParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DeleteWebhookHandler interface for that can handle valid delete webhook params
+type DeleteWebhookHandler interface {
+	Handle(DeleteWebhookParams, *models.User) middleware.Responder
+}
+
+// NewDeleteWebhook creates a new http.Handler for the delete webhook operation
+func NewDeleteWebhook(ctx *middleware.Context, handler DeleteWebhookHandler) *DeleteWebhook {
+	return &DeleteWebhook{Context: ctx, Handler: handler}
+}
+
+/* DeleteWebhook swagger:route DELETE /webhooks/{id} deleteWebhook
+
+Remove an outbound webhook. Requires the admin permission.
+
+*/
+type DeleteWebhook struct {
+	Context *middleware.Context
+	Handler DeleteWebhookHandler
+}
+
+func (o *DeleteWebhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDeleteWebhookParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
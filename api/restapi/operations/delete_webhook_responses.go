@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DeleteWebhookNoContentCode is the HTTP code returned for type DeleteWebhookNoContent
+const DeleteWebhookNoContentCode int = 204
+
+/*DeleteWebhookNoContent Deleted
+
+swagger:response deleteWebhookNoContent
+*/
+type DeleteWebhookNoContent struct {
+}
+
+// NewDeleteWebhookNoContent creates DeleteWebhookNoContent with default headers values
+func NewDeleteWebhookNoContent() *DeleteWebhookNoContent {
+
+	return &DeleteWebhookNoContent{}
+}
+
+// WriteResponse to the client
+func (o *DeleteWebhookNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*DeleteWebhookDefault error
+
+swagger:response deleteWebhookDefault
+*/
+type DeleteWebhookDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDeleteWebhookDefault creates DeleteWebhookDefault with default headers values
+func NewDeleteWebhookDefault(code int) *DeleteWebhookDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DeleteWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the delete webhook default response
+func (o *DeleteWebhookDefault) WithStatusCode(code int) *DeleteWebhookDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the delete webhook default response
+func (o *DeleteWebhookDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the delete webhook default response
+func (o *DeleteWebhookDefault) WithPayload(payload *models.Error) *DeleteWebhookDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the delete webhook default response
+func (o *DeleteWebhookDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DeleteWebhookDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DenyAccessRequestHandlerFunc turns a function with the right signature into a deny access request handler
+type DenyAccessRequestHandlerFunc func(DenyAccessRequestParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn DenyAccessRequestHandlerFunc) Handle(params DenyAccessRequestParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// DenyAccessRequestHandler interface for that can handle valid deny access request params
+type DenyAccessRequestHandler interface {
+	Handle(DenyAccessRequestParams, *models.User) middleware.Responder
+}
+
+// NewDenyAccessRequest creates a new http.Handler for the deny access request operation
+func NewDenyAccessRequest(ctx *middleware.Context, handler DenyAccessRequestHandler) *DenyAccessRequest {
+	return &DenyAccessRequest{Context: ctx, Handler: handler}
+}
+
+/* DenyAccessRequest swagger:route POST /access-requests/{uid}/deny denyAccessRequest
+
+DenyAccessRequest deny access request API
+
+*/
+type DenyAccessRequest struct {
+	Context *middleware.Context
+	Handler DenyAccessRequestHandler
+}
+
+func (o *DenyAccessRequest) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewDenyAccessRequestParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
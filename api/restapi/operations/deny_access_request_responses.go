@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// DenyAccessRequestOKCode is the HTTP code returned for type DenyAccessRequestOK
+const DenyAccessRequestOKCode int = 200
+
+/*DenyAccessRequestOK OK
+
+swagger:response denyAccessRequestOK
+*/
+type DenyAccessRequestOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AccessRequest `json:"body,omitempty"`
+}
+
+// NewDenyAccessRequestOK creates DenyAccessRequestOK with default headers values
+func NewDenyAccessRequestOK() *DenyAccessRequestOK {
+
+	return &DenyAccessRequestOK{}
+}
+
+// WithPayload adds the payload to the deny access request o k response
+func (o *DenyAccessRequestOK) WithPayload(payload *models.AccessRequest) *DenyAccessRequestOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deny access request o k response
+func (o *DenyAccessRequestOK) SetPayload(payload *models.AccessRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DenyAccessRequestOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*DenyAccessRequestDefault error
+
+swagger:response denyAccessRequestDefault
+*/
+type DenyAccessRequestDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewDenyAccessRequestDefault creates DenyAccessRequestDefault with default headers values
+func NewDenyAccessRequestDefault(code int) *DenyAccessRequestDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &DenyAccessRequestDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the deny access request default response
+func (o *DenyAccessRequestDefault) WithStatusCode(code int) *DenyAccessRequestDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the deny access request default response
+func (o *DenyAccessRequestDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the deny access request default response
+func (o *DenyAccessRequestDefault) WithPayload(payload *models.Error) *DenyAccessRequestDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the deny access request default response
+func (o *DenyAccessRequestDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *DenyAccessRequestDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetCommitPolicyHandlerFunc turns a function with the right signature into a get commit policy handler
+type GetCommitPolicyHandlerFunc func(GetCommitPolicyParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetCommitPolicyHandlerFunc) Handle(params GetCommitPolicyParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetCommitPolicyHandler interface for that can handle valid get commit policy params
+type GetCommitPolicyHandler interface {
+	Handle(GetCommitPolicyParams, *models.User) middleware.Responder
+}
+
+// NewGetCommitPolicy creates a new http.Handler for the get commit policy operation
+func NewGetCommitPolicy(ctx *middleware.Context, handler GetCommitPolicyHandler) *GetCommitPolicy {
+	return &GetCommitPolicy{Context: ctx, Handler: handler}
+}
+
+/* GetCommitPolicy swagger:route GET /commit-policy getCommitPolicy
+
+Get the rules, besides every applicable question being answered, that plans must meet to be committed
+
+*/
+type GetCommitPolicy struct {
+	Context *middleware.Context
+	Handler GetCommitPolicyHandler
+}
+
+func (o *GetCommitPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetCommitPolicyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
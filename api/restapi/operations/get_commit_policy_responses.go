@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// GetCommitPolicyOKCode is the HTTP code returned for type GetCommitPolicyOK
+const GetCommitPolicyOKCode int = 200
+
+/*GetCommitPolicyOK OK
+
+swagger:response getCommitPolicyOK
+*/
+type GetCommitPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *lib.CommitPolicy `json:"body,omitempty"`
+}
+
+// NewGetCommitPolicyOK creates GetCommitPolicyOK with default headers values
+func NewGetCommitPolicyOK() *GetCommitPolicyOK {
+
+	return &GetCommitPolicyOK{}
+}
+
+// WithPayload adds the payload to the get commit policy o k response
+func (o *GetCommitPolicyOK) WithPayload(payload *lib.CommitPolicy) *GetCommitPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get commit policy o k response
+func (o *GetCommitPolicyOK) SetPayload(payload *lib.CommitPolicy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetCommitPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetCommitPolicyDefault error
+
+swagger:response getCommitPolicyDefault
+*/
+type GetCommitPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetCommitPolicyDefault creates GetCommitPolicyDefault with default headers values
+func NewGetCommitPolicyDefault(code int) *GetCommitPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetCommitPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get commit policy default response
+func (o *GetCommitPolicyDefault) WithStatusCode(code int) *GetCommitPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get commit policy default response
+func (o *GetCommitPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get commit policy default response
+func (o *GetCommitPolicyDefault) WithPayload(payload *models.Error) *GetCommitPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get commit policy default response
+func (o *GetCommitPolicyDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetCommitPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetMaturityMetricsHandlerFunc turns a function with the right signature into a get maturity metrics handler
+type GetMaturityMetricsHandlerFunc func(GetMaturityMetricsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetMaturityMetricsHandlerFunc) Handle(params GetMaturityMetricsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetMaturityMetricsHandler interface for that can handle valid get maturity metrics params
+type GetMaturityMetricsHandler interface {
+	Handle(GetMaturityMetricsParams, *models.User) middleware.Responder
+}
+
+// NewGetMaturityMetrics creates a new http.Handler for the get maturity metrics operation
+func NewGetMaturityMetrics(ctx *middleware.Context, handler GetMaturityMetricsHandler) *GetMaturityMetrics {
+	return &GetMaturityMetrics{Context: ctx, Handler: handler}
+}
+
+/* GetMaturityMetrics swagger:route GET /metrics/maturity getMaturityMetrics
+
+Roll the maturity of each project up the org unit tree. Each project's maturity is taken from the most recent committed revision of its plans, and each org unit summarises the projects in it and all of its descendants.
+
+
+*/
+type GetMaturityMetrics struct {
+	Context *middleware.Context
+	Handler GetMaturityMetricsHandler
+}
+
+func (o *GetMaturityMetrics) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetMaturityMetricsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetMaturityMetricsOKCode is the HTTP code returned for type GetMaturityMetricsOK
+const GetMaturityMetricsOKCode int = 200
+
+/*GetMaturityMetricsOK OK
+
+swagger:response getMaturityMetricsOK
+*/
+type GetMaturityMetricsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.OrgUnitMaturity `json:"body,omitempty"`
+}
+
+// NewGetMaturityMetricsOK creates GetMaturityMetricsOK with default headers values
+func NewGetMaturityMetricsOK() *GetMaturityMetricsOK {
+
+	return &GetMaturityMetricsOK{}
+}
+
+// WithPayload adds the payload to the get maturity metrics o k response
+func (o *GetMaturityMetricsOK) WithPayload(payload []*models.OrgUnitMaturity) *GetMaturityMetricsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get maturity metrics o k response
+func (o *GetMaturityMetricsOK) SetPayload(payload []*models.OrgUnitMaturity) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMaturityMetricsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.OrgUnitMaturity, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*GetMaturityMetricsDefault error
+
+swagger:response getMaturityMetricsDefault
+*/
+type GetMaturityMetricsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetMaturityMetricsDefault creates GetMaturityMetricsDefault with default headers values
+func NewGetMaturityMetricsDefault(code int) *GetMaturityMetricsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetMaturityMetricsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get maturity metrics default response
+func (o *GetMaturityMetricsDefault) WithStatusCode(code int) *GetMaturityMetricsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get maturity metrics default response
+func (o *GetMaturityMetricsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get maturity metrics default response
+func (o *GetMaturityMetricsDefault) WithPayload(payload *models.Error) *GetMaturityMetricsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get maturity metrics default response
+func (o *GetMaturityMetricsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMaturityMetricsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
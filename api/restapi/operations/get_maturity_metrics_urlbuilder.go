@@ -0,0 +1,162 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
+)
+
+// GetMaturityMetricsURL generates an URL for the get maturity metrics operation
+type GetMaturityMetricsURL struct {
+	DataClassification *string
+	InternetFacing     *bool
+	OrgUnit            *string
+	ProductType        *string
+	Tag                []string
+	Tech               []string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetMaturityMetricsURL) WithBasePath(bp string) *GetMaturityMetricsURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *GetMaturityMetricsURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *GetMaturityMetricsURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/metrics/maturity"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1alpha1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var dataClassificationQ string
+	if o.DataClassification != nil {
+		dataClassificationQ = *o.DataClassification
+	}
+	if dataClassificationQ != "" {
+		qs.Set("dataClassification", dataClassificationQ)
+	}
+
+	var internetFacingQ string
+	if o.InternetFacing != nil {
+		internetFacingQ = swag.FormatBool(*o.InternetFacing)
+	}
+	if internetFacingQ != "" {
+		qs.Set("internetFacing", internetFacingQ)
+	}
+
+	var orgUnitQ string
+	if o.OrgUnit != nil {
+		orgUnitQ = *o.OrgUnit
+	}
+	if orgUnitQ != "" {
+		qs.Set("orgUnit", orgUnitQ)
+	}
+
+	var productTypeQ string
+	if o.ProductType != nil {
+		productTypeQ = *o.ProductType
+	}
+	if productTypeQ != "" {
+		qs.Set("productType", productTypeQ)
+	}
+
+	var tagIR []string
+	for _, tagI := range o.Tag {
+		tagIS := tagI
+		if tagIS != "" {
+			tagIR = append(tagIR, tagIS)
+		}
+	}
+
+	tag := swag.JoinByFormat(tagIR, "multi")
+
+	for _, qsv := range tag {
+		qs.Add("tag", qsv)
+	}
+
+	var techIR []string
+	for _, techI := range o.Tech {
+		techIS := techI
+		if techIS != "" {
+			techIR = append(techIR, techIS)
+		}
+	}
+
+	tech := swag.JoinByFormat(techIR, "multi")
+
+	for _, qsv := range tech {
+		qs.Add("tech", qsv)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *GetMaturityMetricsURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *GetMaturityMetricsURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *GetMaturityMetricsURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on GetMaturityMetricsURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on GetMaturityMetricsURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *GetMaturityMetricsURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetMyAccessRequestHandlerFunc turns a function with the right signature into a get my access request handler
+type GetMyAccessRequestHandlerFunc func(GetMyAccessRequestParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetMyAccessRequestHandlerFunc) Handle(params GetMyAccessRequestParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetMyAccessRequestHandler interface for that can handle valid get my access request params
+type GetMyAccessRequestHandler interface {
+	Handle(GetMyAccessRequestParams, *models.User) middleware.Responder
+}
+
+// NewGetMyAccessRequest creates a new http.Handler for the get my access request operation
+func NewGetMyAccessRequest(ctx *middleware.Context, handler GetMyAccessRequestHandler) *GetMyAccessRequest {
+	return &GetMyAccessRequest{Context: ctx, Handler: handler}
+}
+
+/* GetMyAccessRequest swagger:route GET /access-request getMyAccessRequest
+
+The caller's request for access, if they have made one. Users that don't yet have access can use this.
+
+*/
+type GetMyAccessRequest struct {
+	Context *middleware.Context
+	Handler GetMyAccessRequestHandler
+}
+
+func (o *GetMyAccessRequest) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetMyAccessRequestParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetMyAccessRequestOKCode is the HTTP code returned for type GetMyAccessRequestOK
+const GetMyAccessRequestOKCode int = 200
+
+/*GetMyAccessRequestOK OK
+
+swagger:response getMyAccessRequestOK
+*/
+type GetMyAccessRequestOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AccessRequest `json:"body,omitempty"`
+}
+
+// NewGetMyAccessRequestOK creates GetMyAccessRequestOK with default headers values
+func NewGetMyAccessRequestOK() *GetMyAccessRequestOK {
+
+	return &GetMyAccessRequestOK{}
+}
+
+// WithPayload adds the payload to the get my access request o k response
+func (o *GetMyAccessRequestOK) WithPayload(payload *models.AccessRequest) *GetMyAccessRequestOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get my access request o k response
+func (o *GetMyAccessRequestOK) SetPayload(payload *models.AccessRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMyAccessRequestOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetMyAccessRequestDefault error
+
+swagger:response getMyAccessRequestDefault
+*/
+type GetMyAccessRequestDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetMyAccessRequestDefault creates GetMyAccessRequestDefault with default headers values
+func NewGetMyAccessRequestDefault(code int) *GetMyAccessRequestDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetMyAccessRequestDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get my access request default response
+func (o *GetMyAccessRequestDefault) WithStatusCode(code int) *GetMyAccessRequestDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get my access request default response
+func (o *GetMyAccessRequestDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get my access request default response
+func (o *GetMyAccessRequestDefault) WithPayload(payload *models.Error) *GetMyAccessRequestDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get my access request default response
+func (o *GetMyAccessRequestDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetMyAccessRequestDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetOrgUnitHandlerFunc turns a function with the right signature into a get org unit handler
+type GetOrgUnitHandlerFunc func(GetOrgUnitParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetOrgUnitHandlerFunc) Handle(params GetOrgUnitParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetOrgUnitHandler interface for that can handle valid get org unit params
+type GetOrgUnitHandler interface {
+	Handle(GetOrgUnitParams, *models.User) middleware.Responder
+}
+
+// NewGetOrgUnit creates a new http.Handler for the get org unit operation
+func NewGetOrgUnit(ctx *middleware.Context, handler GetOrgUnitHandler) *GetOrgUnit {
+	return &GetOrgUnit{Context: ctx, Handler: handler}
+}
+
+/* GetOrgUnit swagger:route GET /orgunit/{id} getOrgUnit
+
+GetOrgUnit get org unit API
+
+*/
+type GetOrgUnit struct {
+	Context *middleware.Context
+	Handler GetOrgUnitHandler
+}
+
+func (o *GetOrgUnit) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetOrgUnitParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
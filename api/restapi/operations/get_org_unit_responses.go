@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetOrgUnitOKCode is the HTTP code returned for type GetOrgUnitOK
+const GetOrgUnitOKCode int = 200
+
+/*GetOrgUnitOK OK
+
+swagger:response getOrgUnitOK
+*/
+type GetOrgUnitOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.OrgUnit `json:"body,omitempty"`
+}
+
+// NewGetOrgUnitOK creates GetOrgUnitOK with default headers values
+func NewGetOrgUnitOK() *GetOrgUnitOK {
+
+	return &GetOrgUnitOK{}
+}
+
+// WithPayload adds the payload to the get org unit o k response
+func (o *GetOrgUnitOK) WithPayload(payload *models.OrgUnit) *GetOrgUnitOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get org unit o k response
+func (o *GetOrgUnitOK) SetPayload(payload *models.OrgUnit) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetOrgUnitOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetOrgUnitDefault error
+
+swagger:response getOrgUnitDefault
+*/
+type GetOrgUnitDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetOrgUnitDefault creates GetOrgUnitDefault with default headers values
+func NewGetOrgUnitDefault(code int) *GetOrgUnitDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetOrgUnitDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get org unit default response
+func (o *GetOrgUnitDefault) WithStatusCode(code int) *GetOrgUnitDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get org unit default response
+func (o *GetOrgUnitDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get org unit default response
+func (o *GetOrgUnitDefault) WithPayload(payload *models.Error) *GetOrgUnitDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get org unit default response
+func (o *GetOrgUnitDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetOrgUnitDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
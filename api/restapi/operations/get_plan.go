@@ -80,6 +80,9 @@ func (o *GetPlan) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
 // swagger:model GetPlanOKBody
 type GetPlanOKBody struct {
 
+	// chain
+	Chain *models.PlanChain `json:"chain,omitempty"`
+
 	// The ID of the latest revision of this plan
 	// Required: true
 	LatestRevision *string `json:"latestRevision"`
@@ -93,6 +96,10 @@ type GetPlanOKBody struct {
 func (o *GetPlanOKBody) Validate(formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.validateChain(formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.validateLatestRevision(formats); err != nil {
 		res = append(res, err)
 	}
@@ -107,6 +114,25 @@ func (o *GetPlanOKBody) Validate(formats strfmt.Registry) error {
 	return nil
 }
 
+func (o *GetPlanOKBody) validateChain(formats strfmt.Registry) error {
+	if swag.IsZero(o.Chain) { // not required
+		return nil
+	}
+
+	if o.Chain != nil {
+		if err := o.Chain.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("getPlanOK" + "." + "chain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("getPlanOK" + "." + "chain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *GetPlanOKBody) validateLatestRevision(formats strfmt.Registry) error {
 
 	if err := validate.Required("getPlanOK"+"."+"latestRevision", "body", o.LatestRevision); err != nil {
@@ -140,6 +166,10 @@ func (o *GetPlanOKBody) validatePlan(formats strfmt.Registry) error {
 func (o *GetPlanOKBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
 	var res []error
 
+	if err := o.contextValidateChain(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
 	if err := o.contextValidatePlan(ctx, formats); err != nil {
 		res = append(res, err)
 	}
@@ -150,6 +180,22 @@ func (o *GetPlanOKBody) ContextValidate(ctx context.Context, formats strfmt.Regi
 	return nil
 }
 
+func (o *GetPlanOKBody) contextValidateChain(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Chain != nil {
+		if err := o.Chain.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("getPlanOK" + "." + "chain")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("getPlanOK" + "." + "chain")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
 func (o *GetPlanOKBody) contextValidatePlan(ctx context.Context, formats strfmt.Registry) error {
 
 	if o.Plan != nil {
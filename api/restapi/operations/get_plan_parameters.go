@@ -9,8 +9,10 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetPlanParams creates a new GetPlanParams object
@@ -35,6 +37,10 @@ type GetPlanParams struct {
 	  In: path
 	*/
 	ID string
+	/*Check the integrity of the plan's revision history, reporting it in the chain property
+	  In: query
+	*/
+	VerifyChain *bool
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -46,10 +52,17 @@ func (o *GetPlanParams) BindRequest(r *http.Request, route *middleware.MatchedRo
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
+
+	qVerifyChain, qhkVerifyChain, _ := qs.GetOK("verifyChain")
+	if err := o.bindVerifyChain(qVerifyChain, qhkVerifyChain, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
@@ -69,3 +82,26 @@ func (o *GetPlanParams) bindID(rawData []string, hasKey bool, formats strfmt.Reg
 
 	return nil
 }
+
+// bindVerifyChain binds and validates parameter VerifyChain from query.
+func (o *GetPlanParams) bindVerifyChain(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("verifyChain", "query", "bool", raw)
+	}
+	o.VerifyChain = &value
+
+	return nil
+}
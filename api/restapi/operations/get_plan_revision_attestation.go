@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetPlanRevisionAttestationHandlerFunc turns a function with the right signature into a get plan revision attestation handler
+type GetPlanRevisionAttestationHandlerFunc func(GetPlanRevisionAttestationParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetPlanRevisionAttestationHandlerFunc) Handle(params GetPlanRevisionAttestationParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetPlanRevisionAttestationHandler interface for that can handle valid get plan revision attestation params
+type GetPlanRevisionAttestationHandler interface {
+	Handle(GetPlanRevisionAttestationParams, *models.User) middleware.Responder
+}
+
+// NewGetPlanRevisionAttestation creates a new http.Handler for the get plan revision attestation operation
+func NewGetPlanRevisionAttestation(ctx *middleware.Context, handler GetPlanRevisionAttestationHandler) *GetPlanRevisionAttestation {
+	return &GetPlanRevisionAttestation{Context: ctx, Handler: handler}
+}
+
+/* GetPlanRevisionAttestation swagger:route GET /plan/{id}/revision/{revId}/attestation getPlanRevisionAttestation
+
+Export a committed plan revision as an in-toto statement, signed in a DSSE envelope with the server's attestation key. The statement includes the plan's projects, date, practices version, maturity, and a digest of the full responses.
+
+
+*/
+type GetPlanRevisionAttestation struct {
+	Context *middleware.Context
+	Handler GetPlanRevisionAttestationHandler
+}
+
+func (o *GetPlanRevisionAttestation) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetPlanRevisionAttestationParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
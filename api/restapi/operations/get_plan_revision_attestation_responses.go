@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetPlanRevisionAttestationOKCode is the HTTP code returned for type GetPlanRevisionAttestationOK
+const GetPlanRevisionAttestationOKCode int = 200
+
+/*GetPlanRevisionAttestationOK OK
+
+swagger:response getPlanRevisionAttestationOK
+*/
+type GetPlanRevisionAttestationOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.DsseEnvelope `json:"body,omitempty"`
+}
+
+// NewGetPlanRevisionAttestationOK creates GetPlanRevisionAttestationOK with default headers values
+func NewGetPlanRevisionAttestationOK() *GetPlanRevisionAttestationOK {
+
+	return &GetPlanRevisionAttestationOK{}
+}
+
+// WithPayload adds the payload to the get plan revision attestation o k response
+func (o *GetPlanRevisionAttestationOK) WithPayload(payload *models.DsseEnvelope) *GetPlanRevisionAttestationOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get plan revision attestation o k response
+func (o *GetPlanRevisionAttestationOK) SetPayload(payload *models.DsseEnvelope) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPlanRevisionAttestationOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetPlanRevisionAttestationDefault error
+
+swagger:response getPlanRevisionAttestationDefault
+*/
+type GetPlanRevisionAttestationDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetPlanRevisionAttestationDefault creates GetPlanRevisionAttestationDefault with default headers values
+func NewGetPlanRevisionAttestationDefault(code int) *GetPlanRevisionAttestationDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetPlanRevisionAttestationDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get plan revision attestation default response
+func (o *GetPlanRevisionAttestationDefault) WithStatusCode(code int) *GetPlanRevisionAttestationDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get plan revision attestation default response
+func (o *GetPlanRevisionAttestationDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get plan revision attestation default response
+func (o *GetPlanRevisionAttestationDefault) WithPayload(payload *models.Error) *GetPlanRevisionAttestationDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get plan revision attestation default response
+func (o *GetPlanRevisionAttestationDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetPlanRevisionAttestationDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -9,8 +9,10 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
 	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
 )
 
 // NewGetPlanRevisionPracticeResponsesParams creates a new GetPlanRevisionPracticeResponsesParams object
@@ -35,6 +37,14 @@ type GetPlanRevisionPracticeResponsesParams struct {
 	  In: path
 	*/
 	ID string
+	/*Fetch the title and state of each task's issues from the configured issue trackers
+	  In: query
+	*/
+	LinkedIssues *bool
+	/*Prepare the responses as the starting point for a new revision, answering Yes to the questions of tasks marked as done
+	  In: query
+	*/
+	NextRevision *bool
 	/*
 	  Required: true
 	  In: path
@@ -51,11 +61,23 @@ func (o *GetPlanRevisionPracticeResponsesParams) BindRequest(r *http.Request, ro
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
 	rID, rhkID, _ := route.Params.GetOK("id")
 	if err := o.bindID(rID, rhkID, route.Formats); err != nil {
 		res = append(res, err)
 	}
 
+	qLinkedIssues, qhkLinkedIssues, _ := qs.GetOK("linkedIssues")
+	if err := o.bindLinkedIssues(qLinkedIssues, qhkLinkedIssues, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qNextRevision, qhkNextRevision, _ := qs.GetOK("nextRevision")
+	if err := o.bindNextRevision(qNextRevision, qhkNextRevision, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
 	rRevID, rhkRevID, _ := route.Params.GetOK("revId")
 	if err := o.bindRevID(rRevID, rhkRevID, route.Formats); err != nil {
 		res = append(res, err)
@@ -80,6 +102,52 @@ func (o *GetPlanRevisionPracticeResponsesParams) bindID(rawData []string, hasKey
 	return nil
 }
 
+// bindLinkedIssues binds and validates parameter LinkedIssues from query.
+func (o *GetPlanRevisionPracticeResponsesParams) bindLinkedIssues(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("linkedIssues", "query", "bool", raw)
+	}
+	o.LinkedIssues = &value
+
+	return nil
+}
+
+// bindNextRevision binds and validates parameter NextRevision from query.
+func (o *GetPlanRevisionPracticeResponsesParams) bindNextRevision(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("nextRevision", "query", "bool", raw)
+	}
+	o.NextRevision = &value
+
+	return nil
+}
+
 // bindRevID binds and validates parameter RevID from path.
 func (o *GetPlanRevisionPracticeResponsesParams) bindRevID(rawData []string, hasKey bool, formats strfmt.Registry) error {
 	var raw string
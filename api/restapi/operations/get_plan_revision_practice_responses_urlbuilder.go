@@ -10,6 +10,8 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // GetPlanRevisionPracticeResponsesURL generates an URL for the get plan revision practice responses operation
@@ -17,6 +19,9 @@ type GetPlanRevisionPracticeResponsesURL struct {
 	ID    string
 	RevID string
 
+	LinkedIssues *bool
+	NextRevision *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -63,6 +68,26 @@ func (o *GetPlanRevisionPracticeResponsesURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var linkedIssuesQ string
+	if o.LinkedIssues != nil {
+		linkedIssuesQ = swag.FormatBool(*o.LinkedIssues)
+	}
+	if linkedIssuesQ != "" {
+		qs.Set("linkedIssues", linkedIssuesQ)
+	}
+
+	var nextRevisionQ string
+	if o.NextRevision != nil {
+		nextRevisionQ = swag.FormatBool(*o.NextRevision)
+	}
+	if nextRevisionQ != "" {
+		qs.Set("nextRevision", nextRevisionQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -10,12 +10,16 @@ import (
 	"net/url"
 	golangswaggerpaths "path"
 	"strings"
+
+	"github.com/go-openapi/swag"
 )
 
 // GetPlanURL generates an URL for the get plan operation
 type GetPlanURL struct {
 	ID string
 
+	VerifyChain *bool
+
 	_basePath string
 	// avoid unkeyed usage
 	_ struct{}
@@ -55,6 +59,18 @@ func (o *GetPlanURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var verifyChainQ string
+	if o.VerifyChain != nil {
+		verifyChainQ = swag.FormatBool(*o.VerifyChain)
+	}
+	if verifyChainQ != "" {
+		qs.Set("verifyChain", verifyChainQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetProjectSubscriptionHandlerFunc turns a function with the right signature into a get project subscription handler
+type GetProjectSubscriptionHandlerFunc func(GetProjectSubscriptionParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn GetProjectSubscriptionHandlerFunc) Handle(params GetProjectSubscriptionParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// GetProjectSubscriptionHandler interface for that can handle valid get project subscription params
+type GetProjectSubscriptionHandler interface {
+	Handle(GetProjectSubscriptionParams, *models.User) middleware.Responder
+}
+
+// NewGetProjectSubscription creates a new http.Handler for the get project subscription operation
+func NewGetProjectSubscription(ctx *middleware.Context, handler GetProjectSubscriptionHandler) *GetProjectSubscription {
+	return &GetProjectSubscription{Context: ctx, Handler: handler}
+}
+
+/* GetProjectSubscription swagger:route GET /project/{id}/subscription getProjectSubscription
+
+Get the current user's subscription to the project's events
+
+*/
+type GetProjectSubscription struct {
+	Context *middleware.Context
+	Handler GetProjectSubscriptionHandler
+}
+
+func (o *GetProjectSubscription) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewGetProjectSubscriptionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetProjectSubscriptionOKCode is the HTTP code returned for type GetProjectSubscriptionOK
+const GetProjectSubscriptionOKCode int = 200
+
+/*GetProjectSubscriptionOK OK
+
+swagger:response getProjectSubscriptionOK
+*/
+type GetProjectSubscriptionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Subscription `json:"body,omitempty"`
+}
+
+// NewGetProjectSubscriptionOK creates GetProjectSubscriptionOK with default headers values
+func NewGetProjectSubscriptionOK() *GetProjectSubscriptionOK {
+
+	return &GetProjectSubscriptionOK{}
+}
+
+// WithPayload adds the payload to the get project subscription o k response
+func (o *GetProjectSubscriptionOK) WithPayload(payload *models.Subscription) *GetProjectSubscriptionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get project subscription o k response
+func (o *GetProjectSubscriptionOK) SetPayload(payload *models.Subscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetProjectSubscriptionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*GetProjectSubscriptionDefault error
+
+swagger:response getProjectSubscriptionDefault
+*/
+type GetProjectSubscriptionDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetProjectSubscriptionDefault creates GetProjectSubscriptionDefault with default headers values
+func NewGetProjectSubscriptionDefault(code int) *GetProjectSubscriptionDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetProjectSubscriptionDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get project subscription default response
+func (o *GetProjectSubscriptionDefault) WithStatusCode(code int) *GetProjectSubscriptionDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get project subscription default response
+func (o *GetProjectSubscriptionDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get project subscription default response
+func (o *GetProjectSubscriptionDefault) WithPayload(payload *models.Error) *GetProjectSubscriptionDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get project subscription default response
+func (o *GetProjectSubscriptionDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetProjectSubscriptionDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// GetUserRolesOKCode is the HTTP code returned for type GetUserRolesOK
+const GetUserRolesOKCode int = 200
+
+/*GetUserRolesOK OK
+
+swagger:response getUserRolesOK
+*/
+type GetUserRolesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload models.Roles `json:"body,omitempty"`
+}
+
+// NewGetUserRolesOK creates GetUserRolesOK with default headers values
+func NewGetUserRolesOK() *GetUserRolesOK {
+
+	return &GetUserRolesOK{}
+}
+
+// WithPayload adds the payload to the get user roles o k response
+func (o *GetUserRolesOK) WithPayload(payload models.Roles) *GetUserRolesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user roles o k response
+func (o *GetUserRolesOK) SetPayload(payload models.Roles) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserRolesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = models.Roles{}
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*GetUserRolesDefault error
+
+swagger:response getUserRolesDefault
+*/
+type GetUserRolesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewGetUserRolesDefault creates GetUserRolesDefault with default headers values
+func NewGetUserRolesDefault(code int) *GetUserRolesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &GetUserRolesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the get user roles default response
+func (o *GetUserRolesDefault) WithStatusCode(code int) *GetUserRolesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the get user roles default response
+func (o *GetUserRolesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the get user roles default response
+func (o *GetUserRolesDefault) WithPayload(payload *models.Error) *GetUserRolesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the get user roles default response
+func (o *GetUserRolesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *GetUserRolesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListAccessRequestsHandlerFunc turns a function with the right signature into a list access requests handler
+type ListAccessRequestsHandlerFunc func(ListAccessRequestsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListAccessRequestsHandlerFunc) Handle(params ListAccessRequestsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListAccessRequestsHandler interface for that can handle valid list access requests params
+type ListAccessRequestsHandler interface {
+	Handle(ListAccessRequestsParams, *models.User) middleware.Responder
+}
+
+// NewListAccessRequests creates a new http.Handler for the list access requests operation
+func NewListAccessRequests(ctx *middleware.Context, handler ListAccessRequestsHandler) *ListAccessRequests {
+	return &ListAccessRequests{Context: ctx, Handler: handler}
+}
+
+/* ListAccessRequests swagger:route GET /access-requests listAccessRequests
+
+Requests for access, most recent first
+
+*/
+type ListAccessRequests struct {
+	Context *middleware.Context
+	Handler ListAccessRequestsHandler
+}
+
+func (o *ListAccessRequests) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListAccessRequestsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListAccessRequestsOKCode is the HTTP code returned for type ListAccessRequestsOK
+const ListAccessRequestsOKCode int = 200
+
+/*ListAccessRequestsOK OK
+
+swagger:response listAccessRequestsOK
+*/
+type ListAccessRequestsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.AccessRequest `json:"body,omitempty"`
+}
+
+// NewListAccessRequestsOK creates ListAccessRequestsOK with default headers values
+func NewListAccessRequestsOK() *ListAccessRequestsOK {
+
+	return &ListAccessRequestsOK{}
+}
+
+// WithPayload adds the payload to the list access requests o k response
+func (o *ListAccessRequestsOK) WithPayload(payload []*models.AccessRequest) *ListAccessRequestsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list access requests o k response
+func (o *ListAccessRequestsOK) SetPayload(payload []*models.AccessRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAccessRequestsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.AccessRequest, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListAccessRequestsDefault error
+
+swagger:response listAccessRequestsDefault
+*/
+type ListAccessRequestsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListAccessRequestsDefault creates ListAccessRequestsDefault with default headers values
+func NewListAccessRequestsDefault(code int) *ListAccessRequestsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListAccessRequestsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list access requests default response
+func (o *ListAccessRequestsDefault) WithStatusCode(code int) *ListAccessRequestsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list access requests default response
+func (o *ListAccessRequestsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list access requests default response
+func (o *ListAccessRequestsDefault) WithPayload(payload *models.Error) *ListAccessRequestsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list access requests default response
+func (o *ListAccessRequestsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAccessRequestsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
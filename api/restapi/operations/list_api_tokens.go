@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListAPITokensHandlerFunc turns a function with the right signature into a list Api tokens handler
+type ListAPITokensHandlerFunc func(ListAPITokensParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListAPITokensHandlerFunc) Handle(params ListAPITokensParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListAPITokensHandler interface for that can handle valid list Api tokens params
+type ListAPITokensHandler interface {
+	Handle(ListAPITokensParams, *models.User) middleware.Responder
+}
+
+// NewListAPITokens creates a new http.Handler for the list Api tokens operation
+func NewListAPITokens(ctx *middleware.Context, handler ListAPITokensHandler) *ListAPITokens {
+	return &ListAPITokens{Context: ctx, Handler: handler}
+}
+
+/* ListAPITokens swagger:route GET /tokens listApiTokens
+
+The caller's API tokens. Security admins can list every token, including service account tokens.
+
+*/
+type ListAPITokens struct {
+	Context *middleware.Context
+	Handler ListAPITokensHandler
+}
+
+func (o *ListAPITokens) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListAPITokensParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,83 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListAPITokensParams creates a new ListAPITokensParams object
+//
+// There are no default values defined in the spec.
+func NewListAPITokensParams() ListAPITokensParams {
+
+	return ListAPITokensParams{}
+}
+
+// ListAPITokensParams contains all the bound params for the list Api tokens operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listApiTokens
+type ListAPITokensParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*List every user's and service account's tokens, not just the caller's
+	  In: query
+	*/
+	All *bool
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListAPITokensParams() beforehand.
+func (o *ListAPITokensParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qAll, qhkAll, _ := qs.GetOK("all")
+	if err := o.bindAll(qAll, qhkAll, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAll binds and validates parameter All from query.
+func (o *ListAPITokensParams) bindAll(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("all", "query", "bool", raw)
+	}
+	o.All = &value
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListAPITokensOKCode is the HTTP code returned for type ListAPITokensOK
+const ListAPITokensOKCode int = 200
+
+/*ListAPITokensOK OK
+
+swagger:response listApiTokensOK
+*/
+type ListAPITokensOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.APIToken `json:"body,omitempty"`
+}
+
+// NewListAPITokensOK creates ListAPITokensOK with default headers values
+func NewListAPITokensOK() *ListAPITokensOK {
+
+	return &ListAPITokensOK{}
+}
+
+// WithPayload adds the payload to the list Api tokens o k response
+func (o *ListAPITokensOK) WithPayload(payload []*models.APIToken) *ListAPITokensOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list Api tokens o k response
+func (o *ListAPITokensOK) SetPayload(payload []*models.APIToken) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAPITokensOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.APIToken, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListAPITokensDefault error
+
+swagger:response listApiTokensDefault
+*/
+type ListAPITokensDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListAPITokensDefault creates ListAPITokensDefault with default headers values
+func NewListAPITokensDefault(code int) *ListAPITokensDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListAPITokensDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list Api tokens default response
+func (o *ListAPITokensDefault) WithStatusCode(code int) *ListAPITokensDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list Api tokens default response
+func (o *ListAPITokensDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list Api tokens default response
+func (o *ListAPITokensDefault) WithPayload(payload *models.Error) *ListAPITokensDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list Api tokens default response
+func (o *ListAPITokensDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAPITokensDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListAuditEventsHandlerFunc turns a function with the right signature into a list audit events handler
+type ListAuditEventsHandlerFunc func(ListAuditEventsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListAuditEventsHandlerFunc) Handle(params ListAuditEventsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListAuditEventsHandler interface for that can handle valid list audit events params
+type ListAuditEventsHandler interface {
+	Handle(ListAuditEventsParams, *models.User) middleware.Responder
+}
+
+// NewListAuditEvents creates a new http.Handler for the list audit events operation
+func NewListAuditEvents(ctx *middleware.Context, handler ListAuditEventsHandler) *ListAuditEvents {
+	return &ListAuditEvents{Context: ctx, Handler: handler}
+}
+
+/* ListAuditEvents swagger:route GET /audit listAuditEvents
+
+The audit log of changes made through the API and the CLI, most recent first. Requires the audit permission.
+
+
+*/
+type ListAuditEvents struct {
+	Context *middleware.Context
+	Handler ListAuditEventsHandler
+}
+
+func (o *ListAuditEvents) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListAuditEventsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,304 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewListAuditEventsParams creates a new ListAuditEventsParams object
+//
+// There are no default values defined in the spec.
+func NewListAuditEventsParams() ListAuditEventsParams {
+
+	return ListAuditEventsParams{}
+}
+
+// ListAuditEventsParams contains all the bound params for the list audit events operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listAuditEvents
+type ListAuditEventsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*e.g. project.delete
+	  In: query
+	*/
+	Action *string
+	/*The UID of the user or service account that made the changes
+	  In: query
+	*/
+	Actor *string
+	/*The maximum number of events to return, 100 if not set
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	*/
+	Limit *int64
+	/*
+	  In: query
+	*/
+	Since *strfmt.DateTime
+	/*
+	  In: query
+	*/
+	TargetID *string
+	/*e.g. project
+	  In: query
+	*/
+	TargetType *string
+	/*
+	  In: query
+	*/
+	Until *strfmt.DateTime
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListAuditEventsParams() beforehand.
+func (o *ListAuditEventsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qAction, qhkAction, _ := qs.GetOK("action")
+	if err := o.bindAction(qAction, qhkAction, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qActor, qhkActor, _ := qs.GetOK("actor")
+	if err := o.bindActor(qActor, qhkActor, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qLimit, qhkLimit, _ := qs.GetOK("limit")
+	if err := o.bindLimit(qLimit, qhkLimit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qSince, qhkSince, _ := qs.GetOK("since")
+	if err := o.bindSince(qSince, qhkSince, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTargetID, qhkTargetID, _ := qs.GetOK("targetId")
+	if err := o.bindTargetID(qTargetID, qhkTargetID, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTargetType, qhkTargetType, _ := qs.GetOK("targetType")
+	if err := o.bindTargetType(qTargetType, qhkTargetType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qUntil, qhkUntil, _ := qs.GetOK("until")
+	if err := o.bindUntil(qUntil, qhkUntil, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAction binds and validates parameter Action from query.
+func (o *ListAuditEventsParams) bindAction(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Action = &raw
+
+	return nil
+}
+
+// bindActor binds and validates parameter Actor from query.
+func (o *ListAuditEventsParams) bindActor(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Actor = &raw
+
+	return nil
+}
+
+// bindLimit binds and validates parameter Limit from query.
+func (o *ListAuditEventsParams) bindLimit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("limit", "query", "int64", raw)
+	}
+	o.Limit = &value
+
+	if err := o.validateLimit(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateLimit carries on validations for parameter Limit
+func (o *ListAuditEventsParams) validateLimit(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("limit", "query", *o.Limit, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("limit", "query", *o.Limit, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindSince binds and validates parameter Since from query.
+func (o *ListAuditEventsParams) bindSince(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("since", "query", "strfmt.DateTime", raw)
+	}
+	o.Since = (value.(*strfmt.DateTime))
+
+	if err := o.validateSince(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateSince carries on validations for parameter Since
+func (o *ListAuditEventsParams) validateSince(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("since", "query", "date-time", o.Since.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
+
+// bindTargetID binds and validates parameter TargetID from query.
+func (o *ListAuditEventsParams) bindTargetID(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.TargetID = &raw
+
+	return nil
+}
+
+// bindTargetType binds and validates parameter TargetType from query.
+func (o *ListAuditEventsParams) bindTargetType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.TargetType = &raw
+
+	return nil
+}
+
+// bindUntil binds and validates parameter Until from query.
+func (o *ListAuditEventsParams) bindUntil(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	// Format: date-time
+	value, err := formats.Parse("date-time", raw)
+	if err != nil {
+		return errors.InvalidType("until", "query", "strfmt.DateTime", raw)
+	}
+	o.Until = (value.(*strfmt.DateTime))
+
+	if err := o.validateUntil(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateUntil carries on validations for parameter Until
+func (o *ListAuditEventsParams) validateUntil(formats strfmt.Registry) error {
+
+	if err := validate.FormatOf("until", "query", "date-time", o.Until.String(), formats); err != nil {
+		return err
+	}
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListAuditEventsOKCode is the HTTP code returned for type ListAuditEventsOK
+const ListAuditEventsOKCode int = 200
+
+/*ListAuditEventsOK OK
+
+swagger:response listAuditEventsOK
+*/
+type ListAuditEventsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.AuditEvent `json:"body,omitempty"`
+}
+
+// NewListAuditEventsOK creates ListAuditEventsOK with default headers values
+func NewListAuditEventsOK() *ListAuditEventsOK {
+
+	return &ListAuditEventsOK{}
+}
+
+// WithPayload adds the payload to the list audit events o k response
+func (o *ListAuditEventsOK) WithPayload(payload []*models.AuditEvent) *ListAuditEventsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list audit events o k response
+func (o *ListAuditEventsOK) SetPayload(payload []*models.AuditEvent) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAuditEventsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.AuditEvent, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListAuditEventsDefault error
+
+swagger:response listAuditEventsDefault
+*/
+type ListAuditEventsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListAuditEventsDefault creates ListAuditEventsDefault with default headers values
+func NewListAuditEventsDefault(code int) *ListAuditEventsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListAuditEventsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list audit events default response
+func (o *ListAuditEventsDefault) WithStatusCode(code int) *ListAuditEventsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list audit events default response
+func (o *ListAuditEventsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list audit events default response
+func (o *ListAuditEventsDefault) WithPayload(payload *models.Error) *ListAuditEventsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list audit events default response
+func (o *ListAuditEventsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListAuditEventsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListNotificationsHandlerFunc turns a function with the right signature into a list notifications handler
+type ListNotificationsHandlerFunc func(ListNotificationsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListNotificationsHandlerFunc) Handle(params ListNotificationsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListNotificationsHandler interface for that can handle valid list notifications params
+type ListNotificationsHandler interface {
+	Handle(ListNotificationsParams, *models.User) middleware.Responder
+}
+
+// NewListNotifications creates a new http.Handler for the list notifications operation
+func NewListNotifications(ctx *middleware.Context, handler ListNotificationsHandler) *ListNotifications {
+	return &ListNotifications{Context: ctx, Handler: handler}
+}
+
+/* ListNotifications swagger:route GET /notifications listNotifications
+
+The notification outbox: notifications waiting to be delivered to each channel, and those recently delivered or given up on, most recently created first. Requires the admin permission.
+
+
+*/
+type ListNotifications struct {
+	Context *middleware.Context
+	Handler ListNotificationsHandler
+}
+
+func (o *ListNotifications) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListNotificationsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
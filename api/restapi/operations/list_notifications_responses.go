@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListNotificationsOKCode is the HTTP code returned for type ListNotificationsOK
+const ListNotificationsOKCode int = 200
+
+/*ListNotificationsOK OK
+
+swagger:response listNotificationsOK
+*/
+type ListNotificationsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.OutboxMessage `json:"body,omitempty"`
+}
+
+// NewListNotificationsOK creates ListNotificationsOK with default headers values
+func NewListNotificationsOK() *ListNotificationsOK {
+
+	return &ListNotificationsOK{}
+}
+
+// WithPayload adds the payload to the list notifications o k response
+func (o *ListNotificationsOK) WithPayload(payload []*models.OutboxMessage) *ListNotificationsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list notifications o k response
+func (o *ListNotificationsOK) SetPayload(payload []*models.OutboxMessage) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListNotificationsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.OutboxMessage, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListNotificationsDefault error
+
+swagger:response listNotificationsDefault
+*/
+type ListNotificationsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListNotificationsDefault creates ListNotificationsDefault with default headers values
+func NewListNotificationsDefault(code int) *ListNotificationsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListNotificationsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list notifications default response
+func (o *ListNotificationsDefault) WithStatusCode(code int) *ListNotificationsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list notifications default response
+func (o *ListNotificationsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list notifications default response
+func (o *ListNotificationsDefault) WithPayload(payload *models.Error) *ListNotificationsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list notifications default response
+func (o *ListNotificationsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListNotificationsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
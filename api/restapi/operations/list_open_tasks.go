@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListOpenTasksHandlerFunc turns a function with the right signature into a list open tasks handler
+type ListOpenTasksHandlerFunc func(ListOpenTasksParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOpenTasksHandlerFunc) Handle(params ListOpenTasksParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListOpenTasksHandler interface for that can handle valid list open tasks params
+type ListOpenTasksHandler interface {
+	Handle(ListOpenTasksParams, *models.User) middleware.Responder
+}
+
+// NewListOpenTasks creates a new http.Handler for the list open tasks operation
+func NewListOpenTasks(ctx *middleware.Context, handler ListOpenTasksHandler) *ListOpenTasks {
+	return &ListOpenTasks{Context: ctx, Handler: handler}
+}
+
+/* ListOpenTasks swagger:route GET /tasks listOpenTasks
+
+Lists the prioritised tasks that are planned or in progress, from the latest revision of each project's most recent plan, soonest target date first
+
+*/
+type ListOpenTasks struct {
+	Context *middleware.Context
+	Handler ListOpenTasksHandler
+}
+
+func (o *ListOpenTasks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListOpenTasksParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,146 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewListOpenTasksParams creates a new ListOpenTasksParams object
+//
+// There are no default values defined in the spec.
+func NewListOpenTasksParams() ListOpenTasksParams {
+
+	return ListOpenTasksParams{}
+}
+
+// ListOpenTasksParams contains all the bound params for the list open tasks operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listOpenTasks
+type ListOpenTasksParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only list tasks assigned to this person
+	  In: query
+	*/
+	Assignee *string
+	/*Only list the project's tasks
+	  In: query
+	*/
+	Project *string
+	/*Only list tasks with this status
+	  In: query
+	*/
+	Status *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListOpenTasksParams() beforehand.
+func (o *ListOpenTasksParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qAssignee, qhkAssignee, _ := qs.GetOK("assignee")
+	if err := o.bindAssignee(qAssignee, qhkAssignee, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qProject, qhkProject, _ := qs.GetOK("project")
+	if err := o.bindProject(qProject, qhkProject, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qStatus, qhkStatus, _ := qs.GetOK("status")
+	if err := o.bindStatus(qStatus, qhkStatus, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAssignee binds and validates parameter Assignee from query.
+func (o *ListOpenTasksParams) bindAssignee(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Assignee = &raw
+
+	return nil
+}
+
+// bindProject binds and validates parameter Project from query.
+func (o *ListOpenTasksParams) bindProject(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Project = &raw
+
+	return nil
+}
+
+// bindStatus binds and validates parameter Status from query.
+func (o *ListOpenTasksParams) bindStatus(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Status = &raw
+
+	if err := o.validateStatus(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateStatus carries on validations for parameter Status
+func (o *ListOpenTasksParams) validateStatus(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("status", "query", *o.Status, []interface{}{"planned", "in-progress"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
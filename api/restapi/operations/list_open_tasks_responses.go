@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListOpenTasksOKCode is the HTTP code returned for type ListOpenTasksOK
+const ListOpenTasksOKCode int = 200
+
+/*ListOpenTasksOK OK
+
+swagger:response listOpenTasksOK
+*/
+type ListOpenTasksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.OpenTask `json:"body,omitempty"`
+}
+
+// NewListOpenTasksOK creates ListOpenTasksOK with default headers values
+func NewListOpenTasksOK() *ListOpenTasksOK {
+
+	return &ListOpenTasksOK{}
+}
+
+// WithPayload adds the payload to the list open tasks o k response
+func (o *ListOpenTasksOK) WithPayload(payload []*models.OpenTask) *ListOpenTasksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list open tasks o k response
+func (o *ListOpenTasksOK) SetPayload(payload []*models.OpenTask) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOpenTasksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.OpenTask, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListOpenTasksDefault error
+
+swagger:response listOpenTasksDefault
+*/
+type ListOpenTasksDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListOpenTasksDefault creates ListOpenTasksDefault with default headers values
+func NewListOpenTasksDefault(code int) *ListOpenTasksDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListOpenTasksDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list open tasks default response
+func (o *ListOpenTasksDefault) WithStatusCode(code int) *ListOpenTasksDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list open tasks default response
+func (o *ListOpenTasksDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list open tasks default response
+func (o *ListOpenTasksDefault) WithPayload(payload *models.Error) *ListOpenTasksDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list open tasks default response
+func (o *ListOpenTasksDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOpenTasksDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
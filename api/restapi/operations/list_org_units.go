@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListOrgUnitsHandlerFunc turns a function with the right signature into a list org units handler
+type ListOrgUnitsHandlerFunc func(ListOrgUnitsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOrgUnitsHandlerFunc) Handle(params ListOrgUnitsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListOrgUnitsHandler interface for that can handle valid list org units params
+type ListOrgUnitsHandler interface {
+	Handle(ListOrgUnitsParams, *models.User) middleware.Responder
+}
+
+// NewListOrgUnits creates a new http.Handler for the list org units operation
+func NewListOrgUnits(ctx *middleware.Context, handler ListOrgUnitsHandler) *ListOrgUnits {
+	return &ListOrgUnits{Context: ctx, Handler: handler}
+}
+
+/* ListOrgUnits swagger:route GET /orgunit listOrgUnits
+
+ListOrgUnits list org units API
+
+*/
+type ListOrgUnits struct {
+	Context *middleware.Context
+	Handler ListOrgUnitsHandler
+}
+
+func (o *ListOrgUnits) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListOrgUnitsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
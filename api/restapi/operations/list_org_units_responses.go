@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListOrgUnitsOKCode is the HTTP code returned for type ListOrgUnitsOK
+const ListOrgUnitsOKCode int = 200
+
+/*ListOrgUnitsOK OK
+
+swagger:response listOrgUnitsOK
+*/
+type ListOrgUnitsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.OrgUnit `json:"body,omitempty"`
+}
+
+// NewListOrgUnitsOK creates ListOrgUnitsOK with default headers values
+func NewListOrgUnitsOK() *ListOrgUnitsOK {
+
+	return &ListOrgUnitsOK{}
+}
+
+// WithPayload adds the payload to the list org units o k response
+func (o *ListOrgUnitsOK) WithPayload(payload []*models.OrgUnit) *ListOrgUnitsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list org units o k response
+func (o *ListOrgUnitsOK) SetPayload(payload []*models.OrgUnit) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOrgUnitsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.OrgUnit, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListOrgUnitsDefault error
+
+swagger:response listOrgUnitsDefault
+*/
+type ListOrgUnitsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListOrgUnitsDefault creates ListOrgUnitsDefault with default headers values
+func NewListOrgUnitsDefault(code int) *ListOrgUnitsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListOrgUnitsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list org units default response
+func (o *ListOrgUnitsDefault) WithStatusCode(code int) *ListOrgUnitsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list org units default response
+func (o *ListOrgUnitsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list org units default response
+func (o *ListOrgUnitsDefault) WithPayload(payload *models.Error) *ListOrgUnitsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list org units default response
+func (o *ListOrgUnitsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOrgUnitsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListOverdueProjectsHandlerFunc turns a function with the right signature into a list overdue projects handler
+type ListOverdueProjectsHandlerFunc func(ListOverdueProjectsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListOverdueProjectsHandlerFunc) Handle(params ListOverdueProjectsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListOverdueProjectsHandler interface for that can handle valid list overdue projects params
+type ListOverdueProjectsHandler interface {
+	Handle(ListOverdueProjectsParams, *models.User) middleware.Responder
+}
+
+// NewListOverdueProjects creates a new http.Handler for the list overdue projects operation
+func NewListOverdueProjects(ctx *middleware.Context, handler ListOverdueProjectsHandler) *ListOverdueProjects {
+	return &ListOverdueProjects{Context: ctx, Handler: handler}
+}
+
+/* ListOverdueProjects swagger:route GET /overdue-projects listOverdueProjects
+
+Projects whose latest committed plan is older than their cadence, or that have never committed one, most overdue first. Projects without a cadence aren't included.
+
+
+*/
+type ListOverdueProjects struct {
+	Context *middleware.Context
+	Handler ListOverdueProjectsHandler
+}
+
+func (o *ListOverdueProjects) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListOverdueProjectsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,77 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+)
+
+// NewListOverdueProjectsParams creates a new ListOverdueProjectsParams object
+//
+// There are no default values defined in the spec.
+func NewListOverdueProjectsParams() ListOverdueProjectsParams {
+
+	return ListOverdueProjectsParams{}
+}
+
+// ListOverdueProjectsParams contains all the bound params for the list overdue projects operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listOverdueProjects
+type ListOverdueProjectsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only include projects in this org unit or the units below it
+	  In: query
+	*/
+	OrgUnit *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListOverdueProjectsParams() beforehand.
+func (o *ListOverdueProjectsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qOrgUnit, qhkOrgUnit, _ := qs.GetOK("orgUnit")
+	if err := o.bindOrgUnit(qOrgUnit, qhkOrgUnit, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindOrgUnit binds and validates parameter OrgUnit from query.
+func (o *ListOverdueProjectsParams) bindOrgUnit(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.OrgUnit = &raw
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListOverdueProjectsOKCode is the HTTP code returned for type ListOverdueProjectsOK
+const ListOverdueProjectsOKCode int = 200
+
+/*ListOverdueProjectsOK OK
+
+swagger:response listOverdueProjectsOK
+*/
+type ListOverdueProjectsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.OverdueProject `json:"body,omitempty"`
+}
+
+// NewListOverdueProjectsOK creates ListOverdueProjectsOK with default headers values
+func NewListOverdueProjectsOK() *ListOverdueProjectsOK {
+
+	return &ListOverdueProjectsOK{}
+}
+
+// WithPayload adds the payload to the list overdue projects o k response
+func (o *ListOverdueProjectsOK) WithPayload(payload []*models.OverdueProject) *ListOverdueProjectsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list overdue projects o k response
+func (o *ListOverdueProjectsOK) SetPayload(payload []*models.OverdueProject) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOverdueProjectsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.OverdueProject, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListOverdueProjectsDefault error
+
+swagger:response listOverdueProjectsDefault
+*/
+type ListOverdueProjectsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListOverdueProjectsDefault creates ListOverdueProjectsDefault with default headers values
+func NewListOverdueProjectsDefault(code int) *ListOverdueProjectsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListOverdueProjectsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list overdue projects default response
+func (o *ListOverdueProjectsDefault) WithStatusCode(code int) *ListOverdueProjectsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list overdue projects default response
+func (o *ListOverdueProjectsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list overdue projects default response
+func (o *ListOverdueProjectsDefault) WithPayload(payload *models.Error) *ListOverdueProjectsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list overdue projects default response
+func (o *ListOverdueProjectsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListOverdueProjectsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
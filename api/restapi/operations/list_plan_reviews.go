@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListPlanReviewsHandlerFunc turns a function with the right signature into a list plan reviews handler
+type ListPlanReviewsHandlerFunc func(ListPlanReviewsParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListPlanReviewsHandlerFunc) Handle(params ListPlanReviewsParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListPlanReviewsHandler interface for that can handle valid list plan reviews params
+type ListPlanReviewsHandler interface {
+	Handle(ListPlanReviewsParams, *models.User) middleware.Responder
+}
+
+// NewListPlanReviews creates a new http.Handler for the list plan reviews operation
+func NewListPlanReviews(ctx *middleware.Context, handler ListPlanReviewsHandler) *ListPlanReviews {
+	return &ListPlanReviews{Context: ctx, Handler: handler}
+}
+
+/* ListPlanReviews swagger:route GET /reviews listPlanReviews
+
+Lists the plans whose latest committed revision is in the review state, submitted by default, oldest first. Use this as a reviewer's queue.
+
+
+*/
+type ListPlanReviews struct {
+	Context *middleware.Context
+	Handler ListPlanReviewsHandler
+}
+
+func (o *ListPlanReviews) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListPlanReviewsParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
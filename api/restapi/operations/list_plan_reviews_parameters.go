@@ -0,0 +1,92 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/validate"
+)
+
+// NewListPlanReviewsParams creates a new ListPlanReviewsParams object
+//
+// There are no default values defined in the spec.
+func NewListPlanReviewsParams() ListPlanReviewsParams {
+
+	return ListPlanReviewsParams{}
+}
+
+// ListPlanReviewsParams contains all the bound params for the list plan reviews operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listPlanReviews
+type ListPlanReviewsParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*
+	  In: query
+	*/
+	State *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListPlanReviewsParams() beforehand.
+func (o *ListPlanReviewsParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qState, qhkState, _ := qs.GetOK("state")
+	if err := o.bindState(qState, qhkState, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindState binds and validates parameter State from query.
+func (o *ListPlanReviewsParams) bindState(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.State = &raw
+
+	if err := o.validateState(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateState carries on validations for parameter State
+func (o *ListPlanReviewsParams) validateState(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("state", "query", *o.State, []interface{}{"submitted", "approved", "changes-requested"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListPlanReviewsOKCode is the HTTP code returned for type ListPlanReviewsOK
+const ListPlanReviewsOKCode int = 200
+
+/*ListPlanReviewsOK OK
+
+swagger:response listPlanReviewsOK
+*/
+type ListPlanReviewsOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.ReviewSummary `json:"body,omitempty"`
+}
+
+// NewListPlanReviewsOK creates ListPlanReviewsOK with default headers values
+func NewListPlanReviewsOK() *ListPlanReviewsOK {
+
+	return &ListPlanReviewsOK{}
+}
+
+// WithPayload adds the payload to the list plan reviews o k response
+func (o *ListPlanReviewsOK) WithPayload(payload []*models.ReviewSummary) *ListPlanReviewsOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list plan reviews o k response
+func (o *ListPlanReviewsOK) SetPayload(payload []*models.ReviewSummary) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPlanReviewsOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.ReviewSummary, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListPlanReviewsDefault error
+
+swagger:response listPlanReviewsDefault
+*/
+type ListPlanReviewsDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListPlanReviewsDefault creates ListPlanReviewsDefault with default headers values
+func NewListPlanReviewsDefault(code int) *ListPlanReviewsDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListPlanReviewsDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list plan reviews default response
+func (o *ListPlanReviewsDefault) WithStatusCode(code int) *ListPlanReviewsDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list plan reviews default response
+func (o *ListPlanReviewsDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list plan reviews default response
+func (o *ListPlanReviewsDefault) WithPayload(payload *models.Error) *ListPlanReviewsDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list plan reviews default response
+func (o *ListPlanReviewsDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListPlanReviewsDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
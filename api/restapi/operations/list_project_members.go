@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListProjectMembersHandlerFunc turns a function with the right signature into a list project members handler
+type ListProjectMembersHandlerFunc func(ListProjectMembersParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListProjectMembersHandlerFunc) Handle(params ListProjectMembersParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListProjectMembersHandler interface for that can handle valid list project members params
+type ListProjectMembersHandler interface {
+	Handle(ListProjectMembersParams, *models.User) middleware.Responder
+}
+
+// NewListProjectMembers creates a new http.Handler for the list project members operation
+func NewListProjectMembers(ctx *middleware.Context, handler ListProjectMembersHandler) *ListProjectMembers {
+	return &ListProjectMembers{Context: ctx, Handler: handler}
+}
+
+/* ListProjectMembers swagger:route GET /project/{id}/members listProjectMembers
+
+ListProjectMembers list project members API
+
+*/
+type ListProjectMembers struct {
+	Context *middleware.Context
+	Handler ListProjectMembersHandler
+}
+
+func (o *ListProjectMembers) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListProjectMembersParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
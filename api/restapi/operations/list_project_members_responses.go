@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListProjectMembersOKCode is the HTTP code returned for type ListProjectMembersOK
+const ListProjectMembersOKCode int = 200
+
+/*ListProjectMembersOK OK
+
+swagger:response listProjectMembersOK
+*/
+type ListProjectMembersOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.ProjectMember `json:"body,omitempty"`
+}
+
+// NewListProjectMembersOK creates ListProjectMembersOK with default headers values
+func NewListProjectMembersOK() *ListProjectMembersOK {
+
+	return &ListProjectMembersOK{}
+}
+
+// WithPayload adds the payload to the list project members o k response
+func (o *ListProjectMembersOK) WithPayload(payload []*models.ProjectMember) *ListProjectMembersOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list project members o k response
+func (o *ListProjectMembersOK) SetPayload(payload []*models.ProjectMember) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListProjectMembersOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.ProjectMember, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListProjectMembersDefault error
+
+swagger:response listProjectMembersDefault
+*/
+type ListProjectMembersDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListProjectMembersDefault creates ListProjectMembersDefault with default headers values
+func NewListProjectMembersDefault(code int) *ListProjectMembersDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListProjectMembersDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list project members default response
+func (o *ListProjectMembersDefault) WithStatusCode(code int) *ListProjectMembersDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list project members default response
+func (o *ListProjectMembersDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list project members default response
+func (o *ListProjectMembersDefault) WithPayload(payload *models.Error) *ListProjectMembersDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list project members default response
+func (o *ListProjectMembersDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListProjectMembersDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -33,7 +33,7 @@ func NewListProjects(ctx *middleware.Context, handler ListProjectsHandler) *List
 
 /* ListProjects swagger:route GET /project listProjects
 
-ListProjects list projects API
+List projects, with those the user is an owner or member of first
 
 */
 type ListProjects struct {
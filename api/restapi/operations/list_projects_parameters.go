@@ -9,15 +9,26 @@ import (
 	"net/http"
 
 	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
 	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
 )
 
 // NewListProjectsParams creates a new ListProjectsParams object
-//
-// There are no default values defined in the spec.
+// with the default values initialized.
 func NewListProjectsParams() ListProjectsParams {
 
-	return ListProjectsParams{}
+	var (
+		// initialize parameters with default values
+
+		mineDefault = bool(false)
+	)
+
+	return ListProjectsParams{
+		Mine: &mineDefault,
+	}
 }
 
 // ListProjectsParams contains all the bound params for the list projects operation
@@ -28,6 +39,34 @@ type ListProjectsParams struct {
 
 	// HTTP Request Object
 	HTTPRequest *http.Request `json:"-"`
+
+	/*Only include projects handling data of this classification
+	  In: query
+	*/
+	DataClassification *string
+	/*Only include projects that are (or aren't) internet facing
+	  In: query
+	*/
+	InternetFacing *bool
+	/*Only list projects the user is an owner or member of
+	  In: query
+	  Default: false
+	*/
+	Mine *bool
+	/*Only include projects of this product type
+	  In: query
+	*/
+	ProductType *string
+	/*Only include projects with all of these tags
+	  In: query
+	  Collection Format: multi
+	*/
+	Tag []string
+	/*Only include projects using all of these technologies
+	  In: query
+	  Collection Format: multi
+	*/
+	Tech []string
 }
 
 // BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
@@ -39,8 +78,194 @@ func (o *ListProjectsParams) BindRequest(r *http.Request, route *middleware.Matc
 
 	o.HTTPRequest = r
 
+	qs := runtime.Values(r.URL.Query())
+
+	qDataClassification, qhkDataClassification, _ := qs.GetOK("dataClassification")
+	if err := o.bindDataClassification(qDataClassification, qhkDataClassification, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qInternetFacing, qhkInternetFacing, _ := qs.GetOK("internetFacing")
+	if err := o.bindInternetFacing(qInternetFacing, qhkInternetFacing, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qMine, qhkMine, _ := qs.GetOK("mine")
+	if err := o.bindMine(qMine, qhkMine, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qProductType, qhkProductType, _ := qs.GetOK("productType")
+	if err := o.bindProductType(qProductType, qhkProductType, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTag, qhkTag, _ := qs.GetOK("tag")
+	if err := o.bindTag(qTag, qhkTag, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qTech, qhkTech, _ := qs.GetOK("tech")
+	if err := o.bindTech(qTech, qhkTech, route.Formats); err != nil {
+		res = append(res, err)
+	}
 	if len(res) > 0 {
 		return errors.CompositeValidationError(res...)
 	}
 	return nil
 }
+
+// bindDataClassification binds and validates parameter DataClassification from query.
+func (o *ListProjectsParams) bindDataClassification(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.DataClassification = &raw
+
+	if err := o.validateDataClassification(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateDataClassification carries on validations for parameter DataClassification
+func (o *ListProjectsParams) validateDataClassification(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("dataClassification", "query", *o.DataClassification, []interface{}{"public", "internal", "confidential", "restricted"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindInternetFacing binds and validates parameter InternetFacing from query.
+func (o *ListProjectsParams) bindInternetFacing(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("internetFacing", "query", "bool", raw)
+	}
+	o.InternetFacing = &value
+
+	return nil
+}
+
+// bindMine binds and validates parameter Mine from query.
+func (o *ListProjectsParams) bindMine(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		// Default values have been previously initialized by NewListProjectsParams()
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("mine", "query", "bool", raw)
+	}
+	o.Mine = &value
+
+	return nil
+}
+
+// bindProductType binds and validates parameter ProductType from query.
+func (o *ListProjectsParams) bindProductType(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.ProductType = &raw
+
+	if err := o.validateProductType(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validateProductType carries on validations for parameter ProductType
+func (o *ListProjectsParams) validateProductType(formats strfmt.Registry) error {
+
+	if err := validate.EnumCase("productType", "query", *o.ProductType, []interface{}{"saas", "onPremises", "desktop", "mobile", "embedded", "library", "internalTool"}, true); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindTag binds and validates array parameter Tag from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *ListProjectsParams) bindTag(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	tagIC := rawData
+	if len(tagIC) == 0 {
+		return nil
+	}
+
+	var tagIR []string
+	for _, tagIV := range tagIC {
+		tagI := tagIV
+
+		tagIR = append(tagIR, tagI)
+	}
+
+	o.Tag = tagIR
+
+	return nil
+}
+
+// bindTech binds and validates array parameter Tech from query.
+//
+// Arrays are parsed according to CollectionFormat: "multi" (defaults to "csv" when empty).
+func (o *ListProjectsParams) bindTech(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	// CollectionFormat: multi
+	techIC := rawData
+	if len(techIC) == 0 {
+		return nil
+	}
+
+	var techIR []string
+	for _, techIV := range techIC {
+		techI := techIV
+
+		techIR = append(techIR, techI)
+	}
+
+	o.Tech = techIR
+
+	return nil
+}
@@ -9,11 +9,22 @@ import (
 	"errors"
 	"net/url"
 	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
 )
 
 // ListProjectsURL generates an URL for the list projects operation
 type ListProjectsURL struct {
+	DataClassification *string
+	InternetFacing     *bool
+	Mine               *bool
+	ProductType        *string
+	Tag                []string
+	Tech               []string
+
 	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
 }
 
 // WithBasePath sets the base path for this url builder, only required when it's different from the
@@ -43,6 +54,70 @@ func (o *ListProjectsURL) Build() (*url.URL, error) {
 	}
 	_result.Path = golangswaggerpaths.Join(_basePath, _path)
 
+	qs := make(url.Values)
+
+	var dataClassificationQ string
+	if o.DataClassification != nil {
+		dataClassificationQ = *o.DataClassification
+	}
+	if dataClassificationQ != "" {
+		qs.Set("dataClassification", dataClassificationQ)
+	}
+
+	var internetFacingQ string
+	if o.InternetFacing != nil {
+		internetFacingQ = swag.FormatBool(*o.InternetFacing)
+	}
+	if internetFacingQ != "" {
+		qs.Set("internetFacing", internetFacingQ)
+	}
+
+	var mineQ string
+	if o.Mine != nil {
+		mineQ = swag.FormatBool(*o.Mine)
+	}
+	if mineQ != "" {
+		qs.Set("mine", mineQ)
+	}
+
+	var productTypeQ string
+	if o.ProductType != nil {
+		productTypeQ = *o.ProductType
+	}
+	if productTypeQ != "" {
+		qs.Set("productType", productTypeQ)
+	}
+
+	var tagIR []string
+	for _, tagI := range o.Tag {
+		tagIS := tagI
+		if tagIS != "" {
+			tagIR = append(tagIR, tagIS)
+		}
+	}
+
+	tag := swag.JoinByFormat(tagIR, "multi")
+
+	for _, qsv := range tag {
+		qs.Add("tag", qsv)
+	}
+
+	var techIR []string
+	for _, techI := range o.Tech {
+		techIS := techI
+		if techIS != "" {
+			techIR = append(techIR, techIS)
+		}
+	}
+
+	tech := swag.JoinByFormat(techIR, "multi")
+
+	for _, qsv := range tech {
+		qs.Add("tech", qsv)
+	}
+
+	_result.RawQuery = qs.Encode()
+
 	return &_result, nil
 }
 
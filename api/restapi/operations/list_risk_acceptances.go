@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListRiskAcceptancesHandlerFunc turns a function with the right signature into a list risk acceptances handler
+type ListRiskAcceptancesHandlerFunc func(ListRiskAcceptancesParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListRiskAcceptancesHandlerFunc) Handle(params ListRiskAcceptancesParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListRiskAcceptancesHandler interface for that can handle valid list risk acceptances params
+type ListRiskAcceptancesHandler interface {
+	Handle(ListRiskAcceptancesParams, *models.User) middleware.Responder
+}
+
+// NewListRiskAcceptances creates a new http.Handler for the list risk acceptances operation
+func NewListRiskAcceptances(ctx *middleware.Context, handler ListRiskAcceptancesHandler) *ListRiskAcceptances {
+	return &ListRiskAcceptances{Context: ctx, Handler: handler}
+}
+
+/* ListRiskAcceptances swagger:route GET /risk-acceptances listRiskAcceptances
+
+Lists the risk acceptances in each project's latest committed plan, soonest expiry first
+
+*/
+type ListRiskAcceptances struct {
+	Context *middleware.Context
+	Handler ListRiskAcceptancesHandler
+}
+
+func (o *ListRiskAcceptances) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListRiskAcceptancesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,110 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+)
+
+// NewListRiskAcceptancesParams creates a new ListRiskAcceptancesParams object
+//
+// There are no default values defined in the spec.
+func NewListRiskAcceptancesParams() ListRiskAcceptancesParams {
+
+	return ListRiskAcceptancesParams{}
+}
+
+// ListRiskAcceptancesParams contains all the bound params for the list risk acceptances operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listRiskAcceptances
+type ListRiskAcceptancesParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only list acceptances that have expired (true) or haven't (false)
+	  In: query
+	*/
+	Expired *bool
+	/*Only list the project's risk acceptances
+	  In: query
+	*/
+	Project *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListRiskAcceptancesParams() beforehand.
+func (o *ListRiskAcceptancesParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qExpired, qhkExpired, _ := qs.GetOK("expired")
+	if err := o.bindExpired(qExpired, qhkExpired, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qProject, qhkProject, _ := qs.GetOK("project")
+	if err := o.bindProject(qProject, qhkProject, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindExpired binds and validates parameter Expired from query.
+func (o *ListRiskAcceptancesParams) bindExpired(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("expired", "query", "bool", raw)
+	}
+	o.Expired = &value
+
+	return nil
+}
+
+// bindProject binds and validates parameter Project from query.
+func (o *ListRiskAcceptancesParams) bindProject(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Project = &raw
+
+	return nil
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListRiskAcceptancesOKCode is the HTTP code returned for type ListRiskAcceptancesOK
+const ListRiskAcceptancesOKCode int = 200
+
+/*ListRiskAcceptancesOK OK
+
+swagger:response listRiskAcceptancesOK
+*/
+type ListRiskAcceptancesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.AcceptedRisk `json:"body,omitempty"`
+}
+
+// NewListRiskAcceptancesOK creates ListRiskAcceptancesOK with default headers values
+func NewListRiskAcceptancesOK() *ListRiskAcceptancesOK {
+
+	return &ListRiskAcceptancesOK{}
+}
+
+// WithPayload adds the payload to the list risk acceptances o k response
+func (o *ListRiskAcceptancesOK) WithPayload(payload []*models.AcceptedRisk) *ListRiskAcceptancesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list risk acceptances o k response
+func (o *ListRiskAcceptancesOK) SetPayload(payload []*models.AcceptedRisk) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRiskAcceptancesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.AcceptedRisk, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListRiskAcceptancesDefault error
+
+swagger:response listRiskAcceptancesDefault
+*/
+type ListRiskAcceptancesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListRiskAcceptancesDefault creates ListRiskAcceptancesDefault with default headers values
+func NewListRiskAcceptancesDefault(code int) *ListRiskAcceptancesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListRiskAcceptancesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list risk acceptances default response
+func (o *ListRiskAcceptancesDefault) WithStatusCode(code int) *ListRiskAcceptancesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list risk acceptances default response
+func (o *ListRiskAcceptancesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list risk acceptances default response
+func (o *ListRiskAcceptancesDefault) WithPayload(payload *models.Error) *ListRiskAcceptancesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list risk acceptances default response
+func (o *ListRiskAcceptancesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListRiskAcceptancesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListUsersHandlerFunc turns a function with the right signature into a list users handler
+type ListUsersHandlerFunc func(ListUsersParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListUsersHandlerFunc) Handle(params ListUsersParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListUsersHandler interface for that can handle valid list users params
+type ListUsersHandler interface {
+	Handle(ListUsersParams, *models.User) middleware.Responder
+}
+
+// NewListUsers creates a new http.Handler for the list users operation
+func NewListUsers(ctx *middleware.Context, handler ListUsersHandler) *ListUsers {
+	return &ListUsers{Context: ctx, Handler: handler}
+}
+
+/* ListUsers swagger:route GET /user listUsers
+
+The users known to BeSec, in UID order. Only security admins can list users. Pass the nextPageToken of one page as the pageToken of the request for the next.
+
+
+*/
+type ListUsers struct {
+	Context *middleware.Context
+	Handler ListUsersHandler
+}
+
+func (o *ListUsers) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListUsersParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
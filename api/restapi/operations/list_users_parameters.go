@@ -0,0 +1,217 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+)
+
+// NewListUsersParams creates a new ListUsersParams object
+//
+// There are no default values defined in the spec.
+func NewListUsersParams() ListUsersParams {
+
+	return ListUsersParams{}
+}
+
+// ListUsersParams contains all the bound params for the list users operation
+// typically these are obtained from a http.Request
+//
+// swagger:parameters listUsers
+type ListUsersParams struct {
+
+	// HTTP Request Object
+	HTTPRequest *http.Request `json:"-"`
+
+	/*Only include users that are (or are not) manually authorized
+	  In: query
+	*/
+	Authorized *bool
+	/*Only include users whose email address is from this domain
+	  In: query
+	*/
+	Domain *string
+	/*The maximum number of users to return, 100 if not set
+	  Maximum: 1000
+	  Minimum: 1
+	  In: query
+	*/
+	PageSize *int64
+	/*
+	  In: query
+	*/
+	PageToken *string
+	/*Only include users who signed in with this identity provider
+	  In: query
+	*/
+	Provider *string
+}
+
+// BindRequest both binds and validates a request, it assumes that complex things implement a Validatable(strfmt.Registry) error interface
+// for simple values it will use straight method calls.
+//
+// To ensure default values, the struct must have been initialized with NewListUsersParams() beforehand.
+func (o *ListUsersParams) BindRequest(r *http.Request, route *middleware.MatchedRoute) error {
+	var res []error
+
+	o.HTTPRequest = r
+
+	qs := runtime.Values(r.URL.Query())
+
+	qAuthorized, qhkAuthorized, _ := qs.GetOK("authorized")
+	if err := o.bindAuthorized(qAuthorized, qhkAuthorized, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qDomain, qhkDomain, _ := qs.GetOK("domain")
+	if err := o.bindDomain(qDomain, qhkDomain, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPageSize, qhkPageSize, _ := qs.GetOK("pageSize")
+	if err := o.bindPageSize(qPageSize, qhkPageSize, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qPageToken, qhkPageToken, _ := qs.GetOK("pageToken")
+	if err := o.bindPageToken(qPageToken, qhkPageToken, route.Formats); err != nil {
+		res = append(res, err)
+	}
+
+	qProvider, qhkProvider, _ := qs.GetOK("provider")
+	if err := o.bindProvider(qProvider, qhkProvider, route.Formats); err != nil {
+		res = append(res, err)
+	}
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+// bindAuthorized binds and validates parameter Authorized from query.
+func (o *ListUsersParams) bindAuthorized(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertBool(raw)
+	if err != nil {
+		return errors.InvalidType("authorized", "query", "bool", raw)
+	}
+	o.Authorized = &value
+
+	return nil
+}
+
+// bindDomain binds and validates parameter Domain from query.
+func (o *ListUsersParams) bindDomain(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Domain = &raw
+
+	return nil
+}
+
+// bindPageSize binds and validates parameter PageSize from query.
+func (o *ListUsersParams) bindPageSize(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+
+	value, err := swag.ConvertInt64(raw)
+	if err != nil {
+		return errors.InvalidType("pageSize", "query", "int64", raw)
+	}
+	o.PageSize = &value
+
+	if err := o.validatePageSize(formats); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// validatePageSize carries on validations for parameter PageSize
+func (o *ListUsersParams) validatePageSize(formats strfmt.Registry) error {
+
+	if err := validate.MinimumInt("pageSize", "query", *o.PageSize, 1, false); err != nil {
+		return err
+	}
+
+	if err := validate.MaximumInt("pageSize", "query", *o.PageSize, 1000, false); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// bindPageToken binds and validates parameter PageToken from query.
+func (o *ListUsersParams) bindPageToken(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.PageToken = &raw
+
+	return nil
+}
+
+// bindProvider binds and validates parameter Provider from query.
+func (o *ListUsersParams) bindProvider(rawData []string, hasKey bool, formats strfmt.Registry) error {
+	var raw string
+	if len(rawData) > 0 {
+		raw = rawData[len(rawData)-1]
+	}
+
+	// Required: false
+	// AllowEmptyValue: false
+
+	if raw == "" { // empty values pass all other validations
+		return nil
+	}
+	o.Provider = &raw
+
+	return nil
+}
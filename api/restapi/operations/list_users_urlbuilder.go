@@ -0,0 +1,141 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"errors"
+	"net/url"
+	golangswaggerpaths "path"
+
+	"github.com/go-openapi/swag"
+)
+
+// ListUsersURL generates an URL for the list users operation
+type ListUsersURL struct {
+	Authorized *bool
+	Domain     *string
+	PageSize   *int64
+	PageToken  *string
+	Provider   *string
+
+	_basePath string
+	// avoid unkeyed usage
+	_ struct{}
+}
+
+// WithBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListUsersURL) WithBasePath(bp string) *ListUsersURL {
+	o.SetBasePath(bp)
+	return o
+}
+
+// SetBasePath sets the base path for this url builder, only required when it's different from the
+// base path specified in the swagger spec.
+// When the value of the base path is an empty string
+func (o *ListUsersURL) SetBasePath(bp string) {
+	o._basePath = bp
+}
+
+// Build a url path and query string
+func (o *ListUsersURL) Build() (*url.URL, error) {
+	var _result url.URL
+
+	var _path = "/user"
+
+	_basePath := o._basePath
+	if _basePath == "" {
+		_basePath = "/v1alpha1"
+	}
+	_result.Path = golangswaggerpaths.Join(_basePath, _path)
+
+	qs := make(url.Values)
+
+	var authorizedQ string
+	if o.Authorized != nil {
+		authorizedQ = swag.FormatBool(*o.Authorized)
+	}
+	if authorizedQ != "" {
+		qs.Set("authorized", authorizedQ)
+	}
+
+	var domainQ string
+	if o.Domain != nil {
+		domainQ = *o.Domain
+	}
+	if domainQ != "" {
+		qs.Set("domain", domainQ)
+	}
+
+	var pageSizeQ string
+	if o.PageSize != nil {
+		pageSizeQ = swag.FormatInt64(*o.PageSize)
+	}
+	if pageSizeQ != "" {
+		qs.Set("pageSize", pageSizeQ)
+	}
+
+	var pageTokenQ string
+	if o.PageToken != nil {
+		pageTokenQ = *o.PageToken
+	}
+	if pageTokenQ != "" {
+		qs.Set("pageToken", pageTokenQ)
+	}
+
+	var providerQ string
+	if o.Provider != nil {
+		providerQ = *o.Provider
+	}
+	if providerQ != "" {
+		qs.Set("provider", providerQ)
+	}
+
+	_result.RawQuery = qs.Encode()
+
+	return &_result, nil
+}
+
+// Must is a helper function to panic when the url builder returns an error
+func (o *ListUsersURL) Must(u *url.URL, err error) *url.URL {
+	if err != nil {
+		panic(err)
+	}
+	if u == nil {
+		panic("url can't be nil")
+	}
+	return u
+}
+
+// String returns the string representation of the path with query string
+func (o *ListUsersURL) String() string {
+	return o.Must(o.Build()).String()
+}
+
+// BuildFull builds a full url with scheme, host, path and query string
+func (o *ListUsersURL) BuildFull(scheme, host string) (*url.URL, error) {
+	if scheme == "" {
+		return nil, errors.New("scheme is required for a full url on ListUsersURL")
+	}
+	if host == "" {
+		return nil, errors.New("host is required for a full url on ListUsersURL")
+	}
+
+	base, err := o.Build()
+	if err != nil {
+		return nil, err
+	}
+
+	base.Scheme = scheme
+	base.Host = host
+	return base, nil
+}
+
+// StringFull returns the string representation of a complete url
+func (o *ListUsersURL) StringFull(scheme, host string) string {
+	return o.Must(o.BuildFull(scheme, host)).String()
+}
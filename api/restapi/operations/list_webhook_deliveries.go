@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListWebhookDeliveriesHandlerFunc turns a function with the right signature into a list webhook deliveries handler
+type ListWebhookDeliveriesHandlerFunc func(ListWebhookDeliveriesParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListWebhookDeliveriesHandlerFunc) Handle(params ListWebhookDeliveriesParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListWebhookDeliveriesHandler interface for that can handle valid list webhook deliveries params
+type ListWebhookDeliveriesHandler interface {
+	Handle(ListWebhookDeliveriesParams, *models.User) middleware.Responder
+}
+
+// NewListWebhookDeliveries creates a new http.Handler for the list webhook deliveries operation
+func NewListWebhookDeliveries(ctx *middleware.Context, handler ListWebhookDeliveriesHandler) *ListWebhookDeliveries {
+	return &ListWebhookDeliveries{Context: ctx, Handler: handler}
+}
+
+/* ListWebhookDeliveries swagger:route GET /webhooks/{id}/deliveries listWebhookDeliveries
+
+The webhook's recent deliveries from the outbox, most recent first. Requires the admin permission.
+
+*/
+type ListWebhookDeliveries struct {
+	Context *middleware.Context
+	Handler ListWebhookDeliveriesHandler
+}
+
+func (o *ListWebhookDeliveries) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListWebhookDeliveriesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
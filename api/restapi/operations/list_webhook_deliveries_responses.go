@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListWebhookDeliveriesOKCode is the HTTP code returned for type ListWebhookDeliveriesOK
+const ListWebhookDeliveriesOKCode int = 200
+
+/*ListWebhookDeliveriesOK OK
+
+swagger:response listWebhookDeliveriesOK
+*/
+type ListWebhookDeliveriesOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.OutboxMessage `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesOK creates ListWebhookDeliveriesOK with default headers values
+func NewListWebhookDeliveriesOK() *ListWebhookDeliveriesOK {
+
+	return &ListWebhookDeliveriesOK{}
+}
+
+// WithPayload adds the payload to the list webhook deliveries o k response
+func (o *ListWebhookDeliveriesOK) WithPayload(payload []*models.OutboxMessage) *ListWebhookDeliveriesOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries o k response
+func (o *ListWebhookDeliveriesOK) SetPayload(payload []*models.OutboxMessage) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.OutboxMessage, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListWebhookDeliveriesDefault error
+
+swagger:response listWebhookDeliveriesDefault
+*/
+type ListWebhookDeliveriesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhookDeliveriesDefault creates ListWebhookDeliveriesDefault with default headers values
+func NewListWebhookDeliveriesDefault(code int) *ListWebhookDeliveriesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListWebhookDeliveriesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list webhook deliveries default response
+func (o *ListWebhookDeliveriesDefault) WithStatusCode(code int) *ListWebhookDeliveriesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list webhook deliveries default response
+func (o *ListWebhookDeliveriesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list webhook deliveries default response
+func (o *ListWebhookDeliveriesDefault) WithPayload(payload *models.Error) *ListWebhookDeliveriesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhook deliveries default response
+func (o *ListWebhookDeliveriesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhookDeliveriesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
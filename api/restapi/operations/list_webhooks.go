@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListWebhooksHandlerFunc turns a function with the right signature into a list webhooks handler
+type ListWebhooksHandlerFunc func(ListWebhooksParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ListWebhooksHandlerFunc) Handle(params ListWebhooksParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ListWebhooksHandler interface for that can handle valid list webhooks params
+type ListWebhooksHandler interface {
+	Handle(ListWebhooksParams, *models.User) middleware.Responder
+}
+
+// NewListWebhooks creates a new http.Handler for the list webhooks operation
+func NewListWebhooks(ctx *middleware.Context, handler ListWebhooksHandler) *ListWebhooks {
+	return &ListWebhooks{Context: ctx, Handler: handler}
+}
+
+/* ListWebhooks swagger:route GET /webhooks listWebhooks
+
+The registered outbound webhooks. Requires the admin permission.
+
+*/
+type ListWebhooks struct {
+	Context *middleware.Context
+	Handler ListWebhooksHandler
+}
+
+func (o *ListWebhooks) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewListWebhooksParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,119 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ListWebhooksOKCode is the HTTP code returned for type ListWebhooksOK
+const ListWebhooksOKCode int = 200
+
+/*ListWebhooksOK OK
+
+swagger:response listWebhooksOK
+*/
+type ListWebhooksOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload []*models.Webhook `json:"body,omitempty"`
+}
+
+// NewListWebhooksOK creates ListWebhooksOK with default headers values
+func NewListWebhooksOK() *ListWebhooksOK {
+
+	return &ListWebhooksOK{}
+}
+
+// WithPayload adds the payload to the list webhooks o k response
+func (o *ListWebhooksOK) WithPayload(payload []*models.Webhook) *ListWebhooksOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks o k response
+func (o *ListWebhooksOK) SetPayload(payload []*models.Webhook) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	payload := o.Payload
+	if payload == nil {
+		// return empty array
+		payload = make([]*models.Webhook, 0, 50)
+	}
+
+	if err := producer.Produce(rw, payload); err != nil {
+		panic(err) // let the recovery middleware deal with this
+	}
+}
+
+/*ListWebhooksDefault error
+
+swagger:response listWebhooksDefault
+*/
+type ListWebhooksDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewListWebhooksDefault creates ListWebhooksDefault with default headers values
+func NewListWebhooksDefault(code int) *ListWebhooksDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ListWebhooksDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the list webhooks default response
+func (o *ListWebhooksDefault) WithStatusCode(code int) *ListWebhooksDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the list webhooks default response
+func (o *ListWebhooksDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the list webhooks default response
+func (o *ListWebhooksDefault) WithPayload(payload *models.Error) *ListWebhooksDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the list webhooks default response
+func (o *ListWebhooksDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ListWebhooksDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
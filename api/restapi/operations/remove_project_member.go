@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RemoveProjectMemberHandlerFunc turns a function with the right signature into a remove project member handler
+type RemoveProjectMemberHandlerFunc func(RemoveProjectMemberParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RemoveProjectMemberHandlerFunc) Handle(params RemoveProjectMemberParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RemoveProjectMemberHandler interface for that can handle valid remove project member params
+type RemoveProjectMemberHandler interface {
+	Handle(RemoveProjectMemberParams, *models.User) middleware.Responder
+}
+
+// NewRemoveProjectMember creates a new http.Handler for the remove project member operation
+func NewRemoveProjectMember(ctx *middleware.Context, handler RemoveProjectMemberHandler) *RemoveProjectMember {
+	return &RemoveProjectMember{Context: ctx, Handler: handler}
+}
+
+/* RemoveProjectMember swagger:route DELETE /project/{id}/members/{uid} removeProjectMember
+
+RemoveProjectMember remove project member API
+
+*/
+type RemoveProjectMember struct {
+	Context *middleware.Context
+	Handler RemoveProjectMemberHandler
+}
+
+func (o *RemoveProjectMember) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRemoveProjectMemberParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
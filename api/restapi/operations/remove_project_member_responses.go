@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RemoveProjectMemberNoContentCode is the HTTP code returned for type RemoveProjectMemberNoContent
+const RemoveProjectMemberNoContentCode int = 204
+
+/*RemoveProjectMemberNoContent Removed
+
+swagger:response removeProjectMemberNoContent
+*/
+type RemoveProjectMemberNoContent struct {
+}
+
+// NewRemoveProjectMemberNoContent creates RemoveProjectMemberNoContent with default headers values
+func NewRemoveProjectMemberNoContent() *RemoveProjectMemberNoContent {
+
+	return &RemoveProjectMemberNoContent{}
+}
+
+// WriteResponse to the client
+func (o *RemoveProjectMemberNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*RemoveProjectMemberDefault error
+
+swagger:response removeProjectMemberDefault
+*/
+type RemoveProjectMemberDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRemoveProjectMemberDefault creates RemoveProjectMemberDefault with default headers values
+func NewRemoveProjectMemberDefault(code int) *RemoveProjectMemberDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RemoveProjectMemberDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the remove project member default response
+func (o *RemoveProjectMemberDefault) WithStatusCode(code int) *RemoveProjectMemberDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the remove project member default response
+func (o *RemoveProjectMemberDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the remove project member default response
+func (o *RemoveProjectMemberDefault) WithPayload(payload *models.Error) *RemoveProjectMemberDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the remove project member default response
+func (o *RemoveProjectMemberDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RemoveProjectMemberDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RemoveUserHandlerFunc turns a function with the right signature into a remove user handler
+type RemoveUserHandlerFunc func(RemoveUserParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RemoveUserHandlerFunc) Handle(params RemoveUserParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RemoveUserHandler interface for that can handle valid remove user params
+type RemoveUserHandler interface {
+	Handle(RemoveUserParams, *models.User) middleware.Responder
+}
+
+// NewRemoveUser creates a new http.Handler for the remove user operation
+func NewRemoveUser(ctx *middleware.Context, handler RemoveUserHandler) *RemoveUser {
+	return &RemoveUser{Context: ctx, Handler: handler}
+}
+
+/* RemoveUser swagger:route DELETE /user/{uid} removeUser
+
+Remove the user from the identity platform and delete their local data and API tokens. They can sign in again if their provider is whitelisted or an access rule matches them.
+
+
+*/
+type RemoveUser struct {
+	Context *middleware.Context
+	Handler RemoveUserHandler
+}
+
+func (o *RemoveUser) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRemoveUserParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
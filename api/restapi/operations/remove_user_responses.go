@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RemoveUserNoContentCode is the HTTP code returned for type RemoveUserNoContent
+const RemoveUserNoContentCode int = 204
+
+/*RemoveUserNoContent Removed
+
+swagger:response removeUserNoContent
+*/
+type RemoveUserNoContent struct {
+}
+
+// NewRemoveUserNoContent creates RemoveUserNoContent with default headers values
+func NewRemoveUserNoContent() *RemoveUserNoContent {
+
+	return &RemoveUserNoContent{}
+}
+
+// WriteResponse to the client
+func (o *RemoveUserNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*RemoveUserDefault error
+
+swagger:response removeUserDefault
+*/
+type RemoveUserDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRemoveUserDefault creates RemoveUserDefault with default headers values
+func NewRemoveUserDefault(code int) *RemoveUserDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RemoveUserDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the remove user default response
+func (o *RemoveUserDefault) WithStatusCode(code int) *RemoveUserDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the remove user default response
+func (o *RemoveUserDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the remove user default response
+func (o *RemoveUserDefault) WithPayload(payload *models.Error) *RemoveUserDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the remove user default response
+func (o *RemoveUserDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RemoveUserDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,72 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RequestAccessHandlerFunc turns a function with the right signature into a request access handler
+type RequestAccessHandlerFunc func(RequestAccessParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RequestAccessHandlerFunc) Handle(params RequestAccessParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RequestAccessHandler interface for that can handle valid request access params
+type RequestAccessHandler interface {
+	Handle(RequestAccessParams, *models.User) middleware.Responder
+}
+
+// NewRequestAccess creates a new http.Handler for the request access operation
+func NewRequestAccess(ctx *middleware.Context, handler RequestAccessHandler) *RequestAccess {
+	return &RequestAccess{Context: ctx, Handler: handler}
+}
+
+/* RequestAccess swagger:route PUT /access-request requestAccess
+
+Ask the security team for access, explaining why it's needed. Users that don't yet have access can use this. A pending request's justification is replaced; a denied request is reopened.
+
+
+*/
+type RequestAccess struct {
+	Context *middleware.Context
+	Handler RequestAccessHandler
+}
+
+func (o *RequestAccess) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRequestAccessParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RequestAccessOKCode is the HTTP code returned for type RequestAccessOK
+const RequestAccessOKCode int = 200
+
+/*RequestAccessOK OK
+
+swagger:response requestAccessOK
+*/
+type RequestAccessOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.AccessRequest `json:"body,omitempty"`
+}
+
+// NewRequestAccessOK creates RequestAccessOK with default headers values
+func NewRequestAccessOK() *RequestAccessOK {
+
+	return &RequestAccessOK{}
+}
+
+// WithPayload adds the payload to the request access o k response
+func (o *RequestAccessOK) WithPayload(payload *models.AccessRequest) *RequestAccessOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the request access o k response
+func (o *RequestAccessOK) SetPayload(payload *models.AccessRequest) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RequestAccessOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*RequestAccessDefault error
+
+swagger:response requestAccessDefault
+*/
+type RequestAccessDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRequestAccessDefault creates RequestAccessDefault with default headers values
+func NewRequestAccessDefault(code int) *RequestAccessDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RequestAccessDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the request access default response
+func (o *RequestAccessDefault) WithStatusCode(code int) *RequestAccessDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the request access default response
+func (o *RequestAccessDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the request access default response
+func (o *RequestAccessDefault) WithPayload(payload *models.Error) *RequestAccessDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the request access default response
+func (o *RequestAccessDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RequestAccessDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,170 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ReviewPlanRevisionHandlerFunc turns a function with the right signature into a review plan revision handler
+type ReviewPlanRevisionHandlerFunc func(ReviewPlanRevisionParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn ReviewPlanRevisionHandlerFunc) Handle(params ReviewPlanRevisionParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// ReviewPlanRevisionHandler interface for that can handle valid review plan revision params
+type ReviewPlanRevisionHandler interface {
+	Handle(ReviewPlanRevisionParams, *models.User) middleware.Responder
+}
+
+// NewReviewPlanRevision creates a new http.Handler for the review plan revision operation
+func NewReviewPlanRevision(ctx *middleware.Context, handler ReviewPlanRevisionHandler) *ReviewPlanRevision {
+	return &ReviewPlanRevision{Context: ctx, Handler: handler}
+}
+
+/* ReviewPlanRevision swagger:route POST /plan/{id}/revision/{revId}/review reviewPlanRevision
+
+Approve a committed revision that's been submitted for review, or request changes to it, optionally with a comment; this needs the review permission, and reviewers can't review their own revisions. Without a decision, just add a comment, which the plan's contributors can also do.
+
+
+*/
+type ReviewPlanRevision struct {
+	Context *middleware.Context
+	Handler ReviewPlanRevisionHandler
+}
+
+func (o *ReviewPlanRevision) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewReviewPlanRevisionParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// ReviewPlanRevisionBody review plan revision body
+//
+// swagger:model ReviewPlanRevisionBody
+type ReviewPlanRevisionBody struct {
+
+	// comment
+	Comment string `json:"comment,omitempty"`
+
+	// decision
+	// Enum: [approved changes-requested]
+	Decision string `json:"decision,omitempty"`
+}
+
+// Validate validates this review plan revision body
+func (o *ReviewPlanRevisionBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateDecision(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+var reviewPlanRevisionBodyTypeDecisionPropEnum []interface{}
+
+func init() {
+	var res []string
+	if err := json.Unmarshal([]byte(`["approved","changes-requested"]`), &res); err != nil {
+		panic(err)
+	}
+	for _, v := range res {
+		reviewPlanRevisionBodyTypeDecisionPropEnum = append(reviewPlanRevisionBodyTypeDecisionPropEnum, v)
+	}
+}
+
+const (
+
+	// ReviewPlanRevisionBodyDecisionApproved captures enum value "approved"
+	ReviewPlanRevisionBodyDecisionApproved string = "approved"
+
+	// ReviewPlanRevisionBodyDecisionChangesDashRequested captures enum value "changes-requested"
+	ReviewPlanRevisionBodyDecisionChangesDashRequested string = "changes-requested"
+)
+
+// prop value enum
+func (o *ReviewPlanRevisionBody) validateDecisionEnum(path, location string, value string) error {
+	if err := validate.EnumCase(path, location, value, reviewPlanRevisionBodyTypeDecisionPropEnum, true); err != nil {
+		return err
+	}
+	return nil
+}
+
+func (o *ReviewPlanRevisionBody) validateDecision(formats strfmt.Registry) error {
+	if swag.IsZero(o.Decision) { // not required
+		return nil
+	}
+
+	// value enum
+	if err := o.validateDecisionEnum("body"+"."+"decision", "body", o.Decision); err != nil {
+		return err
+	}
+
+	return nil
+}
+
+// ContextValidate validates this review plan revision body based on context it is used
+func (o *ReviewPlanRevisionBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *ReviewPlanRevisionBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *ReviewPlanRevisionBody) UnmarshalBinary(b []byte) error {
+	var res ReviewPlanRevisionBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// ReviewPlanRevisionOKCode is the HTTP code returned for type ReviewPlanRevisionOK
+const ReviewPlanRevisionOKCode int = 200
+
+/*ReviewPlanRevisionOK OK
+
+swagger:response reviewPlanRevisionOK
+*/
+type ReviewPlanRevisionOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.PlanReview `json:"body,omitempty"`
+}
+
+// NewReviewPlanRevisionOK creates ReviewPlanRevisionOK with default headers values
+func NewReviewPlanRevisionOK() *ReviewPlanRevisionOK {
+
+	return &ReviewPlanRevisionOK{}
+}
+
+// WithPayload adds the payload to the review plan revision o k response
+func (o *ReviewPlanRevisionOK) WithPayload(payload *models.PlanReview) *ReviewPlanRevisionOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the review plan revision o k response
+func (o *ReviewPlanRevisionOK) SetPayload(payload *models.PlanReview) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReviewPlanRevisionOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*ReviewPlanRevisionDefault error
+
+swagger:response reviewPlanRevisionDefault
+*/
+type ReviewPlanRevisionDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewReviewPlanRevisionDefault creates ReviewPlanRevisionDefault with default headers values
+func NewReviewPlanRevisionDefault(code int) *ReviewPlanRevisionDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &ReviewPlanRevisionDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the review plan revision default response
+func (o *ReviewPlanRevisionDefault) WithStatusCode(code int) *ReviewPlanRevisionDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the review plan revision default response
+func (o *ReviewPlanRevisionDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the review plan revision default response
+func (o *ReviewPlanRevisionDefault) WithPayload(payload *models.Error) *ReviewPlanRevisionDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the review plan revision default response
+func (o *ReviewPlanRevisionDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *ReviewPlanRevisionDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
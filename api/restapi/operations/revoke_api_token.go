@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RevokeAPITokenHandlerFunc turns a function with the right signature into a revoke Api token handler
+type RevokeAPITokenHandlerFunc func(RevokeAPITokenParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn RevokeAPITokenHandlerFunc) Handle(params RevokeAPITokenParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// RevokeAPITokenHandler interface for that can handle valid revoke Api token params
+type RevokeAPITokenHandler interface {
+	Handle(RevokeAPITokenParams, *models.User) middleware.Responder
+}
+
+// NewRevokeAPIToken creates a new http.Handler for the revoke Api token operation
+func NewRevokeAPIToken(ctx *middleware.Context, handler RevokeAPITokenHandler) *RevokeAPIToken {
+	return &RevokeAPIToken{Context: ctx, Handler: handler}
+}
+
+/* RevokeAPIToken swagger:route DELETE /tokens/{id} revokeApiToken
+
+Revoke an API token. Users can revoke their own tokens, security admins can revoke any token.
+
+*/
+type RevokeAPIToken struct {
+	Context *middleware.Context
+	Handler RevokeAPITokenHandler
+}
+
+func (o *RevokeAPIToken) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewRevokeAPITokenParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// RevokeAPITokenNoContentCode is the HTTP code returned for type RevokeAPITokenNoContent
+const RevokeAPITokenNoContentCode int = 204
+
+/*RevokeAPITokenNoContent Revoked
+
+swagger:response revokeApiTokenNoContent
+*/
+type RevokeAPITokenNoContent struct {
+}
+
+// NewRevokeAPITokenNoContent creates RevokeAPITokenNoContent with default headers values
+func NewRevokeAPITokenNoContent() *RevokeAPITokenNoContent {
+
+	return &RevokeAPITokenNoContent{}
+}
+
+// WriteResponse to the client
+func (o *RevokeAPITokenNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*RevokeAPITokenDefault error
+
+swagger:response revokeApiTokenDefault
+*/
+type RevokeAPITokenDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewRevokeAPITokenDefault creates RevokeAPITokenDefault with default headers values
+func NewRevokeAPITokenDefault(code int) *RevokeAPITokenDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &RevokeAPITokenDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the revoke Api token default response
+func (o *RevokeAPITokenDefault) WithStatusCode(code int) *RevokeAPITokenDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the revoke Api token default response
+func (o *RevokeAPITokenDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the revoke Api token default response
+func (o *RevokeAPITokenDefault) WithPayload(payload *models.Error) *RevokeAPITokenDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the revoke Api token default response
+func (o *RevokeAPITokenDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *RevokeAPITokenDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SetCommitPolicyHandlerFunc turns a function with the right signature into a set commit policy handler
+type SetCommitPolicyHandlerFunc func(SetCommitPolicyParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SetCommitPolicyHandlerFunc) Handle(params SetCommitPolicyParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SetCommitPolicyHandler interface for that can handle valid set commit policy params
+type SetCommitPolicyHandler interface {
+	Handle(SetCommitPolicyParams, *models.User) middleware.Responder
+}
+
+// NewSetCommitPolicy creates a new http.Handler for the set commit policy operation
+func NewSetCommitPolicy(ctx *middleware.Context, handler SetCommitPolicyHandler) *SetCommitPolicy {
+	return &SetCommitPolicy{Context: ctx, Handler: handler}
+}
+
+/* SetCommitPolicy swagger:route PUT /commit-policy setCommitPolicy
+
+Replace the rules plans must meet to be committed. Revisions that are already committed aren't affected.
+
+*/
+type SetCommitPolicy struct {
+	Context *middleware.Context
+	Handler SetCommitPolicyHandler
+}
+
+func (o *SetCommitPolicy) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSetCommitPolicyParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,117 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// SetCommitPolicyOKCode is the HTTP code returned for type SetCommitPolicyOK
+const SetCommitPolicyOKCode int = 200
+
+/*SetCommitPolicyOK OK
+
+swagger:response setCommitPolicyOK
+*/
+type SetCommitPolicyOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *lib.CommitPolicy `json:"body,omitempty"`
+}
+
+// NewSetCommitPolicyOK creates SetCommitPolicyOK with default headers values
+func NewSetCommitPolicyOK() *SetCommitPolicyOK {
+
+	return &SetCommitPolicyOK{}
+}
+
+// WithPayload adds the payload to the set commit policy o k response
+func (o *SetCommitPolicyOK) WithPayload(payload *lib.CommitPolicy) *SetCommitPolicyOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set commit policy o k response
+func (o *SetCommitPolicyOK) SetPayload(payload *lib.CommitPolicy) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetCommitPolicyOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SetCommitPolicyDefault error
+
+swagger:response setCommitPolicyDefault
+*/
+type SetCommitPolicyDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetCommitPolicyDefault creates SetCommitPolicyDefault with default headers values
+func NewSetCommitPolicyDefault(code int) *SetCommitPolicyDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetCommitPolicyDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set commit policy default response
+func (o *SetCommitPolicyDefault) WithStatusCode(code int) *SetCommitPolicyDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set commit policy default response
+func (o *SetCommitPolicyDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set commit policy default response
+func (o *SetCommitPolicyDefault) WithPayload(payload *models.Error) *SetCommitPolicyDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set commit policy default response
+func (o *SetCommitPolicyDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetCommitPolicyDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,172 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/errors"
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+	"github.com/go-openapi/validate"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SetProjectMemberHandlerFunc turns a function with the right signature into a set project member handler
+type SetProjectMemberHandlerFunc func(SetProjectMemberParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SetProjectMemberHandlerFunc) Handle(params SetProjectMemberParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SetProjectMemberHandler interface for that can handle valid set project member params
+type SetProjectMemberHandler interface {
+	Handle(SetProjectMemberParams, *models.User) middleware.Responder
+}
+
+// NewSetProjectMember creates a new http.Handler for the set project member operation
+func NewSetProjectMember(ctx *middleware.Context, handler SetProjectMemberHandler) *SetProjectMember {
+	return &SetProjectMember{Context: ctx, Handler: handler}
+}
+
+/* SetProjectMember swagger:route PUT /project/{id}/members/{uid} setProjectMember
+
+Add a user to the project, or change their role in it. Only project owners and security admins can manage members.
+
+*/
+type SetProjectMember struct {
+	Context *middleware.Context
+	Handler SetProjectMemberHandler
+}
+
+func (o *SetProjectMember) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSetProjectMemberParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// SetProjectMemberBody set project member body
+//
+// swagger:model SetProjectMemberBody
+type SetProjectMemberBody struct {
+
+	// role
+	// Required: true
+	Role *models.ProjectMemberRole `json:"role"`
+}
+
+// Validate validates this set project member body
+func (o *SetProjectMemberBody) Validate(formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.validateRole(formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *SetProjectMemberBody) validateRole(formats strfmt.Registry) error {
+
+	if err := validate.Required("body"+"."+"role", "body", o.Role); err != nil {
+		return err
+	}
+
+	if err := validate.Required("body"+"."+"role", "body", o.Role); err != nil {
+		return err
+	}
+
+	if o.Role != nil {
+		if err := o.Role.Validate(formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("body" + "." + "role")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("body" + "." + "role")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// ContextValidate validate this set project member body based on the context it is used
+func (o *SetProjectMemberBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	var res []error
+
+	if err := o.contextValidateRole(ctx, formats); err != nil {
+		res = append(res, err)
+	}
+
+	if len(res) > 0 {
+		return errors.CompositeValidationError(res...)
+	}
+	return nil
+}
+
+func (o *SetProjectMemberBody) contextValidateRole(ctx context.Context, formats strfmt.Registry) error {
+
+	if o.Role != nil {
+		if err := o.Role.ContextValidate(ctx, formats); err != nil {
+			if ve, ok := err.(*errors.Validation); ok {
+				return ve.ValidateName("body" + "." + "role")
+			} else if ce, ok := err.(*errors.CompositeError); ok {
+				return ce.ValidateName("body" + "." + "role")
+			}
+			return err
+		}
+	}
+
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *SetProjectMemberBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *SetProjectMemberBody) UnmarshalBinary(b []byte) error {
+	var res SetProjectMemberBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SetProjectMemberOKCode is the HTTP code returned for type SetProjectMemberOK
+const SetProjectMemberOKCode int = 200
+
+/*SetProjectMemberOK OK
+
+swagger:response setProjectMemberOK
+*/
+type SetProjectMemberOK struct {
+}
+
+// NewSetProjectMemberOK creates SetProjectMemberOK with default headers values
+func NewSetProjectMemberOK() *SetProjectMemberOK {
+
+	return &SetProjectMemberOK{}
+}
+
+// WriteResponse to the client
+func (o *SetProjectMemberOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+/*SetProjectMemberDefault error
+
+swagger:response setProjectMemberDefault
+*/
+type SetProjectMemberDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetProjectMemberDefault creates SetProjectMemberDefault with default headers values
+func NewSetProjectMemberDefault(code int) *SetProjectMemberDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetProjectMemberDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set project member default response
+func (o *SetProjectMemberDefault) WithStatusCode(code int) *SetProjectMemberDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set project member default response
+func (o *SetProjectMemberDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set project member default response
+func (o *SetProjectMemberDefault) WithPayload(payload *models.Error) *SetProjectMemberDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set project member default response
+func (o *SetProjectMemberDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetProjectMemberDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SetUserRolesHandlerFunc turns a function with the right signature into a set user roles handler
+type SetUserRolesHandlerFunc func(SetUserRolesParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SetUserRolesHandlerFunc) Handle(params SetUserRolesParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SetUserRolesHandler interface for that can handle valid set user roles params
+type SetUserRolesHandler interface {
+	Handle(SetUserRolesParams, *models.User) middleware.Responder
+}
+
+// NewSetUserRoles creates a new http.Handler for the set user roles operation
+func NewSetUserRoles(ctx *middleware.Context, handler SetUserRolesHandler) *SetUserRoles {
+	return &SetUserRoles{Context: ctx, Handler: handler}
+}
+
+/* SetUserRoles swagger:route PUT /user/{uid}/roles setUserRoles
+
+Replace the roles explicitly granted to a user. An empty list reverts the user to the default roles.
+
+*/
+type SetUserRoles struct {
+	Context *middleware.Context
+	Handler SetUserRolesHandler
+}
+
+func (o *SetUserRoles) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSetUserRolesParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SetUserRolesOKCode is the HTTP code returned for type SetUserRolesOK
+const SetUserRolesOKCode int = 200
+
+/*SetUserRolesOK OK
+
+swagger:response setUserRolesOK
+*/
+type SetUserRolesOK struct {
+}
+
+// NewSetUserRolesOK creates SetUserRolesOK with default headers values
+func NewSetUserRolesOK() *SetUserRolesOK {
+
+	return &SetUserRolesOK{}
+}
+
+// WriteResponse to the client
+func (o *SetUserRolesOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+/*SetUserRolesDefault error
+
+swagger:response setUserRolesDefault
+*/
+type SetUserRolesDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSetUserRolesDefault creates SetUserRolesDefault with default headers values
+func NewSetUserRolesDefault(code int) *SetUserRolesDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SetUserRolesDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the set user roles default response
+func (o *SetUserRolesDefault) WithStatusCode(code int) *SetUserRolesDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the set user roles default response
+func (o *SetUserRolesDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the set user roles default response
+func (o *SetUserRolesDefault) WithPayload(payload *models.Error) *SetUserRolesDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the set user roles default response
+func (o *SetUserRolesDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SetUserRolesDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
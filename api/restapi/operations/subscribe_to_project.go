@@ -0,0 +1,111 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	"github.com/go-openapi/swag"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SubscribeToProjectHandlerFunc turns a function with the right signature into a subscribe to project handler
+type SubscribeToProjectHandlerFunc func(SubscribeToProjectParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn SubscribeToProjectHandlerFunc) Handle(params SubscribeToProjectParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// SubscribeToProjectHandler interface for that can handle valid subscribe to project params
+type SubscribeToProjectHandler interface {
+	Handle(SubscribeToProjectParams, *models.User) middleware.Responder
+}
+
+// NewSubscribeToProject creates a new http.Handler for the subscribe to project operation
+func NewSubscribeToProject(ctx *middleware.Context, handler SubscribeToProjectHandler) *SubscribeToProject {
+	return &SubscribeToProject{Context: ctx, Handler: handler}
+}
+
+/* SubscribeToProject swagger:route PUT /project/{id}/subscription subscribeToProject
+
+Notify the current user, by email, of the project's events. Replaces any existing subscription.
+
+*/
+type SubscribeToProject struct {
+	Context *middleware.Context
+	Handler SubscribeToProjectHandler
+}
+
+func (o *SubscribeToProject) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewSubscribeToProjectParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
+
+// SubscribeToProjectBody subscribe to project body
+//
+// swagger:model SubscribeToProjectBody
+type SubscribeToProjectBody struct {
+
+	// The event types to be notified of, e.g. plan.committed or project.*; every event if empty
+	Events []string `json:"events"`
+}
+
+// Validate validates this subscribe to project body
+func (o *SubscribeToProjectBody) Validate(formats strfmt.Registry) error {
+	return nil
+}
+
+// ContextValidate validates this subscribe to project body based on context it is used
+func (o *SubscribeToProjectBody) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// MarshalBinary interface implementation
+func (o *SubscribeToProjectBody) MarshalBinary() ([]byte, error) {
+	if o == nil {
+		return nil, nil
+	}
+	return swag.WriteJSON(o)
+}
+
+// UnmarshalBinary interface implementation
+func (o *SubscribeToProjectBody) UnmarshalBinary(b []byte) error {
+	var res SubscribeToProjectBody
+	if err := swag.ReadJSON(b, &res); err != nil {
+		return err
+	}
+	*o = res
+	return nil
+}
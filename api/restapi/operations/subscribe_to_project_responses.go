@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// SubscribeToProjectOKCode is the HTTP code returned for type SubscribeToProjectOK
+const SubscribeToProjectOKCode int = 200
+
+/*SubscribeToProjectOK OK
+
+swagger:response subscribeToProjectOK
+*/
+type SubscribeToProjectOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Subscription `json:"body,omitempty"`
+}
+
+// NewSubscribeToProjectOK creates SubscribeToProjectOK with default headers values
+func NewSubscribeToProjectOK() *SubscribeToProjectOK {
+
+	return &SubscribeToProjectOK{}
+}
+
+// WithPayload adds the payload to the subscribe to project o k response
+func (o *SubscribeToProjectOK) WithPayload(payload *models.Subscription) *SubscribeToProjectOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the subscribe to project o k response
+func (o *SubscribeToProjectOK) SetPayload(payload *models.Subscription) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SubscribeToProjectOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*SubscribeToProjectDefault error
+
+swagger:response subscribeToProjectDefault
+*/
+type SubscribeToProjectDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewSubscribeToProjectDefault creates SubscribeToProjectDefault with default headers values
+func NewSubscribeToProjectDefault(code int) *SubscribeToProjectDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &SubscribeToProjectDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the subscribe to project default response
+func (o *SubscribeToProjectDefault) WithStatusCode(code int) *SubscribeToProjectDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the subscribe to project default response
+func (o *SubscribeToProjectDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the subscribe to project default response
+func (o *SubscribeToProjectDefault) WithPayload(payload *models.Error) *SubscribeToProjectDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the subscribe to project default response
+func (o *SubscribeToProjectDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *SubscribeToProjectDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// TestWebhookHandlerFunc turns a function with the right signature into a test webhook handler
+type TestWebhookHandlerFunc func(TestWebhookParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn TestWebhookHandlerFunc) Handle(params TestWebhookParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// TestWebhookHandler interface for that can handle valid test webhook params
+type TestWebhookHandler interface {
+	Handle(TestWebhookParams, *models.User) middleware.Responder
+}
+
+// NewTestWebhook creates a new http.Handler for the test webhook operation
+func NewTestWebhook(ctx *middleware.Context, handler TestWebhookHandler) *TestWebhook {
+	return &TestWebhook{Context: ctx, Handler: handler}
+}
+
+/* TestWebhook swagger:route POST /webhooks/{id}/test testWebhook
+
+Send a webhook.test event to the webhook straight away, and report the outcome. Requires the admin permission.
+
+*/
+type TestWebhook struct {
+	Context *middleware.Context
+	Handler TestWebhookHandler
+}
+
+func (o *TestWebhook) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewTestWebhookParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
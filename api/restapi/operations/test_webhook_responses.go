@@ -0,0 +1,116 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// TestWebhookOKCode is the HTTP code returned for type TestWebhookOK
+const TestWebhookOKCode int = 200
+
+/*TestWebhookOK The test was sent, see the result for whether it was delivered
+
+swagger:response testWebhookOK
+*/
+type TestWebhookOK struct {
+
+	/*
+	  In: Body
+	*/
+	Payload *models.WebhookTestResult `json:"body,omitempty"`
+}
+
+// NewTestWebhookOK creates TestWebhookOK with default headers values
+func NewTestWebhookOK() *TestWebhookOK {
+
+	return &TestWebhookOK{}
+}
+
+// WithPayload adds the payload to the test webhook o k response
+func (o *TestWebhookOK) WithPayload(payload *models.WebhookTestResult) *TestWebhookOK {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the test webhook o k response
+func (o *TestWebhookOK) SetPayload(payload *models.WebhookTestResult) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TestWebhookOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(200)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
+
+/*TestWebhookDefault error
+
+swagger:response testWebhookDefault
+*/
+type TestWebhookDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewTestWebhookDefault creates TestWebhookDefault with default headers values
+func NewTestWebhookDefault(code int) *TestWebhookDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &TestWebhookDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the test webhook default response
+func (o *TestWebhookDefault) WithStatusCode(code int) *TestWebhookDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the test webhook default response
+func (o *TestWebhookDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the test webhook default response
+func (o *TestWebhookDefault) WithPayload(payload *models.Error) *TestWebhookDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the test webhook default response
+func (o *TestWebhookDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *TestWebhookDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// UnsubscribeFromProjectHandlerFunc turns a function with the right signature into a unsubscribe from project handler
+type UnsubscribeFromProjectHandlerFunc func(UnsubscribeFromProjectParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UnsubscribeFromProjectHandlerFunc) Handle(params UnsubscribeFromProjectParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UnsubscribeFromProjectHandler interface for that can handle valid unsubscribe from project params
+type UnsubscribeFromProjectHandler interface {
+	Handle(UnsubscribeFromProjectParams, *models.User) middleware.Responder
+}
+
+// NewUnsubscribeFromProject creates a new http.Handler for the unsubscribe from project operation
+func NewUnsubscribeFromProject(ctx *middleware.Context, handler UnsubscribeFromProjectHandler) *UnsubscribeFromProject {
+	return &UnsubscribeFromProject{Context: ctx, Handler: handler}
+}
+
+/* UnsubscribeFromProject swagger:route DELETE /project/{id}/subscription unsubscribeFromProject
+
+UnsubscribeFromProject unsubscribe from project API
+
+*/
+type UnsubscribeFromProject struct {
+	Context *middleware.Context
+	Handler UnsubscribeFromProjectHandler
+}
+
+func (o *UnsubscribeFromProject) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUnsubscribeFromProjectParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
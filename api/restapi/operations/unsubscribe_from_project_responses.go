@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// UnsubscribeFromProjectNoContentCode is the HTTP code returned for type UnsubscribeFromProjectNoContent
+const UnsubscribeFromProjectNoContentCode int = 204
+
+/*UnsubscribeFromProjectNoContent Unsubscribed
+
+swagger:response unsubscribeFromProjectNoContent
+*/
+type UnsubscribeFromProjectNoContent struct {
+}
+
+// NewUnsubscribeFromProjectNoContent creates UnsubscribeFromProjectNoContent with default headers values
+func NewUnsubscribeFromProjectNoContent() *UnsubscribeFromProjectNoContent {
+
+	return &UnsubscribeFromProjectNoContent{}
+}
+
+// WriteResponse to the client
+func (o *UnsubscribeFromProjectNoContent) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(204)
+}
+
+/*UnsubscribeFromProjectDefault error
+
+swagger:response unsubscribeFromProjectDefault
+*/
+type UnsubscribeFromProjectDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUnsubscribeFromProjectDefault creates UnsubscribeFromProjectDefault with default headers values
+func NewUnsubscribeFromProjectDefault(code int) *UnsubscribeFromProjectDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UnsubscribeFromProjectDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the unsubscribe from project default response
+func (o *UnsubscribeFromProjectDefault) WithStatusCode(code int) *UnsubscribeFromProjectDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the unsubscribe from project default response
+func (o *UnsubscribeFromProjectDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the unsubscribe from project default response
+func (o *UnsubscribeFromProjectDefault) WithPayload(payload *models.Error) *UnsubscribeFromProjectDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the unsubscribe from project default response
+func (o *UnsubscribeFromProjectDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UnsubscribeFromProjectDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
@@ -0,0 +1,71 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime/middleware"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// UpdateOrgUnitHandlerFunc turns a function with the right signature into a update org unit handler
+type UpdateOrgUnitHandlerFunc func(UpdateOrgUnitParams, *models.User) middleware.Responder
+
+// Handle executing the request and returning a response
+func (fn UpdateOrgUnitHandlerFunc) Handle(params UpdateOrgUnitParams, principal *models.User) middleware.Responder {
+	return fn(params, principal)
+}
+
+// UpdateOrgUnitHandler interface for that can handle valid update org unit params
+type UpdateOrgUnitHandler interface {
+	Handle(UpdateOrgUnitParams, *models.User) middleware.Responder
+}
+
+// NewUpdateOrgUnit creates a new http.Handler for the update org unit operation
+func NewUpdateOrgUnit(ctx *middleware.Context, handler UpdateOrgUnitHandler) *UpdateOrgUnit {
+	return &UpdateOrgUnit{Context: ctx, Handler: handler}
+}
+
+/* UpdateOrgUnit swagger:route PUT /orgunit/{id} updateOrgUnit
+
+Update an org unit. Only security admins and the leads of the unit or any of its ancestors can change it.
+
+*/
+type UpdateOrgUnit struct {
+	Context *middleware.Context
+	Handler UpdateOrgUnitHandler
+}
+
+func (o *UpdateOrgUnit) ServeHTTP(rw http.ResponseWriter, r *http.Request) {
+	route, rCtx, _ := o.Context.RouteInfo(r)
+	if rCtx != nil {
+		*r = *rCtx
+	}
+	var Params = NewUpdateOrgUnitParams()
+	uprinc, aCtx, err := o.Context.Authorize(r, route)
+	if err != nil {
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+	if aCtx != nil {
+		*r = *aCtx
+	}
+	var principal *models.User
+	if uprinc != nil {
+		principal = uprinc.(*models.User) // this is really a models.User, I promise
+	}
+
+	if err := o.Context.BindValidRequest(r, route, &Params); err != nil { // bind params
+		o.Context.Respond(rw, r, route.Produces, route, err)
+		return
+	}
+
+	res := o.Handler.Handle(Params, principal) // actually handle the request
+	o.Context.Respond(rw, r, route.Produces, route, res)
+
+}
@@ -0,0 +1,96 @@
+// Code generated by go-swagger; DO NOT EDIT.
+
+package operations
+
+// This file was generated by the swagger tool.
+// Editing this file might prove futile when you re-run the swagger generate command
+
+import (
+	"net/http"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// UpdateOrgUnitOKCode is the HTTP code returned for type UpdateOrgUnitOK
+const UpdateOrgUnitOKCode int = 200
+
+/*UpdateOrgUnitOK OK
+
+swagger:response updateOrgUnitOK
+*/
+type UpdateOrgUnitOK struct {
+}
+
+// NewUpdateOrgUnitOK creates UpdateOrgUnitOK with default headers values
+func NewUpdateOrgUnitOK() *UpdateOrgUnitOK {
+
+	return &UpdateOrgUnitOK{}
+}
+
+// WriteResponse to the client
+func (o *UpdateOrgUnitOK) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.Header().Del(runtime.HeaderContentType) //Remove Content-Type on empty responses
+
+	rw.WriteHeader(200)
+}
+
+/*UpdateOrgUnitDefault error
+
+swagger:response updateOrgUnitDefault
+*/
+type UpdateOrgUnitDefault struct {
+	_statusCode int
+
+	/*
+	  In: Body
+	*/
+	Payload *models.Error `json:"body,omitempty"`
+}
+
+// NewUpdateOrgUnitDefault creates UpdateOrgUnitDefault with default headers values
+func NewUpdateOrgUnitDefault(code int) *UpdateOrgUnitDefault {
+	if code <= 0 {
+		code = 500
+	}
+
+	return &UpdateOrgUnitDefault{
+		_statusCode: code,
+	}
+}
+
+// WithStatusCode adds the status to the update org unit default response
+func (o *UpdateOrgUnitDefault) WithStatusCode(code int) *UpdateOrgUnitDefault {
+	o._statusCode = code
+	return o
+}
+
+// SetStatusCode sets the status to the update org unit default response
+func (o *UpdateOrgUnitDefault) SetStatusCode(code int) {
+	o._statusCode = code
+}
+
+// WithPayload adds the payload to the update org unit default response
+func (o *UpdateOrgUnitDefault) WithPayload(payload *models.Error) *UpdateOrgUnitDefault {
+	o.Payload = payload
+	return o
+}
+
+// SetPayload sets the payload to the update org unit default response
+func (o *UpdateOrgUnitDefault) SetPayload(payload *models.Error) {
+	o.Payload = payload
+}
+
+// WriteResponse to the client
+func (o *UpdateOrgUnitDefault) WriteResponse(rw http.ResponseWriter, producer runtime.Producer) {
+
+	rw.WriteHeader(o._statusCode)
+	if o.Payload != nil {
+		payload := o.Payload
+		if err := producer.Produce(rw, payload); err != nil {
+			panic(err) // let the recovery middleware deal with this
+		}
+	}
+}
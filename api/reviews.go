@@ -0,0 +1,283 @@
+package api
+
+import (
+	"context"
+	"errors"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// EventPlanReviewed is sent to a plan's projects' subscribers when a reviewer approves a committed revision or requests changes to it
+const EventPlanReviewed = "plan.reviewed"
+
+// newReview returns the review a revision of the plan starts with: committed revisions are submitted for review if
+// reviews are enabled. Drafts, and revisions committed while reviews are disabled, don't have one.
+func (rt *Runtime) newReview(plan *lib.Plan) *models.PlanReview {
+	if !rt.PlanReviews || !plan.Details.Committed {
+		return nil
+	}
+	state := models.PlanReviewStateSubmitted
+	return &models.PlanReview{State: &state, Comments: []*models.ReviewComment{}}
+}
+
+// carriedReview returns a copy of the review of the plan's revision revID, for a new revision that only adds something
+// outside the scope of a review to it, such as a link to a task's issue. The new revision keeps the review state of the
+// one it's based on rather than having to be reviewed again, so an approved plan stays approved.
+func (rt *Runtime) carriedReview(ctx context.Context, planID string, revID string) (*models.PlanReview, error) {
+	if !rt.PlanReviews {
+		return nil, nil
+	}
+	versions, err := rt.Store.GetPlanVersions(ctx, planID)
+	if err != nil {
+		return nil, err
+	}
+	for _, v := range versions {
+		if v.RevID != nil && *v.RevID == revID && v.Review != nil {
+			review := *v.Review
+			review.Comments = append([]*models.ReviewComment{}, v.Review.Comments...)
+			return &review, nil
+		}
+	}
+	return nil, nil
+}
+
+// applyReview records the user's comment on the review, and if they made a decision, updates the review's state and reviewer.
+// A comment without a decision must have some text.
+func applyReview(review *models.PlanReview, u *models.User, decision string, text string, now time.Time) error {
+	if decision == "" && strings.TrimSpace(text) == "" {
+		return errors.New("a comment needs some text, or a decision")
+	}
+	name, uid, when := u.Name, u.UID, strfmt.DateTime(now)
+	review.Comments = append(review.Comments, &models.ReviewComment{Author: &name, AuthorUID: &uid, Time: &when, Decision: decision, Text: text})
+	if decision != "" {
+		state := decision
+		review.State = &state
+		review.Reviewer = u.Name
+		review.ReviewerUID = u.UID
+		review.Reviewed = when
+	}
+	return nil
+}
+
+// reviewQueue returns the plans of the projects whose latest reviewed revision is in the review state, oldest first
+func reviewQueue(ctx context.Context, rt *Runtime, projects []*models.Project, state string) ([]*models.ReviewSummary, error) {
+	planProjects := map[string][]string{} // plan ID to the names of its projects
+	planIDs := []string{}
+	for _, p := range projects {
+		for _, planID := range p.Plans {
+			if _, seen := planProjects[planID]; !seen {
+				planIDs = append(planIDs, planID)
+			}
+			planProjects[planID] = append(planProjects[planID], *p.Attributes.Name)
+		}
+	}
+
+	queue := []*models.ReviewSummary{}
+	for _, planID := range planIDs {
+		versions, err := rt.Store.GetPlanVersions(ctx, planID)
+		if err != nil {
+			return nil, err
+		}
+		var latest *models.RevisionVersion
+		for _, v := range versions {
+			if v.Review != nil {
+				latest = v
+			}
+		}
+		if latest == nil || *latest.Review.State != state {
+			continue
+		}
+		rev, found, err := rt.Store.GetPlanRevision(ctx, planID, *latest.RevID)
+		if err != nil {
+			return nil, err
+		}
+		if !found || rev == nil {
+			continue
+		}
+		id, date, names := planID, rev.Details.Date, strings.Join(planProjects[planID], ", ")
+		summary := &models.ReviewSummary{PlanID: &id, RevisionID: latest.RevID, Projects: &names, Date: &date, Review: latest.Review}
+		if latest.Version != nil {
+			summary.Committed = latest.Version.Time
+			if latest.Version.Author != nil && latest.Version.Author.Name != nil {
+				summary.Author = *latest.Version.Author.Name
+			}
+		}
+		queue = append(queue, summary)
+	}
+	sort.SliceStable(queue, func(i, j int) bool {
+		return time.Time(queue[i].Committed).Before(time.Time(queue[j].Committed))
+	})
+	return queue, nil
+}
+
+// planReviewed notifies that a reviewer approved a revision of the plan or requested changes to it; the
+// review's latest comment records the decision. before is the maturity of the plan's projects prior to the review,
+// from currentMaturity, used to spot decreases caused by an approval.
+func (rt *Runtime) planReviewed(ctx context.Context, planID string, revID string, plan *lib.Plan, review *models.PlanReview, before map[string]map[string]int) {
+	if rt.Notifications == nil {
+		return
+	}
+	latest := review.Comments[len(review.Comments)-1]
+	decision, comment := latest.Decision, latest.Text
+	title := "Plan approved"
+	if decision == models.ReviewCommentDecisionChangesDashRequested {
+		title = "Changes requested to plan"
+	}
+	fields := []NotificationField{
+		{Name: "Plan", Value: planID},
+		{Name: "Revision", Value: revID},
+		{Name: "Date", Value: plan.Details.Date},
+		{Name: "Reviewer", Value: review.Reviewer},
+	}
+	if comment != "" {
+		fields = append(fields, NotificationField{Name: "Comment", Value: comment})
+	}
+	rt.notify(&Notification{
+		Event:    EventPlanReviewed,
+		Title:    title,
+		Subject:  rt.projectNames(ctx, plan.Details.Projects),
+		Fields:   fields,
+		Projects: plan.Details.Projects,
+		Data:     map[string]interface{}{"planId": planID, "revisionId": revID, "decision": decision, "reviewer": review.Reviewer, "comment": comment},
+		Key:      EventPlanReviewed + "/" + planID + "/" + revID + "/" + strconv.Itoa(len(review.Comments)),
+	})
+	rt.maturityChanged(ctx, planID, revID, before, NotificationField{Name: "Approved by", Value: review.Reviewer})
+}
+
+// NewReviewPlanRevisionHandler creates a handler
+func NewReviewPlanRevisionHandler(rt *Runtime) operations.ReviewPlanRevisionHandler {
+	return &reviewPlanRevisionHandlerImp{rt: rt}
+}
+
+type reviewPlanRevisionHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *reviewPlanRevisionHandlerImp) Handle(params operations.ReviewPlanRevisionParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ReviewPlanRevisionDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	logger := log.WithContext(ctx)
+	decision := params.Body.Decision
+
+	versions, err := h.rt.Store.GetPlanVersions(ctx, params.ID)
+	if err != nil {
+		logger.WithFields(log.Fields{"plan": params.ID, "error": err}).Error("ReviewPlanRevision Handler: error retrieving plan versions")
+		return fail(500, "error retrieving plan")
+	}
+	var version, newer *models.RevisionVersion
+	for _, v := range versions {
+		if *v.RevID == params.RevID {
+			version = v
+		} else if version != nil && v.Review != nil && newer == nil {
+			newer = v
+		}
+	}
+	if version == nil {
+		return fail(404, "couldn't find revision "+params.RevID+" of plan "+params.ID)
+	}
+	rev, found, err := h.rt.Store.GetPlanRevision(ctx, params.ID, params.RevID)
+	if err != nil || !found || rev == nil {
+		return fail(500, "error retrieving plan revision")
+	}
+
+	if decision != "" {
+		if !h.rt.Allowed(principal, ReviewPermission) {
+			return fail(403, forbidden(ReviewPermission))
+		}
+		if version.Version != nil && version.Version.Author != nil && version.Version.Author.UID != nil && *version.Version.Author.UID == principal.UID {
+			return fail(403, "reviewers can't review their own revisions")
+		}
+		if newer != nil {
+			return fail(409, "revision "+params.RevID+" has been replaced by revision "+*newer.RevID+", which should be reviewed instead")
+		}
+	} else if !h.rt.Allowed(principal, ReviewPermission) {
+		if code, msg := h.rt.checkContributor(ctx, principal, rev.Details.Projects); code != 0 {
+			return fail(code, msg)
+		}
+	}
+	if version.Review == nil {
+		return fail(409, "revision "+params.RevID+" hasn't been submitted for review")
+	}
+
+	var maturity map[string]map[string]int
+	if decision == models.ReviewCommentDecisionApproved {
+		maturity = h.rt.currentMaturity(ctx, rev.Details.Projects)
+	}
+	var before models.PlanReview
+	code, msg := 0, ""
+	review, err := h.rt.Store.UpdatePlanReview(ctx, params.ID, params.RevID, func(review *models.PlanReview) error {
+		if review == nil {
+			code, msg = 409, "revision "+params.RevID+" hasn't been submitted for review"
+			return errors.New(msg)
+		}
+		before = *review
+		before.Comments = append([]*models.ReviewComment{}, review.Comments...)
+		if err := applyReview(review, principal, decision, params.Body.Comment, time.Now().UTC()); err != nil {
+			code, msg = 400, err.Error()
+			return err
+		}
+		return nil
+	})
+	if code != 0 {
+		return fail(code, msg)
+	}
+	if err != nil {
+		logger.WithFields(log.Fields{"plan": params.ID, "revision": params.RevID, "error": err}).Error("ReviewPlanRevision Handler: error saving review")
+		return fail(500, "error saving the review")
+	}
+	h.rt.audit(params.HTTPRequest, principal, "plan.review", params.ID+"/"+params.RevID, &before, review)
+	if decision != "" {
+		h.rt.planReviewed(ctx, params.ID, params.RevID, rev, review, maturity)
+	}
+	return &operations.ReviewPlanRevisionOK{Payload: review}
+}
+
+// NewListPlanReviewsHandler creates a handler
+func NewListPlanReviewsHandler(rt *Runtime) operations.ListPlanReviewsHandler {
+	return &listPlanReviewsHandlerImp{rt: rt}
+}
+
+type listPlanReviewsHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listPlanReviewsHandlerImp) Handle(params operations.ListPlanReviewsParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListPlanReviewsDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+
+	ctx := params.HTTPRequest.Context()
+	state := models.PlanReviewStateSubmitted
+	if params.State != nil {
+		state = *params.State
+	}
+	projects, err := h.rt.Store.ListProjects(ctx)
+	if err != nil {
+		return fail(500, "error retrieving projects")
+	}
+	queue, err := reviewQueue(ctx, h.rt, projects, state)
+	if err != nil {
+		log.WithContext(ctx).WithField("error", err).Error("ListPlanReviews Handler: error retrieving plan reviews")
+		return fail(500, "error retrieving plans")
+	}
+	return &operations.ListPlanReviewsOK{Payload: queue}
+}
@@ -0,0 +1,157 @@
+package api
+
+import (
+	"context"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+func review(state string) *models.PlanReview {
+	return &models.PlanReview{State: &state, Comments: []*models.ReviewComment{}}
+}
+
+func TestApplyReview(t *testing.T) {
+	r := review(models.PlanReviewStateSubmitted)
+	reviewer := &models.User{UID: "r", Name: "Reviewer"}
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+
+	if err := applyReview(r, reviewer, "", " ", now); err == nil {
+		t.Error("an empty comment without a decision was accepted")
+	}
+	if err := applyReview(r, &models.User{UID: "c", Name: "Contributor"}, "", "Why is MFA N/A?", now); err != nil {
+		t.Fatal(err)
+	}
+	if *r.State != models.PlanReviewStateSubmitted || r.Reviewer != "" {
+		t.Errorf("a comment changed the review's state: %+v", r)
+	}
+	if err := applyReview(r, reviewer, models.PlanReviewStateChangesDashRequested, "", now.Add(time.Hour)); err != nil {
+		t.Fatal(err)
+	}
+	if *r.State != models.PlanReviewStateChangesDashRequested || r.ReviewerUID != "r" || time.Time(r.Reviewed) != now.Add(time.Hour) {
+		t.Errorf("the decision wasn't recorded: %+v", r)
+	}
+	if len(r.Comments) != 2 || *r.Comments[0].AuthorUID != "c" || r.Comments[1].Decision != models.ReviewCommentDecisionChangesDashRequested {
+		t.Errorf("unexpected comments: %+v", r.Comments)
+	}
+}
+
+// reviewedPlan returns a committed plan for the project at the maturity level for the "auth" practice
+func reviewedPlan(project string, date string, level int) *lib.Plan {
+	return &lib.Plan{Details: lib.PlanDetails{Projects: []string{project}, Date: date, Committed: true, Maturity: map[string]int{"auth": level}}}
+}
+
+// reviewTestStore holds alpha's plan, whose approved revision has been followed by one awaiting review,
+// and beta's, committed before reviews were enabled
+func reviewTestStore() *memStore {
+	day := func(d int) time.Time { return time.Date(2021, 1, d, 0, 0, 0, 0, time.UTC) }
+	st := newMemStore()
+	st.projects = []*models.Project{
+		testProject("alpha", "", nil, "plan-a"),
+		testProject("beta", "", nil, "plan-b"),
+	}
+	st.plans = map[string][]lib.ChainedRevision{
+		"plan-a": {
+			{ID: "a1", AuthorUID: "author", Time: day(1), Plan: reviewedPlan("alpha", "2021-01-01", 1)},
+			{ID: "a2", AuthorUID: "author", Time: day(2), Plan: reviewedPlan("alpha", "2021-02-01", 2)},
+		},
+		"plan-b": {{ID: "b1", AuthorUID: "author", Time: day(1), Plan: reviewedPlan("beta", "2021-01-01", 1)}},
+	}
+	st.reviews["a1"] = review(models.PlanReviewStateApproved)
+	st.reviews["a2"] = review(models.PlanReviewStateSubmitted)
+	return st
+}
+
+func TestApprovedOnlyMaturity(t *testing.T) {
+	st := reviewTestStore()
+	rt := &Runtime{Store: st}
+	ctx := context.Background()
+
+	levels, err := projectMaturity(ctx, rt, st.projects, true)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if levels["alpha"]["auth"] != 1 || levels["beta"]["auth"] != 1 {
+		t.Errorf("expected the approved and unreviewed revisions to count, got %v", levels)
+	}
+	if levels, _ = projectMaturity(ctx, rt, st.projects, false); levels["alpha"]["auth"] != 2 {
+		t.Errorf("expected the latest committed revision to count without approval, got %v", levels)
+	}
+
+	st.reviews["a1"] = review(models.PlanReviewStateChangesDashRequested)
+	if levels, _ = projectMaturity(ctx, rt, st.projects, true); levels["alpha"] != nil {
+		t.Errorf("a project without an approved revision had maturity %v", levels["alpha"])
+	}
+}
+
+func TestReviewQueue(t *testing.T) {
+	st := reviewTestStore()
+	rt := &Runtime{Store: st}
+	ctx := context.Background()
+
+	queue, err := reviewQueue(ctx, rt, st.projects, models.PlanReviewStateSubmitted)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(queue) != 1 || *queue[0].PlanID != "plan-a" || *queue[0].RevisionID != "a2" || *queue[0].Projects != "alpha" || queue[0].Author != "author" {
+		t.Errorf("unexpected review queue: %+v", queue)
+	}
+	// an earlier revision's approval doesn't put the plan in the approved queue once a later one is submitted
+	if queue, _ = reviewQueue(ctx, rt, st.projects, models.PlanReviewStateApproved); len(queue) != 0 {
+		t.Errorf("unexpected approved plans: %+v", queue)
+	}
+}
+
+func TestReviewPlanRevision(t *testing.T) {
+	st := reviewTestStore()
+	st.plans["plan-a"][1].Plan.Details.Maturity["auth"] = 0 // approving the revision lowers alpha's maturity
+	router, err := NewNotificationRouter([]Notifier{&recordingNotifier{name: "slack"}}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &Runtime{Store: st, Notifications: router, notifyWake: make(chan struct{}, 1), PlanReviews: true}
+	h := NewReviewPlanRevisionHandler(rt)
+	reviewer := &models.User{UID: "reviewer", Name: "Reviewer", Roles: models.Roles{models.RoleSecurityReviewer}}
+
+	decide := func(u *models.User, revID string, decision string) int {
+		params := operations.ReviewPlanRevisionParams{ID: "plan-a", RevID: revID, Body: operations.ReviewPlanRevisionBody{Decision: decision},
+			HTTPRequest: httptest.NewRequest(http.MethodPost, "/v1alpha1/plan/plan-a/revision/"+revID+"/review", nil)}
+		rec := httptest.NewRecorder()
+		h.Handle(params, u).WriteResponse(rec, runtime.JSONProducer())
+		return rec.Code
+	}
+
+	if code := decide(reviewer, "a1", models.ReviewCommentDecisionChangesDashRequested); code != 409 {
+		t.Errorf("a decision was made on a revision that's been replaced: %v", code)
+	}
+	if code := decide(&models.User{UID: "author", Roles: models.Roles{models.RoleSecurityReviewer}}, "a2", models.ReviewCommentDecisionApproved); code != 403 {
+		t.Errorf("the author approved their own revision: %v", code)
+	}
+	if code := decide(reviewer, "a2", models.ReviewCommentDecisionApproved); code != 200 {
+		t.Fatalf("the revision wasn't approved: %v", code)
+	}
+	if r := st.reviews["a2"]; *r.State != models.PlanReviewStateApproved || len(r.Comments) != 1 {
+		t.Errorf("the approval wasn't saved: %+v", r)
+	}
+
+	events := map[string]int{}
+	for _, m := range st.messages {
+		events[m.Event]++
+	}
+	if events[EventPlanReviewed] != 1 || events[EventMaturityDecreased] != 1 {
+		t.Errorf("expected review and maturity decrease notifications, got %v", events)
+	}
+	for _, m := range st.messages {
+		if m.Event == EventMaturityDecreased && !strings.Contains(m.Payload, "Approved by") {
+			t.Errorf("the maturity decrease didn't say who approved it: %v", m.Payload)
+		}
+	}
+}
@@ -0,0 +1,296 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// EventRiskAcceptanceExpired is sent to a project's owners, the acceptance's approver and the project's subscribers
+// when a task's risk acceptance in its latest committed plan has expired and should be reviewed
+const EventRiskAcceptanceExpired = "project.risk-acceptance-expired"
+
+// planAcceptedRisks returns the risk acceptances in one of a project's plans; they have expired if they expire before today
+func planAcceptedRisks(p *models.Project, plan *lib.Plan, names *practiceNames, today string) []*models.AcceptedRisk {
+	risks := []*models.AcceptedRisk{}
+	version := plan.Responses.PracticesVersion
+	for practiceID, pr := range plan.Responses.PracticeResponses {
+		for taskID, t := range pr.Tasks {
+			a := t.RiskAcceptance
+			if a == nil {
+				continue
+			}
+			projectID, projectName, pID, tID, approver, expires := p.ID, *p.Attributes.Name, practiceID, taskID, a.Approver, a.Expires
+			result, err := plan.Responses.TaskResult(practiceID, taskID)
+			risks = append(risks, &models.AcceptedRisk{
+				ProjectID:     &projectID,
+				Project:       &projectName,
+				PracticeID:    &pID,
+				Practice:      names.practice(practiceID),
+				TaskID:        &tID,
+				Title:         names.task(practiceID, taskID),
+				Justification: a.Justification,
+				Approver:      &approver,
+				Approved:      a.Approved(),
+				Expires:       &expires,
+				Expired:       a.Expired(today),
+				Counted: err == nil && result == lib.No && a.Approved() && plan.Details.Date != "" && !a.Expired(plan.Details.Date) &&
+					names.policy(version, practiceID) == lib.RiskAcceptanceCounted,
+			})
+		}
+	}
+	return risks
+}
+
+// sortAcceptedRisks orders risk acceptances soonest expiry first
+func sortAcceptedRisks(risks []*models.AcceptedRisk) {
+	sort.Slice(risks, func(i, j int) bool {
+		a, b := risks[i], risks[j]
+		if *a.Expires != *b.Expires {
+			return *a.Expires < *b.Expires
+		}
+		if *a.Project != *b.Project {
+			return *a.Project < *b.Project
+		}
+		if *a.PracticeID != *b.PracticeID {
+			return *a.PracticeID < *b.PracticeID
+		}
+		return *a.TaskID < *b.TaskID
+	})
+}
+
+// acceptedRisks returns the risk acceptances in the projects' latest committed plans, soonest expiry first
+func (rt *Runtime) acceptedRisks(ctx context.Context, projects []*models.Project, today string) ([]*models.AcceptedRisk, error) {
+	latest, err := latestCommittedPlans(ctx, rt, projects, rt.PlanReviews)
+	if err != nil {
+		return nil, err
+	}
+	names := newPracticeNames(rt)
+	risks := []*models.AcceptedRisk{}
+	for _, p := range projects {
+		plan, ok := latest[p.ID]
+		if !ok {
+			continue
+		}
+		names.load(ctx, plan.Responses.PracticesVersion)
+		risks = append(risks, planAcceptedRisks(p, plan, names, today)...)
+	}
+	sortAcceptedRisks(risks)
+	return risks, nil
+}
+
+// riskReminderKey identifies the reminders sent about a task's risk acceptance, alongside the projects' overdue reminders
+func riskReminderKey(r *models.AcceptedRisk) string {
+	return "risk:" + *r.ProjectID + ":" + *r.PracticeID + ":" + *r.TaskID
+}
+
+// SendRiskAcceptanceReminders notifies the owners of projects with expired risk acceptances, and their approvers, unless
+// they were reminded less than the repeat interval ago and the acceptance hasn't been renewed since.
+// It returns the number of acceptances reminded about.
+func SendRiskAcceptanceReminders(ctx context.Context, rt *Runtime, now time.Time) (int, error) {
+	projects, err := rt.Store.ListProjects(ctx)
+	if err != nil {
+		return 0, err
+	}
+	today := now.Format("2006-01-02")
+	risks, err := rt.acceptedRisks(ctx, projects, today)
+	if err != nil {
+		return 0, err
+	}
+	reminded, err := rt.Store.ListReminders(ctx)
+	if err != nil {
+		return 0, err
+	}
+	owners := map[string][]string{}
+	for _, p := range projects {
+		for _, m := range p.Members {
+			if m.Role != nil && *m.Role == models.ProjectMemberRoleOwner && m.Email != "" {
+				owners[p.ID] = append(owners[p.ID], m.Email)
+			}
+		}
+	}
+
+	sent := 0
+	for _, r := range risks {
+		if !r.Expired {
+			continue
+		}
+		key := riskReminderKey(r)
+		last := reminded[key]
+		if !last.IsZero() && now.Sub(last) < rt.Reminders.Repeat && last.Format("2006-01-02") > *r.Expires {
+			continue
+		}
+		personal := append([]string{}, owners[*r.ProjectID]...)
+		approver := "awaiting approval"
+		if r.Approved {
+			approver = *r.Approver
+			if strings.Contains(approver, "@") {
+				personal = append(personal, approver)
+			}
+		}
+		rt.notify(&Notification{
+			Event:   EventRiskAcceptanceExpired,
+			Title:   "A risk acceptance has expired",
+			Subject: *r.Project + ": " + r.Title,
+			Fields: []NotificationField{
+				{Name: "Practice", Value: r.Practice},
+				{Name: "Justification", Value: r.Justification},
+				{Name: "Approver", Value: approver},
+				{Name: "Expired", Value: *r.Expires},
+			},
+			Projects: []string{*r.ProjectID},
+			Personal: personal,
+			Data:     r,
+			Key:      EventRiskAcceptanceExpired + "/" + *r.ProjectID + "/" + *r.PracticeID + "/" + *r.TaskID + "/" + today,
+		})
+		if err = rt.Store.RecordReminder(ctx, key, now); err != nil {
+			return sent, err
+		}
+		sent++
+	}
+	return sent, nil
+}
+
+// NewListRiskAcceptancesHandler creates a handler
+func NewListRiskAcceptancesHandler(rt *Runtime) operations.ListRiskAcceptancesHandler {
+	return &listRiskAcceptancesHandlerImp{rt: rt}
+}
+
+type listRiskAcceptancesHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listRiskAcceptancesHandlerImp) Handle(params operations.ListRiskAcceptancesParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListRiskAcceptancesDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	ctx := params.HTTPRequest.Context()
+	var projects []*models.Project
+	if params.Project != nil {
+		p, found, err := h.rt.Store.GetProject(ctx, *params.Project)
+		if err != nil {
+			return fail(500, "error retrieving project")
+		}
+		if !found {
+			return fail(404, "project "+*params.Project+" doesn't exist")
+		}
+		projects = []*models.Project{p}
+	} else {
+		var err error
+		if projects, err = h.rt.Store.ListProjects(ctx); err != nil {
+			return fail(500, "error retrieving projects")
+		}
+	}
+
+	risks, err := h.rt.acceptedRisks(ctx, projects, time.Now().UTC().Format("2006-01-02"))
+	if err != nil {
+		return fail(500, "error retrieving plans")
+	}
+	selected := []*models.AcceptedRisk{}
+	for _, r := range risks {
+		if params.Expired == nil || r.Expired == *params.Expired {
+			selected = append(selected, r)
+		}
+	}
+	return &operations.ListRiskAcceptancesOK{Payload: selected}
+}
+
+// NewApproveRiskAcceptanceHandler creates a handler
+func NewApproveRiskAcceptanceHandler(rt *Runtime) operations.ApproveRiskAcceptanceHandler {
+	return &approveRiskAcceptanceHandlerImp{rt: rt}
+}
+
+type approveRiskAcceptanceHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *approveRiskAcceptanceHandlerImp) Handle(params operations.ApproveRiskAcceptanceParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ApproveRiskAcceptanceDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReviewPermission) && !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(ReviewPermission))
+	}
+
+	ctx := params.HTTPRequest.Context()
+	revs, _, found, err := h.rt.Store.GetPlanRevisionChain(ctx, params.ID)
+	if err != nil {
+		return fail(500, "error retrieving plan")
+	}
+	if !found || len(revs) == 0 {
+		return fail(404, "plan not found")
+	}
+	latest := revs[len(revs)-1].Plan
+	a := latest.Responses.PracticeResponses[params.PracticeID].Tasks[params.TaskID].RiskAcceptance
+	if a == nil {
+		return fail(404, fmt.Sprintf("task %v.%v doesn't have a risk acceptance in the plan's latest revision", params.PracticeID, params.TaskID))
+	}
+	if a.Approved() {
+		return fail(409, "the risk acceptance has already been approved by "+a.Approver)
+	}
+	// Anyone who wrote a revision with the acceptance's current terms may have written them
+	for i := len(revs) - 1; i >= 0; i-- {
+		if !revs[i].Plan.Responses.PracticeResponses[params.PracticeID].Tasks[params.TaskID].RiskAcceptance.SameTerms(a) {
+			break
+		}
+		if revs[i].AuthorUID == principal.UID {
+			return fail(403, "approvers can't approve risk acceptances they wrote")
+		}
+	}
+
+	approved := *a
+	approved.ApproverUID = principal.UID
+	approved.Approver = principal.Email
+	if approved.Approver == "" {
+		approved.Approver = principal.Name
+	}
+	pr := latest.Responses.PracticeResponses[params.PracticeID]
+	t := pr.Tasks[params.TaskID]
+	t.RiskAcceptance = &approved
+	pr.Tasks[params.TaskID] = t
+
+	// An approved acceptance can count towards the practice's level, so the maturity is recalculated
+	practices, err := h.rt.GetPractices(ctx, latest.Responses.PracticesVersion)
+	if err != nil {
+		return fail(500, "error retrieving practices")
+	}
+	project, err := projectContext(ctx, h.rt, latest.Details.Projects)
+	if err != nil {
+		return fail(500, "error retrieving the plan's projects")
+	}
+	plan := lib.NewPlan(latest.Details, latest.Responses, practices, project)
+	// The approver is a reviewer, and nothing else changes, so the revision doesn't need reviewing again
+	review, err := h.rt.carriedReview(ctx, params.ID, revs[len(revs)-1].ID)
+	if err != nil {
+		return fail(500, "error retrieving the plan's review")
+	}
+
+	var before map[string]map[string]int
+	if plan.Details.Committed {
+		before = h.rt.currentMaturity(ctx, plan.Details.Projects)
+	}
+	revID, err := h.rt.Store.CreatePlanRevision(ctx, params.ID, &plan, principal, review)
+	if err != nil {
+		log.WithContext(ctx).WithFields(log.Fields{"plan": params.ID, "error": err}).Error("ApproveRiskAcceptance Handler: error saving approval")
+		return fail(500, "error saving the approval")
+	}
+	h.rt.audit(params.HTTPRequest, principal, "risk.approve", params.ID+"/"+params.PracticeID+"/"+params.TaskID, a, &approved)
+	h.rt.planRevised(ctx, principal, params.ID, revID, &plan, before)
+	return &operations.ApproveRiskAcceptanceCreated{Payload: revID}
+}
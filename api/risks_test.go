@@ -0,0 +1,230 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/go-openapi/runtime"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// riskPlan returns a committed plan for the project that accepts the risk of not doing the "auth" practice's mfa task
+func riskPlan(project string, date string, expires string) *lib.Plan {
+	return &lib.Plan{
+		Details: lib.PlanDetails{Projects: []string{project}, Date: date, Committed: true},
+		Responses: lib.PlanResponses{PracticesVersion: "1", PracticeResponses: map[string]lib.PracticeResponse{"auth": {Tasks: map[string]lib.TaskResponse{
+			"mfa": {
+				Answers:        map[string]lib.Answer{"mfa": {Answer: lib.No}},
+				RiskAcceptance: &lib.RiskAcceptance{Justification: "internal only", Approver: "ciso@example.com", ApproverUID: "u-ciso", Expires: expires},
+			},
+			"sso": {Answers: map[string]lib.Answer{"sso": {Answer: lib.Yes}}},
+		}}}},
+	}
+}
+
+// riskStore holds two projects whose committed plans accept the risk of not enforcing MFA; beta's acceptance has expired
+func riskStore() *memStore {
+	st := newMemStore()
+	st.projects = []*models.Project{
+		testProject("alpha", "", nil, "plan-a"),
+		testProject("beta", "", nil, "plan-b"),
+	}
+	st.plans = map[string][]lib.ChainedRevision{
+		"plan-a": {{ID: "a1", Plan: riskPlan("alpha", "2021-01-10", "2021-12-31")}},
+		"plan-b": {
+			{ID: "b1", Plan: riskPlan("beta", "2021-01-10", "2021-03-31")},
+			{ID: "b2", Plan: &lib.Plan{Details: lib.PlanDetails{Date: "2021-05-01"}}}, // a draft that hasn't been committed
+		},
+	}
+	st.practices = []lib.Practice{{ID: "auth", Name: "Authentication", RiskAcceptance: lib.RiskAcceptanceCounted,
+		Tasks: []lib.Task{
+			{ID: "mfa", Title: "Enforce MFA", Level: 1, Questions: []lib.Question{{ID: "mfa"}}},
+			{ID: "sso", Title: "Use SSO", Level: 2, Questions: []lib.Question{{ID: "sso"}}},
+		}}}
+	return st
+}
+
+func TestAcceptedRisks(t *testing.T) {
+	st := riskStore()
+	rt := &Runtime{Store: st, practicesCache: map[string]practiceCache{}}
+
+	risks, err := rt.acceptedRisks(context.Background(), st.projects, "2021-06-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if len(risks) != 2 {
+		t.Fatalf("expected an acceptance for each project, got %v", len(risks))
+	}
+	beta, alpha := risks[0], risks[1]
+	if *beta.ProjectID != "beta" || !beta.Expired || !beta.Counted || beta.Title != "Enforce MFA" || beta.Practice != "Authentication" {
+		t.Errorf("unexpected expired acceptance: %+v", beta)
+	}
+	if *alpha.ProjectID != "alpha" || alpha.Expired || !alpha.Approved || *alpha.Approver != "ciso@example.com" {
+		t.Errorf("unexpected current acceptance: %+v", alpha)
+	}
+
+	st.plans["plan-a"][0].Plan.Responses.PracticeResponses["auth"].Tasks["mfa"].RiskAcceptance.ApproverUID = ""
+	if risks, err = rt.acceptedRisks(context.Background(), st.projects, "2021-06-01"); err != nil || risks[1].Approved || risks[1].Counted {
+		t.Errorf("an acceptance that hasn't been approved counted (%v)", err)
+	}
+
+	st.practices[0].RiskAcceptance = ""
+	rt.practicesCache = map[string]practiceCache{}
+	if risks, err = rt.acceptedRisks(context.Background(), st.projects, "2021-06-01"); err != nil || risks[0].Counted {
+		t.Errorf("an acceptance counted toward a practice that ignores them (%v)", err)
+	}
+}
+
+func TestSendRiskAcceptanceReminders(t *testing.T) {
+	st := riskStore()
+	router, err := NewNotificationRouter([]Notifier{&recordingNotifier{name: "slack"}}, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	rt := &Runtime{Store: st, Notifications: router, notifyWake: make(chan struct{}, 1), practicesCache: map[string]practiceCache{},
+		Reminders: ReminderConfig{Repeat: 7 * 24 * time.Hour}}
+	now := time.Date(2021, 6, 1, 12, 0, 0, 0, time.UTC)
+	ctx := context.Background()
+
+	for i, c := range []struct {
+		at   time.Time
+		sent int
+	}{
+		{now, 1},
+		{now.Add(24 * time.Hour), 0}, // reminded recently
+		{now.Add(8 * 24 * time.Hour), 1},
+	} {
+		sent, err := SendRiskAcceptanceReminders(ctx, rt, c.at)
+		if err != nil {
+			t.Fatal(err)
+		}
+		if sent != c.sent {
+			t.Errorf("%v: sent %v reminders, want %v", i, sent, c.sent)
+		}
+	}
+	if len(st.messages) != 2 {
+		t.Errorf("expected two queued notifications, got %v", st.messages)
+	}
+	if _, ok := st.reminders["beta"]; ok {
+		t.Error("a risk acceptance reminder was recorded as an overdue plan reminder")
+	}
+
+	// An acceptance that's renewed and expires again is reminded about straight away
+	st.plans["plan-b"][0].Plan.Responses.PracticeResponses["auth"].Tasks["mfa"].RiskAcceptance.Expires = "2021-06-10"
+	if sent, _ := SendRiskAcceptanceReminders(ctx, rt, now.Add(10*24*time.Hour)); sent != 1 {
+		t.Errorf("wasn't reminded about a renewed acceptance that expired")
+	}
+}
+
+func TestApproveRiskAcceptance(t *testing.T) {
+	st := riskStore()
+	pending := riskPlan("alpha", "2021-01-10", "2021-12-31")
+	a := pending.Responses.PracticeResponses["auth"].Tasks["mfa"].RiskAcceptance
+	a.Approver, a.ApproverUID = "", ""
+	st.plans["plan-a"] = []lib.ChainedRevision{
+		{ID: "a1", AuthorUID: "u-alice", Plan: pending},
+		{ID: "a2", AuthorUID: "u-carol", Plan: pending}, // another author's revision, keeping alice's acceptance
+	}
+	st.reviews["a2"] = review(models.PlanReviewStateApproved)
+	rt := &Runtime{Store: st, PlanReviews: true, practicesCache: map[string]practiceCache{}}
+	h := NewApproveRiskAcceptanceHandler(rt)
+
+	approve := func(u *models.User, taskID string) (int, string) {
+		params := operations.ApproveRiskAcceptanceParams{ID: "plan-a", PracticeID: "auth", TaskID: taskID,
+			HTTPRequest: httptest.NewRequest(http.MethodPost, "/v1alpha1/plan/plan-a/practice/auth/task/"+taskID+"/risk-acceptance/approval", nil)}
+		rec := httptest.NewRecorder()
+		h.Handle(params, u).WriteResponse(rec, runtime.JSONProducer())
+		var body string
+		if rec.Code == http.StatusCreated {
+			json.Unmarshal(rec.Body.Bytes(), &body)
+		}
+		return rec.Code, body
+	}
+	reviewer := func(uid string) *models.User {
+		return &models.User{UID: uid, Name: uid, Email: uid + "@example.com", Roles: models.Roles{models.RoleSecurityReviewer}}
+	}
+
+	if code, _ := approve(&models.User{UID: "u-dave", Roles: models.Roles{models.RoleProjectOwner}}, "mfa"); code != 403 {
+		t.Errorf("a user without the review permission approved the acceptance: %v", code)
+	}
+	if code, _ := approve(reviewer("u-alice"), "mfa"); code != 403 {
+		t.Errorf("the acceptance's author approved it: %v", code)
+	}
+	if code, _ := approve(reviewer("u-bob"), "sso"); code != 404 {
+		t.Errorf("a task without an acceptance was approved: %v", code)
+	}
+	if code, revID := approve(reviewer("u-bob"), "mfa"); code != 201 || revID != "r3" {
+		t.Fatalf("the acceptance wasn't approved: %v %v", code, revID)
+	}
+	approved := st.plans["plan-a"][2]
+	if a := approved.Plan.Responses.PracticeResponses["auth"].Tasks["mfa"].RiskAcceptance; approved.AuthorUID != "u-bob" ||
+		a.ApproverUID != "u-bob" || a.Approver != "u-bob@example.com" {
+		t.Errorf("the approval wasn't recorded in a new revision: %+v %+v", approved, a)
+	}
+	if level := approved.Plan.Details.Maturity["auth"]; level != 2 {
+		t.Errorf("the approved acceptance didn't count towards the practice's level: %v", level)
+	}
+	if r := st.reviews["r3"]; r == nil || *r.State != models.PlanReviewStateApproved {
+		t.Errorf("the approval's revision didn't keep the plan's approval: %+v", r)
+	}
+	if a.Approved() {
+		t.Error("the earlier revision's acceptance was changed")
+	}
+	if code, _ := approve(reviewer("u-erin"), "mfa"); code != 409 {
+		t.Errorf("an approved acceptance was approved again: %v", code)
+	}
+	if len(st.audit) != 1 || st.audit[0].Action != "risk.approve" {
+		t.Errorf("the approval wasn't audited: %+v", st.audit)
+	}
+}
+
+func TestSelfApprovedRiskAcceptance(t *testing.T) {
+	st := riskStore()
+	st.plans["plan-a"][0].Plan.Responses.PracticeResponses["auth"].Tasks["mfa"].RiskAcceptance.ApproverUID = ""
+	rt := &Runtime{Store: st, practicesCache: map[string]practiceCache{}}
+	owner := &models.User{UID: "u-alpha", Roles: models.Roles{models.RoleProjectOwner}}
+	req := httptest.NewRequest(http.MethodPost, "/v1alpha1/plan", nil)
+	maturity := func(planID string) int {
+		revs := st.plans[planID]
+		return revs[len(revs)-1].Plan.Details.Maturity["auth"]
+	}
+
+	// riskPlan's acceptance has an approver, as if the author had set it themselves
+	plan := riskPlan("alpha", "2021-06-01", "2021-12-31")
+	created, ok := NewCreatePlanHandler(rt).Handle(operations.CreatePlanParams{HTTPRequest: req,
+		Body: operations.CreatePlanBody{Details: &plan.Details, Responses: &plan.Responses}}, owner).(*operations.CreatePlanCreated)
+	if !ok {
+		t.Fatal("the plan wasn't created")
+	}
+	if level := maturity(*created.Payload.PlanID); level != 0 {
+		t.Errorf("a new plan's self-approved acceptance counted towards its level: %v", level)
+	}
+
+	revise := func() {
+		plan := riskPlan("alpha", "2021-06-01", "2021-12-31")
+		params := operations.CreatePlanRevisionParams{HTTPRequest: req, ID: "plan-a",
+			Body: operations.CreatePlanRevisionBody{Details: &plan.Details, Responses: &plan.Responses}}
+		if _, ok := NewCreatePlanRevisionHandler(rt).Handle(params, owner).(*operations.CreatePlanRevisionOK); !ok {
+			t.Fatal("the revision wasn't created")
+		}
+	}
+	revise()
+	if level := maturity("plan-a"); level != 0 {
+		t.Errorf("a self-approved acceptance counted towards the revision's level: %v", level)
+	}
+
+	// An approval carried from the previous revision counts
+	revs := st.plans["plan-a"]
+	revs[len(revs)-1].Plan.Responses.PracticeResponses["auth"].Tasks["mfa"].RiskAcceptance.ApproverUID = "u-ciso"
+	revise()
+	if level := maturity("plan-a"); level != 2 {
+		t.Errorf("an approved acceptance didn't count towards the revision's level: %v", level)
+	}
+}
@@ -0,0 +1,186 @@
+package api
+
+import (
+	"fmt"
+	"sort"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+)
+
+// Permission is an action, or class of actions, that a role may allow
+type Permission string
+
+// The permissions checked by the API handlers
+const (
+	ReadPermission   Permission = "read"   // view projects, plans and practices
+	EditPermission   Permission = "edit"   // create and update projects and plans
+	DeletePermission Permission = "delete" // delete projects and plans
+	AdminPermission  Permission = "admin"  // manage users and their roles
+	AuditPermission  Permission = "audit"  // view audit information
+	ReviewPermission Permission = "review" // approve committed plans or request changes to them
+)
+
+// rolePermissions defines what each role allows
+var rolePermissions = map[models.Role][]Permission{ //nolint:gochecknoglobals // effectively a constant
+	models.RoleViewer:             {ReadPermission},
+	models.RoleProjectContributor: {ReadPermission, EditPermission},
+	models.RoleProjectOwner:       {ReadPermission, EditPermission, DeletePermission},
+	models.RoleSecurityAdmin:      {ReadPermission, EditPermission, DeletePermission, AdminPermission, AuditPermission, ReviewPermission},
+	models.RoleAuditor:            {ReadPermission, AuditPermission},
+	models.RoleSecurityReviewer:   {ReadPermission, ReviewPermission},
+}
+
+// ParseRoles converts role names into Roles, returning an error for any unknown names
+func ParseRoles(names []string) (models.Roles, error) {
+	roles := models.Roles{}
+	for _, name := range names {
+		role := models.Role(name)
+		if _, ok := rolePermissions[role]; !ok {
+			return nil, fmt.Errorf("unknown role '%v'", name)
+		}
+		roles = append(roles, role)
+	}
+	return roles, nil
+}
+
+// EffectiveRoles returns the user's explicitly granted roles, or if they don't have any, the roles granted by access rules or else the default roles
+func (rt *Runtime) EffectiveRoles(u *models.User) models.Roles {
+	if len(u.Roles) > 0 {
+		return u.Roles
+	}
+	if len(u.RuleRoles) > 0 {
+		return u.RuleRoles
+	}
+	return rt.DefaultRoles
+}
+
+// Permissions returns the set of permissions granted to the user by their roles, limited to the scopes of their API token if they used one
+func (rt *Runtime) Permissions(u *models.User) map[Permission]bool {
+	perms := map[Permission]bool{}
+	for _, role := range rt.EffectiveRoles(u) {
+		for _, p := range rolePermissions[role] {
+			perms[p] = true
+		}
+	}
+	if u.APITokenID != "" {
+		scoped := map[Permission]bool{}
+		for _, s := range u.TokenScopes {
+			if perms[Permission(s)] {
+				scoped[Permission(s)] = true
+			}
+		}
+		perms = scoped
+	}
+	return perms
+}
+
+// Allowed reports whether the user's roles grant them the permission
+func (rt *Runtime) Allowed(u *models.User, p Permission) bool {
+	return rt.Permissions(u)[p]
+}
+
+// forbidden is the message returned to users that lack a permission
+func forbidden(p Permission) string {
+	return fmt.Sprintf("you don't have permission to %v this", p)
+}
+
+// NewGetCurrentUserHandler creates a handler
+func NewGetCurrentUserHandler(rt *Runtime) operations.GetCurrentUserHandler {
+	return &getCurrentUserHandlerImp{rt: rt}
+}
+
+type getCurrentUserHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *getCurrentUserHandlerImp) Handle(params operations.GetCurrentUserParams, principal *models.User) middleware.Responder {
+	perms := []string{}
+	for p := range h.rt.Permissions(principal) {
+		perms = append(perms, string(p))
+	}
+	sort.Strings(perms)
+
+	uid := principal.UID
+	return &operations.GetCurrentUserOK{Payload: &models.CurrentUser{
+		UID:         &uid,
+		Name:        principal.Name,
+		Email:       principal.Email,
+		Roles:       h.rt.EffectiveRoles(principal),
+		Permissions: perms,
+	}}
+}
+
+// NewGetUserRolesHandler creates a handler
+func NewGetUserRolesHandler(rt *Runtime) operations.GetUserRolesHandler {
+	return &getUserRolesHandlerImp{rt: rt}
+}
+
+type getUserRolesHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *getUserRolesHandlerImp) Handle(params operations.GetUserRolesParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.GetUserRolesDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+
+	user := models.User{UID: params.UID}
+	if err := h.rt.Store.GetUserData(params.HTTPRequest.Context(), &user); err != nil {
+		return fail(500, "error retrieving user")
+	}
+	if user.LocalData == nil {
+		return fail(404, "user not found")
+	}
+	roles := user.Roles
+	if roles == nil {
+		roles = models.Roles{}
+	}
+	return &operations.GetUserRolesOK{Payload: roles}
+}
+
+// NewSetUserRolesHandler creates a handler
+func NewSetUserRolesHandler(rt *Runtime) operations.SetUserRolesHandler {
+	return &setUserRolesHandlerImp{rt: rt}
+}
+
+type setUserRolesHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *setUserRolesHandlerImp) Handle(params operations.SetUserRolesParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.SetUserRolesDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	logger := log.WithContext(ctx)
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+	if params.UID == principal.UID {
+		// Stop admins accidentally locking themselves out; another admin can do it for them
+		return fail(400, "you can't change your own roles")
+	}
+
+	before := models.User{UID: params.UID}
+	if err := h.rt.Store.GetUserData(ctx, &before); err != nil {
+		return fail(500, "error retrieving user")
+	}
+	if err := h.rt.Store.SetUserRoles(ctx, params.UID, params.Body); err != nil {
+		return fail(500, err.Error())
+	}
+	logger.WithFields(log.Fields{"user": params.UID, "roles": params.Body, "by": principal.UID}).Info("Updated user roles")
+	h.rt.audit(params.HTTPRequest, principal, "user.roles", params.UID, before.Roles, params.Body)
+	return &operations.SetUserRolesOK{}
+}
@@ -0,0 +1,46 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+func TestAllowed(t *testing.T) {
+	rt := &Runtime{DefaultRoles: models.Roles{models.RoleProjectContributor}}
+
+	cases := []struct {
+		roles models.Roles
+		perm  Permission
+		want  bool
+	}{
+		{nil, EditPermission, true}, // default role
+		{nil, DeletePermission, false},
+		{models.Roles{models.RoleViewer}, ReadPermission, true},
+		{models.Roles{models.RoleViewer}, EditPermission, false}, // explicit roles replace the defaults
+		{models.Roles{models.RoleProjectOwner}, DeletePermission, true},
+		{models.Roles{models.RoleAuditor}, AuditPermission, true},
+		{models.Roles{models.RoleAuditor}, AdminPermission, false},
+		{models.Roles{models.RoleViewer, models.RoleAuditor}, AuditPermission, true},
+		{models.Roles{models.RoleSecurityAdmin}, AdminPermission, true},
+		{models.Roles{models.RoleSecurityReviewer}, ReviewPermission, true},
+		{models.Roles{models.RoleSecurityReviewer}, EditPermission, false},
+	}
+
+	for _, c := range cases {
+		u := &models.User{UID: "u", Roles: c.roles}
+		if got := rt.Allowed(u, c.perm); got != c.want {
+			t.Errorf("Allowed(%v, %v) == %v, want %v", c.roles, c.perm, got, c.want)
+		}
+	}
+}
+
+func TestParseRoles(t *testing.T) {
+	if _, err := ParseRoles([]string{"viewer", "superuser"}); err == nil {
+		t.Error("Expected an error parsing an unknown role")
+	}
+	roles, err := ParseRoles([]string{"auditor"})
+	if err != nil || len(roles) != 1 || roles[0] != models.RoleAuditor {
+		t.Errorf("ParseRoles([auditor]) == %v, %v", roles, err)
+	}
+}
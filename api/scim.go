@@ -0,0 +1,976 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"regexp"
+	"sort"
+	"strconv"
+	"strings"
+
+	"firebase.google.com/go/v4/auth"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/store"
+)
+
+// SCIM 2.0 (RFC 7643 and 7644) lets an identity provider provision and deprovision users.
+// Users map onto the local data in the users collection, and groups map onto roles.
+
+const (
+	scimUserSchema          = "urn:ietf:params:scim:schemas:core:2.0:User"
+	scimGroupSchema         = "urn:ietf:params:scim:schemas:core:2.0:Group"
+	scimListResponseSchema  = "urn:ietf:params:scim:api:messages:2.0:ListResponse"
+	scimErrorSchema         = "urn:ietf:params:scim:api:messages:2.0:Error"
+	scimServiceConfigSchema = "urn:ietf:params:scim:schemas:core:2.0:ServiceProviderConfig"
+	scimResourceTypeSchema  = "urn:ietf:params:scim:schemas:core:2.0:ResourceType"
+	scimContentType         = "application/scim+json"
+
+	// provisionedUIDPrefix identifies users provisioned before they first signed in, whose UID isn't known yet.
+	// Their local data is moved to their real UID when they first sign in with a matching verified email address.
+	provisionedUIDPrefix = "scim-"
+
+	scimMaxResults = 200
+)
+
+type scimName struct {
+	Formatted  string `json:"formatted,omitempty"`
+	GivenName  string `json:"givenName,omitempty"`
+	FamilyName string `json:"familyName,omitempty"`
+}
+
+type scimEmail struct {
+	Value   string `json:"value"`
+	Type    string `json:"type,omitempty"`
+	Primary bool   `json:"primary,omitempty"`
+}
+
+type scimMember struct {
+	Value   string `json:"value"`
+	Display string `json:"display,omitempty"`
+}
+
+type scimMeta struct {
+	ResourceType string `json:"resourceType"`
+}
+
+type scimUser struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	ExternalID  string       `json:"externalId,omitempty"`
+	UserName    string       `json:"userName"`
+	Name        *scimName    `json:"name,omitempty"`
+	DisplayName string       `json:"displayName,omitempty"`
+	Emails      []scimEmail  `json:"emails,omitempty"`
+	Active      *bool        `json:"active,omitempty"` // absent means active
+	Groups      []scimMember `json:"groups,omitempty"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+type scimGroup struct {
+	Schemas     []string     `json:"schemas"`
+	ID          string       `json:"id,omitempty"`
+	DisplayName string       `json:"displayName"`
+	Members     []scimMember `json:"members"`
+	Meta        *scimMeta    `json:"meta,omitempty"`
+}
+
+type scimListResponse struct {
+	Schemas      []string      `json:"schemas"`
+	TotalResults int           `json:"totalResults"`
+	StartIndex   int           `json:"startIndex"`
+	ItemsPerPage int           `json:"itemsPerPage"`
+	Resources    []interface{} `json:"Resources"`
+}
+
+type scimPatch struct {
+	Operations []scimPatchOp `json:"Operations"`
+}
+
+type scimPatchOp struct {
+	Op    string          `json:"op"`
+	Path  string          `json:"path"`
+	Value json.RawMessage `json:"value"`
+}
+
+// scimError is returned by the operations on users and groups, to be sent to the client
+type scimError struct {
+	Schemas  []string `json:"schemas"`
+	Status   string   `json:"status"`
+	ScimType string   `json:"scimType,omitempty"`
+	Detail   string   `json:"detail"`
+
+	code int
+}
+
+func (e *scimError) Error() string { return e.Detail }
+
+func newSCIMError(code int, scimType string, format string, args ...interface{}) *scimError {
+	return &scimError{
+		Schemas:  []string{scimErrorSchema},
+		Status:   strconv.Itoa(code),
+		ScimType: scimType,
+		Detail:   fmt.Sprintf(format, args...),
+		code:     code,
+	}
+}
+
+var scimInternalError = newSCIMError(500, "", "internal error") //nolint:gochecknoglobals // effectively a constant
+
+// NewSCIMHandler returns a handler for the SCIM endpoints, to be served with its prefix stripped.
+// Clients authenticate with the provided bearer token.
+func NewSCIMHandler(rt *Runtime, token string) http.Handler {
+	return &scimHandler{rt: rt, tokenHash: sha256.Sum256([]byte(token))}
+}
+
+type scimHandler struct {
+	rt        *Runtime
+	tokenHash [sha256.Size]byte
+}
+
+func (h *scimHandler) authenticated(r *http.Request) bool {
+	header := r.Header.Get("Authorization")
+	if !strings.HasPrefix(header, "Bearer ") {
+		return false
+	}
+	presented := sha256.Sum256([]byte(header[7:]))
+	return subtle.ConstantTimeCompare(presented[:], h.tokenHash[:]) == 1
+}
+
+func (h *scimHandler) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	ctx := r.Context()
+	logger := log.WithContext(ctx).WithFields(log.Fields{"method": r.Method, "path": r.URL.Path})
+
+	if !h.authenticated(r) {
+		logger.Warn("SCIM: rejected request with missing or invalid token")
+		h.respond(w, 401, newSCIMError(401, "", "a valid bearer token is required"))
+		return
+	}
+
+	segments := strings.Split(strings.Trim(r.URL.Path, "/"), "/")
+	resource, id := segments[0], ""
+	if len(segments) == 2 {
+		id = segments[1]
+	} else if len(segments) > 2 {
+		h.respond(w, 404, newSCIMError(404, "", "unknown resource"))
+		return
+	}
+
+	var result interface{}
+	var err error
+	code := 200
+	switch {
+	case resource == "ServiceProviderConfig" && id == "" && r.Method == http.MethodGet:
+		result = scimServiceProviderConfig()
+	case resource == "ResourceTypes" && id == "" && r.Method == http.MethodGet:
+		result = scimResourceTypes()
+	case resource == "Users" && id == "" && r.Method == http.MethodGet:
+		result, err = h.listUsers(ctx, r)
+	case resource == "Users" && id == "" && r.Method == http.MethodPost:
+		code = 201
+		result, err = h.createUser(ctx, r)
+	case resource == "Users" && id != "" && r.Method == http.MethodGet:
+		result, err = h.getUser(ctx, id)
+	case resource == "Users" && id != "" && r.Method == http.MethodPut:
+		result, err = h.replaceUser(ctx, id, r)
+	case resource == "Users" && id != "" && r.Method == http.MethodPatch:
+		result, err = h.patchUser(ctx, id, r)
+	case resource == "Users" && id != "" && r.Method == http.MethodDelete:
+		code = 204
+		err = h.deleteUser(ctx, id)
+	case resource == "Groups" && id == "" && r.Method == http.MethodGet:
+		result, err = h.listGroups(ctx, r)
+	case resource == "Groups" && id == "" && r.Method == http.MethodPost:
+		code = 201
+		result, err = h.createGroup(ctx, r)
+	case resource == "Groups" && id != "" && r.Method == http.MethodGet:
+		result, err = h.getGroup(ctx, id)
+	case resource == "Groups" && id != "" && r.Method == http.MethodPut:
+		result, err = h.replaceGroup(ctx, id, r)
+	case resource == "Groups" && id != "" && r.Method == http.MethodPatch:
+		result, err = h.patchGroup(ctx, id, r)
+	case resource == "Groups" && id != "" && r.Method == http.MethodDelete:
+		code = 204
+		err = h.deleteGroup(ctx, id)
+	default:
+		err = newSCIMError(404, "", "unknown resource or unsupported method")
+	}
+
+	if err != nil {
+		se, ok := err.(*scimError)
+		if !ok {
+			logger.WithField("error", err).Error("SCIM request failed")
+			se = scimInternalError
+		}
+		h.respond(w, se.code, se)
+		return
+	}
+	if r.Method != http.MethodGet {
+		h.audit(r, resource, id, result)
+	}
+	h.respond(w, code, result)
+}
+
+// audit records a change made by the identity provider
+func (h *scimHandler) audit(r *http.Request, resource string, id string, result interface{}) {
+	action := "user.provision"
+	switch {
+	case resource == "Users" && r.Method == http.MethodDelete:
+		action = "user.deprovision"
+	case resource == "Users" && id != "":
+		action = "user.scim"
+	case resource == "Groups":
+		action = "role.scim"
+	}
+	switch res := result.(type) {
+	case *scimUser:
+		id = res.ID
+	case *scimGroup:
+		id = res.ID
+	}
+	RecordAudit(r.Context(), h.rt.Store, &store.AuditEvent{
+		Actor:     "scim",
+		ActorName: "SCIM provisioning",
+		Action:    action,
+		TargetID:  id,
+		RequestID: requestID(r),
+	}, nil, result)
+}
+
+func (h *scimHandler) respond(w http.ResponseWriter, code int, body interface{}) {
+	if code == 204 {
+		w.WriteHeader(code)
+		return
+	}
+	w.Header().Set("Content-Type", scimContentType)
+	w.WriteHeader(code)
+	if err := json.NewEncoder(w).Encode(body); err != nil {
+		log.WithField("error", err).Warn("SCIM: failed to write response")
+	}
+}
+
+func decodeSCIMBody(r *http.Request, v interface{}) error {
+	if err := json.NewDecoder(r.Body).Decode(v); err != nil {
+		return newSCIMError(400, "invalidSyntax", "invalid request body: %v", err)
+	}
+	return nil
+}
+
+func scimServiceProviderConfig() map[string]interface{} {
+	unsupported := map[string]bool{"supported": false}
+	return map[string]interface{}{
+		"schemas":        []string{scimServiceConfigSchema},
+		"patch":          map[string]bool{"supported": true},
+		"bulk":           map[string]interface{}{"supported": false, "maxOperations": 0, "maxPayloadSize": 0},
+		"filter":         map[string]interface{}{"supported": true, "maxResults": scimMaxResults},
+		"changePassword": unsupported,
+		"sort":           unsupported,
+		"etag":           unsupported,
+		"authenticationSchemes": []map[string]interface{}{{
+			"type":        "oauthbearertoken",
+			"name":        "Bearer token",
+			"description": "The SCIM token configured for this deployment",
+		}},
+	}
+}
+
+func scimResourceTypes() scimListResponse {
+	types := []interface{}{
+		map[string]interface{}{"schemas": []string{scimResourceTypeSchema}, "id": "User", "name": "User", "endpoint": "/Users", "schema": scimUserSchema},
+		map[string]interface{}{"schemas": []string{scimResourceTypeSchema}, "id": "Group", "name": "Group", "endpoint": "/Groups", "schema": scimGroupSchema},
+	}
+	return scimListResponse{Schemas: []string{scimListResponseSchema}, TotalResults: len(types), StartIndex: 1, ItemsPerPage: len(types), Resources: types}
+}
+
+var scimFilterRegexp = regexp.MustCompile(`^\s*([A-Za-z.]+)\s+(?i:eq)\s+"((?:[^"\\]|\\.)*)"\s*$`) //nolint:gochecknoglobals // effectively a constant
+
+// parseSCIMFilter parses the simple equality filters that identity providers use to look resources up, e.g. 'userName eq "a@example.com"'
+func parseSCIMFilter(filter string) (attribute string, value string, err error) {
+	if filter == "" {
+		return "", "", nil
+	}
+	m := scimFilterRegexp.FindStringSubmatch(filter)
+	if m == nil {
+		return "", "", newSCIMError(400, "invalidFilter", "only filters of the form 'attribute eq \"value\"' are supported")
+	}
+	value, err = strconv.Unquote(`"` + m[2] + `"`)
+	if err != nil {
+		return "", "", newSCIMError(400, "invalidFilter", "invalid filter value")
+	}
+	return strings.ToLower(m[1]), value, nil
+}
+
+// scimPage returns the page of resources selected by the startIndex and count parameters
+func scimPage(r *http.Request, resources []interface{}) scimListResponse {
+	start, err := strconv.Atoi(r.URL.Query().Get("startIndex"))
+	if err != nil || start < 1 {
+		start = 1
+	}
+	count, err := strconv.Atoi(r.URL.Query().Get("count"))
+	if err != nil || count < 0 || count > scimMaxResults {
+		count = scimMaxResults
+	}
+
+	page := []interface{}{}
+	if start <= len(resources) {
+		end := start - 1 + count
+		if end > len(resources) {
+			end = len(resources)
+		}
+		page = resources[start-1 : end]
+	}
+	return scimListResponse{
+		Schemas:      []string{scimListResponseSchema},
+		TotalResults: len(resources),
+		StartIndex:   start,
+		ItemsPerPage: len(page),
+		Resources:    page,
+	}
+}
+
+// userResource converts a user with local data into its SCIM representation
+func (h *scimHandler) userResource(ctx context.Context, u *models.User) *scimUser {
+	name, email := u.LocalData.Name, u.LocalData.Email
+	if client := h.rt.firebaseClient(); (name == "" || email == "") && client != nil && !strings.HasPrefix(u.UID, provisionedUIDPrefix) {
+		// the details of users who sign in with Google are only held by Firebase
+		if record, err := client.GetUser(ctx, u.UID); err == nil {
+			name, email = record.DisplayName, record.Email
+		} else {
+			log.WithContext(ctx).WithFields(log.Fields{"user": u.UID, "error": err}).Warn("SCIM: failed to look up user details")
+		}
+	}
+
+	active := !u.LocalData.Deactivated
+	res := &scimUser{
+		Schemas:     []string{scimUserSchema},
+		ID:          u.UID,
+		ExternalID:  u.LocalData.ExternalID,
+		UserName:    email,
+		DisplayName: name,
+		Active:      &active,
+		Groups:      []scimMember{},
+		Meta:        &scimMeta{ResourceType: "User"},
+	}
+	if name != "" {
+		res.Name = &scimName{Formatted: name}
+	}
+	if email != "" {
+		res.Emails = []scimEmail{{Value: email, Type: "work", Primary: true}}
+	}
+	for _, role := range u.LocalData.Roles {
+		res.Groups = append(res.Groups, scimMember{Value: string(role), Display: string(role)})
+	}
+	return res
+}
+
+// scimUserMatches reports whether the user has the given value of the (lower-cased) attribute
+func scimUserMatches(res *scimUser, attribute string, value string) bool {
+	switch attribute {
+	case "":
+		return true
+	case "id":
+		return res.ID == value
+	case "username", "emails", "emails.value":
+		return strings.EqualFold(res.UserName, value)
+	case "externalid":
+		return res.ExternalID == value
+	case "displayname":
+		return res.DisplayName == value
+	}
+	return false
+}
+
+func (h *scimHandler) listUsers(ctx context.Context, r *http.Request) (interface{}, error) {
+	attribute, value, err := parseSCIMFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return nil, err
+	}
+
+	users, err := h.rt.Store.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].UID < users[j].UID })
+
+	matches := []interface{}{}
+	for _, u := range users {
+		if attribute == "externalid" && u.LocalData.ExternalID != value {
+			continue // avoid looking up the details of users that can't match
+		}
+		res := h.userResource(ctx, u)
+		if scimUserMatches(res, attribute, value) {
+			matches = append(matches, res)
+		}
+	}
+	return scimPage(r, matches), nil
+}
+
+// loadUser returns the user with local data with the specified ID
+func (h *scimHandler) loadUser(ctx context.Context, id string) (*models.User, error) {
+	u := &models.User{UID: id}
+	if err := h.rt.Store.GetUserData(ctx, u); err != nil {
+		return nil, err
+	}
+	if u.LocalData == nil {
+		return nil, newSCIMError(404, "", "user %v not found", id)
+	}
+	return u, nil
+}
+
+func (h *scimHandler) getUser(ctx context.Context, id string) (interface{}, error) {
+	u, err := h.loadUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return h.userResource(ctx, u), nil
+}
+
+// email returns the address to identify the user by: their primary email address, or else their userName
+func (res *scimUser) email() string {
+	for _, e := range res.Emails {
+		if e.Primary {
+			return e.Value
+		}
+	}
+	if len(res.Emails) > 0 {
+		return res.Emails[0].Value
+	}
+	return res.UserName
+}
+
+// displayName returns the user's full name
+func (res *scimUser) displayName() string {
+	if res.DisplayName != "" {
+		return res.DisplayName
+	}
+	if res.Name != nil {
+		if res.Name.Formatted != "" {
+			return res.Name.Formatted
+		}
+		return strings.TrimSpace(res.Name.GivenName + " " + res.Name.FamilyName)
+	}
+	return ""
+}
+
+// findUser returns the existing user with the email address, or with the external ID if it is set
+func (h *scimHandler) findUser(ctx context.Context, email string, externalID string) (*models.User, error) {
+	users, err := h.rt.Store.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for _, u := range users {
+		if (externalID != "" && u.LocalData.ExternalID == externalID) || (email != "" && strings.EqualFold(u.LocalData.Email, email)) {
+			return u, nil
+		}
+	}
+
+	if client := h.rt.firebaseClient(); client != nil && email != "" {
+		record, err := client.GetUserByEmail(ctx, email)
+		if err == nil {
+			u := &models.User{UID: record.UID}
+			if err = h.rt.Store.GetUserData(ctx, u); err != nil {
+				return nil, err
+			}
+			return u, nil
+		}
+		if !auth.IsUserNotFound(err) {
+			return nil, err
+		}
+	}
+	return nil, nil
+}
+
+func (h *scimHandler) createUser(ctx context.Context, r *http.Request) (interface{}, error) {
+	res := &scimUser{}
+	if err := decodeSCIMBody(r, res); err != nil {
+		return nil, err
+	}
+	email := res.email()
+	if res.UserName == "" || !strings.Contains(email, "@") {
+		return nil, newSCIMError(400, "invalidValue", "userName and an email address are required")
+	}
+
+	u, err := h.findUser(ctx, email, res.ExternalID)
+	if err != nil {
+		return nil, err
+	}
+	if u == nil {
+		id, err := newProvisionedUID()
+		if err != nil {
+			return nil, err
+		}
+		u = &models.User{UID: id}
+	} else if u.LocalData != nil && u.LocalData.ExternalID != "" {
+		return nil, newSCIMError(409, "uniqueness", "user %v has already been provisioned", email)
+	}
+	if u.LocalData == nil {
+		u.LocalData = &models.LocalUserData{}
+	}
+
+	u.LocalData.ExternalID = res.ExternalID
+	u.LocalData.Email = email
+	u.LocalData.Name = res.displayName()
+	if err = h.saveUser(ctx, u, res.Active == nil || *res.Active); err != nil {
+		return nil, err
+	}
+	log.WithContext(ctx).WithFields(log.Fields{"user": u.UID, "email": email}).Info("SCIM: user provisioned")
+	return h.userResource(ctx, u), nil
+}
+
+func (h *scimHandler) replaceUser(ctx context.Context, id string, r *http.Request) (interface{}, error) {
+	res := &scimUser{}
+	if err := decodeSCIMBody(r, res); err != nil {
+		return nil, err
+	}
+	u, err := h.loadUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	u.LocalData.ExternalID = res.ExternalID
+	if email := res.email(); email != "" {
+		u.LocalData.Email = email
+	}
+	u.LocalData.Name = res.displayName()
+	if err = h.saveUser(ctx, u, res.Active == nil || *res.Active); err != nil {
+		return nil, err
+	}
+	return h.userResource(ctx, u), nil
+}
+
+// scimBool parses a boolean patch value, which some identity providers send as a string
+func scimBool(raw json.RawMessage) (bool, error) {
+	var b bool
+	if err := json.Unmarshal(raw, &b); err == nil {
+		return b, nil
+	}
+	var s string
+	if err := json.Unmarshal(raw, &s); err == nil {
+		if b, err = strconv.ParseBool(s); err == nil {
+			return b, nil
+		}
+	}
+	return false, newSCIMError(400, "invalidValue", "expected a boolean value")
+}
+
+// patchUserAttribute applies a change to the named attribute, ignoring attributes that BeSec doesn't record
+func patchUserAttribute(l *models.LocalUserData, active *bool, path string, raw json.RawMessage) error {
+	var s string
+	switch strings.ToLower(path) {
+	case "active":
+		b, err := scimBool(raw)
+		if err != nil {
+			return err
+		}
+		*active = b
+		return nil
+	case "externalid":
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return newSCIMError(400, "invalidValue", "expected a string for %v", path)
+		}
+		l.ExternalID = s
+	case "username":
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return newSCIMError(400, "invalidValue", "expected a string for %v", path)
+		}
+		if strings.Contains(s, "@") {
+			l.Email = s
+		}
+	case "displayname", "name.formatted":
+		if err := json.Unmarshal(raw, &s); err != nil {
+			return newSCIMError(400, "invalidValue", "expected a string for %v", path)
+		}
+		l.Name = s
+	}
+	return nil
+}
+
+func (h *scimHandler) patchUser(ctx context.Context, id string, r *http.Request) (interface{}, error) {
+	patch := &scimPatch{}
+	if err := decodeSCIMBody(r, patch); err != nil {
+		return nil, err
+	}
+	u, err := h.loadUser(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+
+	active := !u.LocalData.Deactivated
+	for _, op := range patch.Operations {
+		switch strings.ToLower(op.Op) {
+		case "add", "replace":
+		default:
+			return nil, newSCIMError(400, "invalidValue", "unsupported patch operation '%v' on a user", op.Op)
+		}
+		if op.Path != "" {
+			if err = patchUserAttribute(u.LocalData, &active, op.Path, op.Value); err != nil {
+				return nil, err
+			}
+			continue
+		}
+		// without a path, the value holds the attributes to change
+		attrs := map[string]json.RawMessage{}
+		if err = json.Unmarshal(op.Value, &attrs); err != nil {
+			return nil, newSCIMError(400, "invalidValue", "expected an object of attributes")
+		}
+		for name, raw := range attrs {
+			if err = patchUserAttribute(u.LocalData, &active, name, raw); err != nil {
+				return nil, err
+			}
+		}
+	}
+
+	if err = h.saveUser(ctx, u, active); err != nil {
+		return nil, err
+	}
+	return h.userResource(ctx, u), nil
+}
+
+// deleteUser deprovisions the user. Users that have signed in keep a deactivated record, so that their provider or an access rule can't grant them access again.
+func (h *scimHandler) deleteUser(ctx context.Context, id string) error {
+	u, err := h.loadUser(ctx, id)
+	if err != nil {
+		return err
+	}
+	if strings.HasPrefix(u.UID, provisionedUIDPrefix) {
+		u.LocalData = nil
+		return h.rt.Store.SaveUserData(ctx, u)
+	}
+	u.LocalData.ExternalID = ""
+	if err = h.saveUser(ctx, u, false); err != nil {
+		return err
+	}
+	log.WithContext(ctx).WithFields(log.Fields{"user": u.UID}).Info("SCIM: user deleted")
+	return nil
+}
+
+// saveUser records the user's local data, authorizing them if they are active and revoking their access if not
+func (h *scimHandler) saveUser(ctx context.Context, u *models.User, active bool) error {
+	wasActive := !u.LocalData.Deactivated
+	u.LocalData.Deactivated = !active
+	u.LocalData.ManuallyAuthorized = active
+	if err := h.rt.Store.SaveUserData(ctx, u); err != nil {
+		return err
+	}
+
+	if client := h.rt.firebaseClient(); client != nil && !strings.HasPrefix(u.UID, provisionedUIDPrefix) {
+		if err := setClaim(ctx, client, u.UID, manuallyAuthorizedClaim, active); err != nil {
+			return err
+		}
+	}
+
+	if !active {
+		if err := DeleteUserSubscriptions(ctx, h.rt.Store, u.UID); err != nil {
+			return err
+		}
+		tokens, err := h.rt.Store.ListAPITokens(ctx, u.UID)
+		if err != nil {
+			return err
+		}
+		for _, t := range tokens {
+			if err = h.rt.Store.DeleteAPIToken(ctx, t.ID); err != nil {
+				return err
+			}
+		}
+		if wasActive {
+			log.WithContext(ctx).WithFields(log.Fields{"user": u.UID, "revokedTokens": len(tokens)}).Info("SCIM: user deactivated")
+		}
+	}
+	return nil
+}
+
+func newProvisionedUID() (string, error) {
+	b := make([]byte, 12)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return provisionedUIDPrefix + hex.EncodeToString(b), nil
+}
+
+// linkProvisionedUser moves the local data of a user provisioned before they first signed in to their real UID.
+// The user must have signed in with a verified email address that matches the provisioned one.
+func (rt *Runtime) linkProvisionedUser(ctx context.Context, u *models.User) error {
+	if u.LocalData != nil || !u.EmailVerified || u.Email == "" || u.APITokenID != "" {
+		return nil
+	}
+	users, err := rt.Store.ListUsers(ctx)
+	if err != nil {
+		return err
+	}
+	for _, p := range users {
+		if !strings.HasPrefix(p.UID, provisionedUIDPrefix) || !strings.EqualFold(p.LocalData.Email, u.Email) {
+			continue
+		}
+
+		linked := &models.User{UID: u.UID, LocalData: p.LocalData}
+		if err = rt.Store.SaveUserData(ctx, linked); err != nil {
+			return err
+		}
+		if client := rt.firebaseClient(); client != nil {
+			if err = setClaim(ctx, client, u.UID, manuallyAuthorizedClaim, p.LocalData.ManuallyAuthorized); err != nil {
+				return err
+			}
+		}
+		p.LocalData = nil
+		if err = rt.Store.SaveUserData(ctx, p); err != nil {
+			return err
+		}
+
+		u.LocalData = linked.LocalData
+		u.ManuallyAuthorized = linked.LocalData.ManuallyAuthorized
+		u.Roles = linked.LocalData.Roles
+		u.Deactivated = linked.LocalData.Deactivated
+		log.WithContext(ctx).WithFields(log.Fields{"user": u.UID, "provisionedAs": p.UID}).Info("Linked provisioned user on first sign-in")
+		RecordAudit(ctx, rt.Store, &store.AuditEvent{Actor: u.UID, ActorName: u.Name, Action: "user.link", TargetID: u.UID}, map[string]string{"uid": p.UID}, linked.LocalData)
+		return nil
+	}
+	return nil
+}
+
+// roleMembers returns the users with local data that have been explicitly granted each role
+func (h *scimHandler) roleMembers(ctx context.Context) (map[models.Role][]*models.User, error) {
+	users, err := h.rt.Store.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	sort.Slice(users, func(i, j int) bool { return users[i].UID < users[j].UID })
+	members := map[models.Role][]*models.User{}
+	for _, u := range users {
+		for _, role := range u.LocalData.Roles {
+			members[role] = append(members[role], u)
+		}
+	}
+	return members, nil
+}
+
+func groupResource(role models.Role, members []*models.User) *scimGroup {
+	g := &scimGroup{
+		Schemas:     []string{scimGroupSchema},
+		ID:          string(role),
+		DisplayName: string(role),
+		Members:     []scimMember{},
+		Meta:        &scimMeta{ResourceType: "Group"},
+	}
+	for _, u := range members {
+		g.Members = append(g.Members, scimMember{Value: u.UID, Display: u.LocalData.Email})
+	}
+	return g
+}
+
+// sortedRoles returns every role, in name order
+func sortedRoles() models.Roles {
+	roles := models.Roles{}
+	for role := range rolePermissions {
+		roles = append(roles, role)
+	}
+	sort.Slice(roles, func(i, j int) bool { return roles[i] < roles[j] })
+	return roles
+}
+
+func (h *scimHandler) listGroups(ctx context.Context, r *http.Request) (interface{}, error) {
+	attribute, value, err := parseSCIMFilter(r.URL.Query().Get("filter"))
+	if err != nil {
+		return nil, err
+	}
+	members, err := h.roleMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	groups := []interface{}{}
+	for _, role := range sortedRoles() {
+		switch attribute {
+		case "":
+		case "id", "displayname":
+			if string(role) != value {
+				continue
+			}
+		default:
+			continue
+		}
+		groups = append(groups, groupResource(role, members[role]))
+	}
+	return scimPage(r, groups), nil
+}
+
+func scimRole(id string) (models.Role, error) {
+	role := models.Role(id)
+	if _, ok := rolePermissions[role]; !ok {
+		return "", newSCIMError(404, "", "groups correspond to BeSec roles, and there is no role '%v'", id)
+	}
+	return role, nil
+}
+
+func (h *scimHandler) getGroup(ctx context.Context, id string) (interface{}, error) {
+	role, err := scimRole(id)
+	if err != nil {
+		return nil, err
+	}
+	members, err := h.roleMembers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	return groupResource(role, members[role]), nil
+}
+
+// setRoleMembers grants the role to the users in uids, and removes it from its other members.
+// If remove is set, the role is only removed from the users in uids.
+func (h *scimHandler) setRoleMembers(ctx context.Context, role models.Role, uids []string, add bool, remove bool) error {
+	members, err := h.roleMembers(ctx)
+	if err != nil {
+		return err
+	}
+	isMember := map[string]bool{}
+	for _, u := range members[role] {
+		isMember[u.UID] = true
+	}
+	listed := map[string]bool{}
+	for _, uid := range uids {
+		listed[uid] = true
+	}
+
+	for _, uid := range uids {
+		if remove || isMember[uid] {
+			continue
+		}
+		u, err := h.loadUser(ctx, uid)
+		if err != nil {
+			if se, ok := err.(*scimError); ok && se.code == 404 {
+				return newSCIMError(400, "invalidValue", "unknown member %v", uid)
+			}
+			return err
+		}
+		if err = h.rt.Store.SetUserRoles(ctx, uid, append(u.LocalData.Roles, role)); err != nil {
+			return err
+		}
+	}
+
+	if add {
+		return nil
+	}
+	for _, u := range members[role] {
+		if listed[u.UID] != remove {
+			continue
+		}
+		roles := models.Roles{}
+		for _, r := range u.LocalData.Roles {
+			if r != role {
+				roles = append(roles, r)
+			}
+		}
+		if err = h.rt.Store.SetUserRoles(ctx, u.UID, roles); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func memberUIDs(members []scimMember) []string {
+	uids := make([]string, len(members))
+	for i, m := range members {
+		uids[i] = m.Value
+	}
+	return uids
+}
+
+// createGroup assigns the members of an identity provider group to the role with the same name; other groups can't be created
+func (h *scimHandler) createGroup(ctx context.Context, r *http.Request) (interface{}, error) {
+	g := &scimGroup{}
+	if err := decodeSCIMBody(r, g); err != nil {
+		return nil, err
+	}
+	role, err := scimRole(g.DisplayName)
+	if err != nil {
+		return nil, newSCIMError(400, "invalidValue", "groups correspond to BeSec roles, so the group's displayName must be one of %v", sortedRoles())
+	}
+	if err = h.setRoleMembers(ctx, role, memberUIDs(g.Members), false, false); err != nil {
+		return nil, err
+	}
+	return h.getGroup(ctx, string(role))
+}
+
+func (h *scimHandler) replaceGroup(ctx context.Context, id string, r *http.Request) (interface{}, error) {
+	g := &scimGroup{}
+	if err := decodeSCIMBody(r, g); err != nil {
+		return nil, err
+	}
+	role, err := scimRole(id)
+	if err != nil {
+		return nil, err
+	}
+	if err = h.setRoleMembers(ctx, role, memberUIDs(g.Members), false, false); err != nil {
+		return nil, err
+	}
+	return h.getGroup(ctx, id)
+}
+
+var scimMemberPathRegexp = regexp.MustCompile(`^members\[value eq "([^"]*)"\]$`) //nolint:gochecknoglobals // effectively a constant
+
+func (h *scimHandler) patchGroup(ctx context.Context, id string, r *http.Request) (interface{}, error) {
+	patch := &scimPatch{}
+	if err := decodeSCIMBody(r, patch); err != nil {
+		return nil, err
+	}
+	role, err := scimRole(id)
+	if err != nil {
+		return nil, err
+	}
+
+	for _, op := range patch.Operations {
+		members := []scimMember{}
+		if m := scimMemberPathRegexp.FindStringSubmatch(op.Path); m != nil {
+			members = append(members, scimMember{Value: m[1]})
+		} else if strings.EqualFold(op.Path, "members") || op.Path == "" {
+			var value struct {
+				Members []scimMember `json:"members"`
+			}
+			if op.Path == "" {
+				err = json.Unmarshal(op.Value, &value)
+				members = value.Members
+			} else if len(op.Value) > 0 {
+				err = json.Unmarshal(op.Value, &members)
+			}
+			if err != nil {
+				return nil, newSCIMError(400, "invalidValue", "expected a list of members")
+			}
+		} else {
+			continue // the other attributes of a role can't be changed
+		}
+
+		uids := memberUIDs(members)
+		switch strings.ToLower(op.Op) {
+		case "add":
+			err = h.setRoleMembers(ctx, role, uids, true, false)
+		case "remove":
+			if len(uids) == 0 && strings.EqualFold(op.Path, "members") {
+				err = h.setRoleMembers(ctx, role, nil, false, false) // remove every member
+			} else {
+				err = h.setRoleMembers(ctx, role, uids, false, true)
+			}
+		case "replace":
+			err = h.setRoleMembers(ctx, role, uids, false, false)
+		default:
+			return nil, newSCIMError(400, "invalidValue", "unsupported patch operation '%v' on a group", op.Op)
+		}
+		if err != nil {
+			return nil, err
+		}
+	}
+	return h.getGroup(ctx, id)
+}
+
+// deleteGroup removes the role from all of its members, as roles themselves can't be deleted
+func (h *scimHandler) deleteGroup(ctx context.Context, id string) error {
+	role, err := scimRole(id)
+	if err != nil {
+		return err
+	}
+	return h.setRoleMembers(ctx, role, nil, false, false)
+}
@@ -0,0 +1,119 @@
+package api
+
+import (
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+func TestSCIMAuthentication(t *testing.T) {
+	h := NewSCIMHandler(&Runtime{}, "s3cret")
+
+	for _, header := range []string{"", "s3cret", "Bearer wrong", "Bearer s3cret2"} {
+		req := httptest.NewRequest(http.MethodGet, "/ServiceProviderConfig", nil)
+		if header != "" {
+			req.Header.Set("Authorization", header)
+		}
+		rec := httptest.NewRecorder()
+		h.ServeHTTP(rec, req)
+		if rec.Code != 401 {
+			t.Errorf("Authorization '%v': got status %v, expected 401", header, rec.Code)
+		}
+	}
+
+	req := httptest.NewRequest(http.MethodGet, "/ServiceProviderConfig", nil)
+	req.Header.Set("Authorization", "Bearer s3cret")
+	rec := httptest.NewRecorder()
+	h.ServeHTTP(rec, req)
+	if rec.Code != 200 {
+		t.Errorf("Valid token: got status %v, expected 200", rec.Code)
+	}
+	if rec.Header().Get("Content-Type") != scimContentType {
+		t.Errorf("Got content type %v", rec.Header().Get("Content-Type"))
+	}
+}
+
+func TestParseSCIMFilter(t *testing.T) {
+	cases := []struct {
+		filter, attribute, value string
+		valid                    bool
+	}{
+		{"", "", "", true},
+		{`userName eq "a@example.com"`, "username", "a@example.com", true},
+		{`externalId EQ "00u1\"x"`, "externalid", `00u1"x`, true},
+		{`emails.value eq "b@example.com"`, "emails.value", "b@example.com", true},
+		{`userName sw "a"`, "", "", false},
+		{`userName eq "a" and active eq true`, "", "", false},
+	}
+	for _, c := range cases {
+		attribute, value, err := parseSCIMFilter(c.filter)
+		if (err == nil) != c.valid {
+			t.Errorf("Filter '%v': got error %v", c.filter, err)
+			continue
+		}
+		if attribute != c.attribute || value != c.value {
+			t.Errorf("Filter '%v': got (%v, %v), expected (%v, %v)", c.filter, attribute, value, c.attribute, c.value)
+		}
+	}
+}
+
+func TestSCIMPage(t *testing.T) {
+	resources := []interface{}{1, 2, 3, 4, 5}
+	cases := []struct {
+		query       string
+		start, page int
+	}{
+		{"", 1, 5},
+		{"?startIndex=2&count=2", 2, 2},
+		{"?startIndex=4&count=10", 4, 2},
+		{"?startIndex=9", 9, 0},
+		{"?count=0", 1, 0},
+	}
+	for _, c := range cases {
+		res := scimPage(httptest.NewRequest(http.MethodGet, "/Users"+c.query, nil), resources)
+		if res.TotalResults != 5 || res.StartIndex != c.start || res.ItemsPerPage != c.page || len(res.Resources) != c.page {
+			t.Errorf("Query '%v': got %+v", c.query, res)
+		}
+	}
+}
+
+func TestPatchUserAttribute(t *testing.T) {
+	l := &models.LocalUserData{Name: "A", Email: "a@example.com"}
+	active := true
+
+	// Azure AD sends booleans as strings, Okta sends them as booleans
+	for _, value := range []string{`"False"`, `false`} {
+		active = true
+		if err := patchUserAttribute(l, &active, "active", json.RawMessage(value)); err != nil || active {
+			t.Errorf("Value %v: got active %v, error %v", value, active, err)
+		}
+	}
+	if err := patchUserAttribute(l, &active, "active", json.RawMessage(`"maybe"`)); err == nil {
+		t.Error("Expected an error for an invalid boolean")
+	}
+
+	if err := patchUserAttribute(l, &active, "displayName", json.RawMessage(`"B"`)); err != nil || l.Name != "B" {
+		t.Errorf("displayName: got %v, error %v", l.Name, err)
+	}
+	if err := patchUserAttribute(l, &active, "userName", json.RawMessage(`"b@example.com"`)); err != nil || l.Email != "b@example.com" {
+		t.Errorf("userName: got %v, error %v", l.Email, err)
+	}
+	if err := patchUserAttribute(l, &active, "title", json.RawMessage(`"Engineer"`)); err != nil {
+		t.Errorf("Unknown attributes should be ignored, got %v", err)
+	}
+}
+
+func TestDeactivatedUserHasNoAccess(t *testing.T) {
+	rt := &Runtime{}
+	u := &models.User{ManuallyAuthorized: true, RuleAccess: true}
+	if !rt.hasAccess(u) {
+		t.Fatal("Expected an authorized user to have access")
+	}
+	u.Deactivated = true
+	if rt.hasAccess(u) {
+		t.Error("Expected a deactivated user not to have access")
+	}
+}
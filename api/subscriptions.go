@@ -0,0 +1,180 @@
+package api
+
+import (
+	"context"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/store"
+)
+
+// DeleteUserSubscriptions removes the user's subscriptions to every project, when they lose access
+func DeleteUserSubscriptions(ctx context.Context, st store.Store, uid string) error {
+	subs, err := st.ListUserSubscriptions(ctx, uid)
+	if err != nil {
+		return err
+	}
+	return deleteSubscriptions(ctx, st, subs)
+}
+
+// deleteProjectSubscriptions removes every subscription to the project, when it is deleted
+func deleteProjectSubscriptions(ctx context.Context, st store.Store, projectID string) error {
+	subs, err := st.ListProjectSubscriptions(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	return deleteSubscriptions(ctx, st, subs)
+}
+
+func deleteSubscriptions(ctx context.Context, st store.Store, subs []*store.Subscription) error {
+	for _, sub := range subs {
+		if err := st.DeleteSubscription(ctx, sub.UID, sub.ProjectID); err != nil {
+			return err
+		}
+	}
+	if len(subs) > 0 {
+		log.WithContext(ctx).WithFields(log.Fields{"user": subs[0].UID, "project": subs[0].ProjectID, "count": len(subs)}).Info("Deleted subscriptions")
+	}
+	return nil
+}
+
+// subscriberActive reports whether the subscriber can still be sent project events: users deactivated since they
+// subscribed aren't
+func subscriberActive(ctx context.Context, st store.Store, sub *store.Subscription) (bool, error) {
+	u := &models.User{UID: sub.UID}
+	if err := st.GetUserData(ctx, u); err != nil {
+		return false, err
+	}
+	return !u.Deactivated, nil
+}
+
+func subscriptionModel(sub *store.Subscription) *models.Subscription {
+	projectID, email := sub.ProjectID, sub.Email
+	created := strfmt.DateTime(sub.Created)
+	return &models.Subscription{ProjectID: &projectID, Email: &email, Events: sub.Events, Created: &created}
+}
+
+// NewGetProjectSubscriptionHandler creates a handler
+func NewGetProjectSubscriptionHandler(rt *Runtime) operations.GetProjectSubscriptionHandler {
+	return &getProjectSubscriptionHandlerImp{rt: rt}
+}
+
+type getProjectSubscriptionHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *getProjectSubscriptionHandlerImp) Handle(params operations.GetProjectSubscriptionParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.GetProjectSubscriptionDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	sub, found, err := h.rt.Store.GetSubscription(params.HTTPRequest.Context(), principal.UID, params.ID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if !found {
+		return fail(404, "you aren't subscribed to project "+params.ID)
+	}
+	return &operations.GetProjectSubscriptionOK{Payload: subscriptionModel(sub)}
+}
+
+// NewSubscribeToProjectHandler creates a handler
+func NewSubscribeToProjectHandler(rt *Runtime) operations.SubscribeToProjectHandler {
+	return &subscribeToProjectHandlerImp{rt: rt}
+}
+
+type subscribeToProjectHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *subscribeToProjectHandlerImp) Handle(params operations.SubscribeToProjectParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.SubscribeToProjectDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	if h.rt.Notifications == nil || h.rt.Notifications.Subscriptions() == nil {
+		return fail(501, "subscriptions to project events aren't enabled on this server")
+	}
+	if principal.Email == "" || !principal.EmailVerified {
+		return fail(400, "you need a verified email address to subscribe to project events")
+	}
+	for _, event := range params.Body.Events {
+		if event == "" {
+			return fail(400, "event types can't be empty")
+		}
+	}
+
+	ctx := params.HTTPRequest.Context()
+	_, found, err := h.rt.Store.GetProject(ctx, params.ID)
+	if err != nil {
+		return fail(500, "error retrieving project")
+	}
+	if !found {
+		return fail(404, "project "+params.ID+" doesn't exist")
+	}
+
+	sub := &store.Subscription{
+		UID:       principal.UID,
+		ProjectID: params.ID,
+		Email:     principal.Email,
+		Events:    params.Body.Events,
+		Created:   time.Now().UTC(),
+	}
+	before, existed, err := h.rt.Store.GetSubscription(ctx, principal.UID, params.ID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if err = h.rt.Store.SetSubscription(ctx, sub); err != nil {
+		return fail(500, err.Error())
+	}
+	if existed {
+		h.rt.audit(params.HTTPRequest, principal, "subscription.update", params.ID, subscriptionModel(before), subscriptionModel(sub))
+	} else {
+		h.rt.audit(params.HTTPRequest, principal, "subscription.create", params.ID, nil, subscriptionModel(sub))
+	}
+	return &operations.SubscribeToProjectOK{Payload: subscriptionModel(sub)}
+}
+
+// NewUnsubscribeFromProjectHandler creates a handler
+func NewUnsubscribeFromProjectHandler(rt *Runtime) operations.UnsubscribeFromProjectHandler {
+	return &unsubscribeFromProjectHandlerImp{rt: rt}
+}
+
+type unsubscribeFromProjectHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *unsubscribeFromProjectHandlerImp) Handle(params operations.UnsubscribeFromProjectParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.UnsubscribeFromProjectDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	// Anyone can unsubscribe, even if they've since lost access
+	ctx := params.HTTPRequest.Context()
+	sub, found, err := h.rt.Store.GetSubscription(ctx, principal.UID, params.ID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if !found {
+		return &operations.UnsubscribeFromProjectNoContent{}
+	}
+	if err = h.rt.Store.DeleteSubscription(ctx, principal.UID, params.ID); err != nil {
+		return fail(500, err.Error())
+	}
+	h.rt.audit(params.HTTPRequest, principal, "subscription.delete", params.ID, subscriptionModel(sub), nil)
+	return &operations.UnsubscribeFromProjectNoContent{}
+}
@@ -0,0 +1,222 @@
+package api
+
+import (
+	"context"
+	"sort"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// practiceNames looks up the names of practices and the titles of their tasks, from the practices versions that plans
+// were answered against. Anything that can't be found is named by its ID.
+// It also looks up practices' risk acceptance policies, which are ignored for practices that can't be found.
+type practiceNames struct {
+	rt        *Runtime
+	loaded    map[string]bool // keyed on practices version
+	practices map[string]string
+	tasks     map[string]string                   // keyed on practiceID/taskID
+	policies  map[string]lib.RiskAcceptancePolicy // keyed on version/practiceID
+}
+
+func newPracticeNames(rt *Runtime) *practiceNames {
+	return &practiceNames{rt: rt, loaded: map[string]bool{}, practices: map[string]string{}, tasks: map[string]string{}, policies: map[string]lib.RiskAcceptancePolicy{}}
+}
+
+// load adds the names from a version of the practices, which take precedence over those already loaded
+func (n *practiceNames) load(ctx context.Context, version string) {
+	if n.loaded[version] {
+		return
+	}
+	n.loaded[version] = true
+	practices, err := n.rt.GetPractices(ctx, version)
+	if err != nil {
+		log.WithContext(ctx).WithFields(log.Fields{"version": version, "error": err}).Warn("Couldn't retrieve practices, using IDs instead of their names")
+		return
+	}
+	for _, practice := range practices {
+		n.practices[practice.ID] = practice.Name
+		n.policies[version+"/"+practice.ID] = practice.RiskAcceptance
+		for _, task := range practice.Tasks {
+			n.tasks[practice.ID+"/"+task.ID] = task.Title
+		}
+	}
+}
+
+func (n *practiceNames) practice(id string) string {
+	if name, ok := n.practices[id]; ok {
+		return name
+	}
+	return id
+}
+
+// policy returns the practice's risk acceptance policy in a version of the practices that has been loaded
+func (n *practiceNames) policy(version string, practiceID string) lib.RiskAcceptancePolicy {
+	if policy, ok := n.policies[version+"/"+practiceID]; ok {
+		return policy
+	}
+	return lib.RiskAcceptanceIgnored
+}
+
+func (n *practiceNames) task(practiceID string, taskID string) string {
+	if title, ok := n.tasks[practiceID+"/"+taskID]; ok {
+		return title
+	}
+	return taskID
+}
+
+// planRevision is a revision of a plan, with the IDs it's stored under
+type planRevision struct {
+	PlanID     string
+	RevisionID string
+	Plan       *lib.Plan
+}
+
+// currentPlans returns the latest revision, committed or not, of each project's most recently dated plan.
+// Projects without any plans are omitted.
+func currentPlans(ctx context.Context, rt *Runtime, projects []*models.Project) (map[string]*planRevision, error) {
+	revisions := map[string]*planRevision{} // plans can belong to several projects, so only look each one up once
+	current := map[string]*planRevision{}
+	for _, p := range projects {
+		for _, planID := range p.Plans {
+			rev, seen := revisions[planID]
+			if !seen {
+				revIDs, err := rt.Store.ListPlanRevisionIDs(ctx, planID)
+				if err != nil {
+					return nil, err
+				}
+				if len(revIDs) > 0 {
+					plan, found, err := rt.Store.GetPlanRevision(ctx, planID, revIDs[len(revIDs)-1])
+					if err != nil {
+						return nil, err
+					}
+					if found && plan != nil {
+						rev = &planRevision{PlanID: planID, RevisionID: revIDs[len(revIDs)-1], Plan: plan}
+					}
+				}
+				revisions[planID] = rev
+			}
+			if rev != nil && (current[p.ID] == nil || rev.Plan.Details.Date >= current[p.ID].Plan.Details.Date) {
+				current[p.ID] = rev
+			}
+		}
+	}
+	return current, nil
+}
+
+// openTasks returns the prioritised tasks in the projects' current plans that are planned or in progress,
+// soonest target date first; tasks without one come last
+func (rt *Runtime) openTasks(ctx context.Context, projects []*models.Project, today string) ([]*models.OpenTask, error) {
+	current, err := currentPlans(ctx, rt, projects)
+	if err != nil {
+		return nil, err
+	}
+	names := newPracticeNames(rt)
+	tasks := []*models.OpenTask{}
+	for _, p := range projects {
+		rev, ok := current[p.ID]
+		if !ok {
+			continue
+		}
+		names.load(ctx, rev.Plan.Responses.PracticesVersion)
+		for practiceID, pr := range rev.Plan.Responses.PracticeResponses {
+			for taskID, t := range pr.Tasks {
+				if !t.Priority || !t.Status.Open() {
+					continue
+				}
+				projectID, projectName, planID, revID := p.ID, *p.Attributes.Name, rev.PlanID, rev.RevisionID
+				pID, tID := practiceID, taskID
+				status := string(t.Status)
+				if status == "" {
+					status = string(lib.TaskPlanned)
+				}
+				tasks = append(tasks, &models.OpenTask{
+					ProjectID:  &projectID,
+					Project:    &projectName,
+					PlanID:     &planID,
+					RevisionID: &revID,
+					PracticeID: &pID,
+					Practice:   names.practice(practiceID),
+					TaskID:     &tID,
+					Title:      names.task(practiceID, taskID),
+					Assignee:   t.Assignee,
+					TargetDate: t.TargetDate,
+					Status:     &status,
+					Overdue:    t.TargetDate != "" && t.TargetDate < today,
+					Issues:     t.Issues,
+				})
+			}
+		}
+	}
+	sort.Slice(tasks, func(i, j int) bool {
+		a, b := tasks[i], tasks[j]
+		if a.TargetDate != b.TargetDate {
+			if a.TargetDate == "" || b.TargetDate == "" {
+				return b.TargetDate == ""
+			}
+			return a.TargetDate < b.TargetDate
+		}
+		if *a.Project != *b.Project {
+			return *a.Project < *b.Project
+		}
+		if *a.PracticeID != *b.PracticeID {
+			return *a.PracticeID < *b.PracticeID
+		}
+		return *a.TaskID < *b.TaskID
+	})
+	return tasks, nil
+}
+
+// NewListOpenTasksHandler creates a handler
+func NewListOpenTasksHandler(rt *Runtime) operations.ListOpenTasksHandler {
+	return &listOpenTasksHandlerImp{rt: rt}
+}
+
+type listOpenTasksHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listOpenTasksHandlerImp) Handle(params operations.ListOpenTasksParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListOpenTasksDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, ReadPermission) {
+		return fail(403, forbidden(ReadPermission))
+	}
+	ctx := params.HTTPRequest.Context()
+	var projects []*models.Project
+	if params.Project != nil {
+		p, found, err := h.rt.Store.GetProject(ctx, *params.Project)
+		if err != nil {
+			return fail(500, "error retrieving project")
+		}
+		if !found {
+			return fail(404, "project "+*params.Project+" doesn't exist")
+		}
+		projects = []*models.Project{p}
+	} else {
+		var err error
+		if projects, err = h.rt.Store.ListProjects(ctx); err != nil {
+			return fail(500, "error retrieving projects")
+		}
+	}
+
+	tasks, err := h.rt.openTasks(ctx, projects, time.Now().UTC().Format("2006-01-02"))
+	if err != nil {
+		return fail(500, "error retrieving plans")
+	}
+	selected := []*models.OpenTask{}
+	for _, t := range tasks {
+		if (params.Assignee == nil || t.Assignee == *params.Assignee) && (params.Status == nil || *t.Status == *params.Status) {
+			selected = append(selected, t)
+		}
+	}
+	return &operations.ListOpenTasksOK{Payload: selected}
+}
@@ -0,0 +1,69 @@
+package api
+
+import (
+	"context"
+	"testing"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+func TestOpenTasks(t *testing.T) {
+	tracked := func(date string, tasks map[string]lib.TaskResponse) *lib.Plan {
+		return &lib.Plan{
+			Details:   lib.PlanDetails{Date: date},
+			Responses: lib.PlanResponses{PracticesVersion: "1", PracticeResponses: map[string]lib.PracticeResponse{"auth": {Tasks: tasks}}},
+		}
+	}
+	st := newMemStore()
+	st.projects = []*models.Project{
+		testProject("alpha", "", nil, "old", "new"),
+		testProject("beta", "", nil, "shared"),
+		testProject("gamma", "", nil),
+	}
+	st.plans = map[string][]lib.ChainedRevision{
+		"old": {{ID: "o1", Plan: tracked("2020-01-01", map[string]lib.TaskResponse{"legacy": {Priority: true}})}},
+		"new": {
+			{ID: "n1", Plan: tracked("2021-01-01", map[string]lib.TaskResponse{"mfa": {Priority: true}})},
+			{ID: "n2", Plan: tracked("2021-01-01", map[string]lib.TaskResponse{
+				"mfa":   {Priority: true, Status: lib.TaskInProgress, Assignee: "alice", TargetDate: "2021-03-01"},
+				"sso":   {Priority: true},
+				"audit": {Priority: true, Status: lib.TaskDone},
+				"logs":  {Status: lib.TaskPlanned}, // not a priority
+			})},
+		},
+		"shared": {{ID: "s1", Plan: tracked("2021-02-01", map[string]lib.TaskResponse{
+			"mfa":     {Priority: true, TargetDate: "2021-06-01"},
+			"keys":    {Priority: true, Status: lib.TaskDropped},
+			"backups": {Priority: true, Status: lib.TaskPlanned, TargetDate: "2021-02-01"},
+		})}},
+	}
+	st.practices = []lib.Practice{{ID: "auth", Name: "Authentication", Tasks: []lib.Task{{ID: "mfa", Title: "Enforce MFA"}}}}
+	rt := &Runtime{Store: st, practicesCache: map[string]practiceCache{}}
+
+	tasks, err := rt.openTasks(context.Background(), st.projects, "2021-04-01")
+	if err != nil {
+		t.Fatal(err)
+	}
+	want := []struct {
+		project, task, status string
+		overdue               bool
+	}{
+		{"beta", "backups", "planned", true},
+		{"alpha", "mfa", "in-progress", true},
+		{"beta", "mfa", "planned", false},
+		{"alpha", "sso", "planned", false},
+	}
+	if len(tasks) != len(want) {
+		t.Fatalf("got %v open tasks, want %v", len(tasks), len(want))
+	}
+	for i, w := range want {
+		got := tasks[i]
+		if *got.ProjectID != w.project || *got.TaskID != w.task || *got.Status != w.status || got.Overdue != w.overdue || *got.RevisionID == "n1" {
+			t.Errorf("task %v: got %v %v %v overdue %v from %v, want %+v", i, *got.ProjectID, *got.TaskID, *got.Status, got.Overdue, *got.RevisionID, w)
+		}
+	}
+	if tasks[1].Title != "Enforce MFA" || tasks[1].Practice != "Authentication" || tasks[1].Assignee != "alice" {
+		t.Errorf("unexpected details: %+v", tasks[1])
+	}
+}
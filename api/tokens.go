@@ -0,0 +1,279 @@
+package api
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/store"
+)
+
+const (
+	// APITokenPrefix starts every API token secret, to distinguish them from ID tokens
+	APITokenPrefix = "besec_"
+	// ServiceAccountProvider is the identity provider of users authenticated with a service account token
+	ServiceAccountProvider = "besec.serviceaccount"
+
+	apiTokenPrefixLength = len(APITokenPrefix) + 6 // how much of the secret to keep, to help identify tokens
+	apiTokenUsedInterval = time.Minute             // how often to record that a token has been used
+)
+
+// serviceAccountUID returns the UID of the named service account
+func serviceAccountUID(name string) string {
+	return "serviceaccount:" + name
+}
+
+// hashAPIToken returns the hash of the secret that is kept in the store
+func hashAPIToken(secret string) string {
+	h := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(h[:])
+}
+
+// newAPITokenSecret generates a random API token secret
+func newAPITokenSecret() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return APITokenPrefix + base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+// apiTokenUser creates the user that the token acts as.
+// Service accounts are only given their token's roles. Users' tokens have their owner's current roles and authorization,
+// which the authorizer looks up.
+func apiTokenUser(t *store.APIToken, now time.Time) (*models.User, error) {
+	if !t.Expires.IsZero() && now.After(t.Expires) {
+		return nil, fmt.Errorf("API token expired")
+	}
+	u := &models.User{
+		UID:         t.Owner,
+		Provider:    t.OwnerProvider,
+		Email:       t.OwnerEmail,
+		Name:        t.OwnerName,
+		APITokenID:  t.ID,
+		TokenScopes: t.Scopes,
+	}
+	if t.ServiceAccount {
+		u.Provider = ServiceAccountProvider
+		u.ManuallyAuthorized = true
+		u.CreationAlertSent = true
+		u.Roles = t.Roles
+		u.LookedUp = true
+	}
+	return u, nil
+}
+
+// verifyAPIToken looks up the token with the given secret, records its use, and returns the user it acts as
+func (rt *Runtime) verifyAPIToken(ctx context.Context, secret string) (*models.User, error) {
+	logger := log.WithContext(ctx)
+	t, found, err := rt.Store.FindAPIToken(ctx, hashAPIToken(secret))
+	if err != nil {
+		return nil, fmt.Errorf("internal error when checking API token")
+	}
+	if !found {
+		logger.Info("Unknown API token")
+		return nil, fmt.Errorf("invalid API token")
+	}
+
+	u, err := apiTokenUser(t, time.Now())
+	if err != nil {
+		logger.WithFields(log.Fields{"token": t.ID, "error": err}).Info("Rejected API token")
+		return nil, err
+	}
+
+	if time.Since(t.LastUsed) > apiTokenUsedInterval {
+		if err := rt.Store.APITokenUsed(ctx, t.ID, time.Now().UTC()); err != nil {
+			logger.WithFields(log.Fields{"token": t.ID, "error": err}).Warn("Failed to record API token use")
+		}
+	}
+	return u, nil
+}
+
+// apiTokenModel converts the stored token to its API representation, without any of its secret
+func apiTokenModel(t *store.APIToken) *models.APIToken {
+	name, owner := t.Name, t.Owner
+	created := strfmt.DateTime(t.Created)
+	m := &models.APIToken{
+		ID:             t.ID,
+		Name:           &name,
+		Owner:          &owner,
+		OwnerName:      t.OwnerName,
+		ServiceAccount: t.ServiceAccount,
+		Roles:          t.Roles,
+		Scopes:         []models.APITokenScope{},
+		Prefix:         t.Prefix,
+		CreatedBy:      t.CreatedBy,
+		Created:        &created,
+	}
+	for _, s := range t.Scopes {
+		m.Scopes = append(m.Scopes, models.APITokenScope(s))
+	}
+	if !t.Expires.IsZero() {
+		expires := strfmt.DateTime(t.Expires)
+		m.Expires = &expires
+	}
+	if !t.LastUsed.IsZero() {
+		lastUsed := strfmt.DateTime(t.LastUsed)
+		m.LastUsed = &lastUsed
+	}
+	return m
+}
+
+// NewListAPITokensHandler creates a handler
+func NewListAPITokensHandler(rt *Runtime) operations.ListAPITokensHandler {
+	return &listAPITokensHandlerImp{rt: rt}
+}
+
+type listAPITokensHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listAPITokensHandlerImp) Handle(params operations.ListAPITokensParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListAPITokensDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	owner := principal.UID
+	if params.All != nil && *params.All {
+		if !h.rt.Allowed(principal, AdminPermission) {
+			return fail(403, forbidden(AdminPermission))
+		}
+		owner = ""
+	}
+
+	tokens, err := h.rt.Store.ListAPITokens(params.HTTPRequest.Context(), owner)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	sort.Slice(tokens, func(i, j int) bool { return tokens[i].Created.Before(tokens[j].Created) })
+
+	payload := make([]*models.APIToken, len(tokens))
+	for i, t := range tokens {
+		payload[i] = apiTokenModel(t)
+	}
+	return &operations.ListAPITokensOK{Payload: payload}
+}
+
+// NewCreateAPITokenHandler creates a handler
+func NewCreateAPITokenHandler(rt *Runtime) operations.CreateAPITokenHandler {
+	return &createAPITokenHandlerImp{rt: rt}
+}
+
+type createAPITokenHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *createAPITokenHandlerImp) Handle(params operations.CreateAPITokenParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.CreateAPITokenDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	// Otherwise a leaked token could be used to mint replacements that outlive its revocation
+	if principal.APITokenID != "" {
+		return fail(403, "API tokens can't be used to create other tokens")
+	}
+
+	ctx := params.HTTPRequest.Context()
+	req := params.Body
+	now := time.Now().UTC()
+	t := &store.APIToken{
+		Name:      *req.Name,
+		CreatedBy: principal.UID,
+		Created:   now,
+	}
+	for _, s := range req.Scopes {
+		t.Scopes = append(t.Scopes, string(s))
+	}
+	if req.ExpiresInDays > 0 {
+		t.Expires = now.AddDate(0, 0, int(req.ExpiresInDays))
+	}
+
+	if req.ServiceAccount != "" {
+		if !h.rt.Allowed(principal, AdminPermission) {
+			return fail(403, forbidden(AdminPermission))
+		}
+		if len(req.Roles) == 0 {
+			return fail(400, "service account tokens must have at least one role")
+		}
+		t.ServiceAccount = true
+		t.Owner = serviceAccountUID(req.ServiceAccount)
+		t.OwnerName = req.ServiceAccount
+		t.Roles = req.Roles
+	} else {
+		if len(req.Roles) > 0 {
+			return fail(400, "only service account tokens can have roles; other tokens have their owner's roles")
+		}
+		for _, s := range t.Scopes {
+			if !h.rt.Allowed(principal, Permission(s)) {
+				return fail(403, fmt.Sprintf("you can't create a token with the %v scope, as you don't have that permission", s))
+			}
+		}
+		t.Owner = principal.UID
+		t.OwnerProvider = principal.Provider
+		t.OwnerName = principal.Name
+		t.OwnerEmail = principal.Email
+	}
+
+	secret, err := newAPITokenSecret()
+	if err != nil {
+		log.WithContext(ctx).WithField("error", err).Error("CreateAPIToken Handler: failed to generate token")
+		return fail(500, "error generating API token")
+	}
+	t.Hash = hashAPIToken(secret)
+	t.Prefix = secret[:apiTokenPrefixLength]
+
+	if t.ID, err = h.rt.Store.CreateAPIToken(ctx, t); err != nil {
+		return fail(500, err.Error())
+	}
+	log.WithContext(ctx).WithFields(log.Fields{"token": t.ID, "owner": t.Owner, "scopes": strings.Join(t.Scopes, ",")}).Info("Created API token")
+	h.rt.audit(params.HTTPRequest, principal, "apitoken.create", t.ID, nil, apiTokenModel(t))
+	return &operations.CreateAPITokenCreated{Payload: &models.NewAPIToken{Token: apiTokenModel(t), Secret: &secret}}
+}
+
+// NewRevokeAPITokenHandler creates a handler
+func NewRevokeAPITokenHandler(rt *Runtime) operations.RevokeAPITokenHandler {
+	return &revokeAPITokenHandlerImp{rt: rt}
+}
+
+type revokeAPITokenHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *revokeAPITokenHandlerImp) Handle(params operations.RevokeAPITokenParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.RevokeAPITokenDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	t, found, err := h.rt.Store.GetAPIToken(ctx, params.ID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if !found {
+		return fail(404, "API token "+params.ID+" doesn't exist")
+	}
+	if t.Owner != principal.UID && !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+
+	if err = h.rt.Store.DeleteAPIToken(ctx, params.ID); err != nil {
+		return fail(500, err.Error())
+	}
+	h.rt.audit(params.HTTPRequest, principal, "apitoken.revoke", params.ID, apiTokenModel(t), nil)
+	return &operations.RevokeAPITokenNoContent{}
+}
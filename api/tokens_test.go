@@ -0,0 +1,79 @@
+package api
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/store"
+)
+
+func TestAPITokenSecret(t *testing.T) {
+	a, err := newAPITokenSecret()
+	if err != nil {
+		t.Fatal(err)
+	}
+	b, _ := newAPITokenSecret()
+	if !strings.HasPrefix(a, APITokenPrefix) || a == b {
+		t.Errorf("newAPITokenSecret() returned %v and %v", a, b)
+	}
+	if hashAPIToken(a) == hashAPIToken(b) || hashAPIToken(a) != hashAPIToken(a) || strings.Contains(hashAPIToken(a), a) {
+		t.Error("hashAPIToken() isn't a deterministic hash")
+	}
+}
+
+func TestAPITokenUser(t *testing.T) {
+	now := time.Now()
+	personal := &store.APIToken{ID: "t1", Owner: "u1", OwnerProvider: "google.com", Scopes: []string{"read"}}
+	u, err := apiTokenUser(personal, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.UID != "u1" || u.Provider != "google.com" || u.LookedUp || u.ManuallyAuthorized || u.APITokenID != "t1" {
+		t.Errorf("apiTokenUser() of a user's token == %+v; its owner's access should be looked up", u)
+	}
+
+	service := &store.APIToken{ID: "t2", Owner: serviceAccountUID("ci"), ServiceAccount: true, Roles: models.Roles{models.RoleViewer}, Scopes: []string{"read"}}
+	u, err = apiTokenUser(service, now)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if u.Provider != ServiceAccountProvider || !u.LookedUp || !u.ManuallyAuthorized || len(u.Roles) != 1 {
+		t.Errorf("apiTokenUser() of a service account token == %+v", u)
+	}
+
+	expired := &store.APIToken{ID: "t3", Owner: "u1", Expires: now.Add(-time.Second)}
+	if _, err = apiTokenUser(expired, now); err == nil {
+		t.Error("apiTokenUser() accepted an expired token")
+	}
+}
+
+func TestTokenScopes(t *testing.T) {
+	rt := &Runtime{DefaultRoles: models.Roles{models.RoleProjectContributor}}
+
+	cases := []struct {
+		roles  models.Roles
+		scopes []string
+		perm   Permission
+		want   bool
+	}{
+		{nil, []string{"read", "edit"}, EditPermission, true},
+		{nil, []string{"read"}, EditPermission, false},             // scopes restrict the roles
+		{nil, []string{"read", "delete"}, DeletePermission, false}, // but can't extend them
+		{models.Roles{models.RoleSecurityAdmin}, []string{"read", "admin"}, AdminPermission, true},
+		{models.Roles{models.RoleSecurityAdmin}, []string{}, ReadPermission, false},
+	}
+
+	for _, c := range cases {
+		u := &models.User{UID: "u", Roles: c.roles, APITokenID: "t", TokenScopes: c.scopes}
+		if got := rt.Allowed(u, c.perm); got != c.want {
+			t.Errorf("Allowed(%v with scopes %v, %v) == %v, want %v", c.roles, c.scopes, c.perm, got, c.want)
+		}
+	}
+
+	u := &models.User{UID: "u", APITokenID: "t", TokenScopes: []string{"read", "delete"}}
+	if perms := rt.Permissions(u); len(perms) != 1 || !perms[ReadPermission] {
+		t.Errorf("Permissions() == %v, want only read", perms)
+	}
+}
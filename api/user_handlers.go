@@ -0,0 +1,369 @@
+package api
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+
+	"firebase.google.com/go/v4/auth"
+	"github.com/go-openapi/runtime/middleware"
+	log "github.com/sirupsen/logrus"
+	"google.golang.org/api/iterator"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+)
+
+const defaultUserPageSize = 100
+
+// userRecords returns the user's Firebase Auth record, if Firebase authenticates users, and their local data.
+// Either can be nil, but not both: if the user can't be found, found is false.
+func (rt *Runtime) userRecords(ctx context.Context, uid string) (record *auth.UserRecord, local *models.User, found bool, err error) {
+	if client := rt.firebaseClient(); client != nil {
+		record, err = client.GetUser(ctx, uid)
+		if err != nil {
+			if !auth.IsUserNotFound(err) {
+				return nil, nil, false, fmt.Errorf("error retrieving user")
+			}
+			record = nil
+		}
+	}
+
+	local = &models.User{UID: uid}
+	if err = rt.Store.GetUserData(ctx, local); err != nil {
+		return nil, nil, false, err
+	}
+	if local.LocalData == nil {
+		local = nil
+	}
+	return record, local, record != nil || local != nil, nil
+}
+
+// managedUser combines the user's Firebase Auth record and local data, either of which may be nil
+func (rt *Runtime) managedUser(record *auth.UserRecord, local *models.User) *models.ManagedUser {
+	u := &models.ManagedUser{Roles: models.Roles{}}
+	if record != nil {
+		u.UID = &record.UID
+		u.Name = record.DisplayName
+		u.Email = record.Email
+		u.EmailVerified = record.EmailVerified
+		u.Disabled = record.Disabled
+		if len(record.ProviderUserInfo) == 1 {
+			u.Provider = record.ProviderUserInfo[0].ProviderID
+		} else if len(record.ProviderUserInfo) > 1 {
+			u.Provider = "multiple"
+		}
+	}
+
+	authorized := false
+	if local != nil {
+		u.UID = &local.UID
+		l := local.LocalData
+		// Users from SAML and OIDC providers don't have their details in Firebase
+		if u.Name == "" {
+			u.Name = l.Name
+		}
+		if u.Email == "" {
+			u.Email = l.Email
+		}
+		if u.Provider == "" {
+			u.Provider = l.Provider
+		}
+		authorized = l.ManuallyAuthorized
+		u.Deactivated = l.Deactivated
+		u.ExternalID = l.ExternalID
+		if l.Roles != nil {
+			u.Roles = l.Roles
+		}
+	}
+	u.ManuallyAuthorized = &authorized
+	u.TrustedDomain = TrustedDomain(u.Email, rt.TrustedDomains)
+	return u
+}
+
+// managedUsers returns every user known to Firebase Auth, if it authenticates users, or with local data, in UID order
+func (rt *Runtime) managedUsers(ctx context.Context) ([]*models.ManagedUser, error) {
+	local, err := rt.Store.ListUsers(ctx)
+	if err != nil {
+		return nil, err
+	}
+	byUID := map[string]*models.User{}
+	for _, u := range local {
+		byUID[u.UID] = u
+	}
+
+	users := []*models.ManagedUser{}
+	if client := rt.firebaseClient(); client != nil {
+		it := client.Users(ctx, "")
+		for {
+			record, err := it.Next()
+			if err == iterator.Done {
+				break
+			}
+			if err != nil {
+				log.WithContext(ctx).WithField("error", err).Error("Failed to list Firebase users")
+				return nil, fmt.Errorf("error retrieving users")
+			}
+			users = append(users, rt.managedUser(record.UserRecord, byUID[record.UID]))
+			delete(byUID, record.UID)
+		}
+	}
+	for _, u := range byUID {
+		users = append(users, rt.managedUser(nil, u))
+	}
+
+	sort.Slice(users, func(i, j int) bool { return *users[i].UID < *users[j].UID })
+	return users, nil
+}
+
+// filterManagedUsers returns the users that match all of the filters that are set
+func filterManagedUsers(users []*models.ManagedUser, provider, domain *string, authorized *bool) []*models.ManagedUser {
+	filtered := []*models.ManagedUser{}
+	for _, u := range users {
+		if provider != nil && u.Provider != *provider {
+			continue
+		}
+		if domain != nil && !strings.EqualFold(u.Email[strings.LastIndex(u.Email, "@")+1:], *domain) {
+			continue
+		}
+		if authorized != nil && *u.ManuallyAuthorized != *authorized {
+			continue
+		}
+		filtered = append(filtered, u)
+	}
+	return filtered
+}
+
+// pageManagedUsers returns up to size users following the one with the UID in pageToken, and the token of the next page if there is one.
+// The users must be in UID order.
+func pageManagedUsers(users []*models.ManagedUser, pageToken string, size int) ([]*models.ManagedUser, string) {
+	start := sort.Search(len(users), func(i int) bool { return *users[i].UID > pageToken })
+	end := start + size
+	if end >= len(users) {
+		return users[start:], ""
+	}
+	return users[start:end], *users[end-1].UID
+}
+
+// NewListUsersHandler creates a handler
+func NewListUsersHandler(rt *Runtime) operations.ListUsersHandler {
+	return &listUsersHandlerImp{rt: rt}
+}
+
+type listUsersHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listUsersHandlerImp) Handle(params operations.ListUsersParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListUsersDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+
+	users, err := h.rt.managedUsers(params.HTTPRequest.Context())
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	users = filterManagedUsers(users, params.Provider, params.Domain, params.Authorized)
+
+	size := defaultUserPageSize
+	if params.PageSize != nil {
+		size = int(*params.PageSize)
+	}
+	pageToken := ""
+	if params.PageToken != nil {
+		pageToken = *params.PageToken
+	}
+	page, next := pageManagedUsers(users, pageToken, size)
+	return &operations.ListUsersOK{Payload: &models.ManagedUserList{Users: page, NextPageToken: next}}
+}
+
+// NewGetUserHandler creates a handler
+func NewGetUserHandler(rt *Runtime) operations.GetUserHandler {
+	return &getUserHandlerImp{rt: rt}
+}
+
+type getUserHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *getUserHandlerImp) Handle(params operations.GetUserParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.GetUserDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+
+	record, local, found, err := h.rt.userRecords(params.HTTPRequest.Context(), params.UID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if !found {
+		return fail(404, "user not found")
+	}
+	return &operations.GetUserOK{Payload: h.rt.managedUser(record, local)}
+}
+
+// setUserAuthorization manually authorizes or deauthorizes the user, as `besec users authorize` and `deauthorize` do
+func setUserAuthorization(r *http.Request, rt *Runtime, principal *models.User, uid string, authorize bool, decision *models.AccessDecision) (*models.ManagedUser, int, string) {
+	ctx := r.Context()
+	logger := log.WithContext(ctx).WithFields(log.Fields{"user": uid, "by": principal.UID})
+
+	if !rt.Allowed(principal, AdminPermission) {
+		return nil, 403, forbidden(AdminPermission)
+	}
+	if uid == principal.UID && !authorize {
+		// Stop admins accidentally locking themselves out; another admin can do it for them
+		return nil, 400, "you can't deauthorize yourself"
+	}
+	if decision == nil {
+		decision = &models.AccessDecision{}
+	}
+
+	record, local, found, err := rt.userRecords(ctx, uid)
+	if err != nil {
+		return nil, 500, err.Error()
+	}
+	if !found {
+		return nil, 404, "user not found"
+	}
+	user := rt.managedUser(record, local)
+	if authorize && !user.TrustedDomain && !decision.Force {
+		return nil, 409, fmt.Sprintf("%v is not from a trusted domain; set force to authorize the user anyway", user.Email)
+	}
+
+	// Users provisioned over SCIM before they sign in aren't in Firebase yet
+	client := rt.firebaseClient()
+	if record == nil {
+		client = nil
+	}
+	if err = SetManuallyAuthorized(ctx, client, rt.Store, uid, authorize); err != nil {
+		logger.WithField("error", err).Error("Failed to set user authorization")
+		return nil, 500, "error saving authorized state"
+	}
+	if authorize && user.Deactivated {
+		local.LocalData.ManuallyAuthorized = true
+		local.LocalData.Deactivated = false
+		if err = rt.Store.SaveUserData(ctx, local); err != nil {
+			return nil, 500, err.Error()
+		}
+	}
+	if authorize {
+		if err = ResolveAccessRequest(ctx, rt.Store, uid, true, principal.UID, principal.Name, decision.Reason); err != nil {
+			logger.WithField("error", err).Warn("Failed to record approval of access request")
+		}
+	}
+	logger.WithField("authorized", authorize).Info("Updated user authorization")
+
+	record, local, _, err = rt.userRecords(ctx, uid)
+	if err != nil {
+		return nil, 500, err.Error()
+	}
+	updated := rt.managedUser(record, local)
+	action := "user.authorize"
+	if !authorize {
+		action = "user.deauthorize"
+	}
+	rt.audit(r, principal, action, uid, user, updated)
+	return updated, 200, ""
+}
+
+// NewAuthorizeUserHandler creates a handler
+func NewAuthorizeUserHandler(rt *Runtime) operations.AuthorizeUserHandler {
+	return &authorizeUserHandlerImp{rt: rt}
+}
+
+type authorizeUserHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *authorizeUserHandlerImp) Handle(params operations.AuthorizeUserParams, principal *models.User) middleware.Responder {
+	u, code, msg := setUserAuthorization(params.HTTPRequest, h.rt, principal, params.UID, true, params.Body)
+	if u == nil {
+		return operations.NewAuthorizeUserDefault(code).WithPayload(&models.Error{Message: &msg})
+	}
+	return &operations.AuthorizeUserOK{Payload: u}
+}
+
+// NewDeauthorizeUserHandler creates a handler
+func NewDeauthorizeUserHandler(rt *Runtime) operations.DeauthorizeUserHandler {
+	return &deauthorizeUserHandlerImp{rt: rt}
+}
+
+type deauthorizeUserHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *deauthorizeUserHandlerImp) Handle(params operations.DeauthorizeUserParams, principal *models.User) middleware.Responder {
+	u, code, msg := setUserAuthorization(params.HTTPRequest, h.rt, principal, params.UID, false, nil)
+	if u == nil {
+		return operations.NewDeauthorizeUserDefault(code).WithPayload(&models.Error{Message: &msg})
+	}
+	return &operations.DeauthorizeUserOK{Payload: u}
+}
+
+// NewRemoveUserHandler creates a handler
+func NewRemoveUserHandler(rt *Runtime) operations.RemoveUserHandler {
+	return &removeUserHandlerImp{rt: rt}
+}
+
+type removeUserHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *removeUserHandlerImp) Handle(params operations.RemoveUserParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.RemoveUserDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	ctx := params.HTTPRequest.Context()
+	logger := log.WithContext(ctx).WithFields(log.Fields{"user": params.UID, "by": principal.UID})
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+	if params.UID == principal.UID {
+		return fail(400, "you can't remove yourself")
+	}
+
+	record, local, found, err := h.rt.userRecords(ctx, params.UID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if !found {
+		return fail(404, "user not found")
+	}
+
+	if record != nil {
+		if err = h.rt.firebaseClient().DeleteUser(ctx, params.UID); err != nil {
+			logger.WithField("error", err).Error("Failed to delete user from Firebase")
+			return fail(500, "error deleting user")
+		}
+	}
+	tokens, err := h.rt.Store.ListAPITokens(ctx, params.UID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	for _, t := range tokens {
+		if err = h.rt.Store.DeleteAPIToken(ctx, t.ID); err != nil {
+			return fail(500, err.Error())
+		}
+	}
+	if err = h.rt.Store.SaveUserData(ctx, &models.User{UID: params.UID}); err != nil {
+		return fail(500, err.Error())
+	}
+
+	logger.WithField("revokedTokens", len(tokens)).Info("Removed user")
+	h.rt.audit(params.HTTPRequest, principal, "user.remove", params.UID, h.rt.managedUser(record, local), nil)
+	return &operations.RemoveUserNoContent{}
+}
@@ -0,0 +1,85 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+func managedUsersFixture() []*models.ManagedUser {
+	user := func(uid, email, provider string, authorized bool) *models.ManagedUser {
+		return &models.ManagedUser{UID: &uid, Email: email, Provider: provider, ManuallyAuthorized: &authorized}
+	}
+	return []*models.ManagedUser{
+		user("a", "a@example.com", "google.com", true),
+		user("b", "b@Example.com", "saml.corp", false),
+		user("c", "c@partner.net", "google.com", false),
+		user("d", "", "oidc", true),
+	}
+}
+
+func uids(users []*models.ManagedUser) []string {
+	ids := []string{}
+	for _, u := range users {
+		ids = append(ids, *u.UID)
+	}
+	return ids
+}
+
+func TestFilterManagedUsers(t *testing.T) {
+	google, domain, yes, no := "google.com", "example.com", true, false
+	cases := []struct {
+		name       string
+		provider   *string
+		domain     *string
+		authorized *bool
+		want       []string
+	}{
+		{"no filters", nil, nil, nil, []string{"a", "b", "c", "d"}},
+		{"provider", &google, nil, nil, []string{"a", "c"}},
+		{"domain", nil, &domain, nil, []string{"a", "b"}},
+		{"authorized", nil, nil, &yes, []string{"a", "d"}},
+		{"combined", &google, nil, &no, []string{"c"}},
+	}
+	for _, c := range cases {
+		got := uids(filterManagedUsers(managedUsersFixture(), c.provider, c.domain, c.authorized))
+		if len(got) != len(c.want) {
+			t.Errorf("%v: got %v, expected %v", c.name, got, c.want)
+			continue
+		}
+		for i := range got {
+			if got[i] != c.want[i] {
+				t.Errorf("%v: got %v, expected %v", c.name, got, c.want)
+				break
+			}
+		}
+	}
+}
+
+func TestPageManagedUsers(t *testing.T) {
+	users := managedUsersFixture()
+
+	seen := []string{}
+	token := ""
+	for pages := 0; ; pages++ {
+		if pages > len(users) {
+			t.Fatal("Paging didn't finish")
+		}
+		var page []*models.ManagedUser
+		page, token = pageManagedUsers(users, token, 3)
+		seen = append(seen, uids(page)...)
+		if token == "" {
+			break
+		}
+	}
+	if len(seen) != len(users) {
+		t.Errorf("Got %v across the pages, expected every user", seen)
+	}
+
+	if page, next := pageManagedUsers(users, "", 4); len(page) != 4 || next != "" {
+		t.Errorf("An exactly full page shouldn't have a next page, got %v and '%v'", uids(page), next)
+	}
+	if page, next := pageManagedUsers(users, "d", 2); len(page) != 0 || next != "" {
+		t.Errorf("Paging past the end should return nothing, got %v and '%v'", uids(page), next)
+	}
+}
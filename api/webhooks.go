@@ -0,0 +1,343 @@
+package api
+
+import (
+	"bytes"
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/url"
+	"strconv"
+	"strings"
+	"syscall"
+	"time"
+
+	"github.com/go-openapi/runtime/middleware"
+	"github.com/go-openapi/strfmt"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/api/restapi/operations"
+	"github.com/ThalesGroup/besec/store"
+)
+
+// Outbound webhooks are registered through the API rather than configured. Each one gets its own outbox messages,
+// on a channel named after it, and each request is signed with the webhook's secret:
+// X-BeSec-Signature is "sha256=" followed by the hex HMAC-SHA256 of X-BeSec-Timestamp, a full stop, and the body.
+const (
+	webhookChannelPrefix = "webhook:"
+	// EventWebhookTest is sent to a webhook by the test endpoint
+	EventWebhookTest = "webhook.test"
+)
+
+// webhookClient returns a client for webhook deliveries that refuses to connect to internal addresses, other than
+// those in the allowed networks, so that webhooks can't be used to reach services that aren't otherwise exposed.
+// Addresses are checked when connecting, after any redirects and DNS resolution.
+func webhookClient(allowed []*net.IPNet) *http.Client {
+	dialer := &net.Dialer{
+		Timeout: 10 * time.Second,
+		Control: func(network, address string, _ syscall.RawConn) error {
+			host, _, err := net.SplitHostPort(address)
+			if err != nil {
+				return err
+			}
+			if ip := net.ParseIP(host); ip == nil || !webhookAddressAllowed(ip, allowed) {
+				return fmt.Errorf("%w: webhooks can't be sent to the internal address %v", errUndeliverable, host)
+			}
+			return nil
+		},
+	}
+	return &http.Client{
+		Timeout: 30 * time.Second,
+		Transport: &http.Transport{
+			DialContext:         dialer.DialContext,
+			TLSHandshakeTimeout: 10 * time.Second,
+			DisableKeepAlives:   true, // each delivery gets a new client
+		},
+	}
+}
+
+// webhookAddressAllowed reports whether webhooks may be sent to the address: public addresses, and those in the allowed networks
+func webhookAddressAllowed(ip net.IP, allowed []*net.IPNet) bool {
+	for _, n := range allowed {
+		if n.Contains(ip) {
+			return true
+		}
+	}
+	return !(ip.IsLoopback() || ip.IsPrivate() || ip.IsLinkLocalUnicast() || ip.IsLinkLocalMulticast() ||
+		ip.IsInterfaceLocalMulticast() || ip.IsUnspecified() || ip.IsMulticast())
+}
+
+// webhookChannel returns the name of the outbox channel for the webhook
+func webhookChannel(id string) string {
+	return webhookChannelPrefix + id
+}
+
+// webhookID returns the webhook that an outbox channel is for, if it's a webhook channel
+func webhookID(channel string) (string, bool) {
+	if !strings.HasPrefix(channel, webhookChannelPrefix) {
+		return "", false
+	}
+	return strings.TrimPrefix(channel, webhookChannelPrefix), true
+}
+
+// webhookSignature returns the value of the signature header for a request sent at timestamp
+func webhookSignature(secret string, timestamp string, body []byte) string {
+	mac := hmac.New(sha256.New, []byte(secret))
+	mac.Write([]byte(timestamp + "."))
+	mac.Write(body)
+	return "sha256=" + hex.EncodeToString(mac.Sum(nil))
+}
+
+// postWebhook sends the body to the webhook, signed, returning the response status if there was one
+func postWebhook(ctx context.Context, client *http.Client, w *store.Webhook, deliveryID string, event string, body []byte) (int, error) {
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, w.URL, bytes.NewReader(body))
+	if err != nil {
+		return 0, err
+	}
+	timestamp := strconv.FormatInt(time.Now().Unix(), 10)
+	req.Header.Set("Content-Type", "application/json")
+	req.Header.Set("User-Agent", "BeSec-Webhook")
+	req.Header.Set("X-BeSec-Event", event)
+	req.Header.Set("X-BeSec-Delivery", deliveryID)
+	req.Header.Set("X-BeSec-Timestamp", timestamp)
+	req.Header.Set("X-BeSec-Signature", webhookSignature(w.Secret, timestamp, body))
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return 0, fmt.Errorf("failed to post to webhook: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode < 200 || resp.StatusCode > 299 {
+		respBody, _ := ioutil.ReadAll(io.LimitReader(resp.Body, 1024))
+		return resp.StatusCode, fmt.Errorf("got error response %v: %s", resp.StatusCode, respBody)
+	}
+	return resp.StatusCode, nil
+}
+
+// deliverWebhook posts the outbox message's notification to the webhook
+func (rt *Runtime) deliverWebhook(ctx context.Context, id string, m *store.OutboxMessage) error {
+	w, found, err := rt.Store.GetWebhook(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !found {
+		return fmt.Errorf("%w: webhook %v has been deleted", errUndeliverable, id)
+	}
+	_, err = postWebhook(ctx, webhookClient(rt.WebhookNetworks), w, m.ID, m.Event, []byte(m.Payload))
+	return err
+}
+
+func webhookModel(w *store.Webhook) *models.Webhook {
+	id, u := w.ID, w.URL
+	created := strfmt.DateTime(w.Created)
+	return &models.Webhook{
+		ID:          id,
+		URL:         &u,
+		Description: w.Description,
+		Events:      w.Events,
+		CreatedBy:   w.CreatedBy,
+		Created:     &created,
+	}
+}
+
+// NewListWebhooksHandler creates a handler
+func NewListWebhooksHandler(rt *Runtime) operations.ListWebhooksHandler {
+	return &listWebhooksHandlerImp{rt: rt}
+}
+
+type listWebhooksHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listWebhooksHandlerImp) Handle(params operations.ListWebhooksParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListWebhooksDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+	webhooks, err := h.rt.Store.ListWebhooks(params.HTTPRequest.Context())
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	payload := make([]*models.Webhook, len(webhooks))
+	for i, w := range webhooks {
+		payload[i] = webhookModel(w)
+	}
+	return &operations.ListWebhooksOK{Payload: payload}
+}
+
+// NewCreateWebhookHandler creates a handler
+func NewCreateWebhookHandler(rt *Runtime) operations.CreateWebhookHandler {
+	return &createWebhookHandlerImp{rt: rt}
+}
+
+type createWebhookHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *createWebhookHandlerImp) Handle(params operations.CreateWebhookParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.CreateWebhookDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+	u, err := url.Parse(*params.Body.URL)
+	if err != nil || (u.Scheme != "https" && u.Scheme != "http") || u.Host == "" {
+		return fail(400, "the webhook URL must be an absolute http or https URL")
+	}
+	// Names are checked when a delivery connects, as they may resolve differently by then
+	if ip := net.ParseIP(u.Hostname()); (ip != nil && !webhookAddressAllowed(ip, h.rt.WebhookNetworks)) || strings.EqualFold(u.Hostname(), "localhost") {
+		return fail(400, "webhooks can't be sent to internal addresses, unless they're in webhook-allowed-networks")
+	}
+
+	secret := make([]byte, 32)
+	if _, err = rand.Read(secret); err != nil {
+		return fail(500, "error generating webhook secret")
+	}
+	w := &store.Webhook{
+		URL:         u.String(),
+		Description: params.Body.Description,
+		Events:      params.Body.Events,
+		Secret:      hex.EncodeToString(secret),
+		CreatedBy:   principal.UID,
+		Created:     time.Now().UTC(),
+	}
+	w.ID, err = h.rt.Store.CreateWebhook(params.HTTPRequest.Context(), w)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	h.rt.audit(params.HTTPRequest, principal, "webhook.create", w.ID, nil, webhookModel(w))
+	return &operations.CreateWebhookCreated{Payload: &models.NewWebhook{Webhook: webhookModel(w), Secret: &w.Secret}}
+}
+
+// NewDeleteWebhookHandler creates a handler
+func NewDeleteWebhookHandler(rt *Runtime) operations.DeleteWebhookHandler {
+	return &deleteWebhookHandlerImp{rt: rt}
+}
+
+type deleteWebhookHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *deleteWebhookHandlerImp) Handle(params operations.DeleteWebhookParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.DeleteWebhookDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+	ctx := params.HTTPRequest.Context()
+	w, found, err := h.rt.Store.GetWebhook(ctx, params.ID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if !found {
+		return fail(404, "webhook not found")
+	}
+	if err = h.rt.Store.DeleteWebhook(ctx, params.ID); err != nil {
+		return fail(500, err.Error())
+	}
+	h.rt.audit(params.HTTPRequest, principal, "webhook.delete", params.ID, webhookModel(w), nil)
+	return &operations.DeleteWebhookNoContent{}
+}
+
+// NewTestWebhookHandler creates a handler
+func NewTestWebhookHandler(rt *Runtime) operations.TestWebhookHandler {
+	return &testWebhookHandlerImp{rt: rt}
+}
+
+type testWebhookHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *testWebhookHandlerImp) Handle(params operations.TestWebhookParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.TestWebhookDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+	ctx := params.HTTPRequest.Context()
+	w, found, err := h.rt.Store.GetWebhook(ctx, params.ID)
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	if !found {
+		return fail(404, "webhook not found")
+	}
+
+	body, err := json.Marshal(&Notification{
+		Event:   EventWebhookTest,
+		Title:   "Test delivery",
+		Subject: w.Description,
+		Fields:  []NotificationField{{Name: "Requested by", Value: principal.Name}},
+		Time:    time.Now().UTC(),
+	})
+	if err != nil {
+		return fail(500, "error encoding test event")
+	}
+	id := make([]byte, 16)
+	if _, err = rand.Read(id); err != nil {
+		return fail(500, "error generating delivery ID")
+	}
+
+	delivered := true
+	status, err := postWebhook(ctx, webhookClient(h.rt.WebhookNetworks), w, "test-"+hex.EncodeToString(id), EventWebhookTest, body)
+	result := &models.WebhookTestResult{Delivered: &delivered, StatusCode: int64(status)}
+	if err != nil {
+		delivered = false
+		result.Error = err.Error()
+	}
+	h.rt.audit(params.HTTPRequest, principal, "webhook.test", params.ID, nil, result)
+	return &operations.TestWebhookOK{Payload: result}
+}
+
+// NewListWebhookDeliveriesHandler creates a handler
+func NewListWebhookDeliveriesHandler(rt *Runtime) operations.ListWebhookDeliveriesHandler {
+	return &listWebhookDeliveriesHandlerImp{rt: rt}
+}
+
+type listWebhookDeliveriesHandlerImp struct {
+	rt *Runtime
+}
+
+func (h *listWebhookDeliveriesHandlerImp) Handle(params operations.ListWebhookDeliveriesParams, principal *models.User) middleware.Responder {
+	fail := func(code int, msg string) middleware.Responder {
+		r := operations.ListWebhookDeliveriesDefault{}
+		return r.WithStatusCode(code).WithPayload(&models.Error{Message: &msg})
+	}
+
+	if !h.rt.Allowed(principal, AdminPermission) {
+		return fail(403, forbidden(AdminPermission))
+	}
+	limit := 100
+	if params.Limit != nil {
+		limit = int(*params.Limit)
+	}
+	messages, err := h.rt.Store.ListNotifications(params.HTTPRequest.Context(), store.OutboxQuery{Channel: webhookChannel(params.ID), Limit: limit})
+	if err != nil {
+		return fail(500, err.Error())
+	}
+	payload := make([]*models.OutboxMessage, len(messages))
+	for i, m := range messages {
+		payload[i] = outboxMessageModel(m)
+	}
+	return &operations.ListWebhookDeliveriesOK{Payload: payload}
+}
@@ -0,0 +1,110 @@
+package api
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io/ioutil"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/store"
+)
+
+func TestWebhookDelivery(t *testing.T) {
+	type request struct {
+		header http.Header
+		body   []byte
+	}
+	var received []request
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		body, _ := ioutil.ReadAll(r.Body)
+		received = append(received, request{r.Header, body})
+	}))
+	defer srv.Close()
+
+	router, err := NewNotificationRouter(nil, nil, "")
+	if err != nil {
+		t.Fatal(err)
+	}
+	hook := &store.Webhook{ID: "w1", URL: srv.URL, Events: []string{"plan.*"}, Secret: "s3cret"}
+	st := newMemStore()
+	st.webhooks = []*store.Webhook{hook}
+	_, loopback, _ := net.ParseCIDR("127.0.0.0/8")
+	rt := &Runtime{Store: st, Notifications: router, notifyWake: make(chan struct{}, 1), WebhookNetworks: []*net.IPNet{loopback}}
+
+	rt.notify(&Notification{Event: EventPlanDeleted, Title: "Plan deleted", Data: map[string]string{"planId": "p1"}})
+	sendAlert(EventUserFirstSignIn, "First sign-in", rt, &models.User{UID: "u1"})
+	if len(st.messages) != 1 {
+		t.Fatalf("expected a single message for the webhook, got %v", st.messages)
+	}
+
+	rt.deliverNotifications(context.Background(), time.Now().UTC().Add(time.Minute))
+	if len(received) != 1 {
+		t.Fatalf("webhook received %v requests", len(received))
+	}
+	h := received[0].header
+	if h.Get("X-BeSec-Event") != EventPlanDeleted || h.Get("X-BeSec-Delivery") == "" {
+		t.Errorf("unexpected headers: %v", h)
+	}
+	if want := webhookSignature("s3cret", h.Get("X-BeSec-Timestamp"), received[0].body); h.Get("X-BeSec-Signature") != want {
+		t.Errorf("signature %v, want %v", h.Get("X-BeSec-Signature"), want)
+	}
+	var n Notification
+	if err = json.Unmarshal(received[0].body, &n); err != nil || n.Event != EventPlanDeleted || n.Data.(map[string]interface{})["planId"] != "p1" {
+		t.Errorf("unexpected payload %s: %v", received[0].body, err)
+	}
+
+	// Messages for a deleted webhook aren't retried
+	rt.notify(&Notification{Event: EventPlanCommitted, Title: "Plan committed"})
+	st.webhooks = nil
+	rt.deliverNotifications(context.Background(), time.Now().UTC().Add(time.Minute))
+	failed := 0
+	for _, m := range st.messages {
+		if m.Status == store.OutboxFailed && m.Attempts == 1 {
+			failed++
+		}
+	}
+	if failed != 1 || len(received) != 1 {
+		t.Errorf("message for a deleted webhook wasn't abandoned: %v", st.messages)
+	}
+}
+
+func TestWebhookInternalAddresses(t *testing.T) {
+	requests := 0
+	srv := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) { requests++ }))
+	defer srv.Close()
+
+	hook := &store.Webhook{ID: "w1", URL: srv.URL, Secret: "s3cret"}
+	_, err := postWebhook(context.Background(), webhookClient(nil), hook, "d1", EventWebhookTest, []byte("{}"))
+	if !errors.Is(err, errUndeliverable) || requests != 0 {
+		t.Errorf("webhook was sent to a loopback address: %v", err)
+	}
+
+	_, private, _ := net.ParseCIDR("10.1.0.0/16")
+	cases := []struct {
+		ip      string
+		allowed bool
+	}{
+		{"93.184.216.34", true},
+		{"2606:2800:220:1::1", true},
+		{"127.0.0.1", false},
+		{"::1", false},
+		{"10.0.0.1", false},
+		{"10.1.2.3", true},
+		{"192.168.1.1", false},
+		{"169.254.169.254", false},
+		{"fe80::1", false},
+		{"::ffff:127.0.0.1", false},
+		{"0.0.0.0", false},
+	}
+	for _, c := range cases {
+		if got := webhookAddressAllowed(net.ParseIP(c.ip), []*net.IPNet{private}); got != c.allowed {
+			t.Errorf("webhookAddressAllowed(%v) = %v, want %v", c.ip, got, c.allowed)
+		}
+	}
+}
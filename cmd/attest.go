@@ -0,0 +1,175 @@
+package cmd
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"io/ioutil"
+	"sort"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ThalesGroup/besec/api/client/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// attestCmd is a parent command for working with signed plan attestations
+type attestCmd struct {
+	*cobra.Command
+}
+
+func newAttestCmd() *attestCmd {
+	ac := &attestCmd{}
+
+	ac.Command = &cobra.Command{
+		Use:   "attest",
+		Short: "Export and verify signed plan attestations",
+		Long: `A committed plan revision can be exported as an in-toto statement signed in a DSSE envelope, as evidence of a
+project's SDLC maturity. The statement includes the plan's projects, date, practices version, maturity for each
+practice, and a digest of the full responses. The server signs attestations with an ed25519 key, configured with
+attestation-key or attestation-key-name.`,
+	}
+
+	ac.AddCommand(ac.newKeygenCmd())
+	ac.AddCommand(ac.newExportCmd())
+	ac.AddCommand(ac.newVerifyCmd())
+	return ac
+}
+
+func (ac *attestCmd) newKeygenCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "keygen [private key file] [public key file]",
+		Short: "Generate a key pair for signing attestations",
+		Long: `Write a new PEM-encoded ed25519 private key, to configure the server with, and the public key to give to
+whoever verifies attestations.`,
+		Args: cobra.ExactArgs(2),
+		Run: func(cmd *cobra.Command, args []string) {
+			_, key, err := ed25519.GenerateKey(rand.Reader)
+			if err != nil {
+				log.Fatalf("Error generating key: %v", err)
+			}
+			private, public, err := lib.MarshalKeysPEM(key)
+			if err != nil {
+				log.Fatalf("Error encoding key: %v", err)
+			}
+			if err = ioutil.WriteFile(args[0], private, 0600); err != nil {
+				log.Fatalf("Error writing private key: %v", err)
+			}
+			if err = ioutil.WriteFile(args[1], public, 0644); err != nil { //nolint: gosec // it's a public key
+				log.Fatalf("Error writing public key: %v", err)
+			}
+			fmt.Printf("Generated key %v\n", lib.KeyID(key.Public().(ed25519.PublicKey)))
+		},
+	}
+}
+
+func (ac *attestCmd) newExportCmd() *cobra.Command {
+	export := &cobra.Command{
+		Use:   "export [plan ID] [revision ID]",
+		Short: "Export a committed plan revision as a signed attestation from a running instance",
+		Args:  cobra.ExactArgs(2),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			// Bound here rather than when the command is created, so as not to steal the demo command's binding
+			if err := viper.BindPFlag(endpointFlagName, cmd.Flags().Lookup(endpointFlagName)); err != nil {
+				log.Fatalf("Error binding viper flag: %v", err)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			output, err := cmd.Flags().GetString("output")
+			if err != nil {
+				panic(err)
+			}
+
+			c, authInfo := newAPIClient()
+			params := operations.NewGetPlanRevisionAttestationParams().WithID(args[0]).WithRevID(args[1])
+			resp, err := c.Operations.GetPlanRevisionAttestation(params, authInfo)
+			if err != nil {
+				log.Fatal(apiError("exporting attestation", err))
+			}
+			envelope, err := json.MarshalIndent(resp.Payload, "", "  ")
+			if err != nil {
+				log.Fatalf("Error encoding attestation: %v", err)
+			}
+			envelope = append(envelope, '\n')
+
+			if output == "" {
+				fmt.Print(string(envelope))
+				return
+			}
+			if err = ioutil.WriteFile(output, envelope, 0644); err != nil { //nolint: gosec // attestations are meant to be shared
+				log.Fatalf("Error writing attestation: %v", err)
+			}
+		},
+	}
+	export.Flags().StringP(endpointFlagName, "e", defaultEndpoint, endpointFlagUsage)
+	export.Flags().StringP("output", "o", "", "File to write the attestation to, instead of stdout")
+	return export
+}
+
+func (ac *attestCmd) newVerifyCmd() *cobra.Command {
+	verify := &cobra.Command{
+		Use:   "verify [attestation file]",
+		Short: "Verify the signature on a plan attestation and show what it attests to",
+		Long:  "Verification is done offline, against the public key that the attestation should have been signed with.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			keyFile, err := cmd.Flags().GetString("key")
+			if err != nil {
+				panic(err)
+			}
+			keyPEM, err := ioutil.ReadFile(keyFile) //nolint: gosec // reading a user-specified file is the point
+			if err != nil {
+				log.Fatalf("Error reading public key: %v", err)
+			}
+			pub, err := lib.ParsePublicKeyPEM(keyPEM)
+			if err != nil {
+				log.Fatalf("Invalid public key: %v", err)
+			}
+
+			raw, err := ioutil.ReadFile(args[0]) //nolint: gosec // reading a user-specified file is the point
+			if err != nil {
+				log.Fatalf("Error reading attestation: %v", err)
+			}
+			env := new(lib.Envelope)
+			if err = json.Unmarshal(raw, env); err != nil {
+				log.Fatalf("%v isn't a DSSE envelope: %v", args[0], err)
+			}
+			s, err := env.Verify(pub)
+			if err != nil {
+				log.Fatalf("%v is NOT valid: %v", args[0], err)
+			}
+			printStatement(s)
+		},
+	}
+	verify.Flags().String("key", "", "The PEM-encoded ed25519 public key of the server that signed the attestation")
+	if err := verify.MarkFlagRequired("key"); err != nil {
+		panic(err)
+	}
+	return verify
+}
+
+func printStatement(s *lib.Statement) {
+	p := s.Predicate
+	fmt.Printf("Valid attestation of revision %v of plan %v\n", p.RevisionID, p.PlanID)
+	for _, proj := range p.Projects {
+		fmt.Printf("Project:           %v (%v)\n", proj.Name, proj.ID)
+	}
+	fmt.Printf("Date:              %v\n", p.Date)
+	fmt.Printf("Practices version: %v\n", p.PracticesVersion)
+	fmt.Printf("Responses digest:  sha256:%v\n", p.ResponsesDigest["sha256"])
+	if p.RevisionHash != "" {
+		fmt.Printf("Revision hash:     %v\n", p.RevisionHash)
+	}
+	fmt.Println("Maturity:")
+	practices := make([]string, 0, len(p.Maturity))
+	for id := range p.Maturity {
+		practices = append(practices, id)
+	}
+	sort.Strings(practices)
+	for _, id := range practices {
+		fmt.Printf("  %v: %v\n", id, p.Maturity[id])
+	}
+}
@@ -0,0 +1,111 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"os/user"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ThalesGroup/besec/api"
+	"github.com/ThalesGroup/besec/api/client"
+	"github.com/ThalesGroup/besec/api/client/operations"
+	"github.com/ThalesGroup/besec/store"
+)
+
+// cliAudit records a change made by a CLI command in the audit log.
+// The actor is the local user running the command, as the store is accessed with a shared service account.
+func cliAudit(st store.Store, action string, targetID string, before, after interface{}) {
+	name := "unknown"
+	if u, err := user.Current(); err == nil {
+		name = u.Username
+	}
+	actor := "cli:" + name
+	if sa := viper.GetString(serviceAccountFlagName); sa != "" {
+		name += " as " + sa
+	}
+	api.RecordAudit(context.Background(), st, &store.AuditEvent{Actor: actor, ActorName: name, Action: action, TargetID: targetID}, before, after)
+}
+
+// auditCmd queries the audit log through the API
+type auditCmd struct {
+	*cobra.Command
+	client   *client.Besec
+	authInfo runtime.ClientAuthInfoWriter
+}
+
+func newAuditCmd(rc *rootCmd) *auditCmd {
+	ac := &auditCmd{}
+
+	ac.Command = &cobra.Command{
+		Use:   "audit",
+		Short: "Show the audit log of changes",
+		Long: `Show who changed what, and when, most recent first. Requires the audit permission.
+Actions are named after what they act on, e.g. project.create, plan.delete, user.authorize or practices.publish.`,
+		Args: cobra.NoArgs,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			rc.PersistentPreRun(cmd, args)
+			// Bound here rather than when the command is created, so as not to steal the demo command's binding
+			if err := viper.BindPFlag(endpointFlagName, cmd.Flags().Lookup(endpointFlagName)); err != nil {
+				log.Fatalf("Error binding viper flag: %v", err)
+			}
+			ac.client, ac.authInfo = newAPIClient()
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			ac.list(cmd)
+		},
+	}
+	ac.PersistentFlags().StringP(endpointFlagName, "e", defaultEndpoint, endpointFlagUsage)
+	ac.Flags().String("actor", "", "Only show changes made by this UID")
+	ac.Flags().String("action", "", "Only show this action, e.g. project.delete")
+	ac.Flags().String("target-type", "", "Only show changes to this type of thing, e.g. project")
+	ac.Flags().String("target", "", "Only show changes to the thing with this ID")
+	ac.Flags().Duration("since", 0, "Only show changes made within this long, e.g. 72h")
+	ac.Flags().Int64("limit", 100, "The maximum number of changes to show")
+	ac.Flags().Bool("details", false, "Show the summaries of the targets before and after each change")
+	return ac
+}
+
+func (ac *auditCmd) list(cmd *cobra.Command) {
+	params := operations.NewListAuditEventsParams()
+	for flag, param := range map[string]**string{"actor": &params.Actor, "action": &params.Action, "target-type": &params.TargetType, "target": &params.TargetID} {
+		if v, _ := cmd.Flags().GetString(flag); v != "" {
+			value := v
+			*param = &value
+		}
+	}
+	if since, _ := cmd.Flags().GetDuration("since"); since > 0 {
+		t := strfmt.DateTime(time.Now().Add(-since))
+		params.Since = &t
+	}
+	limit, _ := cmd.Flags().GetInt64("limit")
+	params.Limit = &limit
+	details, _ := cmd.Flags().GetBool("details")
+
+	resp, err := ac.client.Operations.ListAuditEvents(params, ac.authInfo)
+	if err != nil {
+		log.Fatal(apiError("listing audit events", err))
+	}
+
+	w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+	fmt.Fprintln(w, "Time\tActor\tAction\tTarget\tRequest")
+	for _, e := range resp.Payload {
+		fmt.Fprintf(w, "%v\t%v (%v)\t%v\t%v\t%v\n", formatTime(e.Time, ""), e.ActorName, *e.Actor, *e.Action, e.TargetID, e.RequestID)
+		if details {
+			if e.Before != "" {
+				fmt.Fprintf(w, "\tbefore: %v\n", e.Before)
+			}
+			if e.After != "" {
+				fmt.Fprintf(w, "\tafter: %v\n", e.After)
+			}
+		}
+	}
+	w.Flush()
+}
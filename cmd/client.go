@@ -0,0 +1,57 @@
+package cmd
+
+import (
+	"fmt"
+	"net/url"
+	"strings"
+
+	"github.com/go-openapi/runtime"
+	httptransport "github.com/go-openapi/runtime/client"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/viper"
+
+	"github.com/ThalesGroup/besec/api/client"
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+const endpointFlagName = "endpoint"
+const defaultEndpoint = "http://localhost:8080"
+const endpointFlagUsage = "The URL of the besec serve instance to interact with"
+
+// newAPIClient creates an API client for the configured endpoint, authenticating with the configured access token
+func newAPIClient() (*client.Besec, runtime.ClientAuthInfoWriter) {
+	endpoint := viper.GetString(endpointFlagName)
+	if endpoint == "" {
+		log.Fatal("A URL endpoint for a running instance of `besec serve` must be provided, for example http://localhost:8080")
+	}
+	url, err := url.Parse(endpoint)
+	if err != nil {
+		log.Fatalf("Cannot parse endpoint URL: %v", err)
+	}
+	cfg := client.TransportConfig{Host: url.Host, Schemes: []string{url.Scheme}, BasePath: client.DefaultBasePath}
+
+	token := viper.GetString("access-token")
+	if token == "" {
+		log.Warn("No access token found. To operate on endpoints that require authentication, set your OAuth token via the BESEC_ACCESS_TOKEN environment variable or access-token config entry")
+	}
+	return client.NewHTTPClientWithConfig(nil, &cfg), httptransport.BearerToken(token)
+}
+
+// apiError describes an error from an API client operation, including the server's message if there is one.
+// If a plan couldn't be committed, each commit policy violation is listed on its own line instead.
+func apiError(action string, err error) error {
+	if ae, ok := err.(interface {
+		Code() int
+		GetPayload() *models.Error
+	}); ok && ae.GetPayload() != nil && ae.GetPayload().Message != nil {
+		if violations := ae.GetPayload().Violations; len(violations) > 0 {
+			lines := make([]string, len(violations))
+			for i, v := range violations {
+				lines[i] = fmt.Sprintf("  %v: %v", v.Rule, v.Message)
+			}
+			return fmt.Errorf("Error %v [%v]: the plan breaks the commit policy:\n%v", action, ae.Code(), strings.Join(lines, "\n"))
+		}
+		return fmt.Errorf("Error %v [%v]: %v", action, ae.Code(), *ae.GetPayload().Message)
+	}
+	return fmt.Errorf("Error %v: %v", action, err)
+}
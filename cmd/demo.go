@@ -3,11 +3,9 @@ package cmd
 import (
 	"fmt"
 	"io/ioutil"
-	"net/url"
 	"path/filepath"
 
 	"github.com/go-openapi/runtime"
-	httptransport "github.com/go-openapi/runtime/client"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
@@ -42,24 +40,9 @@ func newDemoCmd() *demoCmd {
 If remove is specified, remove any projects that have the same name and plan dates as demo projects.`,
 		Run: func(cmd *cobra.Command, args []string) {
 			checkEmulator()
-			endpoint := viper.GetString("endpoint")
-			if endpoint == "" {
-				log.Fatal("A URL endpoint for a running instance of `besec serve` must be provided, for example http://localhost:8080")
-			}
-			url, err := url.Parse(endpoint)
-			if err != nil {
-				log.Fatalf("Cannot parse endpoint URL: %v", err)
-			}
-			cfg := client.TransportConfig{Host: url.Host, Schemes: []string{url.Scheme}, BasePath: client.DefaultBasePath}
-			dc.Client = client.NewHTTPClientWithConfig(nil, &cfg)
-
-			token := viper.GetString("access-token")
-			if token == "" {
-				log.Warn("No access token found. To operate on endpoints that require authentication, set your OAuth token via the BESEC_ACCESS_TOKEN environment variable or access-token config entry")
-			}
-			dc.AuthInfo = httptransport.BearerToken(token)
+			dc.Client, dc.AuthInfo = newAPIClient()
 
-			if err = dc.readDemoData(viper.GetString("demo-dir")); err != nil {
+			if err := dc.readDemoData(viper.GetString("demo-dir")); err != nil {
 				log.Fatalf("Error reading demo data: %v", err)
 			}
 
@@ -83,8 +66,8 @@ If remove is specified, remove any projects that have the same name and plan dat
 		log.Fatalf("Error binding viper flag: %v", err)
 	}
 
-	dc.PersistentFlags().StringP("endpoint", "e", "http://localhost:8080", "The URL of the besec serve instance to interact with")
-	err = viper.BindPFlag("endpoint", dc.PersistentFlags().Lookup("endpoint"))
+	dc.PersistentFlags().StringP(endpointFlagName, "e", defaultEndpoint, endpointFlagUsage)
+	err = viper.BindPFlag(endpointFlagName, dc.PersistentFlags().Lookup(endpointFlagName))
 	if err != nil {
 		log.Fatalf("Error binding viper flag: %v", err)
 	}
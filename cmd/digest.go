@@ -0,0 +1,115 @@
+package cmd
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+
+	"github.com/ThalesGroup/besec/api"
+	"github.com/ThalesGroup/besec/store"
+)
+
+const digestsFlagName = "digests"
+
+// digestCmd previews and sends summaries of assessment activity
+type digestCmd struct {
+	*cobra.Command
+	store store.Store
+}
+
+func newDigestCmd(rc *rootCmd) *digestCmd {
+	dc := &digestCmd{}
+
+	dc.Command = &cobra.Command{
+		Use:   "digest",
+		Short: "Summarise the plans committed, maturity changes and overdue projects over a period",
+		Long: `A digest covers the plans committed in a period, the practices whose maturity changed, the projects that
+regressed, the projects that are overdue at the end of it, and the tasks most commonly prioritised.
+'besec serve' sends the digests listed in its digests setting as each period ends; alternatively, run
+'besec digest send' from cron.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			rc.PersistentPreRun(cmd, args)
+			dc.store = initStore()
+			checkEmulator()
+		},
+	}
+
+	dc.AddCommand(dc.newPreviewCmd())
+	dc.AddCommand(dc.newSendCmd())
+	return dc
+}
+
+// runtime returns a runtime for building and sending digests
+func (dc *digestCmd) runtime() *api.Runtime {
+	router, err := notificationRouter(dc.store)
+	if err != nil {
+		log.Fatalf("Invalid %v: %v", notificationsKey, err)
+	}
+	return api.NewRuntime(dc.store, nil, api.ExtendedAuthConfig{}, false, false, router, api.RuntimeOptions{Reminders: reminderConfig()})
+}
+
+func (dc *digestCmd) newPreviewCmd() *cobra.Command {
+	var period string
+	var since time.Duration
+	var asJSON bool
+
+	cmd := &cobra.Command{
+		Use:   "preview",
+		Short: "Print a digest without sending it",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			now := time.Now().UTC()
+			from, to := now.Add(-since), now
+			if since == 0 {
+				var err error
+				if from, to, err = api.DigestPeriod(period, now); err != nil {
+					log.Fatal(err)
+				}
+			} else {
+				period = ""
+			}
+
+			d, err := api.BuildDigest(context.Background(), dc.runtime(), from, to)
+			if err != nil {
+				log.Fatalf("Error building digest: %v", err)
+			}
+			d.Period = period
+			if asJSON {
+				out, err := json.MarshalIndent(d, "", "  ")
+				if err != nil {
+					log.Fatalf("Error encoding digest: %v", err)
+				}
+				fmt.Println(string(out))
+				return
+			}
+			text, err := api.NotificationText(d.Notification())
+			if err != nil {
+				log.Fatalf("Error formatting digest: %v", err)
+			}
+			fmt.Print(text)
+		},
+	}
+	cmd.Flags().StringVar(&period, "period", api.DigestWeekly, "Summarise the last complete week or month: weekly or monthly")
+	cmd.Flags().DurationVar(&since, "since", 0, "Summarise this long up to now instead, e.g. 72h")
+	cmd.Flags().BoolVar(&asJSON, "json", false, "Print the digest as JSON, as sent to webhooks")
+	return cmd
+}
+
+func (dc *digestCmd) newSendCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "send weekly|monthly...",
+		Short: "Send the digest for the last complete period, if it hasn't been sent already",
+		Long: `The report.digest event is sent to the notification channels and webhooks it's routed to. The notifications
+are delivered by the running server.`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if err := api.SendDigests(context.Background(), dc.runtime(), args, time.Now().UTC()); err != nil {
+				log.Fatalf("Error sending digests: %v", err)
+			}
+		},
+	}
+}
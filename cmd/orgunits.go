@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"sort"
+	"strings"
+	"text/tabwriter"
+
+	"github.com/go-openapi/runtime"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ThalesGroup/besec/api/client"
+	"github.com/ThalesGroup/besec/api/client/operations"
+	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// ouCmd is a parent command for managing the org unit tree through the API, so that unit leads can manage their own part of it
+type ouCmd struct {
+	*cobra.Command
+	client   *client.Besec
+	authInfo runtime.ClientAuthInfoWriter
+}
+
+func newOrgUnitsCmd(rc *rootCmd) *ouCmd {
+	oc := &ouCmd{}
+
+	oc.Command = &cobra.Command{
+		Use:     "orgunits",
+		Aliases: []string{"ou"},
+		Short:   "Manage the tree of org units that projects belong to",
+		Long: `Org units, such as business units and product lines, form a tree that projects belong to.
+Security admins can manage the whole tree; the leads of a unit can manage it and the units below it.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			rc.PersistentPreRun(cmd, args)
+			// Bound here rather than when the command is created, so as not to steal the demo command's binding
+			if err := viper.BindPFlag(endpointFlagName, cmd.Flags().Lookup(endpointFlagName)); err != nil {
+				log.Fatalf("Error binding viper flag: %v", err)
+			}
+			oc.client, oc.authInfo = newAPIClient()
+		},
+	}
+	oc.PersistentFlags().StringP(endpointFlagName, "e", defaultEndpoint, endpointFlagUsage)
+
+	oc.AddCommand(oc.newListCmd())
+	oc.AddCommand(oc.newCreateCmd())
+	oc.AddCommand(oc.newUpdateCmd())
+	oc.AddCommand(oc.newDeleteCmd())
+	oc.AddCommand(oc.newMaturityCmd())
+	return oc
+}
+
+func (oc *ouCmd) listOrgUnits() map[string]*models.OrgUnit {
+	resp, err := oc.client.Operations.ListOrgUnits(operations.NewListOrgUnitsParams(), oc.authInfo)
+	if err != nil {
+		log.Fatal(apiError("listing org units", err))
+	}
+	units := map[string]*models.OrgUnit{}
+	for _, u := range resp.Payload {
+		units[u.ID] = u
+	}
+	return units
+}
+
+// sortedTree returns the unit IDs in tree order, and the depth of each unit in the tree
+func sortedTree(units map[string]*models.OrgUnit) ([]string, map[string]int) {
+	parents := map[string]string{}
+	for id, u := range units {
+		parents[id] = u.Attributes.Parent
+	}
+	ids := lib.DepthFirst(parents, func(a, b string) bool { return *units[a].Attributes.Name < *units[b].Attributes.Name })
+	depths := map[string]int{}
+	for _, id := range ids {
+		depths[id] = len(lib.Ancestors(parents, id))
+	}
+	return ids, depths
+}
+
+func (oc *ouCmd) newListCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "list",
+		Short: "Show the org unit tree",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			units := oc.listOrgUnits()
+			ids, depths := sortedTree(units)
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+			fmt.Fprintln(w, "Name\tID\tLeads")
+			for _, id := range ids {
+				u := units[id].Attributes
+				fmt.Fprintf(w, "%v%v\t%v\t%v\n", strings.Repeat("  ", depths[id]), *u.Name, id, strings.Join(u.Leads, ", "))
+			}
+			w.Flush()
+		},
+	}
+}
+
+// addDetailsFlags adds flags for each of the org unit's attributes
+func addDetailsFlags(cmd *cobra.Command) {
+	cmd.Flags().String("name", "", "The unit's name")
+	cmd.Flags().String("description", "", "A description of the unit")
+	cmd.Flags().String("parent", "", "The ID of the parent unit; leave empty for a top-level unit")
+	cmd.Flags().StringSlice("lead", []string{}, "The UID of a security lead for the unit (repeat for more than one)")
+	cmd.Flags().Int64("cadence-days", -1, "How often, in days, the unit's projects should commit a new plan; -1 to inherit the cadence from above")
+}
+
+// applyDetailsFlags updates the details with any flags that have been set
+func applyDetailsFlags(cmd *cobra.Command, d *models.OrgUnitDetails) {
+	var err error
+	if cmd.Flags().Changed("name") {
+		name, _ := cmd.Flags().GetString("name")
+		d.Name = &name
+	}
+	if cmd.Flags().Changed("description") {
+		d.Description, err = cmd.Flags().GetString("description")
+	}
+	if err == nil && cmd.Flags().Changed("parent") {
+		d.Parent, err = cmd.Flags().GetString("parent")
+	}
+	if err == nil && cmd.Flags().Changed("lead") {
+		d.Leads, err = cmd.Flags().GetStringSlice("lead")
+	}
+	if err == nil && cmd.Flags().Changed("cadence-days") {
+		var days int64
+		if days, err = cmd.Flags().GetInt64("cadence-days"); days < 0 {
+			d.CadenceDays = nil
+		} else {
+			d.CadenceDays = &days
+		}
+	}
+	if err != nil {
+		panic(err)
+	}
+}
+
+func (oc *ouCmd) newCreateCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create an org unit",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := args[0]
+			details := &models.OrgUnitDetails{Name: &name}
+			applyDetailsFlags(cmd, details)
+
+			resp, err := oc.client.Operations.CreateOrgUnit(operations.NewCreateOrgUnitParams().WithBody(details), oc.authInfo)
+			if err != nil {
+				log.Fatal(apiError("creating org unit", err))
+			}
+			fmt.Printf("Created org unit %v\n", resp.Payload)
+		},
+	}
+	addDetailsFlags(c)
+	return c
+}
+
+func (oc *ouCmd) newUpdateCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "update ID",
+		Short: "Change an org unit's name, description, parent, or leads",
+		Long:  "Only the attributes given as flags are changed. --lead replaces the existing leads.",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			resp, err := oc.client.Operations.GetOrgUnit(operations.NewGetOrgUnitParams().WithID(args[0]), oc.authInfo)
+			if err != nil {
+				log.Fatal(apiError("retrieving org unit", err))
+			}
+			details := resp.Payload.Attributes
+			applyDetailsFlags(cmd, details)
+
+			if _, err = oc.client.Operations.UpdateOrgUnit(operations.NewUpdateOrgUnitParams().WithID(args[0]).WithBody(details), oc.authInfo); err != nil {
+				log.Fatal(apiError("updating org unit", err))
+			}
+			fmt.Printf("Updated org unit %v\n", args[0])
+		},
+	}
+	addDetailsFlags(c)
+	return c
+}
+
+func (oc *ouCmd) newDeleteCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "delete ID",
+		Short: "Delete an org unit, which must not have any child units or projects",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if _, err := oc.client.Operations.DeleteOrgUnit(operations.NewDeleteOrgUnitParams().WithID(args[0]), oc.authInfo); err != nil {
+				log.Fatal(apiError("deleting org unit", err))
+			}
+			fmt.Printf("Deleted org unit %v\n", args[0])
+		},
+	}
+}
+
+func (oc *ouCmd) newMaturityCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "maturity [ID]",
+		Short: "Show the maturity of each org unit's projects, or just those below the specified unit",
+		Long: `For each practice, show the median and minimum maturity level of the projects in each unit and the units below it.
+Each project's maturity comes from the most recent committed revision of its plans.`,
+		Args: cobra.MaximumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			params := operations.NewGetMaturityMetricsParams()
+			if len(args) == 1 {
+				params = params.WithOrgUnit(&args[0])
+			}
+			resp, err := oc.client.Operations.GetMaturityMetrics(params, oc.authInfo)
+			if err != nil {
+				log.Fatal(apiError("retrieving maturity metrics", err))
+			}
+
+			// The units are returned in tree order; indent each under its parent
+			depths := map[string]int{}
+			w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+			fmt.Fprintln(w, "Org unit\tProjects\tPractice\tMedian\tMin\tProjects assessed")
+			for _, m := range resp.Payload {
+				depth := 0
+				if d, ok := depths[m.Parent]; ok {
+					depth = d + 1
+				}
+				depths[*m.OrgUnit] = depth
+
+				fmt.Fprintf(w, "%v%v\t%v\t\t\t\t\n", strings.Repeat("  ", depth), *m.Name, *m.Projects)
+				practices := make([]string, 0, len(m.Practices))
+				for p := range m.Practices {
+					practices = append(practices, p)
+				}
+				sort.Strings(practices)
+				for _, p := range practices {
+					pm := m.Practices[p]
+					fmt.Fprintf(w, "\t\t%v\t%v\t%v\t%v\n", p, *pm.Median, *pm.Min, *pm.Projects)
+				}
+			}
+			w.Flush()
+		},
+	}
+}
@@ -0,0 +1,241 @@
+package cmd
+
+import (
+	"fmt"
+	"io/ioutil"
+	"os"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ThalesGroup/besec/api/client/operations"
+	"github.com/ThalesGroup/besec/lib"
+)
+
+// planCmd is a parent command for working with plan files kept under version control
+type planCmd struct {
+	*cobra.Command
+}
+
+func newPlanCmd() *planCmd {
+	pc := &planCmd{}
+
+	pc.Command = &cobra.Command{
+		Use:     "plan",
+		Aliases: []string{"plans"},
+		Short:   "Validate and push plan files, and verify plan histories",
+		Long: `Work with plans written as YAML files, so they can be kept alongside a project's code and reviewed like any other change.
+The file format is described by the JSON Schema in lib/planSchema.json.`,
+	}
+
+	pc.AddCommand(pc.newPlanValidateCmd())
+	pc.AddCommand(pc.newPlanPushCmd())
+	pc.AddCommand(pc.newPlanVerifyCmd())
+	return pc
+}
+
+func (pc *planCmd) newPlanValidateCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate [file]",
+		Short: "Check a plan file offline, against the local practice definitions",
+		Long: `Check a plan file is well-formed and that its responses match the practices in practices-dir, applying the
+same checks as the server does when a plan revision is created. The file's practicesVersion is not checked: make sure
+practices-dir holds the practices that version was published from.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			pf := readPlanFile(args[0])
+
+			parser := newLocalPracticeParser()
+			practices, err := parser.ParsePracticesDir()
+			if err != nil {
+				log.Fatal(err)
+			}
+
+			plan, err := pf.Plan(practices)
+			if err != nil {
+				log.Fatalf("%v is not valid: %v", args[0], err)
+			}
+			fmt.Printf("%v is valid. Calculated maturity: %v\n", args[0], plan.Details.Maturity)
+		},
+	}
+}
+
+func (pc *planCmd) newPlanPushCmd() *cobra.Command {
+	push := &cobra.Command{
+		Use:   "push [file]",
+		Short: "Push a plan file to a running instance",
+		Long: `Create a new revision of the file's plan, unless the latest revision already has the same content.
+If the file doesn't have a planId, a new plan is created - add its ID to the file before pushing again.`,
+		Args: cobra.ExactArgs(1),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			// Bound here rather than when the command is created, so as not to steal the demo command's binding
+			if err := viper.BindPFlag(endpointFlagName, cmd.Flags().Lookup(endpointFlagName)); err != nil {
+				log.Fatalf("Error binding viper flag: %v", err)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			dryRun, err := cmd.Flags().GetBool("dry-run")
+			if err != nil {
+				panic(err)
+			}
+			pf := readPlanFile(args[0])
+			if err = pushPlanFile(pf, dryRun); err != nil {
+				log.Fatal(err)
+			}
+		},
+	}
+	push.Flags().StringP(endpointFlagName, "e", defaultEndpoint, endpointFlagUsage)
+	push.Flags().Bool("dry-run", false, "Report what would be pushed, without changing anything")
+	return push
+}
+
+func (pc *planCmd) newPlanVerifyCmd() *cobra.Command {
+	verify := &cobra.Command{
+		Use:   "verify [plan ID]...",
+		Short: "Check that the revision history of plans on a running instance hasn't been tampered with",
+		Long: `Each plan revision includes a hash of its content and of the previous revision, so any retroactive edit,
+removal or reordering of revisions is detected. Record the head hash reported for a plan and pass it with --head
+later, to also detect the whole history being rewritten. Exits with an error if any plan's history isn't intact.`,
+		Args: cobra.MinimumNArgs(1),
+		PreRun: func(cmd *cobra.Command, args []string) {
+			// Bound here rather than when the command is created, so as not to steal the demo command's binding
+			if err := viper.BindPFlag(endpointFlagName, cmd.Flags().Lookup(endpointFlagName)); err != nil {
+				log.Fatalf("Error binding viper flag: %v", err)
+			}
+		},
+		Run: func(cmd *cobra.Command, args []string) {
+			head, err := cmd.Flags().GetString("head")
+			if err != nil {
+				panic(err)
+			}
+			if head != "" && len(args) > 1 {
+				log.Fatal("--head can only be used when verifying a single plan")
+			}
+			intact := true
+			for _, id := range args {
+				ok, err := verifyPlan(id, head)
+				if err != nil {
+					log.Fatal(err)
+				}
+				intact = intact && ok
+			}
+			if !intact {
+				os.Exit(1)
+			}
+		},
+	}
+	verify.Flags().StringP(endpointFlagName, "e", defaultEndpoint, endpointFlagUsage)
+	verify.Flags().String("head", "", "The previously recorded head hash of the plan, which it should still have or descend from")
+	return verify
+}
+
+// verifyPlan reports on the integrity of the plan's revision history, returning false if it isn't intact.
+// If head is set, the plan must still include a revision with that hash.
+func verifyPlan(id string, head string) (bool, error) {
+	c, authInfo := newAPIClient()
+
+	verifyChain := true
+	resp, err := c.Operations.GetPlan(operations.NewGetPlanParams().WithID(id).WithVerifyChain(&verifyChain), authInfo)
+	if err != nil {
+		return false, apiError("verifying plan "+id, err)
+	}
+	chain := resp.Payload.Chain
+	if chain == nil {
+		return false, fmt.Errorf("the server didn't report on the integrity of plan %v - is it running an older version?", id)
+	}
+
+	intact := *chain.Intact
+	problems := chain.Problems
+	if head != "" && head != chain.Head {
+		versions, err := c.Operations.GetPlanVersions(operations.NewGetPlanVersionsParams().WithID(id), authInfo)
+		if err != nil {
+			return false, apiError("retrieving the versions of plan "+id, err)
+		}
+		found := false
+		for _, v := range versions.Payload {
+			found = found || v.Hash == head
+		}
+		if !found {
+			intact = false
+			problems = append(problems, "no revision has the expected head hash: the history has been rewritten")
+		}
+	}
+
+	if intact {
+		fmt.Printf("Plan %v: intact, %v revisions, head %v\n", id, *chain.Revisions, chain.Head)
+	} else {
+		fmt.Printf("Plan %v: NOT INTACT, %v revisions, head %v\n", id, *chain.Revisions, chain.Head)
+		for _, p := range problems {
+			fmt.Printf("  - %v\n", p)
+		}
+	}
+	if chain.UnchainedRevisions > 0 {
+		fmt.Printf("  %v revisions predate hashing and couldn't be checked\n", chain.UnchainedRevisions)
+	}
+	return intact, nil
+}
+
+func readPlanFile(path string) lib.PlanFile {
+	planYaml, err := ioutil.ReadFile(path) //nolint: gosec // reading a user-specified file is the point
+	if err != nil {
+		log.Fatalf("Error reading plan file: %v", err)
+	}
+	pf, err := lib.ParsePlanFile(planYaml)
+	if err != nil {
+		log.Fatalf("%v: %v", path, err)
+	}
+	return pf
+}
+
+func pushPlanFile(pf lib.PlanFile, dryRun bool) error {
+	c, authInfo := newAPIClient()
+
+	if pf.PlanID == "" {
+		if dryRun {
+			fmt.Println("Would create a new plan")
+			return nil
+		}
+		params := operations.NewCreatePlanParams().WithBody(operations.CreatePlanBody{Details: &pf.Details, Responses: &pf.Responses})
+		resp, err := c.Operations.CreatePlan(params, authInfo)
+		if err != nil {
+			return apiError("creating plan", err)
+		}
+		fmt.Printf("Created plan %v. Add 'planId: %v' to the plan file so that future pushes update this plan.\n", *resp.Payload.PlanID, *resp.Payload.PlanID)
+		return nil
+	}
+
+	planResp, err := c.Operations.GetPlan(operations.NewGetPlanParams().WithID(pf.PlanID), authInfo)
+	if err != nil {
+		if ae, ok := err.(*operations.GetPlanDefault); ok {
+			return fmt.Errorf("Error retrieving plan %v [%v]: %v", pf.PlanID, ae.Code(), *ae.Payload.Message)
+		}
+		return err
+	}
+	revID := *planResp.Payload.LatestRevision
+	respResp, err := c.Operations.GetPlanRevisionPracticeResponses(operations.NewGetPlanRevisionPracticeResponsesParams().WithID(pf.PlanID).WithRevID(revID), authInfo)
+	if err != nil {
+		return fmt.Errorf("Error retrieving responses for revision %v of plan %v: %v", revID, pf.PlanID, err)
+	}
+
+	same, err := pf.Matches(*planResp.Payload.Plan.Attributes, *respResp.Payload)
+	if err != nil {
+		return err
+	}
+	if same {
+		fmt.Printf("Plan %v is up to date with the file, nothing to push\n", pf.PlanID)
+		return nil
+	}
+	if dryRun {
+		fmt.Printf("Would create a new revision of plan %v\n", pf.PlanID)
+		return nil
+	}
+
+	params := operations.NewCreatePlanRevisionParams().WithID(pf.PlanID).WithBody(operations.CreatePlanRevisionBody{Details: &pf.Details, Responses: &pf.Responses})
+	resp, err := c.Operations.CreatePlanRevision(params, authInfo)
+	if err != nil {
+		return apiError("creating plan revision", err)
+	}
+	fmt.Printf("Created revision %v of plan %v\n", resp.Payload, pf.PlanID)
+	return nil
+}
@@ -15,6 +15,7 @@ import (
 	"github.com/spf13/cobra"
 	"github.com/spf13/viper"
 
+	"github.com/ThalesGroup/besec/api"
 	"github.com/ThalesGroup/besec/lib"
 	"github.com/ThalesGroup/besec/store"
 )
@@ -29,6 +30,7 @@ type practicesCmd struct {
 
 const serviceAccountFlagName = "service-account"
 const practicesDirFlagName = "practices-dir"
+const schemaFileFlagName = "schema-file"
 
 func newPracticesCmd(rc *rootCmd) *practicesCmd {
 	mc := &practicesCmd{}
@@ -41,12 +43,7 @@ func newPracticesCmd(rc *rootCmd) *practicesCmd {
 			rc.PersistentPreRun(cmd, args)
 
 			if cmd.Name() != "prune" {
-				practicesDir := viper.GetString(practicesDirFlagName)
-				schemaFile := viper.GetString("schema-file")
-				if schemaFile == "" {
-					schemaFile = filepath.Join(practicesDir, "schema.json")
-				}
-				mc.parser = lib.NewPracticeParser(practicesDir, schemaFile, nil)
+				mc.parser = newLocalPracticeParser()
 			}
 
 			if cmd.Name() != "check" {
@@ -62,18 +59,6 @@ func newPracticesCmd(rc *rootCmd) *practicesCmd {
 		},
 	}
 
-	mc.PersistentFlags().String(practicesDirFlagName, "./practices", "A directory containing practice definitions")
-	err := viper.BindPFlag(practicesDirFlagName, mc.PersistentFlags().Lookup(practicesDirFlagName))
-	if err != nil {
-		log.Fatalf("Error binding viper flag: %v", err)
-	}
-
-	mc.PersistentFlags().String("schema-file", "", "The practice schema definitions, default is practicesdir/schema.json")
-	err = viper.BindPFlag("schema-file", mc.PersistentFlags().Lookup("schema-file"))
-	if err != nil {
-		log.Fatalf("Error binding viper flag: %v", err)
-	}
-
 	mc.AddCommand(mc.newPracticesCheckCmd())
 	mc.AddCommand(mc.newPracticesCompareCmd())
 	mc.AddCommand(mc.newPracticesPublishCmd())
@@ -176,6 +161,8 @@ func (mc *practicesCmd) newPracticesPublishCmd() *cobra.Command {
 			if err != nil {
 				log.Fatal(err)
 			}
+			cliAudit(mc.store, "practices.publish", version, nil, practicesSummary(practices))
+			cliNotify(mc.store, api.PracticesPublishedNotification(version, practices))
 			fmt.Println("Published local practices as", version)
 		},
 	}
@@ -238,6 +225,7 @@ func (mc *practicesCmd) newPracticesDeleteCmd() *cobra.Command {
 			if err != nil {
 				log.Fatal(err)
 			}
+			cliAudit(mc.store, "practices.delete", version, nil, nil)
 			log.Infof("Deleted practice definitions version %v. Running serve processes may still have it cached for up to two minutes.", version)
 		},
 	}
@@ -273,6 +261,7 @@ previous version - deleting it underneath them will break their plan.`,
 						if err != nil {
 							log.Fatal(err)
 						}
+						cliAudit(mc.store, "practices.delete", v, nil, nil)
 						delete(inUse, v)
 						deleted = true
 					}
@@ -302,6 +291,15 @@ previous version - deleting it underneath them will break their plan.`,
 	return pc
 }
 
+// practicesSummary lists the IDs of the practices, for the audit log
+func practicesSummary(practices []lib.Practice) map[string][]string {
+	ids := make([]string, len(practices))
+	for i, p := range practices {
+		ids[i] = p.ID
+	}
+	return map[string][]string{"practices": ids}
+}
+
 func usedPractices(versions []string, store store.Store) map[string]bool {
 	inUse := make(map[string]bool)
 	for _, v := range versions {
@@ -335,6 +333,16 @@ func usedPractices(versions []string, store store.Store) map[string]bool {
 	return inUse
 }
 
+// newLocalPracticeParser creates a parser for the configured local practices directory
+func newLocalPracticeParser() lib.PracticeParser {
+	practicesDir := viper.GetString(practicesDirFlagName)
+	schemaFile := viper.GetString(schemaFileFlagName)
+	if schemaFile == "" {
+		schemaFile = filepath.Join(practicesDir, "schema.json")
+	}
+	return lib.NewPracticeParser(practicesDir, schemaFile, nil)
+}
+
 // Prompt the user to confirm, defaulting to no
 func confirm(prompt string) bool {
 	var s string
@@ -0,0 +1,79 @@
+package cmd
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ThalesGroup/besec/api"
+	"github.com/ThalesGroup/besec/store"
+)
+
+const defaultCadenceFlagName = "default-cadence-days"
+const reminderRepeatFlagName = "reminder-repeat"
+const reminderIntervalFlagName = "reminder-interval"
+
+// reminderConfig returns the assessment cadence settings
+func reminderConfig() api.ReminderConfig {
+	return api.ReminderConfig{
+		DefaultCadence: viper.GetInt(defaultCadenceFlagName),
+		Repeat:         viper.GetDuration(reminderRepeatFlagName),
+	}
+}
+
+// remindersCmd chases up projects that haven't committed a plan as often as their cadence requires
+type remindersCmd struct {
+	*cobra.Command
+	store store.Store
+}
+
+func newRemindersCmd(rc *rootCmd) *remindersCmd {
+	mc := &remindersCmd{}
+
+	mc.Command = &cobra.Command{
+		Use:   "reminders",
+		Short: "Remind the owners of projects whose plans are overdue, or whose risk acceptances have expired",
+		Long: `Each project should commit a new plan at least as often as its cadence, which is set on the project, its org unit
+or a unit above it, or with default-cadence-days. 'besec serve' sends reminders itself every reminder-interval;
+alternatively, disable that and run 'besec reminders run' from cron.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			rc.PersistentPreRun(cmd, args)
+			mc.store = initStore()
+			checkEmulator()
+		},
+	}
+
+	mc.AddCommand(mc.newRunCmd())
+	return mc
+}
+
+func (mc *remindersCmd) newRunCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "run",
+		Short: "Notify the owners of overdue projects and of expired risk acceptances",
+		Long: `Owners, and the approvers of expired risk acceptances, are emailed through the notifications subscriptions channel,
+and the project.overdue and project.risk-acceptance-expired events are sent to any channels and webhooks they're routed
+to. Nobody is reminded again until reminder-repeat has passed. The notifications are delivered by the running server.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			router, err := notificationRouter(mc.store)
+			if err != nil {
+				log.Fatalf("Invalid %v: %v", notificationsKey, err)
+			}
+			rt := api.NewRuntime(mc.store, nil, api.ExtendedAuthConfig{}, false, false, router, api.RuntimeOptions{Reminders: reminderConfig()})
+			sent, err := api.SendReminders(context.Background(), rt, time.Now().UTC())
+			if err != nil {
+				log.Fatalf("Error sending reminders: %v", err)
+			}
+			fmt.Printf("Sent reminders about %v overdue projects\n", sent)
+			if sent, err = api.SendRiskAcceptanceReminders(context.Background(), rt, time.Now().UTC()); err != nil {
+				log.Fatalf("Error sending reminders: %v", err)
+			}
+			fmt.Printf("Sent reminders about %v expired risk acceptances\n", sent)
+		},
+	}
+}
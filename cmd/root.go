@@ -6,6 +6,7 @@ import (
 	"os"
 	"path/filepath"
 	"strings"
+	"time"
 
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -84,9 +85,40 @@ func newRootCmd() *rootCmd {
 		log.Fatalf("Error binding viper flag: %v", err)
 	}
 
+	rc.PersistentFlags().String(practicesDirFlagName, "./practices", "A directory containing practice definitions")
+	err = viper.BindPFlag(practicesDirFlagName, rc.PersistentFlags().Lookup(practicesDirFlagName))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
+	rc.PersistentFlags().String(schemaFileFlagName, "", "The practice schema definitions, default is practicesdir/schema.json")
+	err = viper.BindPFlag(schemaFileFlagName, rc.PersistentFlags().Lookup(schemaFileFlagName))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
+	rc.PersistentFlags().Int(defaultCadenceFlagName, 0, "How often, in days, projects should commit a new plan if neither they nor their org unit set a cadence; 0 if they aren't tracked")
+	err = viper.BindPFlag(defaultCadenceFlagName, rc.PersistentFlags().Lookup(defaultCadenceFlagName))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
+	rc.PersistentFlags().Duration(reminderRepeatFlagName, 7*24*time.Hour, "How long to wait before reminding the owners of a project that's still overdue again")
+	err = viper.BindPFlag(reminderRepeatFlagName, rc.PersistentFlags().Lookup(reminderRepeatFlagName))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
 	rc.AddCommand(newPracticesCmd(rc).Command)
 	rc.AddCommand(newUsersCmd(rc).Command)
 	rc.AddCommand(newDemoCmd().Command)
+	rc.AddCommand(newPlanCmd().Command)
+	rc.AddCommand(newAttestCmd().Command)
+	rc.AddCommand(newOrgUnitsCmd(rc).Command)
+	rc.AddCommand(newTokensCmd(rc).Command)
+	rc.AddCommand(newAuditCmd(rc).Command)
+	rc.AddCommand(newRemindersCmd(rc).Command)
+	rc.AddCommand(newDigestCmd(rc).Command)
 	rc.AddCommand(newServeCmd())
 
 	return rc
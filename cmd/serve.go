@@ -2,7 +2,9 @@ package cmd
 
 import (
 	"context"
+	"crypto/ed25519"
 	"fmt"
+	"net"
 	"net/http"
 	"net/http/pprof" // for --pprof
 	"os"
@@ -10,7 +12,6 @@ import (
 	"strings"
 	"time"
 
-	"firebase.google.com/go/v4/auth"
 	"github.com/gorilla/mux"
 	log "github.com/sirupsen/logrus"
 	"github.com/spf13/cobra"
@@ -19,6 +20,8 @@ import (
 
 	"github.com/ThalesGroup/besec/api"
 	"github.com/ThalesGroup/besec/api/models"
+	"github.com/ThalesGroup/besec/lib"
+	"github.com/ThalesGroup/besec/store"
 )
 
 // UIDir holds the embedded static web files
@@ -28,8 +31,15 @@ const stackdriverLogsFlagName = "stackdriver-logs"
 const disableAuthFlagName = "disable-auth"
 const requestAccessAlertsFlagName = "alert-access-request"
 const newUserAlertsFlagName = "alert-first-login"
+const defaultRolesFlagName = "default-roles"
+const planReviewsFlagName = "plan-reviews"
+const webhookNetworksFlagName = "webhook-allowed-networks"
 const apiVersion = "/v1alpha1"
+const scimPrefix = "/scim/v2"
 const authConfigKey = "auth"
+const accessRulesKey = "access-rules"
+const notificationsKey = "notifications"
+const issueTrackersKey = "issue-trackers"
 
 func newServeCmd() *cobra.Command {
 	serveCmd := &cobra.Command{
@@ -70,6 +80,58 @@ func newServeCmd() *cobra.Command {
 		log.Fatalf("Error binding viper flag: %v", err)
 	}
 
+	serveCmd.PersistentFlags().String("scim-token-name", "", "Name of the bearer token (in the database config as scim-token-<name>) that identity providers use to provision users over SCIM")
+	err = viper.BindPFlag("scim-token-name", serveCmd.PersistentFlags().Lookup("scim-token-name"))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+	serveCmd.PersistentFlags().String("scim-token", "", "Bearer token that identity providers use to provision users over SCIM at /scim/v2; SCIM is disabled if neither this nor a token name is set")
+	err = viper.BindPFlag("scim-token", serveCmd.PersistentFlags().Lookup("scim-token"))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
+	serveCmd.PersistentFlags().String("attestation-key-name", "", "Name of the PEM-encoded ed25519 private key (in the database config as attestation-key-<name>) used to sign plan attestations")
+	err = viper.BindPFlag("attestation-key-name", serveCmd.PersistentFlags().Lookup("attestation-key-name"))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+	serveCmd.PersistentFlags().String("attestation-key", "", "PEM-encoded ed25519 private key used to sign plan attestations (see 'besec attest keygen'); attestations are disabled if neither this nor a key name is set")
+	err = viper.BindPFlag("attestation-key", serveCmd.PersistentFlags().Lookup("attestation-key"))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
+	serveCmd.PersistentFlags().StringSlice(defaultRolesFlagName, []string{string(models.RoleProjectContributor)}, "The roles of authorized users who haven't been granted any roles explicitly")
+	err = viper.BindPFlag(defaultRolesFlagName, serveCmd.PersistentFlags().Lookup(defaultRolesFlagName))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
+	serveCmd.PersistentFlags().Bool(planReviewsFlagName, false, "Require committed plans to be approved by a security reviewer before they count towards the org's maturity metrics")
+	err = viper.BindPFlag(planReviewsFlagName, serveCmd.PersistentFlags().Lookup(planReviewsFlagName))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
+	serveCmd.PersistentFlags().StringSlice(webhookNetworksFlagName, nil, "Internal networks, in CIDR notation, that webhooks may be sent to; other loopback, private and link-local addresses are refused")
+	err = viper.BindPFlag(webhookNetworksFlagName, serveCmd.PersistentFlags().Lookup(webhookNetworksFlagName))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
+	serveCmd.PersistentFlags().Duration(reminderIntervalFlagName, 24*time.Hour, "How often to check for overdue projects and remind their owners; 0 to disable, e.g. to use 'besec reminders run' instead")
+	err = viper.BindPFlag(reminderIntervalFlagName, serveCmd.PersistentFlags().Lookup(reminderIntervalFlagName))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
+	serveCmd.PersistentFlags().StringSlice(digestsFlagName, []string{}, "The digests to send as each period ends: weekly and/or monthly")
+	err = viper.BindPFlag(digestsFlagName, serveCmd.PersistentFlags().Lookup(digestsFlagName))
+	if err != nil {
+		log.Fatalf("Error binding viper flag: %v", err)
+	}
+
 	serveCmd.PersistentFlags().Bool("pprof", false, "Enable insecure pprof debug server at /debug/pprof/")
 	err = viper.BindPFlag("pprof", serveCmd.PersistentFlags().Lookup("pprof"))
 	if err != nil {
@@ -133,7 +195,8 @@ func uiHandler(w http.ResponseWriter, r *http.Request) {
 }
 
 // newServer creates a server encapsulating both the API server and the static file server
-func newServer(port int, rt *api.Runtime) *http.Server {
+// If scimToken is set, the SCIM provisioning endpoints are served too.
+func newServer(port int, rt *api.Runtime, scimToken string) *http.Server {
 	apiSrv := api.NewAPI(rt)
 	defer func() {
 		err := apiSrv.Shutdown()
@@ -145,6 +208,9 @@ func newServer(port int, rt *api.Runtime) *http.Server {
 	// Instead of using apiSrv.Serve(), register its handler with our own router so we can serve both the API and other assets
 	r := mux.NewRouter()
 	r.PathPrefix(apiVersion).Handler(apiSrv.GetHandler())
+	if scimToken != "" {
+		r.PathPrefix(scimPrefix).Handler(http.StripPrefix(scimPrefix, api.NewSCIMHandler(rt, scimToken)))
+	}
 	if viper.GetBool("pprof") {
 		log.Warn("Insecure pprof server listening at /debug/pprof/")
 		r.PathPrefix("/debug").Handler(http.HandlerFunc(pprof.Index))
@@ -168,17 +234,6 @@ func newServer(port int, rt *api.Runtime) *http.Server {
 func serve() {
 	disableAuth := viper.GetBool(disableAuthFlagName)
 	project := viper.GetString("gcp-project")
-	if project == "" && !disableAuth {
-		log.Fatal("No Google Cloud project ID specified - run with --gcp-project or set gcp-project in the configuration file.\nA project ID is required unless --disable-auth is set, as it is used to validate authentication tokens.")
-	}
-
-	var authClient *auth.Client
-	if disableAuth {
-		authClient = nil
-		log.Warn("Authentication has been disabled, anonymous users will be authenticated as a pre-authorized example user")
-	} else {
-		authClient = api.InitAuthClient(project, true, viper.GetString(serviceAccountFlagName))
-	}
 
 	if viper.GetBool(stackdriverLogsFlagName) {
 		// Configure log output to work well with Stackdriver Logging, when paired with middleware to extract the trace ID.
@@ -189,47 +244,135 @@ func serve() {
 	}
 
 	authConfig := api.NewExtendedAuthConfig(getAuthConfig())
+	if project == "" && !disableAuth && authConfig.Flow != models.AuthConfigFlowOidc {
+		log.Fatal("No Google Cloud project ID specified - run with --gcp-project or set gcp-project in the configuration file.\nA project ID is required to validate Firebase authentication tokens, unless --disable-auth is set or the OIDC flow is configured.")
+	}
+
+	var verifier api.IdentityVerifier
+	if disableAuth {
+		log.Warn("Authentication has been disabled, anonymous users will be authenticated as a pre-authorized example user")
+	} else if authConfig.Flow == models.AuthConfigFlowOidc {
+		oidc, err := api.NewOIDCVerifier(context.Background(), authConfig.Oidc)
+		if err != nil {
+			log.Fatalf("Failed to initialize the OIDC provider: %v", err)
+		}
+		verifier = oidc
+	} else {
+		verifier = &api.FirebaseVerifier{
+			Client:     api.InitAuthClient(project, true, viper.GetString(serviceAccountFlagName)),
+			AuthConfig: authConfig,
+		}
+	}
 
 	st := initStore()
 
 	requestAccessAlerts := viper.GetBool(requestAccessAlertsFlagName)
 	newUserAlerts := viper.GetBool(newUserAlertsFlagName)
-	webhook := viper.GetString("slack-webhook-url")
-	webhookName := viper.GetString("slack-webhook-name")
-	if requestAccessAlerts || newUserAlerts {
-		if webhook == "" {
-			if webhookName == "" {
-				log.Error("Configured to send alerts but no webhook url or name specified.")
-				return
-			}
+	router, err := notificationRouter(st)
+	if err != nil {
+		log.Errorf("Invalid %v: %v", notificationsKey, err)
+		return
+	}
+	if (requestAccessAlerts || newUserAlerts) && router.Empty() {
+		log.Error("Configured to send alerts but no notification channels or Slack webhook url or name specified.")
+		return
+	}
+	scimToken := viper.GetString("scim-token")
+	scimTokenName := viper.GetString("scim-token-name")
+	if scimToken == "" && scimTokenName != "" {
+		var err error
+		scimToken, err = st.GetConfigString(context.Background(), "scim-token-"+scimTokenName)
+		if err != nil {
+			log.WithFields(log.Fields{"token": scimTokenName, "error": err}).Fatal("Couldn't get SCIM token")
+		}
+	} else if scimToken != "" && scimTokenName != "" {
+		log.Warn("Both a SCIM token config name and explicit token have been provided; the name will be ignored.")
+	}
+	if scimToken != "" && disableAuth {
+		log.Fatal("SCIM provisioning can't be enabled when authentication is disabled")
+	}
 
-			var err error
-			webhook, err = st.GetConfigString(context.Background(), "slack-webhook-"+webhookName)
-			if err != nil {
-				log.WithFields(log.Fields{"webhook": webhookName, "error": err}).Error("Couldn't get webhook URL")
-				return
-			}
-		} else if webhookName != "" {
-			log.Warn("Both a Slack webhook config name and explicit URL have been provided; the name will be ignored.")
+	var attestationKey ed25519.PrivateKey
+	attestationKeyPEM := viper.GetString("attestation-key")
+	attestationKeyName := viper.GetString("attestation-key-name")
+	if attestationKeyPEM == "" && attestationKeyName != "" {
+		attestationKeyPEM, err = st.GetConfigString(context.Background(), "attestation-key-"+attestationKeyName)
+		if err != nil {
+			log.WithFields(log.Fields{"key": attestationKeyName, "error": err}).Fatal("Couldn't get attestation key")
+		}
+	} else if attestationKeyPEM != "" && attestationKeyName != "" {
+		log.Warn("Both an attestation key config name and explicit key have been provided; the name will be ignored.")
+	}
+	if attestationKeyPEM != "" {
+		attestationKey, err = lib.ParsePrivateKeyPEM([]byte(attestationKeyPEM))
+		if err != nil {
+			log.Fatalf("Invalid attestation key: %v", err)
 		}
+		log.WithField("keyid", lib.KeyID(attestationKey.Public().(ed25519.PublicKey))).Info("Plan attestations are enabled")
+	}
+
+	defaultRoles, err := api.ParseRoles(viper.GetStringSlice(defaultRolesFlagName))
+	if err != nil {
+		log.Fatalf("Invalid %v: %v", defaultRolesFlagName, err)
+	}
+
+	var rules []api.AccessRule
+	if err = viper.UnmarshalKey(accessRulesKey, &rules); err != nil {
+		log.Fatalf("Failed to parse %v: %v", accessRulesKey, err)
+	}
+	accessRules, err := api.NewAccessRules(rules)
+	if err != nil {
+		log.Fatalf("Invalid %v: %v", accessRulesKey, err)
 	}
 
-	sc := make(chan api.SlackMessage, 10)
+	issueTrackers, err := loadIssueTrackers(st)
+	if err != nil {
+		log.Fatalf("Invalid %v: %v", issueTrackersKey, err)
+	}
+
+	var webhookNetworks []*net.IPNet
+	for _, cidr := range viper.GetStringSlice(webhookNetworksFlagName) {
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			log.Fatalf("Invalid %v: %v", webhookNetworksFlagName, err)
+		}
+		webhookNetworks = append(webhookNetworks, network)
+	}
 
 	rt := api.NewRuntime(
 		st,
-		authClient,
+		verifier,
 		authConfig,
 		requestAccessAlerts,
 		newUserAlerts,
-		sc,
+		router,
+		api.RuntimeOptions{
+			DefaultRoles:    defaultRoles,
+			TrustedDomains:  viper.GetStringSlice(trustedDomainsFlagName),
+			AccessRules:     accessRules,
+			AttestationKey:  attestationKey,
+			Reminders:       reminderConfig(),
+			IssueTrackers:   issueTrackers,
+			PlanReviews:     viper.GetBool(planReviewsFlagName),
+			WebhookNetworks: webhookNetworks,
+		},
 	)
 
 	port := viper.GetInt("port")
-	srv := newServer(port, rt)
+	srv := newServer(port, rt, scimToken)
 
-	if requestAccessAlerts || newUserAlerts {
-		go api.SlackSender(sc, rt, webhook)
+	// Even without any channels, there may be webhooks to deliver to
+	go api.NotificationWorker(context.Background(), rt)
+	if interval := viper.GetDuration(reminderIntervalFlagName); interval > 0 {
+		go api.ReminderScheduler(context.Background(), rt, interval)
+	}
+	if digests := viper.GetStringSlice(digestsFlagName); len(digests) > 0 {
+		for _, period := range digests {
+			if _, _, err = api.DigestPeriod(period, time.Now()); err != nil {
+				log.Fatalf("Invalid %v: %v", digestsFlagName, err)
+			}
+		}
+		go api.DigestScheduler(context.Background(), rt, digests, time.Hour)
 	}
 
 	log.WithFields(log.Fields{"port": port}).Print("Listening")
@@ -274,3 +417,88 @@ func yamlStringToStruct(m interface{}) func(rf reflect.Kind, rt reflect.Kind, da
 		return m, yaml.UnmarshalStrict([]byte(raw), &m)
 	}
 }
+
+// notificationRouter sets up the notification channels and routes in the config, including a channel for the
+// slack-webhook-* options. Secrets can be kept in the database config rather than the config file.
+func notificationRouter(st store.Store) (*api.NotificationRouter, error) {
+	var cfg struct {
+		Channels      []api.NotificationChannelConfig
+		Routes        []api.NotificationRoute
+		Subscriptions string // the email channel that users' project subscriptions are delivered through
+	}
+	if err := viper.UnmarshalKey(notificationsKey, &cfg); err != nil {
+		return nil, err
+	}
+
+	webhook := viper.GetString("slack-webhook-url")
+	webhookName := viper.GetString("slack-webhook-name")
+	if webhook != "" || webhookName != "" {
+		c := api.NotificationChannelConfig{Name: "slack", Type: "slack", URL: webhook}
+		if webhook == "" {
+			c.URLName = "slack-webhook-" + webhookName
+		} else if webhookName != "" {
+			log.Warn("Both a Slack webhook config name and explicit URL have been provided; the name will be ignored.")
+		}
+		cfg.Channels = append(cfg.Channels, c)
+		if len(cfg.Routes) > 0 {
+			// Otherwise it gets every notification anyway
+			cfg.Routes = append(cfg.Routes, api.NotificationRoute{Events: []string{"user.*"}, Channels: []string{c.Name}})
+		}
+	}
+
+	notifiers := make([]api.Notifier, 0, len(cfg.Channels))
+	for _, c := range cfg.Channels {
+		var err error
+		if c.URL == "" && c.URLName != "" {
+			if c.URL, err = st.GetConfigString(context.Background(), c.URLName); err != nil {
+				return nil, fmt.Errorf("couldn't get the URL for notification channel %v: %v", c.Name, err)
+			}
+		}
+		if c.Password == "" && c.PasswordName != "" {
+			if c.Password, err = st.GetConfigString(context.Background(), c.PasswordName); err != nil {
+				return nil, fmt.Errorf("couldn't get the password for notification channel %v: %v", c.Name, err)
+			}
+		}
+		n, err := api.NewNotifier(c)
+		if err != nil {
+			return nil, err
+		}
+		notifiers = append(notifiers, n)
+	}
+	return api.NewNotificationRouter(notifiers, cfg.Routes, cfg.Subscriptions)
+}
+
+// loadIssueTrackers creates the issue trackers in the config
+func loadIssueTrackers(st store.Store) ([]api.IssueTracker, error) {
+	var cfg []api.IssueTrackerConfig
+	if err := viper.UnmarshalKey(issueTrackersKey, &cfg); err != nil {
+		return nil, err
+	}
+	trackers := make([]api.IssueTracker, 0, len(cfg))
+	for _, c := range cfg {
+		var err error
+		if c.Token == "" && c.TokenName != "" {
+			if c.Token, err = st.GetConfigString(context.Background(), c.TokenName); err != nil {
+				return nil, fmt.Errorf("couldn't get the token for issue tracker %v: %v", c.Name, err)
+			}
+		}
+		t, err := api.NewIssueTracker(c)
+		if err != nil {
+			return nil, err
+		}
+		trackers = append(trackers, t)
+	}
+	return trackers, nil
+}
+
+// cliNotify queues a notification about something done through the CLI. It's delivered by a running server's notification worker.
+func cliNotify(st store.Store, n *api.Notification) {
+	router, err := notificationRouter(st)
+	if err != nil {
+		log.Warnf("Not sending a notification, as the notification configuration is invalid: %v", err)
+		return
+	}
+	if err = api.QueueNotification(context.Background(), st, router, n); err != nil {
+		log.Warnf("Failed to queue notification: %v", err)
+	}
+}
@@ -0,0 +1,149 @@
+package cmd
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"text/tabwriter"
+	"time"
+
+	"github.com/go-openapi/runtime"
+	"github.com/go-openapi/strfmt"
+	log "github.com/sirupsen/logrus"
+	"github.com/spf13/cobra"
+	"github.com/spf13/viper"
+
+	"github.com/ThalesGroup/besec/api"
+	"github.com/ThalesGroup/besec/api/client"
+	"github.com/ThalesGroup/besec/api/client/operations"
+	"github.com/ThalesGroup/besec/api/models"
+)
+
+// tokensCmd is a parent command for managing API tokens through the API
+type tokensCmd struct {
+	*cobra.Command
+	client   *client.Besec
+	authInfo runtime.ClientAuthInfoWriter
+}
+
+func newTokensCmd(rc *rootCmd) *tokensCmd {
+	tc := &tokensCmd{}
+
+	tc.Command = &cobra.Command{
+		Use:   "tokens",
+		Short: "Manage long-lived API tokens for scripts and automation",
+		Long: `API tokens can be used in place of an ID token as BESEC_ACCESS_TOKEN, and don't expire unless asked to.
+A user's token acts as them, limited to the token's scopes. Security admins can also create tokens for service accounts,
+which have their own roles.`,
+		PersistentPreRun: func(cmd *cobra.Command, args []string) {
+			rc.PersistentPreRun(cmd, args)
+			// Bound here rather than when the command is created, so as not to steal the demo command's binding
+			if err := viper.BindPFlag(endpointFlagName, cmd.Flags().Lookup(endpointFlagName)); err != nil {
+				log.Fatalf("Error binding viper flag: %v", err)
+			}
+			tc.client, tc.authInfo = newAPIClient()
+		},
+	}
+	tc.PersistentFlags().StringP(endpointFlagName, "e", defaultEndpoint, endpointFlagUsage)
+
+	tc.AddCommand(tc.newListCmd())
+	tc.AddCommand(tc.newCreateCmd())
+	tc.AddCommand(tc.newRevokeCmd())
+	return tc
+}
+
+// formatTime returns a short representation of the time, or the alternative if it is unset
+func formatTime(t *strfmt.DateTime, unset string) string {
+	if t == nil {
+		return unset
+	}
+	return time.Time(*t).Local().Format("2006-01-02 15:04")
+}
+
+func (tc *tokensCmd) newListCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "list",
+		Short: "List your API tokens",
+		Args:  cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			all, _ := cmd.Flags().GetBool("all")
+			resp, err := tc.client.Operations.ListAPITokens(operations.NewListAPITokensParams().WithAll(&all), tc.authInfo)
+			if err != nil {
+				log.Fatal(apiError("listing API tokens", err))
+			}
+
+			w := tabwriter.NewWriter(os.Stdout, 0, 8, 1, '\t', 0)
+			fmt.Fprintln(w, "ID\tName\tPrefix\tOwner\tScopes\tCreated\tExpires\tLast used")
+			for _, t := range resp.Payload {
+				owner := t.OwnerName
+				if t.ServiceAccount {
+					owner = fmt.Sprintf("%v (service account: %v)", owner, t.Roles)
+				}
+				scopes := make([]string, len(t.Scopes))
+				for i, s := range t.Scopes {
+					scopes[i] = string(s)
+				}
+				fmt.Fprintf(w, "%v\t%v\t%v…\t%v\t%v\t%v\t%v\t%v\n", t.ID, *t.Name, t.Prefix, owner, strings.Join(scopes, ","),
+					formatTime(t.Created, ""), formatTime(t.Expires, "never"), formatTime(t.LastUsed, "never"))
+			}
+			w.Flush()
+		},
+	}
+	c.Flags().Bool("all", false, "List every user's and service account's tokens (security admins only)")
+	return c
+}
+
+func (tc *tokensCmd) newCreateCmd() *cobra.Command {
+	c := &cobra.Command{
+		Use:   "create NAME",
+		Short: "Create an API token, and print its secret",
+		Long: `Create an API token named after what it will be used for. The secret is only shown once.
+Scopes are the permissions the token may use: read, edit, delete, admin and audit.`,
+		Args: cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			name := args[0]
+			scopes, _ := cmd.Flags().GetStringSlice("scope")
+			serviceAccount, _ := cmd.Flags().GetString("account")
+			roleNames, _ := cmd.Flags().GetStringSlice("role")
+			days, _ := cmd.Flags().GetInt64("expires-in-days")
+
+			roles, err := api.ParseRoles(roleNames)
+			if err != nil {
+				log.Fatal(err)
+			}
+			req := &models.APITokenRequest{Name: &name, ServiceAccount: serviceAccount, Roles: roles, ExpiresInDays: days}
+			for _, s := range scopes {
+				req.Scopes = append(req.Scopes, models.APITokenScope(s))
+			}
+			if err = req.Validate(strfmt.Default); err != nil {
+				log.Fatal(err)
+			}
+
+			resp, err := tc.client.Operations.CreateAPIToken(operations.NewCreateAPITokenParams().WithBody(req), tc.authInfo)
+			if err != nil {
+				log.Fatal(apiError("creating API token", err))
+			}
+			fmt.Fprintf(os.Stderr, "Created API token %v. Store the secret securely, it won't be shown again:\n", resp.Payload.Token.ID)
+			fmt.Println(*resp.Payload.Secret)
+		},
+	}
+	c.Flags().StringSlice("scope", []string{"read"}, "A permission the token may use (repeat for more than one)")
+	c.Flags().String("account", "", "Create the token for this service account rather than yourself (security admins only)")
+	c.Flags().StringSlice("role", []string{}, "A role of the service account (repeat for more than one)")
+	c.Flags().Int64("expires-in-days", 0, "Expire the token after this many days; by default it doesn't expire")
+	return c
+}
+
+func (tc *tokensCmd) newRevokeCmd() *cobra.Command {
+	return &cobra.Command{
+		Use:   "revoke ID",
+		Short: "Revoke an API token",
+		Args:  cobra.ExactArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			if _, err := tc.client.Operations.RevokeAPIToken(operations.NewRevokeAPITokenParams().WithID(args[0]), tc.authInfo); err != nil {
+				log.Fatal(apiError("revoking API token", err))
+			}
+			fmt.Printf("Revoked API token %v\n", args[0])
+		},
+	}
+}
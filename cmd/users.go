@@ -4,7 +4,7 @@ import (
 	"context"
 	"fmt"
 	"os"
-	"strings"
+	"sort"
 	"text/tabwriter"
 
 	"firebase.google.com/go/v4/auth"
@@ -57,6 +57,8 @@ func newUsersCmd(rc *rootCmd) *uCmd {
 	uc.AddCommand(uc.newAuthorizeCmd(true))
 	uc.AddCommand(uc.newAuthorizeCmd(false))
 	uc.AddCommand(uc.newRemoveCmd())
+	uc.AddCommand(uc.newRolesCmd())
+	uc.AddCommand(uc.newRequestsCmd())
 
 	return uc
 }
@@ -106,6 +108,95 @@ func (uc *uCmd) newRemoveCmd() *cobra.Command {
 	}
 }
 
+func (uc *uCmd) newRolesCmd() *cobra.Command {
+	rc := &cobra.Command{
+		Use:   "roles [UID] [ROLE]...",
+		Short: "Show or set the roles granted to a user",
+		Long: `With just a UID, show the roles explicitly granted to that user. Otherwise replace their roles with those listed.
+Users without any explicit roles get the default roles configured for the server (see serve --default-roles).
+
+Roles: viewer, projectContributor, projectOwner, securityAdmin, auditor`,
+		Args: cobra.MinimumNArgs(1),
+		Run: func(cmd *cobra.Command, args []string) {
+			reset, err := cmd.Flags().GetBool("reset")
+			if err != nil {
+				panic(err)
+			}
+			uid := args[0]
+
+			if len(args) == 1 && !reset {
+				user := &models.User{UID: uid}
+				if err := uc.store.GetUserData(context.Background(), user); err != nil {
+					log.Fatalf("Error retrieving local user data: %v", err)
+				}
+				if len(user.Roles) == 0 {
+					fmt.Printf("%s has the default roles\n", uid)
+				} else {
+					fmt.Printf("%s has roles: %v\n", uid, user.Roles)
+				}
+				return
+			}
+
+			roles, err := api.ParseRoles(args[1:])
+			if err != nil {
+				log.Fatal(err)
+			}
+			before := &models.User{UID: uid}
+			if err = uc.store.GetUserData(context.Background(), before); err != nil {
+				log.Fatalf("Error retrieving local user data: %v", err)
+			}
+			if err = uc.store.SetUserRoles(context.Background(), uid, roles); err != nil {
+				log.Fatalf("Error saving roles: %v", err)
+			}
+			cliAudit(uc.store, "user.roles", uid, before.Roles, roles)
+			if len(roles) == 0 {
+				fmt.Printf("Reset %s to the default roles\n", uid)
+			} else {
+				fmt.Printf("Granted %s roles: %v\n", uid, roles)
+			}
+		},
+	}
+	rc.Flags().Bool("reset", false, "Remove all explicitly granted roles, so the user gets the default roles")
+	return rc
+}
+
+func (uc *uCmd) newRequestsCmd() *cobra.Command {
+	rc := &cobra.Command{
+		Use:   "requests",
+		Short: "List pending access requests",
+		Long: `Users without access can ask for it, explaining why they need it. Approve a request with 'users authorize',
+or approve or deny it through the /access-requests API.`,
+		Args: cobra.NoArgs,
+		Run: func(cmd *cobra.Command, args []string) {
+			status := models.AccessRequestStatusPending
+			if all, _ := cmd.Flags().GetBool("all"); all {
+				status = ""
+			}
+			requests, err := uc.store.ListAccessRequests(context.Background(), status)
+			if err != nil {
+				log.Fatalf("Error retrieving access requests: %v", err)
+			}
+			sort.Slice(requests, func(i, j int) bool { return requests[i].Created.Before(requests[j].Created) })
+
+			fmt.Fprint(uc.w, "UID\tEmail\tDisplay name\tProvider\tRequested\tStatus\tJustification\n")
+			for _, r := range requests {
+				domainWarning := ""
+				if !userDomainWhitelisted(r.Email) {
+					domainWarning = "[WARNING: this domain is not on the whitelist]"
+				}
+				status := r.Status
+				if r.DecidedByName != "" {
+					status += " by " + r.DecidedByName
+				}
+				fmt.Fprintf(uc.w, "%s\t%s%s\t'%s'\t%s\t%s\t%s\t%q\n", r.UID, r.Email, domainWarning, r.Name, r.Provider,
+					r.Created.Local().Format("2006-01-02 15:04"), status, r.Justification)
+			}
+		},
+	}
+	rc.Flags().Bool("all", false, "Include approved and denied requests")
+	return rc
+}
+
 func (uc *uCmd) newListCmd() *cobra.Command {
 	return &cobra.Command{Use: "list [UID] [UID] ...",
 		Short: "List all users and their local records",
@@ -173,10 +264,19 @@ func (uc *uCmd) authorizeUser(uid string, authorize bool, force bool) {
 		log.Fatalf("Error saving authorized state: %v", err)
 	} else {
 		action := "Authorized "
+		auditAction := "user.authorize"
 		if !authorize {
 			action = "De-authorized "
+			auditAction = "user.deauthorize"
 		}
 		fmt.Println(action + record.DisplayName)
+		cliAudit(uc.store, auditAction, uid, map[string]bool{"manuallyAuthorized": authorized}, map[string]bool{"manuallyAuthorized": authorize})
+	}
+
+	if authorize {
+		if err = api.ResolveAccessRequest(context.Background(), uc.store, uid, true, "", "besec users authorize", ""); err != nil {
+			log.Warnf("Failed to record approval of %v's access request: %v", uid, err)
+		}
 	}
 }
 
@@ -196,6 +296,7 @@ func (uc *uCmd) removeUser(uid string) {
 	}
 
 	fmt.Printf("Deleted %s\n", uid)
+	cliAudit(uc.store, "user.remove", uid, nil, nil)
 }
 
 func (uc *uCmd) getUsers() ([]*auth.UserRecord, error) {
@@ -235,6 +336,9 @@ func (uc *uCmd) showUser(r *auth.UserRecord) {
 	if user.ManuallyAuthorized {
 		localText = "[manually authorized]"
 	}
+	if len(user.Roles) > 0 {
+		localText += fmt.Sprintf("%v", user.Roles)
+	}
 
 	provider := ""
 	if len(r.ProviderUserInfo) == 1 {
@@ -262,14 +366,7 @@ func (uc *uCmd) printUserHeader() {
 }
 
 func userDomainWhitelisted(email string) bool {
-	mailComponents := strings.Split(email, "@")
-	domain := mailComponents[len(mailComponents)-1]
-	for _, whiteListed := range viper.GetStringSlice(trustedDomainsFlagName) {
-		if domain == whiteListed {
-			return true
-		}
-	}
-	return false
+	return api.TrustedDomain(email, viper.GetStringSlice(trustedDomainsFlagName))
 }
 
 // lookupUser returns a User model corresponding to the record, and also a copy of record with any local data applied
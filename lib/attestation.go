@@ -0,0 +1,202 @@
+package lib
+
+import (
+	"crypto/ed25519"
+	"crypto/sha256"
+	"crypto/x509"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"encoding/pem"
+	"fmt"
+)
+
+// Plan attestations are in-toto statements about a committed plan revision, signed in a DSSE envelope.
+// See https://github.com/in-toto/attestation and https://github.com/secure-systems-lab/dsse
+
+// Attestation types
+const (
+	DSSEPayloadType     = "application/vnd.in-toto+json"
+	InTotoStatementType = "https://in-toto.io/Statement/v1"
+	PlanPredicateType   = "https://github.com/ThalesGroup/besec/attestation/plan/v1"
+)
+
+// Envelope is a DSSE envelope
+type Envelope struct {
+	PayloadType string      `json:"payloadType"`
+	Payload     string      `json:"payload"` // base64 encoded
+	Signatures  []Signature `json:"signatures"`
+}
+
+// Signature is a DSSE signature
+type Signature struct {
+	KeyID string `json:"keyid"`
+	Sig   string `json:"sig"` // base64 encoded
+}
+
+// Statement is an in-toto statement about a plan revision
+type Statement struct {
+	Type          string        `json:"_type"`
+	Subject       []Subject     `json:"subject"`
+	PredicateType string        `json:"predicateType"`
+	Predicate     PlanPredicate `json:"predicate"`
+}
+
+// Subject identifies what a statement is about
+type Subject struct {
+	Name   string            `json:"name"`
+	Digest map[string]string `json:"digest"`
+}
+
+// PlanPredicate summarizes a plan revision. The full responses aren't included, only their digest, so they can be
+// shared separately if required.
+type PlanPredicate struct {
+	PlanID           string            `json:"planId"`
+	RevisionID       string            `json:"revisionId"`
+	RevisionHash     string            `json:"revisionHash,omitempty"` // the revision's place in the plan's hash chain, see RevisionHash
+	Projects         []AttestedProject `json:"projects"`
+	Date             string            `json:"date"`
+	PracticesVersion string            `json:"practicesVersion"`
+	Maturity         map[string]int    `json:"maturity"`        // keyed on practice ID
+	ResponsesDigest  map[string]string `json:"responsesDigest"` // of the canonical JSON encoding of the responses
+}
+
+// AttestedProject identifies a project a plan applies to
+type AttestedProject struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+}
+
+// NewPlanStatement returns a statement about a plan revision
+func NewPlanStatement(planID string, revID string, revisionHash string, p *Plan, projects []AttestedProject) (*Statement, error) {
+	planDigest, err := CanonicalDigest(p)
+	if err != nil {
+		return nil, err
+	}
+	responsesDigest, err := CanonicalDigest(p.Responses)
+	if err != nil {
+		return nil, err
+	}
+	return &Statement{
+		Type:          InTotoStatementType,
+		Subject:       []Subject{{Name: "besec-plan/" + planID + "/revision/" + revID, Digest: map[string]string{"sha256": planDigest}}},
+		PredicateType: PlanPredicateType,
+		Predicate: PlanPredicate{
+			PlanID:           planID,
+			RevisionID:       revID,
+			RevisionHash:     revisionHash,
+			Projects:         projects,
+			Date:             p.Details.Date,
+			PracticesVersion: p.Responses.PracticesVersion,
+			Maturity:         p.Details.Maturity,
+			ResponsesDigest:  map[string]string{"sha256": responsesDigest},
+		},
+	}, nil
+}
+
+// pae is the DSSE pre-authentication encoding of a payload, which is what gets signed
+func pae(payloadType string, payload []byte) []byte {
+	return []byte(fmt.Sprintf("DSSEv1 %d %s %d %s", len(payloadType), payloadType, len(payload), payload))
+}
+
+// KeyID identifies an ed25519 public key, as the hex-encoded SHA-256 digest of the key
+func KeyID(pub ed25519.PublicKey) string {
+	sum := sha256.Sum256(pub)
+	return hex.EncodeToString(sum[:])
+}
+
+// Sign returns a DSSE envelope containing the statement, signed with key
+func (s *Statement) Sign(key ed25519.PrivateKey) (*Envelope, error) {
+	payload, err := json.Marshal(s)
+	if err != nil {
+		return nil, err
+	}
+	sig := ed25519.Sign(key, pae(DSSEPayloadType, payload))
+	return &Envelope{
+		PayloadType: DSSEPayloadType,
+		Payload:     base64.StdEncoding.EncodeToString(payload),
+		Signatures:  []Signature{{KeyID: KeyID(key.Public().(ed25519.PublicKey)), Sig: base64.StdEncoding.EncodeToString(sig)}},
+	}, nil
+}
+
+// Verify checks the envelope has a valid signature from pub and returns the plan statement it contains
+func (e *Envelope) Verify(pub ed25519.PublicKey) (*Statement, error) {
+	if e.PayloadType != DSSEPayloadType {
+		return nil, fmt.Errorf("unexpected payload type %q", e.PayloadType)
+	}
+	payload, err := base64.StdEncoding.DecodeString(e.Payload)
+	if err != nil {
+		return nil, fmt.Errorf("invalid payload encoding: %v", err)
+	}
+
+	verified := false
+	for _, s := range e.Signatures {
+		sig, err := base64.StdEncoding.DecodeString(s.Sig)
+		if err != nil {
+			continue
+		}
+		if ed25519.Verify(pub, pae(e.PayloadType, payload), sig) {
+			verified = true
+			break
+		}
+	}
+	if !verified {
+		return nil, fmt.Errorf("no valid signature from key %v", KeyID(pub))
+	}
+
+	s := new(Statement)
+	if err = json.Unmarshal(payload, s); err != nil {
+		return nil, fmt.Errorf("invalid statement: %v", err)
+	}
+	if s.Type != InTotoStatementType || s.PredicateType != PlanPredicateType {
+		return nil, fmt.Errorf("not a plan attestation: statement type %q, predicate type %q", s.Type, s.PredicateType)
+	}
+	return s, nil
+}
+
+// ParsePrivateKeyPEM parses a PEM-encoded PKCS #8 ed25519 private key
+func ParsePrivateKeyPEM(b []byte) (ed25519.PrivateKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+	key, err := x509.ParsePKCS8PrivateKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	edKey, ok := key.(ed25519.PrivateKey)
+	if !ok {
+		return nil, fmt.Errorf("not an ed25519 private key")
+	}
+	return edKey, nil
+}
+
+// ParsePublicKeyPEM parses a PEM-encoded PKIX ed25519 public key
+func ParsePublicKeyPEM(b []byte) (ed25519.PublicKey, error) {
+	block, _ := pem.Decode(b)
+	if block == nil {
+		return nil, fmt.Errorf("no PEM data found")
+	}
+	key, err := x509.ParsePKIXPublicKey(block.Bytes)
+	if err != nil {
+		return nil, err
+	}
+	edKey, ok := key.(ed25519.PublicKey)
+	if !ok {
+		return nil, fmt.Errorf("not an ed25519 public key")
+	}
+	return edKey, nil
+}
+
+// MarshalKeysPEM returns the PEM encodings of the private key and its public key
+func MarshalKeysPEM(key ed25519.PrivateKey) (private []byte, public []byte, err error) {
+	der, err := x509.MarshalPKCS8PrivateKey(key)
+	if err != nil {
+		return nil, nil, err
+	}
+	pubDer, err := x509.MarshalPKIXPublicKey(key.Public())
+	if err != nil {
+		return nil, nil, err
+	}
+	return pem.EncodeToMemory(&pem.Block{Type: "PRIVATE KEY", Bytes: der}), pem.EncodeToMemory(&pem.Block{Type: "PUBLIC KEY", Bytes: pubDer}), nil
+}
@@ -0,0 +1,67 @@
+package lib
+
+import (
+	"crypto/ed25519"
+	"crypto/rand"
+	"encoding/base64"
+	"testing"
+)
+
+func TestPlanAttestation(t *testing.T) {
+	_, key, err := ed25519.GenerateKey(rand.Reader)
+	if err != nil {
+		t.Fatal(err)
+	}
+	privPEM, pubPEM, err := MarshalKeysPEM(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+	key, err = ParsePrivateKeyPEM(privPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+	pub, err := ParsePublicKeyPEM(pubPEM)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	p := &Plan{
+		Details:   PlanDetails{Projects: []string{"p1"}, Date: "2021-03-04", Committed: true, Maturity: map[string]int{"slsa": 2}},
+		Responses: PlanResponses{PracticesVersion: "1.0"},
+	}
+	s, err := NewPlanStatement("plan", "rev", "abc", p, []AttestedProject{{ID: "p1", Name: "Project 1"}})
+	if err != nil {
+		t.Fatal(err)
+	}
+	env, err := s.Sign(key)
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	got, err := env.Verify(pub)
+	if err != nil {
+		t.Fatalf("failed to verify a freshly signed attestation: %v", err)
+	}
+	if got.Predicate.Maturity["slsa"] != 2 || got.Predicate.Projects[0].Name != "Project 1" || got.Subject[0].Digest["sha256"] == "" {
+		t.Errorf("verified statement doesn't match what was signed: %+v", got)
+	}
+
+	otherPub, _, _ := ed25519.GenerateKey(rand.Reader)
+	if _, err = env.Verify(otherPub); err == nil {
+		t.Error("attestation verified with the wrong key")
+	}
+
+	tampered := *env
+	s.Predicate.Maturity["slsa"] = 3
+	forged, _ := s.Sign(key)
+	tampered.Payload = forged.Payload
+	if _, err = tampered.Verify(pub); err == nil {
+		t.Error("attestation with a modified payload verified")
+	}
+
+	tampered = *env
+	tampered.Payload = base64.StdEncoding.EncodeToString([]byte("{}"))
+	if _, err = tampered.Verify(pub); err == nil {
+		t.Error("attestation with a replaced payload verified")
+	}
+}
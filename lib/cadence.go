@@ -0,0 +1,39 @@
+package lib
+
+import (
+	"fmt"
+	"time"
+)
+
+// Cadence returns how often, in days, a project should commit a new plan: its own cadence if it has one, otherwise
+// that of its org unit or the nearest unit above it with one, otherwise def. Units' cadences are keyed on unit ID.
+// Zero means the project isn't expected to be assessed regularly.
+func Cadence(own *int, unit string, parents map[string]string, unitCadences map[string]int, def int) int {
+	if own != nil {
+		return *own
+	}
+	if unit != "" {
+		for _, u := range append([]string{unit}, Ancestors(parents, unit)...) {
+			if c, ok := unitCadences[u]; ok {
+				return c
+			}
+		}
+	}
+	return def
+}
+
+// AssessmentDue returns the date a new plan is due, given the date (YYYY-MM-DD) of the latest committed plan
+func AssessmentDue(last string, cadenceDays int) (time.Time, error) {
+	t, err := time.Parse("2006-01-02", last)
+	if err != nil {
+		return time.Time{}, fmt.Errorf("invalid plan date %v: %v", last, err)
+	}
+	return t.AddDate(0, 0, cadenceDays), nil
+}
+
+// DaysOverdue returns how many whole days have passed since the due date: 0 on the due date, and a negative number before it
+func DaysOverdue(due time.Time, now time.Time) int {
+	now = now.UTC()
+	today := time.Date(now.Year(), now.Month(), now.Day(), 0, 0, 0, 0, time.UTC)
+	return int(today.Sub(due).Hours() / 24)
+}
@@ -0,0 +1,52 @@
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func TestCadence(t *testing.T) {
+	parents := map[string]string{"bu": "", "team": "bu", "squad": "team"}
+	units := map[string]int{"bu": 180, "team": 90}
+	zero, thirty := 0, 30
+
+	cases := []struct {
+		own  *int
+		unit string
+		want int
+	}{
+		{&thirty, "squad", 30},
+		{&zero, "squad", 0}, // opted out
+		{nil, "squad", 90},
+		{nil, "bu", 180},
+		{nil, "unknown", 365},
+		{nil, "", 365},
+	}
+	for _, c := range cases {
+		if got := Cadence(c.own, c.unit, parents, units, 365); got != c.want {
+			t.Errorf("Cadence(%v, %v) = %v, want %v", c.own, c.unit, got, c.want)
+		}
+	}
+}
+
+func TestAssessmentDue(t *testing.T) {
+	due, err := AssessmentDue("2024-01-31", 30)
+	if err != nil {
+		t.Fatal(err)
+	}
+	if due.Format("2006-01-02") != "2024-03-01" {
+		t.Errorf("due %v", due)
+	}
+	if d := DaysOverdue(due, time.Date(2024, 3, 1, 23, 0, 0, 0, time.UTC)); d != 0 {
+		t.Errorf("due today is %v days overdue", d)
+	}
+	if d := DaysOverdue(due, time.Date(2024, 3, 11, 1, 0, 0, 0, time.UTC)); d != 10 {
+		t.Errorf("got %v days overdue, want 10", d)
+	}
+	if d := DaysOverdue(due, time.Date(2024, 2, 29, 0, 0, 0, 0, time.UTC)); d >= 0 {
+		t.Errorf("not yet due, but got %v days overdue", d)
+	}
+	if _, err = AssessmentDue("31/01/2024", 30); err == nil {
+		t.Error("invalid date was accepted")
+	}
+}
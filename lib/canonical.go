@@ -0,0 +1,63 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+)
+
+// CanonicalJSON returns a stable JSON encoding of v, suitable for comparing and hashing.
+// Object keys are sorted, and null values, empty strings, false booleans, and empty arrays and objects are dropped,
+// so that values which only differ in how they represent 'nothing' (e.g. a YAML file vs an API response) encode identically.
+func CanonicalJSON(v interface{}) ([]byte, error) {
+	raw, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	var generic interface{}
+	if err = json.Unmarshal(raw, &generic); err != nil {
+		return nil, err
+	}
+	pruned, _ := pruneEmpty(generic)
+	return json.Marshal(pruned) // encoding/json sorts map keys
+}
+
+// CanonicalDigest returns the hex-encoded SHA-256 digest of the canonical JSON encoding of v
+func CanonicalDigest(v interface{}) (string, error) {
+	c, err := CanonicalJSON(v)
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(c)
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// pruneEmpty recursively removes empty values, returning false if v itself is empty
+func pruneEmpty(v interface{}) (interface{}, bool) {
+	switch v := v.(type) {
+	case nil:
+		return nil, false
+	case string:
+		return v, v != ""
+	case bool:
+		return v, v
+	case []interface{}:
+		out := []interface{}{}
+		for _, e := range v {
+			if pruned, ok := pruneEmpty(e); ok {
+				out = append(out, pruned)
+			}
+		}
+		return out, len(out) > 0
+	case map[string]interface{}:
+		out := map[string]interface{}{}
+		for k, e := range v {
+			if pruned, ok := pruneEmpty(e); ok {
+				out[k] = pruned
+			}
+		}
+		return out, len(out) > 0
+	default:
+		return v, true
+	}
+}
@@ -0,0 +1,64 @@
+package lib
+
+import "sort"
+
+// PracticeMaturity summarises the maturity of a set of projects for a single practice
+type PracticeMaturity struct {
+	Median   float64
+	Min      int
+	Projects int // the number of projects with a calculated maturity for the practice
+}
+
+// MaturityRollUp summarises the maturity of the projects in an org unit and its descendants
+type MaturityRollUp struct {
+	Projects  int                         // the number of projects in the unit and its descendants
+	Practices map[string]PracticeMaturity // keyed on practice ID
+}
+
+// SummariseMaturity calculates per-practice statistics for a set of projects' maturity levels, each keyed on practice ID.
+// Practices that no project has a maturity for are omitted.
+func SummariseMaturity(levels []map[string]int) map[string]PracticeMaturity {
+	byPractice := map[string][]int{}
+	for _, l := range levels {
+		for practice, level := range l {
+			byPractice[practice] = append(byPractice[practice], level)
+		}
+	}
+
+	summary := make(map[string]PracticeMaturity, len(byPractice))
+	for practice, ls := range byPractice {
+		sort.Ints(ls)
+		median := float64(ls[len(ls)/2])
+		if len(ls)%2 == 0 {
+			median = float64(ls[len(ls)/2-1]+ls[len(ls)/2]) / 2
+		}
+		summary[practice] = PracticeMaturity{Median: median, Min: ls[0], Projects: len(ls)}
+	}
+	return summary
+}
+
+// RollUpMaturity summarises project maturity for every org unit, counting each project towards its own unit and all of that unit's ancestors.
+// parents maps each unit ID to its parent's ID ("" for top-level units), projectUnits maps each project ID to the ID of the unit it belongs to,
+// and projectLevels maps project IDs to their maturity levels, keyed on practice ID. Projects without any levels are counted, but
+// don't contribute to the practice statistics.
+func RollUpMaturity(parents map[string]string, projectUnits map[string]string, projectLevels map[string]map[string]int) map[string]MaturityRollUp {
+	counts := map[string]int{}
+	levels := map[string][]map[string]int{}
+	for project, unit := range projectUnits {
+		if _, ok := parents[unit]; !ok {
+			continue // not in the tree
+		}
+		for _, u := range append([]string{unit}, Ancestors(parents, unit)...) {
+			counts[u]++
+			if l, ok := projectLevels[project]; ok {
+				levels[u] = append(levels[u], l)
+			}
+		}
+	}
+
+	rollUp := make(map[string]MaturityRollUp, len(parents))
+	for unit := range parents {
+		rollUp[unit] = MaturityRollUp{Projects: counts[unit], Practices: SummariseMaturity(levels[unit])}
+	}
+	return rollUp
+}
@@ -0,0 +1,51 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestSummariseMaturity(t *testing.T) {
+	cases := []struct {
+		in   []map[string]int
+		want map[string]PracticeMaturity
+	}{
+		{nil, map[string]PracticeMaturity{}},
+		{[]map[string]int{{"a": 2}}, map[string]PracticeMaturity{"a": {Median: 2, Min: 2, Projects: 1}}},
+		{[]map[string]int{{"a": 3}, {"a": 1}, {"a": 2}}, map[string]PracticeMaturity{"a": {Median: 2, Min: 1, Projects: 3}}},
+		{[]map[string]int{{"a": 1, "b": 0}, {"a": 2}}, map[string]PracticeMaturity{"a": {Median: 1.5, Min: 1, Projects: 2}, "b": {Median: 0, Min: 0, Projects: 1}}},
+	}
+
+	for _, c := range cases {
+		got := SummariseMaturity(c.in)
+		if !reflect.DeepEqual(got, c.want) {
+			t.Errorf("SummariseMaturity(%v) == %v, want %v", c.in, got, c.want)
+		}
+	}
+}
+
+func TestRollUpMaturity(t *testing.T) {
+	// bu -> line -> {p1, p2}; bu -> p3; other -> nothing; cyclic units are tolerated
+	parents := map[string]string{"bu": "", "line": "bu", "other": "", "x": "y", "y": "x"}
+	projectUnits := map[string]string{"p1": "line", "p2": "line", "p3": "bu", "p4": "x", "orphan": "deleted"}
+	levels := map[string]map[string]int{"p1": {"a": 1}, "p2": {"a": 3}, "p3": {"a": 0, "b": 2}}
+
+	got := RollUpMaturity(parents, projectUnits, levels)
+	want := map[string]MaturityRollUp{
+		"line":  {Projects: 2, Practices: map[string]PracticeMaturity{"a": {Median: 2, Min: 1, Projects: 2}}},
+		"bu":    {Projects: 3, Practices: map[string]PracticeMaturity{"a": {Median: 1, Min: 0, Projects: 3}, "b": {Median: 2, Min: 2, Projects: 1}}},
+		"other": {Projects: 0, Practices: map[string]PracticeMaturity{}},
+		"x":     {Projects: 1, Practices: map[string]PracticeMaturity{}},
+		"y":     {Projects: 1, Practices: map[string]PracticeMaturity{}},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("RollUpMaturity() == %v, want %v", got, want)
+	}
+
+	if a := Ancestors(parents, "line"); !reflect.DeepEqual(a, []string{"bu"}) {
+		t.Errorf("Ancestors(line) == %v, want [bu]", a)
+	}
+	if d := DepthFirst(parents, func(a, b string) bool { return a < b }); !reflect.DeepEqual(d, []string{"bu", "line", "other"}) {
+		t.Errorf("DepthFirst() == %v, want [bu line other]", d)
+	}
+}
@@ -0,0 +1,42 @@
+package lib
+
+import "sort"
+
+// Org units form a tree, represented by a map from each unit's ID to its parent's ID ("" for top-level units)
+
+// Ancestors returns the IDs of the unit's ancestors, nearest first, given each unit's parent ID.
+// It stops at a unit without a parent, or on detecting a cycle.
+func Ancestors(parents map[string]string, unit string) []string {
+	ancestors := []string{}
+	seen := map[string]bool{unit: true}
+	for p := parents[unit]; p != "" && !seen[p]; p = parents[p] {
+		ancestors = append(ancestors, p)
+		seen[p] = true
+	}
+	return ancestors
+}
+
+// DepthFirst returns the IDs of the units in the tree, each followed by its descendants, with siblings sorted by less.
+// Units with a parent that isn't in the tree are treated as top-level; units in a cycle are omitted.
+func DepthFirst(parents map[string]string, less func(a, b string) bool) []string {
+	children := map[string][]string{}
+	for id, parent := range parents {
+		if _, ok := parents[parent]; !ok {
+			parent = ""
+		}
+		children[parent] = append(children[parent], id)
+	}
+
+	ids := []string{}
+	var visit func(parent string)
+	visit = func(parent string) {
+		cs := children[parent]
+		sort.Slice(cs, func(i, j int) bool { return less(cs[i], cs[j]) })
+		for _, c := range cs {
+			ids = append(ids, c)
+			visit(c)
+		}
+	}
+	visit("")
+	return ids
+}
@@ -90,6 +90,8 @@ type PlanResponses struct {
 	PracticesVersion  string                      `json:"practicesVersion" yaml:"practicesVersion"`
 	PracticeResponses map[string]PracticeResponse `json:"practiceResponses" yaml:"practiceResponses"` // keyed on practiceID
 	practices         []Practice
+	project           ProjectContext
+	date              string // the plan's date, which risk acceptances must not have expired by to count toward maturity
 }
 
 // PracticeResponse holds the responses to the practice and task questions
@@ -98,12 +100,22 @@ type PracticeResponse struct {
 	Tasks    map[string]TaskResponse `json:"tasks"`    // keyed on task ID
 }
 
-// TaskResponse holds the answers to a task's questions and the optional extra info about a task's implementation
+// TaskResponse holds the answers to a task's questions and the optional extra info about a task's implementation.
+// Prioritised tasks can be tracked with an assignee, target date and status.
+// Tasks that won't be done can have their risk formally accepted instead.
 type TaskResponse struct {
 	Answers    map[string]Answer `json:"answers"`
 	Priority   bool              `json:"priority"`
 	Issues     []string          `json:"issues"`
 	References string            `json:"references"`
+	Assignee   string            `json:"assignee,omitempty" yaml:"assignee,omitempty"`     // who is responsible for implementing the task
+	TargetDate string            `json:"targetDate,omitempty" yaml:"targetDate,omitempty"` // when it should be done by (YYYY-MM-DD)
+	Status     TaskStatus        `json:"status,omitempty" yaml:"status,omitempty"`
+	// LinkedIssues and Reassess are filled in from the issue trackers when requested, and aren't stored
+	LinkedIssues []LinkedIssue `json:"linkedIssues,omitempty" yaml:"-"`
+	Reassess     bool          `json:"reassess,omitempty" yaml:"-"` // every linked issue is closed, but the task isn't answered Yes
+	// RiskAcceptance records a decision not to do the task for now, and accept the risk until it expires
+	RiskAcceptance *RiskAcceptance `json:"riskAcceptance,omitempty" yaml:"riskAcceptance,omitempty"`
 }
 
 // Answer holds the 'hard' answer and any notes for a response to a question
@@ -112,9 +124,12 @@ type Answer struct {
 	Notes  string    `json:"notes"`
 }
 
-// NewPlan returns a Plan with its calculated maturity
-func NewPlan(details PlanDetails, responses PlanResponses, practices []Practice) Plan {
+// NewPlan returns a Plan with its calculated maturity.
+// project holds the attributes of the plan's projects, which practice conditions may use; it is nil if they aren't known.
+func NewPlan(details PlanDetails, responses PlanResponses, practices []Practice, project ProjectContext) Plan {
 	responses.practices = practices
+	responses.project = project
+	responses.date = details.Date
 	p := Plan{Details: details, Responses: responses}
 	p.CalculateMaturity()
 	return p
@@ -157,10 +172,13 @@ func (mr *PracticeResponse) Validate(formats interface{}) error {
 	return nil
 }
 
-// Validate that the responses to this Plan have answers to all questions.
+// Validate that the responses to this Plan have answers to all questions, and that tasks' tracking details are valid.
 // Note practice-applicability isn't considered: all questions need a response, even if it is "Unanswered"
 // If the response doesn't have practices populated, no validation is done
 func (responses *PlanResponses) Validate(formats interface{}) error {
+	if err := responses.validateTaskTracking(); err != nil {
+		return err
+	}
 	if responses.practices == nil {
 		return nil
 	}
@@ -205,12 +223,21 @@ func (responses *PlanResponses) MissingPracticeAnswers() []string {
 	return missing
 }
 
-// PracticeApplies returns true if the plan indicates this practice applies
-// If there is a missing or invalid response to a question, return an error
+// PracticeApplies returns true if the plan indicates this practice applies.
+// If the attributes of the plan's projects are enough to evaluate the practice's condition, the answers to its questions aren't needed.
+// Otherwise, if there is a missing or invalid response to a question, return an error
 func (responses *PlanResponses) PracticeApplies(practice Practice) (bool, error) {
-	if len(practice.Questions) == 0 {
+	if practice.Condition == "" {
 		return true, nil
 	}
+	if responses.project != nil {
+		if result, err := practice.EvaluateCondition(nil, responses.project); err == nil {
+			return result, nil
+		}
+	}
+	if len(practice.Questions) == 0 {
+		return false, fmt.Errorf("the condition for practice %v depends on the attributes of the plan's projects, which aren't known", practice.ID)
+	}
 
 	practiceResp, ok := responses.PracticeResponses[practice.ID]
 	if !ok {
@@ -242,7 +269,7 @@ func (responses *PlanResponses) PracticeApplies(practice Practice) (bool, error)
 			return false, fmt.Errorf("Unanswered question in plan: %v", q)
 		}
 	}
-	result, err := practice.EvaluateCondition(params)
+	result, err := practice.EvaluateCondition(params, responses.project)
 	if err != nil {
 		return false, fmt.Errorf("Failed to evaluate condition for practice %v: %v", practice.ID, err)
 	}
@@ -277,6 +304,7 @@ func (responses *PlanResponses) TaskResult(practiceID string, taskID string) (An
 
 // PracticeLevel returns the highest level in the given practice for which all tasks of the same or lower level are answered Yes or N/A
 // It only considers answers in the response - if an answer is missing, it will be as if the task doesn't exist (or didn't have that question where they have multiple qs)
+// Tasks answered No count as Yes if the practice counts risk acceptances and the task's hadn't expired by the plan's date.
 // Returns an error if there are unanswered questions.
 func (responses *PlanResponses) PracticeLevel(practice Practice) (int, error) {
 	ts := responses.PracticeResponses[practice.ID].Tasks
@@ -293,7 +321,11 @@ func (responses *PlanResponses) PracticeLevel(practice Practice) (int, error) {
 		}
 		switch res {
 		case No:
-			no[t.Level] = true
+			if practice.RiskAcceptance == RiskAcceptanceCounted && responses.riskAccepted(practice.ID, tID) {
+				yes[t.Level] = true
+			} else {
+				no[t.Level] = true
+			}
 		case Unanswered:
 			return 0, fmt.Errorf("Can't compute practice level if it has unanswered questions")
 		default:
@@ -363,16 +395,8 @@ func (responses *PlanResponses) MissingAnswers(ignoreUnanswered bool) []string {
 // Depends on the global Practices array being populated
 func (responses *PlanResponses) ReadyToCommit() (bool, []string) {
 	errors := []string{}
-	for _, practice := range responses.practices {
-		applies, err := responses.PracticeApplies(practice)
-		if err != nil {
-			errors = append(errors, fmt.Sprintf("Can't tell if practice %v applies: %v", practice.ID, err))
-		} else if applies {
-			missing := responses.MissingAnswersForPractice(practice, false)
-			if len(missing) > 0 {
-				errors = append(errors, fmt.Sprintf("Missing or unanswered answers for applicable practice %v: %v", practice.ID, missing))
-			}
-		}
+	for _, v := range responses.incomplete() {
+		errors = append(errors, v.Message)
 	}
 	return len(errors) == 0, errors
 }
@@ -0,0 +1,91 @@
+package lib
+
+import (
+	"bytes"
+	_ "embed" // for the plan file schema
+	"fmt"
+
+	"github.com/santhosh-tekuri/jsonschema/v2"
+	yaml "gopkg.in/yaml.v2"
+)
+
+//go:embed planSchema.json
+var planSchemaJSON []byte // The JSON Schema for plan files. Editors can also use it to validate plan files as they are written.
+
+const planSchemaURL = "planSchema.json"
+
+// PlanFile is the YAML file representation of a single plan revision, for keeping plans under version control.
+// It uses the same field names as Plan, plus the ID of the plan the file corresponds to.
+type PlanFile struct {
+	PlanID    string        `yaml:"planId,omitempty"`
+	Details   PlanDetails   `yaml:"details"`
+	Responses PlanResponses `yaml:"responses"`
+}
+
+// ParsePlanFile validates the YAML in planYaml against the plan schema and parses it into a PlanFile
+func ParsePlanFile(planYaml []byte) (PlanFile, error) {
+	compiler := jsonschema.NewCompiler()
+	if err := compiler.AddResource(planSchemaURL, bytes.NewReader(planSchemaJSON)); err != nil {
+		panic(err)
+	}
+	schema, err := compiler.Compile(planSchemaURL)
+	if err != nil {
+		panic(fmt.Sprintf("Error parsing the embedded plan schema! %v", err))
+	}
+
+	// As with practices, we need a map[string]interface{} representation to validate against the schema
+	var ifPlan interface{}
+	if err = unmarshalYaml(planYaml, &ifPlan); err != nil {
+		return PlanFile{}, fmt.Errorf("failed to parse plan file: %v", err)
+	}
+	if err = schema.ValidateInterface(ifPlan); err != nil {
+		return PlanFile{}, fmt.Errorf("failed to validate plan file: %v", err)
+	}
+
+	var pf PlanFile
+	if err = yaml.Unmarshal(planYaml, &pf); err != nil { // can't use UnmarshalStrict with yamlv2 due to https://github.com/go-yaml/yaml/issues/410
+		return PlanFile{}, fmt.Errorf("error unmarshalling plan file, but validation against the schema succeeded! %v", err)
+	}
+	return pf, nil
+}
+
+// Plan checks the file's responses against the practices, in the same way the API does when a revision is created,
+// and returns the resulting Plan with its maturity calculated.
+// If the file is committed, the plan must also be ready to commit.
+// The projects' attributes aren't known offline, so any practice conditions that depend on them are evaluated from the answers alone.
+func (pf PlanFile) Plan(practices []Practice) (Plan, error) {
+	if err := pf.Details.Validate(nil); err != nil {
+		return Plan{}, err
+	}
+
+	plan := NewPlan(pf.Details, pf.Responses, practices, nil)
+	if err := plan.Responses.Validate(nil); err != nil {
+		return Plan{}, err
+	}
+
+	if plan.Details.Committed {
+		if ready, issues := plan.Responses.ReadyToCommit(); !ready {
+			return Plan{}, fmt.Errorf("cannot commit plan: %v", issues)
+		}
+	}
+	return plan, nil
+}
+
+// Matches reports whether the file has the same content as the plan revision with the given details and responses.
+// Calculated fields, like maturity, are not compared.
+func (pf PlanFile) Matches(details PlanDetails, responses PlanResponses) (bool, error) {
+	local, err := CanonicalJSON(Plan{Details: withoutMaturity(pf.Details), Responses: pf.Responses})
+	if err != nil {
+		return false, err
+	}
+	remote, err := CanonicalJSON(Plan{Details: withoutMaturity(details), Responses: responses})
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(local, remote), nil
+}
+
+func withoutMaturity(d PlanDetails) PlanDetails {
+	d.Maturity = nil
+	return d
+}
@@ -0,0 +1,62 @@
+package lib
+
+import (
+	"io/ioutil"
+	"testing"
+)
+
+func TestExamplePlanFile(t *testing.T) {
+	planYaml, err := ioutil.ReadFile("../docs/examplePlan.yaml")
+	if err != nil {
+		t.Fatal(err)
+	}
+	pf, err := ParsePlanFile(planYaml)
+	if err != nil {
+		t.Fatalf("Failed to parse the example plan: %v", err)
+	}
+
+	parser := NewPracticeParser("../demo/practices", "../practices/schema.json", nil)
+	practices, err := parser.ParsePracticesDir()
+	if err != nil {
+		t.Fatal(err)
+	}
+	plan, err := pf.Plan(practices)
+	if err != nil {
+		t.Fatalf("The example plan isn't valid against the demo practices: %v", err)
+	}
+
+	// A revision that has been through the API has its maturity populated and empty values made explicit
+	details := plan.Details
+	responses := plan.Responses
+	gibson := responses.PracticeResponses["gibson"]
+	gibson.Practice = map[string]Answer{}
+	responses.PracticeResponses["gibson"] = gibson
+	if same, err := pf.Matches(details, responses); err != nil || !same {
+		t.Errorf("Expected the plan file to match its own plan, got %v (%v)", same, err)
+	}
+
+	details.Notes = "changed"
+	if same, err := pf.Matches(details, responses); err != nil || same {
+		t.Errorf("Expected the plan file not to match a revision with different notes, got %v (%v)", same, err)
+	}
+}
+
+func TestParsePlanFileRejectsUnquotedAnswers(t *testing.T) {
+	planYaml := []byte(`
+details:
+  projects: ["a"]
+  date: "2021-01-01"
+responses:
+  practicesVersion: "1"
+  practiceResponses:
+    p:
+      tasks:
+        t:
+          answers:
+            t:
+              answer: Yes
+`)
+	if _, err := ParsePlanFile(planYaml); err == nil {
+		t.Error("Expected an unquoted Yes to fail validation")
+	}
+}
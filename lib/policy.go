@@ -0,0 +1,160 @@
+package lib
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/go-openapi/strfmt"
+)
+
+// CommitRule identifies a check that a plan must pass before it can be committed
+type CommitRule string
+
+// The rules a commit policy can enforce. RuleComplete is always enforced, whatever the policy.
+const (
+	RuleComplete          CommitRule = "complete"            // applicable practices have every question answered
+	RuleNANotes           CommitRule = "na-notes"            // every N/A answer has notes explaining why
+	RuleTargetLevelIssues CommitRule = "target-level-issues" // every task answered No at a practice's target level has an issue
+	RulePriorityTask      CommitRule = "priority-task"       // at least one task is prioritised
+	RuleNoFutureDate      CommitRule = "no-future-date"      // the plan isn't dated in the future
+)
+
+var commitRules = []CommitRule{RuleComplete, RuleNANotes, RuleTargetLevelIssues, RulePriorityTask, RuleNoFutureDate}
+
+// CommitPolicy lists the rules, in addition to RuleComplete, that plans must pass to be committed
+type CommitPolicy struct {
+	Rules []CommitRule `json:"rules"`
+}
+
+// Validate checks every rule in the policy is known
+func (p *CommitPolicy) Validate(formats interface{}) error {
+	for _, r := range p.Rules {
+		known := false
+		for _, k := range commitRules {
+			known = known || r == k
+		}
+		if !known {
+			return fmt.Errorf("unknown commit rule %q, must be one of %v", r, commitRules)
+		}
+	}
+	return nil
+}
+
+// ContextValidate is required for the generated API code, but the goswagger docs don't describe its purpose.
+// It is related to validating read-only properties, see https://github.com/go-swagger/go-swagger/issues/2648
+func (p *CommitPolicy) ContextValidate(ctx context.Context, formats strfmt.Registry) error {
+	return nil
+}
+
+// has reports whether the policy enforces the rule
+func (p *CommitPolicy) has(rule CommitRule) bool {
+	for _, r := range p.Rules {
+		if r == rule {
+			return true
+		}
+	}
+	return rule == RuleComplete
+}
+
+// PolicyViolation is a reason a plan can't be committed. Practice, Task and Question locate the problem, where it has one.
+type PolicyViolation struct {
+	Rule     CommitRule `json:"rule"`
+	Practice string     `json:"practice,omitempty"`
+	Task     string     `json:"task,omitempty"`
+	Question string     `json:"question,omitempty"`
+	Message  string     `json:"message"`
+}
+
+// incomplete returns a violation for each applicable practice that has unanswered questions, or that can't be told to apply
+func (responses *PlanResponses) incomplete() []PolicyViolation {
+	violations := []PolicyViolation{}
+	for _, practice := range responses.practices {
+		applies, err := responses.PracticeApplies(practice)
+		if err != nil {
+			violations = append(violations, PolicyViolation{Rule: RuleComplete, Practice: practice.ID,
+				Message: fmt.Sprintf("Can't tell if practice %v applies: %v", practice.ID, err)})
+		} else if applies {
+			missing := responses.MissingAnswersForPractice(practice, false)
+			if len(missing) > 0 {
+				violations = append(violations, PolicyViolation{Rule: RuleComplete, Practice: practice.ID,
+					Message: fmt.Sprintf("Missing or unanswered answers for applicable practice %v: %v", practice.ID, missing)})
+			}
+		}
+	}
+	return violations
+}
+
+// CommitViolations returns the ways the plan breaks the policy, in the order of the practice definitions; if there are
+// none it can be committed. Plans dated after today (YYYY-MM-DD) break RuleNoFutureDate.
+// Depends on the plan's practices being populated.
+func (p *Plan) CommitViolations(policy CommitPolicy, today string) []PolicyViolation {
+	responses := &p.Responses
+	violations := responses.incomplete()
+
+	if policy.has(RuleNoFutureDate) && p.Details.Date > today {
+		violations = append(violations, PolicyViolation{Rule: RuleNoFutureDate,
+			Message: fmt.Sprintf("The plan is dated %v, which is in the future", p.Details.Date)})
+	}
+
+	prioritised := false
+	for _, practice := range responses.practices {
+		pr := responses.PracticeResponses[practice.ID]
+		if policy.has(RuleNANotes) {
+			for _, q := range practice.Questions {
+				if a := pr.Practice[q.ID]; a.Answer == NA && a.Notes == "" {
+					violations = append(violations, PolicyViolation{Rule: RuleNANotes, Practice: practice.ID, Question: q.ID,
+						Message: fmt.Sprintf("Question %v of practice %v is answered N/A without notes explaining why", q.ID, practice.ID)})
+				}
+			}
+			for _, t := range practice.Tasks {
+				for _, q := range t.Questions {
+					if a := pr.Tasks[t.ID].Answers[q.ID]; a.Answer == NA && a.Notes == "" {
+						violations = append(violations, PolicyViolation{Rule: RuleNANotes, Practice: practice.ID, Task: t.ID, Question: q.ID,
+							Message: fmt.Sprintf("Task %v.%v is answered N/A without notes explaining why", practice.ID, t.ID)})
+					}
+				}
+			}
+		}
+
+		for _, t := range pr.Tasks {
+			prioritised = prioritised || t.Priority
+		}
+
+		if policy.has(RuleTargetLevelIssues) {
+			violations = append(violations, responses.targetLevelViolations(practice)...)
+		}
+	}
+
+	if policy.has(RulePriorityTask) && !prioritised {
+		violations = append(violations, PolicyViolation{Rule: RulePriorityTask, Message: "At least one task must be prioritised"})
+	}
+	return violations
+}
+
+// targetLevelViolations returns a violation for each task at the practice's target level, the one above its current
+// maturity, that's answered No without an issue tracking it. Tasks whose risk has been accepted don't need an issue.
+// Practices that don't apply, or can't be assessed, have no target level.
+func (responses *PlanResponses) targetLevelViolations(practice Practice) []PolicyViolation {
+	violations := []PolicyViolation{}
+	if applies, err := responses.PracticeApplies(practice); err != nil || !applies {
+		return violations
+	}
+	level, err := responses.PracticeLevel(practice)
+	if err != nil {
+		return violations
+	}
+	for _, t := range practice.Tasks {
+		if int(t.Level) != level+1 {
+			continue
+		}
+		resp, ok := responses.PracticeResponses[practice.ID].Tasks[t.ID]
+		if !ok || len(resp.Issues) > 0 || responses.riskAccepted(practice.ID, t.ID) {
+			continue
+		}
+		if res, err := responses.TaskResult(practice.ID, t.ID); err == nil && res == No {
+			violations = append(violations, PolicyViolation{Rule: RuleTargetLevelIssues, Practice: practice.ID, Task: t.ID,
+				Message: fmt.Sprintf("Task %v.%v is answered No at the practice's target level %v, but has no linked issue", practice.ID, t.ID, level+1)})
+		}
+	}
+	return violations
+}
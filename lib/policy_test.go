@@ -0,0 +1,76 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestCommitViolations(t *testing.T) {
+	practices := []Practice{{
+		ID:        "auth",
+		Questions: []Question{{ID: "users", NA: true}},
+		Condition: "users",
+		Tasks: []Task{
+			{ID: "mfa", Level: 1, Questions: []Question{{ID: "mfa"}}},
+			{ID: "sso", Level: 2, Questions: []Question{{ID: "sso"}}},
+			{ID: "audit", Level: 2, Questions: []Question{{ID: "audit"}}},
+		},
+	}}
+	plan := func(date string, tasks map[string]TaskResponse) Plan {
+		return NewPlan(PlanDetails{Date: date, Projects: []string{"p"}}, PlanResponses{PracticeResponses: map[string]PracticeResponse{
+			"auth": {Practice: map[string]Answer{"users": {Answer: Yes}}, Tasks: tasks},
+		}}, practices, nil)
+	}
+	answered := func(a AnswerVal, q string) map[string]Answer { return map[string]Answer{q: {Answer: a}} }
+	everything := CommitPolicy{Rules: []CommitRule{RuleNANotes, RuleTargetLevelIssues, RulePriorityTask, RuleNoFutureDate}}
+
+	good := plan("2021-06-01", map[string]TaskResponse{
+		"mfa":   {Answers: answered(Yes, "mfa")},
+		"sso":   {Answers: answered(No, "sso"), Priority: true, Issues: []string{"https://issues.example.com/1"}},
+		"audit": {Answers: map[string]Answer{"audit": {Answer: NA, Notes: "no audit trail to keep"}}},
+	})
+	if v := good.CommitViolations(everything, "2021-06-01"); len(v) != 0 {
+		t.Errorf("a plan that meets the policy had violations: %+v", v)
+	}
+
+	bad := plan("2021-06-02", map[string]TaskResponse{
+		"mfa":   {Answers: answered(Yes, "mfa")},
+		"sso":   {Answers: answered(No, "sso")},
+		"audit": {Answers: answered(NA, "audit")},
+	})
+	if v := bad.CommitViolations(CommitPolicy{}, "2021-06-01"); len(v) != 0 {
+		t.Errorf("an empty policy only requires the plan to be complete, got %+v", v)
+	}
+	got := []PolicyViolation{}
+	for _, v := range bad.CommitViolations(everything, "2021-06-01") {
+		v.Message = ""
+		got = append(got, v)
+	}
+	want := []PolicyViolation{
+		{Rule: RuleNoFutureDate},
+		{Rule: RuleNANotes, Practice: "auth", Task: "audit", Question: "audit"},
+		{Rule: RuleTargetLevelIssues, Practice: "auth", Task: "sso"},
+		{Rule: RulePriorityTask},
+	}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("got violations %+v, want %+v", got, want)
+	}
+
+	incomplete := plan("2021-06-01", map[string]TaskResponse{
+		"mfa":   {Answers: answered(Unanswered, "mfa")},
+		"sso":   {Answers: answered(Yes, "sso")},
+		"audit": {Answers: answered(Yes, "audit")},
+	})
+	if v := incomplete.CommitViolations(CommitPolicy{}, "2021-06-01"); len(v) != 1 || v[0].Rule != RuleComplete || v[0].Practice != "auth" {
+		t.Errorf("expected the unanswered task to be reported, got %+v", v)
+	}
+}
+
+func TestCommitPolicyValidate(t *testing.T) {
+	if err := (&CommitPolicy{Rules: []CommitRule{RuleNANotes, RuleNoFutureDate}}).Validate(nil); err != nil {
+		t.Error(err)
+	}
+	if err := (&CommitPolicy{Rules: []CommitRule{"sign-off"}}).Validate(nil); err == nil {
+		t.Error("an unknown rule was accepted")
+	}
+}
@@ -27,6 +27,8 @@ type Practice struct {
 	Page      string     `json:"page"`      // Practice page URL
 	Condition string     `json:"condition"` // how to interpret a practice's qualifying questions
 	Notes     string     `json:"notes"`
+	// RiskAcceptance is whether tasks with unexpired risk acceptances count toward the practice's maturity
+	RiskAcceptance RiskAcceptancePolicy `json:"riskAcceptance,omitempty"`
 }
 
 // Task represents an individual task within a Practice
@@ -63,6 +65,7 @@ type practiceDef struct {
 	Page            string
 	Condition       string
 	Notes           string
+	RiskAcceptance  RiskAcceptancePolicy `yaml:"riskAcceptance"`
 }
 
 // FromDef converts the file representation of a practice into the internal/API representation
@@ -74,6 +77,7 @@ func (p *Practice) FromDef(md practiceDef) error {
 	p.Page = md.Page
 	p.Condition = md.Condition
 	p.Notes = md.Notes
+	p.RiskAcceptance = md.RiskAcceptance
 
 	p.Tasks = []Task{}
 	for _, tID := range md.Tasks {
@@ -99,13 +103,18 @@ func (p *Practice) ContextValidate(ctx context.Context, formats strfmt.Registry)
 	return nil
 }
 
-// EvaluateCondition evaluates the practice's condition with the provided named values
-func (p Practice) EvaluateCondition(parameters map[string]interface{}) (bool, error) {
-	e, err := govaluate.NewEvaluableExpression(p.Condition)
+// EvaluateCondition evaluates the practice's condition with the provided named values and the attributes of the plan's projects.
+// It returns an error if the condition refers to a value that isn't provided, unless the result doesn't depend on it.
+func (p Practice) EvaluateCondition(parameters map[string]interface{}, project ProjectContext) (bool, error) {
+	e, err := govaluate.NewEvaluableExpressionWithFunctions(p.Condition, project.functions())
 	if err != nil {
 		return false, err
 	}
-	result, err := e.Evaluate(parameters)
+	all := project.parameters()
+	for k, v := range parameters {
+		all[k] = v
+	}
+	result, err := e.Evaluate(all)
 	if err != nil {
 		return false, err
 	}
@@ -124,8 +133,8 @@ func (p Practice) EvaluateCondition(parameters map[string]interface{}) (bool, er
 // and also populates implicit question IDs
 //nolint:gocognit
 func (p *Practice) CheckConstraints() error {
-	if len(p.Questions) > 0 { // there must be a valid condition if there are any practice questions
-		if _, err := govaluate.NewEvaluableExpression(p.Condition); err != nil {
+	if len(p.Questions) > 0 || p.Condition != "" { // there must be a valid condition if there are any practice questions
+		if _, err := govaluate.NewEvaluableExpressionWithFunctions(p.Condition, ProjectContext(nil).functions()); err != nil {
 			return fmt.Errorf("Failed to parse condition '%v'", p.Condition)
 		}
 	}
@@ -0,0 +1,84 @@
+package lib
+
+import (
+	"errors"
+	"strings"
+
+	"github.com/Knetic/govaluate"
+)
+
+// DataClassifications lists the data classifications from least to most sensitive
+var DataClassifications = []string{"public", "internal", "confidential", "restricted"} //nolint:gochecknoglobals // effectively a constant
+
+var errConditionArgs = errors.New("project condition functions take a single string argument")
+
+// ProjectAttributes holds the attributes of a project that practice conditions can refer to
+type ProjectAttributes struct {
+	ProductType        string
+	InternetFacing     bool
+	DataClassification string
+	Tags               []string
+	TechStack          []string
+}
+
+// ProjectContext holds the attributes of each of a plan's projects.
+// A nil ProjectContext means the projects aren't known, for example when validating a plan offline.
+//
+// Practice conditions can refer to the context with these parameters and functions, alongside the answers to the practice's questions:
+//
+//	project_internetFacing       true if any of the projects is internet facing
+//	project_dataClassification   the most sensitive data classification of the projects, or '' if none is set
+//	isProductType('saas')        true if any of the projects is of that product type
+//	hasTag('pci')                true if any of the projects has that tag (ignoring case)
+//	usesTech('go')               true if any of the projects lists that technology in its tech stack (ignoring case)
+type ProjectContext []ProjectAttributes
+
+func (pc ProjectContext) parameters() map[string]interface{} {
+	internetFacing := false
+	classification := -1
+	for _, p := range pc {
+		internetFacing = internetFacing || p.InternetFacing
+		for i, c := range DataClassifications {
+			if c == p.DataClassification && i > classification {
+				classification = i
+			}
+		}
+	}
+	params := map[string]interface{}{
+		"project_internetFacing":     internetFacing,
+		"project_dataClassification": "",
+	}
+	if classification >= 0 {
+		params["project_dataClassification"] = DataClassifications[classification]
+	}
+	return params
+}
+
+// any returns a condition function that is true if the attribute of any of the projects matches its single string argument
+func (pc ProjectContext) any(attr func(ProjectAttributes) []string) govaluate.ExpressionFunction {
+	return func(args ...interface{}) (interface{}, error) {
+		if len(args) != 1 {
+			return nil, errConditionArgs
+		}
+		want, ok := args[0].(string)
+		if !ok {
+			return nil, errConditionArgs
+		}
+		for _, p := range pc {
+			for _, v := range attr(p) {
+				if strings.EqualFold(v, want) {
+					return true, nil
+				}
+			}
+		}
+		return false, nil
+	}
+}
+
+func (pc ProjectContext) functions() map[string]govaluate.ExpressionFunction {
+	return map[string]govaluate.ExpressionFunction{
+		"isProductType": pc.any(func(p ProjectAttributes) []string { return []string{p.ProductType} }),
+		"hasTag":        pc.any(func(p ProjectAttributes) []string { return p.Tags }),
+		"usesTech":      pc.any(func(p ProjectAttributes) []string { return p.TechStack }),
+	}
+}
@@ -0,0 +1,50 @@
+package lib
+
+import "testing"
+
+func TestPracticeAppliesWithProjectContext(t *testing.T) {
+	practice := Practice{
+		ID:        "hosted",
+		Questions: []Question{{ID: "isHosted"}},
+		Condition: "isProductType('saas') || isHosted",
+	}
+	onlyProject := Practice{ID: "restricted", Condition: "project_dataClassification == 'restricted' || hasTag('PCI')"}
+
+	answered := func(a AnswerVal) PlanResponses {
+		return PlanResponses{PracticeResponses: map[string]PracticeResponse{
+			"hosted": {Practice: map[string]Answer{"isHosted": {Answer: a}}},
+		}}
+	}
+	saas := ProjectContext{{ProductType: "library"}, {ProductType: "saas"}}
+	onPrem := ProjectContext{{ProductType: "onPremises", DataClassification: "restricted"}, {DataClassification: "internal"}}
+	tagged := ProjectContext{{Tags: []string{"pci"}}}
+
+	cases := []struct {
+		name      string
+		practice  Practice
+		responses PlanResponses
+		project   ProjectContext
+		want      bool
+		wantErr   bool
+	}{
+		{"saas project, question unanswered", practice, answered(Unanswered), saas, true, false},
+		{"saas project, answered no", practice, answered(No), saas, true, false},
+		{"other project, falls back to the answer", practice, answered(Yes), onPrem, true, false},
+		{"other project, unanswered", practice, answered(Unanswered), onPrem, false, true},
+		{"unknown project, falls back to the answer", practice, answered(No), nil, false, false},
+		{"most sensitive classification", onlyProject, PlanResponses{}, onPrem, true, false},
+		{"tags ignore case", onlyProject, PlanResponses{}, tagged, true, false},
+		{"no matching attributes", onlyProject, PlanResponses{}, saas, false, false},
+		{"project-only condition, unknown project", onlyProject, PlanResponses{}, nil, false, true},
+	}
+
+	for _, c := range cases {
+		c.responses.project = c.project
+		got, err := c.responses.PracticeApplies(c.practice)
+		if (err != nil) != c.wantErr {
+			t.Errorf("%v: PracticeApplies() error = %v, want error: %v", c.name, err, c.wantErr)
+		} else if got != c.want {
+			t.Errorf("%v: PracticeApplies() == %v, want %v", c.name, got, c.want)
+		}
+	}
+}
@@ -0,0 +1,94 @@
+package lib
+
+import (
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"time"
+)
+
+// Plan revisions form a hash chain: each revision's hash covers its content, author and time, and the hash of the
+// revision before it. Editing, removing or reordering a revision after the fact breaks the chain.
+// The hash of the latest revision (the head) is also kept with the plan, so removing the latest revision is detected.
+// Someone who can write to the database could rebuild the whole chain, so to detect that compare the head against
+// a previously recorded value.
+
+// ChainedRevision is a stored plan revision, with the fields that are covered by its hash
+type ChainedRevision struct {
+	ID        string
+	Plan      *Plan
+	AuthorUID string
+	Time      time.Time
+	PrevHash  string // empty for the first revision in the chain
+	Hash      string // empty for revisions created before chaining was introduced
+}
+
+// RevisionTime returns t at the precision it is stored with, so hashes computed before and after storage match
+func RevisionTime(t time.Time) time.Time {
+	return t.UTC().Truncate(time.Microsecond)
+}
+
+// RevisionHash returns the hex-encoded SHA-256 hash of a plan revision that follows the revision with hash prevHash
+func RevisionHash(prevHash string, p *Plan, authorUID string, t time.Time) (string, error) {
+	content, err := CanonicalJSON(struct {
+		Plan   *Plan  `json:"plan"`
+		Author string `json:"author"`
+		Time   string `json:"time"`
+	}{p, authorUID, RevisionTime(t).Format(time.RFC3339Nano)})
+	if err != nil {
+		return "", err
+	}
+	sum := sha256.Sum256(append(append([]byte(prevHash), 0), content...))
+	return hex.EncodeToString(sum[:]), nil
+}
+
+// ChainReport describes the integrity of a plan's revision history
+type ChainReport struct {
+	Intact    bool
+	Head      string   // the hash of the latest chained revision
+	Revisions int      // the number of revisions checked
+	Unchained int      // revisions from before chaining was introduced, which can't be checked
+	Problems  []string // why the chain isn't intact
+}
+
+// VerifyRevisionChain checks a plan's revisions, in order from oldest to latest, against each other and against head,
+// the chain head stored with the plan.
+func VerifyRevisionChain(revs []ChainedRevision, head string) ChainReport {
+	report := ChainReport{Revisions: len(revs), Problems: []string{}}
+	problem := func(format string, a ...interface{}) {
+		report.Problems = append(report.Problems, fmt.Sprintf(format, a...))
+	}
+
+	prev, prevID := "", ""
+	for _, rev := range revs {
+		if rev.Hash == "" {
+			if prevID == "" {
+				report.Unchained++
+			} else {
+				problem("revision %v has no hash, but follows chained revision %v", rev.ID, prevID)
+			}
+			continue
+		}
+		if rev.PrevHash != prev {
+			if prevID == "" {
+				problem("revision %v is the first chained revision but refers to an earlier one: revisions may have been removed", rev.ID)
+			} else {
+				problem("revision %v doesn't follow revision %v: revisions may have been removed or reordered", rev.ID, prevID)
+			}
+		}
+		hash, err := RevisionHash(rev.PrevHash, rev.Plan, rev.AuthorUID, rev.Time)
+		if err != nil {
+			problem("revision %v couldn't be hashed: %v", rev.ID, err)
+		} else if hash != rev.Hash {
+			problem("revision %v doesn't match its hash: it has been modified", rev.ID)
+		}
+		prev, prevID = rev.Hash, rev.ID
+	}
+	if head != prev {
+		problem("the latest revision doesn't match the plan's chain head: revisions may have been removed")
+	}
+
+	report.Head = prev
+	report.Intact = len(report.Problems) == 0
+	return report
+}
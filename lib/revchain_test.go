@@ -0,0 +1,78 @@
+package lib
+
+import (
+	"testing"
+	"time"
+)
+
+func testChain(t *testing.T, n int) []ChainedRevision {
+	start := time.Date(2021, 3, 4, 5, 6, 7, 891234567, time.UTC)
+	revs := []ChainedRevision{}
+	prev := ""
+	for i := 0; i < n; i++ {
+		p := &Plan{Details: PlanDetails{Projects: []string{"p"}, Date: "2021-03-04", Notes: string(rune('a' + i))}}
+		rev := ChainedRevision{ID: string(rune('A' + i)), Plan: p, AuthorUID: "u", Time: start.Add(time.Duration(i) * time.Hour), PrevHash: prev}
+		hash, err := RevisionHash(prev, p, rev.AuthorUID, rev.Time)
+		if err != nil {
+			t.Fatal(err)
+		}
+		rev.Hash = hash
+		revs = append(revs, rev)
+		prev = hash
+	}
+	return revs
+}
+
+func TestVerifyRevisionChain(t *testing.T) {
+	revs := testChain(t, 3)
+	head := revs[2].Hash
+
+	// Timestamps lose precision when they're stored
+	stored := append([]ChainedRevision{}, revs...)
+	for i := range stored {
+		stored[i].Time = RevisionTime(stored[i].Time)
+	}
+	if r := VerifyRevisionChain(stored, head); !r.Intact || r.Head != head || r.Revisions != 3 {
+		t.Errorf("untouched chain isn't intact: %+v", r)
+	}
+
+	edited := append([]ChainedRevision{}, revs...)
+	edited[1].Plan = &Plan{Details: PlanDetails{Projects: []string{"p"}, Date: "2021-03-04", Notes: "edited"}}
+	if r := VerifyRevisionChain(edited, head); r.Intact || len(r.Problems) != 1 {
+		t.Errorf("edited revision wasn't detected: %+v", r)
+	}
+
+	reattributed := append([]ChainedRevision{}, revs...)
+	reattributed[0].AuthorUID = "someone else"
+	if r := VerifyRevisionChain(reattributed, head); r.Intact {
+		t.Errorf("changed author wasn't detected: %+v", r)
+	}
+
+	removed := []ChainedRevision{revs[0], revs[2]}
+	if r := VerifyRevisionChain(removed, head); r.Intact {
+		t.Errorf("removed revision wasn't detected: %+v", r)
+	}
+
+	if r := VerifyRevisionChain(revs[:2], head); r.Intact {
+		t.Errorf("removed latest revision wasn't detected: %+v", r)
+	}
+	if r := VerifyRevisionChain(revs[1:], head); r.Intact {
+		t.Errorf("removed first revision wasn't detected: %+v", r)
+	}
+}
+
+func TestVerifyRevisionChainUnchained(t *testing.T) {
+	legacy := ChainedRevision{ID: "old", Plan: &Plan{}, AuthorUID: "u", Time: time.Now()}
+	revs := append([]ChainedRevision{legacy}, testChain(t, 2)...)
+	if r := VerifyRevisionChain(revs, revs[2].Hash); !r.Intact || r.Unchained != 1 {
+		t.Errorf("chain following a legacy revision isn't intact: %+v", r)
+	}
+	if r := VerifyRevisionChain([]ChainedRevision{legacy}, ""); !r.Intact || r.Unchained != 1 {
+		t.Errorf("legacy plan isn't intact: %+v", r)
+	}
+
+	unhashed := append(testChain(t, 2), legacy)
+	if r := VerifyRevisionChain(unhashed, unhashed[1].Hash); r.Intact {
+		t.Errorf("unhashed revision after the chain started wasn't detected: %+v", r)
+	}
+}
@@ -0,0 +1,84 @@
+package lib
+
+import (
+	"fmt"
+	"time"
+)
+
+// RiskAcceptancePolicy is how a practice treats tasks whose risk has been accepted when calculating its maturity level
+type RiskAcceptancePolicy string
+
+// Possible values for RiskAcceptancePolicy. A practice without a policy ignores risk acceptances.
+const (
+	RiskAcceptanceIgnored RiskAcceptancePolicy = "ignored"
+	RiskAcceptanceCounted RiskAcceptancePolicy = "counted"
+)
+
+// RiskAcceptance is a decision not to do a task for now, accepting the risk until it expires.
+// The plan's author gives the justification and expiry; the approver is recorded by the server when someone who can
+// review plans, and didn't write the acceptance, approves it. Only approved acceptances count toward maturity.
+type RiskAcceptance struct {
+	Justification string `json:"justification"`
+	Expires       string `json:"expires"`               // when it should be reviewed (YYYY-MM-DD)
+	Approver      string `json:"approver,omitempty"`    // who approved it, reminded when it expires if it's an email address
+	ApproverUID   string `json:"approverUid,omitempty"` // the approver's user ID
+}
+
+// Expired reports whether the acceptance had expired by the given date (YYYY-MM-DD)
+func (a *RiskAcceptance) Expired(date string) bool {
+	return a.Expires < date
+}
+
+// Approved reports whether the acceptance has been approved
+func (a *RiskAcceptance) Approved() bool {
+	return a.ApproverUID != ""
+}
+
+// SameTerms reports whether the two acceptances have the same justification and expiry, so an approval of one applies to the other
+func (a *RiskAcceptance) SameTerms(b *RiskAcceptance) bool {
+	return a != nil && b != nil && a.Justification == b.Justification && a.Expires == b.Expires
+}
+
+// riskAccepted reports whether the task has an approved risk acceptance that hadn't expired by the plan's date.
+// Without a date, the acceptance can't be shown to be current, so it's treated as expired.
+func (responses *PlanResponses) riskAccepted(practiceID string, taskID string) bool {
+	a := responses.PracticeResponses[practiceID].Tasks[taskID].RiskAcceptance
+	return a != nil && a.Approved() && responses.date != "" && !a.Expired(responses.date)
+}
+
+// CarryRiskApprovals replaces the approvals of the responses' risk acceptances with those from the previous revision's
+// responses, which may be nil: an approval is kept if the acceptance's terms haven't changed, and is otherwise removed.
+// Approvals are only given through the API, so this stops a plan's author approving their own acceptances.
+func (responses *PlanResponses) CarryRiskApprovals(previous *PlanResponses) {
+	for practiceID, pr := range responses.PracticeResponses {
+		for taskID, t := range pr.Tasks {
+			a := t.RiskAcceptance
+			if a == nil {
+				continue
+			}
+			carried := *a
+			carried.Approver, carried.ApproverUID = "", ""
+			if previous != nil {
+				if prev := previous.PracticeResponses[practiceID].Tasks[taskID].RiskAcceptance; prev.SameTerms(a) {
+					carried.Approver, carried.ApproverUID = prev.Approver, prev.ApproverUID
+				}
+			}
+			t.RiskAcceptance = &carried
+			pr.Tasks[taskID] = t
+		}
+	}
+}
+
+// validateRiskAcceptance checks a task's risk acceptance, if it has one, is complete
+func validateRiskAcceptance(practiceID string, taskID string, a *RiskAcceptance) error {
+	if a == nil {
+		return nil
+	}
+	if a.Justification == "" {
+		return fmt.Errorf("the risk acceptance for task %v.%v needs a justification", practiceID, taskID)
+	}
+	if _, err := time.Parse("2006-01-02", a.Expires); err != nil {
+		return fmt.Errorf("invalid expiry date for the risk acceptance of task %v.%v, must be YYYY-MM-DD: %v", practiceID, taskID, a.Expires)
+	}
+	return nil
+}
@@ -0,0 +1,63 @@
+package lib
+
+import "testing"
+
+func TestPracticeLevelRiskAcceptance(t *testing.T) {
+	practice := Practice{ID: "auth", Tasks: []Task{{ID: "mfa", Level: 1}, {ID: "sso", Level: 2}}}
+	approved := &RiskAcceptance{Justification: "internal only", Approver: "ciso@example.com", ApproverUID: "u-ciso", Expires: "2024-06-30"}
+	pending := &RiskAcceptance{Justification: "internal only", Approver: "ciso@example.com", Expires: "2024-06-30"}
+
+	cases := []struct {
+		policy   RiskAcceptancePolicy
+		accepted *RiskAcceptance
+		date     string
+		want     int
+	}{
+		{"", approved, "2024-01-01", 0},
+		{RiskAcceptanceIgnored, approved, "2024-01-01", 0},
+		{RiskAcceptanceCounted, approved, "2024-01-01", 2},
+		{RiskAcceptanceCounted, approved, "2024-06-30", 2},
+		{RiskAcceptanceCounted, approved, "2024-07-01", 0},
+		{RiskAcceptanceCounted, approved, "", 0},          // undated, so it can't be shown to be current
+		{RiskAcceptanceCounted, pending, "2024-01-01", 0}, // the author's own approver isn't verified
+	}
+	for _, c := range cases {
+		practice.RiskAcceptance = c.policy
+		responses := PlanResponses{date: c.date, PracticeResponses: map[string]PracticeResponse{"auth": {Tasks: map[string]TaskResponse{
+			"mfa": {Answers: map[string]Answer{"mfa": {Answer: No}}, RiskAcceptance: c.accepted},
+			"sso": {Answers: map[string]Answer{"sso": {Answer: Yes}}},
+		}}}}
+		got, err := responses.PracticeLevel(practice)
+		if err != nil || got != c.want {
+			t.Errorf("policy %q, approved %v, on %q: got level %v (%v), want %v", c.policy, c.accepted.Approved(), c.date, got, err, c.want)
+		}
+	}
+}
+
+func TestCarryRiskApprovals(t *testing.T) {
+	approved := &RiskAcceptance{Justification: "internal only", Approver: "ciso@example.com", ApproverUID: "u-ciso", Expires: "2024-06-30"}
+	previous := PlanResponses{PracticeResponses: map[string]PracticeResponse{"auth": {Tasks: map[string]TaskResponse{
+		"mfa": {RiskAcceptance: approved},
+		"sso": {RiskAcceptance: approved},
+	}}}}
+	responses := PlanResponses{PracticeResponses: map[string]PracticeResponse{"auth": {Tasks: map[string]TaskResponse{
+		"mfa": {RiskAcceptance: &RiskAcceptance{Justification: "internal only", Expires: "2024-06-30"}},
+		"sso": {RiskAcceptance: &RiskAcceptance{Justification: "internal only", Expires: "2025-06-30"}},
+		"vpn": {RiskAcceptance: &RiskAcceptance{Justification: "legacy", Approver: "me@example.com", ApproverUID: "u-me", Expires: "2024-06-30"}},
+	}}}}
+
+	responses.CarryRiskApprovals(&previous)
+	tasks := responses.PracticeResponses["auth"].Tasks
+	if a := tasks["mfa"].RiskAcceptance; a.ApproverUID != "u-ciso" || a.Approver != "ciso@example.com" {
+		t.Errorf("an unchanged acceptance lost its approval: %+v", a)
+	}
+	if a := tasks["sso"].RiskAcceptance; a.Approved() || a.Approver != "" {
+		t.Errorf("a renewed acceptance kept its approval: %+v", a)
+	}
+	if a := tasks["vpn"].RiskAcceptance; a.Approved() || a.Approver != "" {
+		t.Errorf("an approval given by the author was kept: %+v", a)
+	}
+	if !approved.Approved() {
+		t.Error("the previous revision's acceptance was changed")
+	}
+}
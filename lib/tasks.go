@@ -0,0 +1,98 @@
+package lib
+
+import (
+	"fmt"
+	"sort"
+	"time"
+)
+
+// TaskStatus is the progress of work on a prioritised task
+type TaskStatus string
+
+// Possible values for TaskStatus. A task without a status is treated as planned.
+const (
+	TaskPlanned    TaskStatus = "planned"
+	TaskInProgress TaskStatus = "in-progress"
+	TaskDone       TaskStatus = "done"
+	TaskDropped    TaskStatus = "dropped"
+)
+
+// Open reports whether work on a task with this status is still expected
+func (s TaskStatus) Open() bool {
+	return s == "" || s == TaskPlanned || s == TaskInProgress
+}
+
+// LinkedIssue is the state of one of a task's issues, as reported by its issue tracker
+type LinkedIssue struct {
+	URL     string `json:"url"`
+	Tracker string `json:"tracker,omitempty"` // the name of the issue tracker, empty if none of them handle the URL
+	Title   string `json:"title,omitempty"`
+	State   string `json:"state,omitempty"` // as named by the tracker
+	Closed  bool   `json:"closed"`
+	Error   string `json:"error,omitempty"` // why the issue couldn't be fetched
+}
+
+// ClearLinkedIssues removes the details filled in from issue trackers, which aren't part of a revision
+func (responses *PlanResponses) ClearLinkedIssues() {
+	for _, pr := range responses.PracticeResponses {
+		for taskID, t := range pr.Tasks {
+			if t.LinkedIssues != nil || t.Reassess {
+				t.LinkedIssues, t.Reassess = nil, false
+				pr.Tasks[taskID] = t
+			}
+		}
+	}
+}
+
+// validateTaskTracking checks the status, target date and risk acceptance of every task
+func (responses *PlanResponses) validateTaskTracking() error {
+	for practiceID, pr := range responses.PracticeResponses {
+		for taskID, t := range pr.Tasks {
+			switch t.Status {
+			case "", TaskPlanned, TaskInProgress, TaskDone, TaskDropped:
+			default:
+				return fmt.Errorf("invalid status for task %v.%v: %q, must be one of %v, %v, %v or %v",
+					practiceID, taskID, t.Status, TaskPlanned, TaskInProgress, TaskDone, TaskDropped)
+			}
+			if t.TargetDate != "" {
+				if _, err := time.Parse("2006-01-02", t.TargetDate); err != nil {
+					return fmt.Errorf("invalid target date for task %v.%v, must be YYYY-MM-DD: %v", practiceID, taskID, t.TargetDate)
+				}
+			}
+			if err := validateRiskAcceptance(practiceID, taskID, t.RiskAcceptance); err != nil {
+				return err
+			}
+		}
+	}
+	return nil
+}
+
+// OfferCompletedTasks prepares the responses as the starting point for a new revision: each question of a task marked
+// as done that's answered No or left unanswered is answered Yes instead, ready for the author to confirm.
+// It returns the tasks that changed, as practiceID.taskID, in order.
+func (responses *PlanResponses) OfferCompletedTasks() []string {
+	offered := []string{}
+	for practiceID, pr := range responses.PracticeResponses {
+		for taskID, t := range pr.Tasks {
+			if t.Status != TaskDone {
+				continue
+			}
+			changed := false
+			for q, a := range t.Answers {
+				if a.Answer == No || a.Answer == Unanswered {
+					a.Answer = Yes
+					if a.Notes == "" {
+						a.Notes = "The task was marked as done in the previous revision"
+					}
+					t.Answers[q] = a
+					changed = true
+				}
+			}
+			if changed {
+				offered = append(offered, practiceID+"."+taskID)
+			}
+		}
+	}
+	sort.Strings(offered)
+	return offered
+}
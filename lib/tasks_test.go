@@ -0,0 +1,53 @@
+package lib
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestOfferCompletedTasks(t *testing.T) {
+	responses := PlanResponses{PracticeResponses: map[string]PracticeResponse{
+		"auth": {Tasks: map[string]TaskResponse{
+			"mfa": {Status: TaskDone, Answers: map[string]Answer{
+				"q1": {Answer: No},
+				"q2": {Answer: NA},
+				"q3": {Answer: Unanswered, Notes: "only for admins so far"},
+			}},
+			"sso":   {Status: TaskInProgress, Answers: map[string]Answer{"q1": {Answer: No}}},
+			"audit": {Status: TaskDone, Answers: map[string]Answer{"q1": {Answer: Yes}}},
+		}},
+	}}
+
+	if got := responses.OfferCompletedTasks(); !reflect.DeepEqual(got, []string{"auth.mfa"}) {
+		t.Errorf("offered %v", got)
+	}
+	mfa := responses.PracticeResponses["auth"].Tasks["mfa"].Answers
+	if mfa["q1"].Answer != Yes || mfa["q1"].Notes == "" || mfa["q2"].Answer != NA || mfa["q3"] != (Answer{Yes, "only for admins so far"}) {
+		t.Errorf("unexpected answers for the done task: %v", mfa)
+	}
+	if a := responses.PracticeResponses["auth"].Tasks["sso"].Answers["q1"]; a.Answer != No {
+		t.Errorf("a task in progress was answered %v", a.Answer)
+	}
+}
+
+func TestValidateTaskTracking(t *testing.T) {
+	cases := []struct {
+		task  TaskResponse
+		valid bool
+	}{
+		{TaskResponse{}, true},
+		{TaskResponse{Priority: true, Assignee: "alice@example.com", TargetDate: "2024-06-30", Status: TaskInProgress}, true},
+		{TaskResponse{Status: "started"}, false},
+		{TaskResponse{TargetDate: "30/06/2024"}, false},
+		{TaskResponse{RiskAcceptance: &RiskAcceptance{Justification: "internal only", Approver: "ciso@example.com", Expires: "2024-06-30"}}, true},
+		{TaskResponse{RiskAcceptance: &RiskAcceptance{Justification: "internal only", Expires: "2024-06-30"}}, true},
+		{TaskResponse{RiskAcceptance: &RiskAcceptance{Approver: "ciso@example.com", Expires: "2024-06-30"}}, false},
+		{TaskResponse{RiskAcceptance: &RiskAcceptance{Justification: "internal only", Approver: "ciso@example.com"}}, false},
+	}
+	for _, c := range cases {
+		responses := PlanResponses{PracticeResponses: map[string]PracticeResponse{"auth": {Tasks: map[string]TaskResponse{"mfa": c.task}}}}
+		if err := responses.Validate(nil); (err == nil) != c.valid {
+			t.Errorf("validating %+v: got %v", c.task, err)
+		}
+	}
+}
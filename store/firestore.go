@@ -36,6 +36,17 @@ const plansCollection = "plans"
 const planRevisionsSubCollection = "revisions" // a collection within a particular plan
 const usersCollection = "users"
 const practicesCollection = "practices"
+const orgUnitsCollection = "orgunits"
+const apiTokensCollection = "apitokens"
+const accessRequestsCollection = "accessrequests"
+const auditCollection = "audit"
+const outboxCollection = "outbox"
+const subscriptionsCollection = "subscriptions"
+const webhooksCollection = "webhooks"
+const remindersCollection = "reminders" // keyed on project ID
+const digestsCollection = "digests"     // keyed on period
+const policiesCollection = "policies"
+const commitPolicyDoc = "commit"
 
 const configDoc = "config/config"
 
@@ -43,14 +54,65 @@ const configDoc = "config/config"
 // revision easier than storing versions separately. Note the storage here doesn't directly
 // match the API data structures.
 type storedPlanRevision struct {
-	Plan    *lib.Plan      `json:"plan"`
-	Version *storedVersion `json:"version"`
+	Plan     *lib.Plan      `json:"plan"`
+	Version  *storedVersion `json:"version"`
+	PrevHash string         `json:"prevHash"` // see lib.RevisionHash
+	Hash     string         `json:"hash"`
+	Review   *storedReview  `json:"review"` // not covered by the hash, as it changes after the revision is created
 }
 type storedVersion struct {
 	Author *models.VersionAuthor `json:"author"`
 	Time   time.Time             `json:"time"` // this is aliased in models.Version, so doesn't get saved properly
 }
 
+// storedReview holds a models.PlanReview, whose times would otherwise not be saved properly
+type storedReview struct {
+	State       string
+	Reviewer    string
+	ReviewerUID string
+	Reviewed    time.Time
+	Comments    []storedReviewComment
+}
+type storedReviewComment struct {
+	Author    string
+	AuthorUID string
+	Time      time.Time
+	Decision  string
+	Text      string
+}
+
+func newStoredReview(r *models.PlanReview) *storedReview {
+	if r == nil {
+		return nil
+	}
+	sr := &storedReview{State: *r.State, Reviewer: r.Reviewer, ReviewerUID: r.ReviewerUID, Reviewed: time.Time(r.Reviewed),
+		Comments: make([]storedReviewComment, len(r.Comments))}
+	for i, c := range r.Comments {
+		sr.Comments[i] = storedReviewComment{Author: *c.Author, AuthorUID: *c.AuthorUID, Time: time.Time(*c.Time), Decision: c.Decision, Text: c.Text}
+	}
+	return sr
+}
+
+func (sr *storedReview) model() *models.PlanReview {
+	if sr == nil {
+		return nil
+	}
+	state := sr.State
+	r := &models.PlanReview{State: &state, Reviewer: sr.Reviewer, ReviewerUID: sr.ReviewerUID, Reviewed: strfmt.DateTime(sr.Reviewed),
+		Comments: make([]*models.ReviewComment, len(sr.Comments))}
+	for i := range sr.Comments {
+		c := sr.Comments[i]
+		t := strfmt.DateTime(c.Time)
+		r.Comments[i] = &models.ReviewComment{Author: &c.Author, AuthorUID: &c.AuthorUID, Time: &t, Decision: c.Decision, Text: c.Text}
+	}
+	return r
+}
+
+// The plan document itself only records the hash of its latest revision, so that removing it can be detected
+type storedPlan struct {
+	ChainHead string `json:"chainHead"`
+}
+
 // Firestore documents can't directly contain arrays
 type storedPractices struct {
 	Practices []lib.Practice
@@ -61,8 +123,9 @@ type storedPractices struct {
 // Operations that affect the latest plan revision need to also update the project's record of
 // associated plan IDs
 type storedProject struct {
-	Details *models.ProjectDetails `json:"details"`
-	Plans   []string               `json:"plans"`
+	Details *models.ProjectDetails  `json:"details"`
+	Plans   []string                `json:"plans"`
+	Members []*models.ProjectMember `json:"members"`
 }
 
 // FireStore implements the Store interface with Google Firestore
@@ -130,7 +193,11 @@ func projectFromDocsnap(d *firestore.DocumentSnapshot) (*models.Project, error)
 		return nil, err
 	}
 	// populate the ID as it's not stored as part of the document
-	return &models.Project{ID: d.Ref.ID, Attributes: sp.Details, Plans: sp.Plans}, nil
+	if sp.Members == nil {
+		// projects created before membership was introduced
+		sp.Members = []*models.ProjectMember{}
+	}
+	return &models.Project{ID: d.Ref.ID, Attributes: sp.Details, Plans: sp.Plans, Members: sp.Members}, nil
 }
 
 // GetProject returns the project with the specified ID and true, or false if it can't be found
@@ -234,6 +301,73 @@ func (s *FireStore) DeleteProject(ctx context.Context, id string) error {
 	return s.delete(ctx, "project", projectsCollection, id)
 }
 
+// ListOrgUnits returns all of the org units
+func (s *FireStore) ListOrgUnits(ctx context.Context) ([]*models.OrgUnit, error) {
+	logger := log.WithContext(ctx)
+
+	docs, err := s.client.Collection(orgUnitsCollection).Documents(ctx).GetAll()
+	if err != nil {
+		logger.Error("Firestore ListOrgUnits: error retrieving org units: ", err)
+		return nil, fmt.Errorf("error retrieving org units")
+	}
+
+	units := make([]*models.OrgUnit, len(docs))
+	for i, d := range docs {
+		u, err := orgUnitFromDocsnap(d)
+		if err != nil {
+			logger.Error("FireStore.ListOrgUnits: error coercing retrieved org unit to models.OrgUnit", err)
+			return nil, fmt.Errorf("error retrieving org units")
+		}
+		units[i] = u
+	}
+	return units, nil
+}
+
+func orgUnitFromDocsnap(d *firestore.DocumentSnapshot) (*models.OrgUnit, error) {
+	details := new(models.OrgUnitDetails)
+	if err := d.DataTo(details); err != nil {
+		return nil, err
+	}
+	return &models.OrgUnit{ID: d.Ref.ID, Attributes: details}, nil
+}
+
+// GetOrgUnit returns the org unit with the specified ID and true, or false if it can't be found
+func (s *FireStore) GetOrgUnit(ctx context.Context, id string) (*models.OrgUnit, bool, error) {
+	logger := log.WithContext(ctx)
+
+	docsnap, err := s.client.Collection(orgUnitsCollection).Doc(id).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			logger.Debug("Firestore GetOrgUnit: not found: ", id)
+			return nil, false, nil
+		}
+		logger.Error("Firestore GetOrgUnit: error retrieving org unit: ", err)
+		return nil, false, fmt.Errorf("error retrieving org unit")
+	}
+
+	u, err := orgUnitFromDocsnap(docsnap)
+	if err != nil {
+		logger.Error("Firestore GetOrgUnit: error coercing retrieved org unit to models.OrgUnit: ", err)
+		return nil, true, fmt.Errorf("error retrieving org unit")
+	}
+	return u, true, nil
+}
+
+// CreateOrgUnit creates an org unit and returns its new id
+func (s *FireStore) CreateOrgUnit(ctx context.Context, u *models.OrgUnitDetails) (string, error) {
+	return s.create(ctx, "org unit", orgUnitsCollection, u)
+}
+
+// UpdateOrgUnit replaces the details of the specified org unit
+func (s *FireStore) UpdateOrgUnit(ctx context.Context, id string, u *models.OrgUnitDetails) error {
+	return s.update(ctx, "org unit", orgUnitsCollection, id, "", u)
+}
+
+// DeleteOrgUnit deletes the specified org unit
+func (s *FireStore) DeleteOrgUnit(ctx context.Context, id string) error {
+	return s.delete(ctx, "org unit", orgUnitsCollection, id)
+}
+
 // DeletePlan atomically deletes the specified plan, all of its revisions, and references in any projects
 func (s *FireStore) DeletePlan(ctx context.Context, id string) error {
 	logger := log.WithContext(ctx)
@@ -289,26 +423,29 @@ func (s *FireStore) create(ctx context.Context, name string, collection string,
 	return ref.ID, nil
 }
 
-// CreateProject creates a project and returns its new id
-func (s *FireStore) CreateProject(ctx context.Context, p *models.ProjectDetails) (string, error) {
-	sp := storedProject{Details: p, Plans: []string{}}
+// CreateProject creates a project with the specified members and returns its new id
+func (s *FireStore) CreateProject(ctx context.Context, p *models.ProjectDetails, members []*models.ProjectMember) (string, error) {
+	if members == nil {
+		members = []*models.ProjectMember{}
+	}
+	sp := storedProject{Details: p, Plans: []string{}, Members: members}
 	return s.create(ctx, "project", projectsCollection, sp)
 }
 
-// CreatePlanRevision creates a new revision for the plan, returning its ID
-func (s *FireStore) CreatePlanRevision(ctx context.Context, id string, p *lib.Plan, user *models.User) (string, error) {
-	return s.createPlanRevSyncProjects(ctx, id, p, user, false)
+// CreatePlanRevision creates a new revision for the plan, with its review if it has one, returning its ID
+func (s *FireStore) CreatePlanRevision(ctx context.Context, id string, p *lib.Plan, user *models.User, review *models.PlanReview) (string, error) {
+	return s.createPlanRevSyncProjects(ctx, id, p, user, review, false)
 }
 
-// CreatePlan creates a plan from the plan and plan revision, and returns its new id and revision ID
-func (s *FireStore) CreatePlan(ctx context.Context, p *lib.Plan, user *models.User) (id string, revID string, err error) {
+// CreatePlan creates a plan from the plan and plan revision, with its review if it has one, and returns its new id and revision ID
+func (s *FireStore) CreatePlan(ctx context.Context, p *lib.Plan, user *models.User, review *models.PlanReview) (id string, revID string, err error) {
 	logger := log.WithContext(ctx)
 
 	id, err = s.create(ctx, "plan", plansCollection, map[string]interface{}{}) // the plan has no fields
 	if err != nil {
 		return "", "", err
 	}
-	revID, err = s.createPlanRevSyncProjects(ctx, id, p, user, true)
+	revID, err = s.createPlanRevSyncProjects(ctx, id, p, user, review, true)
 	if err != nil {
 		// This should ideally be a transaction to avoid this scenario
 		logger.WithFields(log.Fields{"plan": id, "error": err}).Error("FireStore CreatePlan: error whilst creating revision for newly created plan")
@@ -345,8 +482,16 @@ func (s *FireStore) UpdateProject(ctx context.Context, id string, p *models.Proj
 	return s.update(ctx, "project", projectsCollection, id, "Details", p)
 }
 
+// SetProjectMembers replaces the project's owners and members
+func (s *FireStore) SetProjectMembers(ctx context.Context, id string, members []*models.ProjectMember) error {
+	if members == nil {
+		members = []*models.ProjectMember{}
+	}
+	return s.update(ctx, "project members", projectsCollection, id, "Members", members)
+}
+
 // createPlanRevSyncProjects creates a new revision for the plan, returning its ID.
-func (s *FireStore) createPlanRevSyncProjects(ctx context.Context, id string, p *lib.Plan, user *models.User, firstRev bool) (string, error) {
+func (s *FireStore) createPlanRevSyncProjects(ctx context.Context, id string, p *lib.Plan, user *models.User, review *models.PlanReview, firstRev bool) (string, error) {
 	logger := log.WithContext(ctx).WithFields(log.Fields{"plan": id})
 
 	// The projects previously associated with this plan may no longer be, we need to keep track
@@ -360,16 +505,37 @@ func (s *FireStore) createPlanRevSyncProjects(ctx context.Context, id string, p
 		prevProjects = prevRev.Attributes.Projects
 	}
 
-	// If multiple requests come in simultaneously we can have multiple revisions with the same timestamp.
-	// That's OK, because revisions have unique IDs anyway.
-	version := storedVersion{Author: &models.VersionAuthor{UID: &user.UID, Name: &user.Name, PictureURL: user.PictureURL}, Time: time.Now().UTC()}
-	spr := storedPlanRevision{Version: &version, Plan: p}
+	// Revisions are created in a transaction with the update to the plan's chain head, so that simultaneous
+	// requests can't both follow the same revision.
+	planRef := s.client.Collection(plansCollection).Doc(id)
+	revRef := s.client.Collection(planRevisionsPath(id)).NewDoc()
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		docsnap, err := tx.Get(planRef)
+		if err != nil {
+			return err
+		}
+		sp := new(storedPlan)
+		if err = docsnap.DataTo(sp); err != nil {
+			return err
+		}
 
-	revID, err := s.create(ctx, "plan revision", planRevisionsPath(id), spr)
+		version := storedVersion{Author: &models.VersionAuthor{UID: &user.UID, Name: &user.Name, PictureURL: user.PictureURL}, Time: lib.RevisionTime(time.Now())}
+		hash, err := lib.RevisionHash(sp.ChainHead, p, user.UID, version.Time)
+		if err != nil {
+			return err
+		}
+		spr := storedPlanRevision{Version: &version, Plan: p, PrevHash: sp.ChainHead, Hash: hash, Review: newStoredReview(review)}
+		if err = tx.Create(revRef, spr); err != nil {
+			return err
+		}
+		return tx.Set(planRef, map[string]interface{}{"ChainHead": hash}, firestore.MergeAll)
+	})
 	if err != nil {
 		logger.WithField("error", err).Error("Firestore: couldn't create plan revision")
 		return "", fmt.Errorf("error creating plan revision")
 	}
+	revID := revRef.ID
+	logger.WithFields(log.Fields{"plan revision": revID}).Info("Created plan revision")
 
 	// delete references for removed projects
 	for _, prev := range prevProjects {
@@ -465,13 +631,59 @@ func (s *FireStore) ListPlanRevisionIDs(ctx context.Context, id string) ([]strin
 	return docIds(docs), nil
 }
 
+// GetPlanRevisionChain returns all of the revisions of the specified plan in date order earliest to latest,
+// and the plan's chain head, or false if the plan can't be found
+func (s *FireStore) GetPlanRevisionChain(ctx context.Context, id string) ([]lib.ChainedRevision, string, bool, error) {
+	logger := log.WithContext(ctx).WithField("plan", id)
+
+	docsnap, err := s.client.Collection(plansCollection).Doc(id).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, "", false, nil
+		}
+		logger.WithField("error", err).Warn("Firestore GetPlanRevisionChain: error retrieving plan")
+		return nil, "", false, fmt.Errorf("error retrieving plan")
+	}
+	sp := new(storedPlan)
+	if err = docsnap.DataTo(sp); err != nil {
+		logger.WithField("error", err).Error("Firestore GetPlanRevisionChain: error coercing retrieved doc to storedPlan")
+		return nil, "", true, fmt.Errorf("error whilst processing plan")
+	}
+
+	docs, err := s.client.Collection(planRevisionsPath(id)).
+		OrderBy("Version.Time", firestore.Asc).
+		Documents(ctx).GetAll()
+	if err != nil {
+		logger.WithField("error", err).Warn("Firestore GetPlanRevisionChain: error retrieving plan revisions")
+		return nil, "", true, fmt.Errorf("error retrieving plan revisions")
+	}
+
+	revs := make([]lib.ChainedRevision, len(docs))
+	for i, docsnap := range docs {
+		spr := new(storedPlanRevision)
+		if err = docsnap.DataTo(spr); err != nil {
+			logger.WithField("error", err).Error("Firestore GetPlanRevisionChain: error coercing retrieved doc to storedPlanRevision")
+			return nil, "", true, fmt.Errorf("error whilst processing plan revisions")
+		}
+		rev := lib.ChainedRevision{ID: docsnap.Ref.ID, Plan: spr.Plan, PrevHash: spr.PrevHash, Hash: spr.Hash}
+		if spr.Version != nil {
+			rev.Time = spr.Version.Time
+			if spr.Version.Author != nil && spr.Version.Author.UID != nil {
+				rev.AuthorUID = *spr.Version.Author.UID
+			}
+		}
+		revs[i] = rev
+	}
+	return revs, sp.ChainHead, true, nil
+}
+
 // GetPlanVersions returns the versions of the specified plan, in date order earliest to latest
 func (s *FireStore) GetPlanVersions(ctx context.Context, id string) ([]*models.RevisionVersion, error) {
 	logger := log.WithContext(ctx)
 
 	docs, err := s.client.Collection(planRevisionsPath(id)).
 		OrderBy("Version.Time", firestore.Asc).
-		Select("Version").
+		Select("Version", "Hash", "Review").
 		Documents(ctx).GetAll()
 	if err != nil {
 		logger.WithFields(log.Fields{"plan": id, "error": err}).Warn("Firestore GetPlanVersionIDs: error retrieving plan versions")
@@ -480,19 +692,59 @@ func (s *FireStore) GetPlanVersions(ctx context.Context, id string) ([]*models.R
 
 	rvs := make([]*models.RevisionVersion, len(docs))
 	for i, docsnap := range docs {
-		sv := new(struct{ Version storedVersion })
-		err = docsnap.DataTo(sv) // only the Version field
+		sv := new(struct {
+			Version storedVersion
+			Hash    string
+			Review  *storedReview
+		})
+		err = docsnap.DataTo(sv) // only the Version, Hash and Review fields
 		if err != nil {
 			logger.Error("FireStore.GetPlanVersions: error coercing retrieved doc to models.RevisionVersion", err)
 			return nil, fmt.Errorf("error whilst processing plan versions")
 		}
 		v := &models.Version{Author: sv.Version.Author, Time: strfmt.DateTime(sv.Version.Time)}
-		rvs[i] = &models.RevisionVersion{Version: v, PlanID: id, RevID: &docsnap.Ref.ID}
+		rvs[i] = &models.RevisionVersion{Version: v, PlanID: id, RevID: &docsnap.Ref.ID, Hash: sv.Hash, Review: sv.Review.model()}
 	}
 
 	return rvs, nil
 }
 
+// UpdatePlanReview applies update to the current review of a plan revision, which is nil if it doesn't have one, and
+// saves the result in a transaction, so concurrent updates aren't lost. update may be called more than once; if it
+// returns an error, the review isn't changed and that error is returned.
+func (s *FireStore) UpdatePlanReview(ctx context.Context, planID string, revID string, update func(review *models.PlanReview) error) (*models.PlanReview, error) {
+	logger := log.WithContext(ctx).WithFields(log.Fields{"plan": planID, "revision": revID})
+
+	ref := s.client.Collection(planRevisionsPath(planID)).Doc(revID)
+	var review *models.PlanReview
+	var updateErr error
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		updateErr = nil
+		docsnap, err := tx.Get(ref)
+		if err != nil {
+			return err
+		}
+		sr := new(struct{ Review *storedReview })
+		if err = docsnap.DataTo(sr); err != nil {
+			return err
+		}
+		review = sr.Review.model()
+		if updateErr = update(review); updateErr != nil {
+			return updateErr
+		}
+		return tx.Update(ref, []firestore.Update{{Path: "Review", Value: newStoredReview(review)}})
+	})
+	if updateErr != nil {
+		return nil, updateErr
+	}
+	if err != nil {
+		logger.WithField("error", err).Warn("Firestore UpdatePlanReview: couldn't update plan review")
+		return nil, fmt.Errorf("error updating plan review")
+	}
+	logger.Info("Updated plan review")
+	return review, nil
+}
+
 // GetUserData extends the referenced user with any additional data recorded in the store
 func (s *FireStore) GetUserData(ctx context.Context, user *models.User) error {
 	logger := log.WithContext(ctx).WithFields(log.Fields{"UID": user.UID})
@@ -519,13 +771,44 @@ func (s *FireStore) GetUserData(ctx context.Context, user *models.User) error {
 		logger.WithFields(log.Fields{"error": err}).Error("Firestore GetUserData: user document not in expected format - failed to coerce to models.LocalUserData")
 		return fmt.Errorf("error getting local user data")
 	}
-	user.LocalData = &l
+	applyLocalData(user, &l)
+
+	return nil
+}
+
+// applyLocalData sets the user's local data, and the fields derived from it
+func applyLocalData(user *models.User, l *models.LocalUserData) {
+	user.LocalData = l
 
 	// the database value is more current than a value set from a claim, so it doesn't matter what these were previously set to
-	user.ManuallyAuthorized = user.LocalData.ManuallyAuthorized
-	user.CreationAlertSent = user.LocalData.CreationAlertSent
+	user.ManuallyAuthorized = l.ManuallyAuthorized
+	user.CreationAlertSent = l.CreationAlertSent
+	user.Roles = l.Roles
+	user.Deactivated = l.Deactivated
+}
 
-	return nil
+// ListUsers returns every user with local data, with only their UID and the fields derived from the local data set
+func (s *FireStore) ListUsers(ctx context.Context) ([]*models.User, error) {
+	logger := log.WithContext(ctx)
+
+	docs, err := s.client.Collection(usersCollection).Documents(ctx).GetAll()
+	if err != nil {
+		logger.Error("Firestore ListUsers: error retrieving users: ", err)
+		return nil, fmt.Errorf("error retrieving users")
+	}
+
+	users := make([]*models.User, 0, len(docs))
+	for _, doc := range docs {
+		l := models.LocalUserData{}
+		if err := doc.DataTo(&l); err != nil {
+			logger.WithFields(log.Fields{"user": doc.Ref.ID, "error": err}).Error("Firestore ListUsers: user document not in expected format - failed to coerce to models.LocalUserData")
+			return nil, fmt.Errorf("error retrieving users")
+		}
+		u := &models.User{UID: doc.Ref.ID, Name: l.Name, Email: l.Email, LookedUp: true}
+		applyLocalData(u, &l)
+		users = append(users, u)
+	}
+	return users, nil
 }
 
 // SaveUserData records the user's LocalData, or removes it if user.LocalData==nil
@@ -564,6 +847,24 @@ func (s *FireStore) SetManuallyAuthorized(ctx context.Context, UID string, value
 	return nil
 }
 
+// SetUserRoles replaces the roles explicitly granted to this user
+func (s *FireStore) SetUserRoles(ctx context.Context, UID string, roles models.Roles) error {
+	logger := log.WithContext(ctx).WithFields(log.Fields{"user": UID})
+
+	if roles == nil {
+		roles = models.Roles{} // store an empty array rather than null
+	}
+	docref := s.client.Collection(usersCollection).Doc(UID)
+	_, err := docref.Set(ctx, map[string]interface{}{"Roles": roles}, firestore.MergeAll)
+
+	if err != nil {
+		logger.WithFields(log.Fields{"error": err}).Error("Firestore SetUserRoles: failed to update/create record")
+		return fmt.Errorf("error recording user roles")
+	}
+
+	return nil
+}
+
 // UserCreationAlertSent sets this user's CreationAlertSent to true
 func (s *FireStore) UserCreationAlertSent(ctx context.Context, UID string) error {
 	logger := log.WithContext(ctx).WithFields(log.Fields{"user": UID})
@@ -579,6 +880,550 @@ func (s *FireStore) UserCreationAlertSent(ctx context.Context, UID string) error
 	return nil
 }
 
+// ListAccessRequests returns the access requests with the specified status, or every request if status is empty
+func (s *FireStore) ListAccessRequests(ctx context.Context, status string) ([]*AccessRequest, error) {
+	logger := log.WithContext(ctx)
+
+	q := s.client.Collection(accessRequestsCollection).Query
+	if status != "" {
+		q = q.Where("Status", "==", status)
+	}
+	docs, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		logger.Error("Firestore ListAccessRequests: error retrieving requests: ", err)
+		return nil, fmt.Errorf("error retrieving access requests")
+	}
+
+	requests := make([]*AccessRequest, len(docs))
+	for i, d := range docs {
+		r := new(AccessRequest)
+		if err := d.DataTo(r); err != nil {
+			logger.Error("Firestore ListAccessRequests: error coercing retrieved request to AccessRequest: ", err)
+			return nil, fmt.Errorf("error retrieving access requests")
+		}
+		requests[i] = r
+	}
+	return requests, nil
+}
+
+// GetAccessRequest returns the user's access request, or false if they haven't made one
+func (s *FireStore) GetAccessRequest(ctx context.Context, UID string) (*AccessRequest, bool, error) {
+	logger := log.WithContext(ctx).WithFields(log.Fields{"user": UID})
+
+	docsnap, err := s.client.Collection(accessRequestsCollection).Doc(UID).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return nil, false, nil
+		}
+		logger.Error("Firestore GetAccessRequest: error retrieving request: ", err)
+		return nil, false, fmt.Errorf("error retrieving access request")
+	}
+
+	r := new(AccessRequest)
+	if err = docsnap.DataTo(r); err != nil {
+		logger.Error("Firestore GetAccessRequest: error coercing retrieved request to AccessRequest: ", err)
+		return nil, true, fmt.Errorf("error retrieving access request")
+	}
+	return r, true, nil
+}
+
+// SaveAccessRequest creates or replaces the user's access request
+func (s *FireStore) SaveAccessRequest(ctx context.Context, r *AccessRequest) error {
+	return s.update(ctx, "access request", accessRequestsCollection, r.UID, "", r)
+}
+
+// ListAPITokens returns the API tokens that act as the owner, or every token if owner is empty
+func (s *FireStore) ListAPITokens(ctx context.Context, owner string) ([]*APIToken, error) {
+	logger := log.WithContext(ctx)
+
+	q := s.client.Collection(apiTokensCollection).Query
+	if owner != "" {
+		q = q.Where("Owner", "==", owner)
+	}
+	docs, err := q.Documents(ctx).GetAll()
+	if err != nil {
+		logger.Error("Firestore ListAPITokens: error retrieving tokens: ", err)
+		return nil, fmt.Errorf("error retrieving API tokens")
+	}
+
+	tokens := make([]*APIToken, len(docs))
+	for i, d := range docs {
+		t, err := apiTokenFromDocsnap(d)
+		if err != nil {
+			logger.Error("Firestore ListAPITokens: error coercing retrieved token to APIToken: ", err)
+			return nil, fmt.Errorf("error retrieving API tokens")
+		}
+		tokens[i] = t
+	}
+	return tokens, nil
+}
+
+func apiTokenFromDocsnap(d *firestore.DocumentSnapshot) (*APIToken, error) {
+	t := new(APIToken)
+	if err := d.DataTo(t); err != nil {
+		return nil, err
+	}
+	t.ID = d.Ref.ID
+	return t, nil
+}
+
+// GetAPIToken returns the API token with the specified ID, or false if it can't be found
+func (s *FireStore) GetAPIToken(ctx context.Context, id string) (*APIToken, bool, error) {
+	logger := log.WithContext(ctx)
+
+	docsnap, err := s.client.Collection(apiTokensCollection).Doc(id).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			logger.Debug("Firestore GetAPIToken: not found: ", id)
+			return nil, false, nil
+		}
+		logger.Error("Firestore GetAPIToken: error retrieving token: ", err)
+		return nil, false, fmt.Errorf("error retrieving API token")
+	}
+
+	t, err := apiTokenFromDocsnap(docsnap)
+	if err != nil {
+		logger.Error("Firestore GetAPIToken: error coercing retrieved token to APIToken: ", err)
+		return nil, true, fmt.Errorf("error retrieving API token")
+	}
+	return t, true, nil
+}
+
+// FindAPIToken returns the API token whose secret has the specified hash, or false if there isn't one
+func (s *FireStore) FindAPIToken(ctx context.Context, hash string) (*APIToken, bool, error) {
+	logger := log.WithContext(ctx)
+
+	docs, err := s.client.Collection(apiTokensCollection).Where("Hash", "==", hash).Limit(1).Documents(ctx).GetAll()
+	if err != nil {
+		logger.Error("Firestore FindAPIToken: error retrieving token: ", err)
+		return nil, false, fmt.Errorf("error retrieving API token")
+	}
+	if len(docs) == 0 {
+		return nil, false, nil
+	}
+
+	t, err := apiTokenFromDocsnap(docs[0])
+	if err != nil {
+		logger.Error("Firestore FindAPIToken: error coercing retrieved token to APIToken: ", err)
+		return nil, true, fmt.Errorf("error retrieving API token")
+	}
+	return t, true, nil
+}
+
+// CreateAPIToken records a new API token and returns its id
+func (s *FireStore) CreateAPIToken(ctx context.Context, t *APIToken) (string, error) {
+	return s.create(ctx, "API token", apiTokensCollection, t)
+}
+
+// APITokenUsed records when the API token was last used
+func (s *FireStore) APITokenUsed(ctx context.Context, id string, when time.Time) error {
+	return s.update(ctx, "API token", apiTokensCollection, id, "LastUsed", when)
+}
+
+// DeleteAPIToken deletes the specified API token, revoking it
+func (s *FireStore) DeleteAPIToken(ctx context.Context, id string) error {
+	return s.delete(ctx, "API token", apiTokensCollection, id)
+}
+
+// RecordAuditEvent appends an event to the audit log, returning its id. Audit events are never updated or deleted.
+func (s *FireStore) RecordAuditEvent(ctx context.Context, e *AuditEvent) (string, error) {
+	return s.create(ctx, "audit event", auditCollection, e)
+}
+
+// ListAuditEvents returns the audit events selected by the query, most recent first
+func (s *FireStore) ListAuditEvents(ctx context.Context, q AuditQuery) ([]*AuditEvent, error) {
+	logger := log.WithContext(ctx)
+
+	// Combining equality filters with ordering by time would need composite indexes for every combination,
+	// so when filtering on anything but time the events are ordered here instead
+	query := s.client.Collection(auditCollection).Query
+	filtered := false
+	for field, value := range map[string]string{"Actor": q.Actor, "Action": q.Action, "TargetType": q.TargetType, "TargetID": q.TargetID} {
+		if value != "" {
+			query = query.Where(field, "==", value)
+			filtered = true
+		}
+	}
+	if !filtered {
+		if !q.Since.IsZero() {
+			query = query.Where("Time", ">=", q.Since)
+		}
+		if !q.Until.IsZero() {
+			query = query.Where("Time", "<", q.Until)
+		}
+		query = query.OrderBy("Time", firestore.Desc)
+		if q.Limit > 0 {
+			query = query.Limit(q.Limit)
+		}
+	}
+
+	docs, err := query.Documents(ctx).GetAll()
+	if err != nil {
+		logger.Error("Firestore ListAuditEvents: error retrieving events: ", err)
+		return nil, fmt.Errorf("error retrieving audit events")
+	}
+
+	events := []*AuditEvent{}
+	for _, d := range docs {
+		e := new(AuditEvent)
+		if err := d.DataTo(e); err != nil {
+			logger.Error("Firestore ListAuditEvents: error coercing retrieved event to AuditEvent: ", err)
+			return nil, fmt.Errorf("error retrieving audit events")
+		}
+		if (!q.Since.IsZero() && e.Time.Before(q.Since)) || (!q.Until.IsZero() && !e.Time.Before(q.Until)) {
+			continue
+		}
+		e.ID = d.Ref.ID
+		events = append(events, e)
+	}
+
+	if filtered {
+		sort.Slice(events, func(i, j int) bool { return events[i].Time.After(events[j].Time) })
+		if q.Limit > 0 && len(events) > q.Limit {
+			events = events[:q.Limit]
+		}
+	}
+	return events, nil
+}
+
+// EnqueueNotification adds a message to the notification outbox, unless a message with the same ID was created
+// less than window before it. Returns whether the message was added.
+func (s *FireStore) EnqueueNotification(ctx context.Context, m *OutboxMessage, window time.Duration) (bool, error) {
+	logger := log.WithContext(ctx).WithFields(log.Fields{"message": m.ID, "channel": m.Channel, "event": m.Event})
+
+	ref := s.client.Collection(outboxCollection).Doc(m.ID)
+	added := false
+	err := s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+		added = false
+		docsnap, err := tx.Get(ref)
+		if err != nil && status.Code(err) != codes.NotFound {
+			return err
+		}
+		if err == nil {
+			existing := new(OutboxMessage)
+			if err = docsnap.DataTo(existing); err != nil {
+				return err
+			}
+			if m.Created.Sub(existing.Created) < window {
+				return nil
+			}
+		}
+		added = true
+		return tx.Set(ref, m)
+	})
+	if err != nil {
+		logger.WithField("error", err).Error("Firestore EnqueueNotification: couldn't add message to outbox")
+		return false, fmt.Errorf("error queueing notification")
+	}
+	return added, nil
+}
+
+// ClaimDueNotifications returns pending messages that are due to be sent at now, postponing their next attempt
+// by lease so that they aren't claimed again while they're being sent
+func (s *FireStore) ClaimDueNotifications(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*OutboxMessage, error) {
+	logger := log.WithContext(ctx)
+
+	// Only the next attempt is filtered on in the query, to avoid needing a composite index: delivered and abandoned
+	// messages don't have one, so they aren't matched
+	docs, err := s.client.Collection(outboxCollection).Where("NextAttempt", "<=", now).OrderBy("NextAttempt", firestore.Asc).
+		Limit(limit).Documents(ctx).GetAll()
+	if err != nil {
+		logger.WithField("error", err).Error("Firestore ClaimDueNotifications: error retrieving pending messages")
+		return nil, fmt.Errorf("error retrieving pending notifications")
+	}
+
+	claimed := []*OutboxMessage{}
+	for _, d := range docs {
+		var m *OutboxMessage
+		err = s.client.RunTransaction(ctx, func(ctx context.Context, tx *firestore.Transaction) error {
+			m = new(OutboxMessage)
+			docsnap, err := tx.Get(d.Ref)
+			if err != nil {
+				return err
+			}
+			if err = docsnap.DataTo(m); err != nil {
+				return err
+			}
+			if m.Status != OutboxPending {
+				// sent by someone else in the meantime, or finished before next attempts were cleared
+				m = nil
+				return tx.Update(d.Ref, []firestore.Update{{Path: "NextAttempt", Value: firestore.Delete}})
+			}
+			if m.NextAttempt.After(now) {
+				m = nil // claimed by someone else in the meantime
+				return nil
+			}
+			return tx.Update(d.Ref, []firestore.Update{{Path: "NextAttempt", Value: now.Add(lease)}})
+		})
+		if err != nil {
+			logger.WithFields(log.Fields{"message": d.Ref.ID, "error": err}).Warn("Firestore ClaimDueNotifications: couldn't claim message")
+			continue
+		}
+		if m != nil {
+			m.ID = d.Ref.ID
+			claimed = append(claimed, m)
+		}
+	}
+	return claimed, nil
+}
+
+// UpdateNotification replaces the outbox message with the same ID
+func (s *FireStore) UpdateNotification(ctx context.Context, m *OutboxMessage) error {
+	return s.update(ctx, "outbox message", outboxCollection, m.ID, "", m)
+}
+
+// ListNotifications returns the outbox messages selected by the query, most recently created first
+func (s *FireStore) ListNotifications(ctx context.Context, q OutboxQuery) ([]*OutboxMessage, error) {
+	logger := log.WithContext(ctx)
+
+	// Only one field is filtered on in the query, to avoid needing composite indexes
+	query := s.client.Collection(outboxCollection).Query
+	filtered := true
+	switch {
+	case q.Channel != "":
+		query = query.Where("Channel", "==", q.Channel)
+	case q.Status != "":
+		query = query.Where("Status", "==", q.Status)
+	default:
+		filtered = false
+		query = query.OrderBy("Created", firestore.Desc)
+		if q.Limit > 0 {
+			query = query.Limit(q.Limit)
+		}
+	}
+	docs, err := query.Documents(ctx).GetAll()
+	if err != nil {
+		logger.WithField("error", err).Error("Firestore ListNotifications: error retrieving messages")
+		return nil, fmt.Errorf("error retrieving notifications")
+	}
+
+	messages := make([]*OutboxMessage, 0, len(docs))
+	for _, d := range docs {
+		m := new(OutboxMessage)
+		if err := d.DataTo(m); err != nil {
+			logger.WithField("error", err).Error("Firestore ListNotifications: error coercing retrieved message to OutboxMessage")
+			return nil, fmt.Errorf("error retrieving notifications")
+		}
+		if q.Status != "" && m.Status != q.Status {
+			continue
+		}
+		m.ID = d.Ref.ID
+		messages = append(messages, m)
+	}
+	if filtered {
+		sort.Slice(messages, func(i, j int) bool { return messages[i].Created.After(messages[j].Created) })
+		if q.Limit > 0 && len(messages) > q.Limit {
+			messages = messages[:q.Limit]
+		}
+	}
+	return messages, nil
+}
+
+// storedReminder records when owners were last reminded about a project's overdue plan or an expired risk acceptance
+type storedReminder struct {
+	Sent time.Time
+}
+
+// ListReminders returns when owners were last reminded about each project's overdue plan or expired risk acceptance,
+// keyed on project ID or on the acceptance's key
+func (s *FireStore) ListReminders(ctx context.Context) (map[string]time.Time, error) {
+	logger := log.WithContext(ctx)
+
+	docs, err := s.client.Collection(remindersCollection).Documents(ctx).GetAll()
+	if err != nil {
+		logger.WithField("error", err).Error("Firestore ListReminders: error retrieving reminders")
+		return nil, fmt.Errorf("error retrieving reminders")
+	}
+	reminders := map[string]time.Time{}
+	for _, d := range docs {
+		r := new(storedReminder)
+		if err := d.DataTo(r); err != nil {
+			logger.WithField("error", err).Error("Firestore ListReminders: error coercing retrieved reminder")
+			return nil, fmt.Errorf("error retrieving reminders")
+		}
+		reminders[d.Ref.ID] = r.Sent
+	}
+	return reminders, nil
+}
+
+// RecordReminder records that the owners were reminded about a project's overdue plan, or a risk acceptance's expiry
+func (s *FireStore) RecordReminder(ctx context.Context, key string, when time.Time) error {
+	return s.update(ctx, "reminder", remindersCollection, key, "", storedReminder{Sent: when})
+}
+
+// storedDigest records the most recent digest sent for a period
+type storedDigest struct {
+	End time.Time
+}
+
+// LastDigest returns the end of the most recent digest sent for the period (e.g. weekly), or the zero time if none has been
+func (s *FireStore) LastDigest(ctx context.Context, period string) (time.Time, error) {
+	docsnap, err := s.client.Collection(digestsCollection).Doc(period).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return time.Time{}, nil
+		}
+		log.WithContext(ctx).WithFields(log.Fields{"period": period, "error": err}).Error("Firestore LastDigest: error retrieving digest")
+		return time.Time{}, fmt.Errorf("error retrieving digest")
+	}
+	d := new(storedDigest)
+	if err = docsnap.DataTo(d); err != nil {
+		log.WithContext(ctx).WithFields(log.Fields{"period": period, "error": err}).Error("Firestore LastDigest: error coercing retrieved digest")
+		return time.Time{}, fmt.Errorf("error retrieving digest")
+	}
+	return d.End, nil
+}
+
+// RecordDigest records that the digest for the period ending at end has been sent
+func (s *FireStore) RecordDigest(ctx context.Context, period string, end time.Time) error {
+	return s.update(ctx, "digest", digestsCollection, period, "", storedDigest{End: end})
+}
+
+// GetCommitPolicy returns the policy plans must meet to be committed, which is empty if none has been set
+func (s *FireStore) GetCommitPolicy(ctx context.Context) (*lib.CommitPolicy, error) {
+	docsnap, err := s.client.Collection(policiesCollection).Doc(commitPolicyDoc).Get(ctx)
+	if err != nil {
+		if status.Code(err) == codes.NotFound {
+			return &lib.CommitPolicy{Rules: []lib.CommitRule{}}, nil
+		}
+		log.WithContext(ctx).WithField("error", err).Error("Firestore GetCommitPolicy: error retrieving policy")
+		return nil, fmt.Errorf("error retrieving commit policy")
+	}
+	p := new(lib.CommitPolicy)
+	if err = docsnap.DataTo(p); err != nil {
+		log.WithContext(ctx).WithField("error", err).Error("Firestore GetCommitPolicy: error coercing retrieved policy")
+		return nil, fmt.Errorf("error retrieving commit policy")
+	}
+	if p.Rules == nil {
+		p.Rules = []lib.CommitRule{}
+	}
+	return p, nil
+}
+
+// SetCommitPolicy replaces the policy plans must meet to be committed
+func (s *FireStore) SetCommitPolicy(ctx context.Context, p *lib.CommitPolicy) error {
+	return s.update(ctx, "policy", policiesCollection, commitPolicyDoc, "", p)
+}
+
+// ListWebhooks returns every registered outbound webhook
+func (s *FireStore) ListWebhooks(ctx context.Context) ([]*Webhook, error) {
+	logger := log.WithContext(ctx)
+
+	docs, err := s.client.Collection(webhooksCollection).Documents(ctx).GetAll()
+	if err != nil {
+		logger.WithField("error", err).Error("Firestore ListWebhooks: error retrieving webhooks")
+		return nil, fmt.Errorf("error retrieving webhooks")
+	}
+	webhooks := make([]*Webhook, len(docs))
+	for i, d := range docs {
+		w := new(Webhook)
+		if err := d.DataTo(w); err != nil {
+			logger.WithField("error", err).Error("Firestore ListWebhooks: error coercing retrieved webhook to Webhook")
+			return nil, fmt.Errorf("error retrieving webhooks")
+		}
+		w.ID = d.Ref.ID
+		webhooks[i] = w
+	}
+	return webhooks, nil
+}
+
+// GetWebhook returns the webhook with the specified ID, or false if it can't be found
+func (s *FireStore) GetWebhook(ctx context.Context, id string) (*Webhook, bool, error) {
+	logger := log.WithContext(ctx).WithField("webhook", id)
+
+	docsnap, err := s.client.Collection(webhooksCollection).Doc(id).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		logger.WithField("error", err).Error("Firestore GetWebhook: error retrieving webhook")
+		return nil, false, fmt.Errorf("error retrieving webhook")
+	}
+	w := new(Webhook)
+	if err = docsnap.DataTo(w); err != nil {
+		logger.WithField("error", err).Error("Firestore GetWebhook: error coercing retrieved webhook to Webhook")
+		return nil, false, fmt.Errorf("error retrieving webhook")
+	}
+	w.ID = id
+	return w, true, nil
+}
+
+// CreateWebhook registers an outbound webhook and returns its id
+func (s *FireStore) CreateWebhook(ctx context.Context, w *Webhook) (string, error) {
+	return s.create(ctx, "webhook", webhooksCollection, w)
+}
+
+// DeleteWebhook removes the webhook, messages already queued for it will fail
+func (s *FireStore) DeleteWebhook(ctx context.Context, id string) error {
+	return s.delete(ctx, "webhook", webhooksCollection, id)
+}
+
+// subscriptionID identifies a user's subscription to a project
+func subscriptionID(uid string, projectID string) string {
+	return uid + "_" + projectID
+}
+
+// SetSubscription creates or replaces the user's subscription to the project's events
+func (s *FireStore) SetSubscription(ctx context.Context, sub *Subscription) error {
+	return s.update(ctx, "subscription", subscriptionsCollection, subscriptionID(sub.UID, sub.ProjectID), "", sub)
+}
+
+// GetSubscription returns the user's subscription to the project's events, if they have one
+func (s *FireStore) GetSubscription(ctx context.Context, uid string, projectID string) (*Subscription, bool, error) {
+	logger := log.WithContext(ctx).WithFields(log.Fields{"user": uid, "project": projectID})
+
+	docsnap, err := s.client.Collection(subscriptionsCollection).Doc(subscriptionID(uid, projectID)).Get(ctx)
+	if status.Code(err) == codes.NotFound {
+		return nil, false, nil
+	}
+	if err != nil {
+		logger.WithField("error", err).Error("Firestore GetSubscription: error retrieving subscription")
+		return nil, false, fmt.Errorf("error retrieving subscription")
+	}
+	sub := new(Subscription)
+	if err = docsnap.DataTo(sub); err != nil {
+		logger.WithField("error", err).Error("Firestore GetSubscription: error coercing retrieved subscription to Subscription")
+		return nil, false, fmt.Errorf("error retrieving subscription")
+	}
+	return sub, true, nil
+}
+
+// DeleteSubscription removes the user's subscription to the project's events, it is not an error if there isn't one
+func (s *FireStore) DeleteSubscription(ctx context.Context, uid string, projectID string) error {
+	return s.delete(ctx, "subscription", subscriptionsCollection, subscriptionID(uid, projectID))
+}
+
+// ListProjectSubscriptions returns every subscription to the project's events
+func (s *FireStore) ListProjectSubscriptions(ctx context.Context, projectID string) ([]*Subscription, error) {
+	return s.listSubscriptions(ctx, "ProjectID", projectID)
+}
+
+// ListUserSubscriptions returns every one of the user's subscriptions
+func (s *FireStore) ListUserSubscriptions(ctx context.Context, uid string) ([]*Subscription, error) {
+	return s.listSubscriptions(ctx, "UID", uid)
+}
+
+// listSubscriptions returns the subscriptions whose field has the value
+func (s *FireStore) listSubscriptions(ctx context.Context, field string, value string) ([]*Subscription, error) {
+	logger := log.WithContext(ctx).WithField(field, value)
+
+	docs, err := s.client.Collection(subscriptionsCollection).Where(field, "==", value).Documents(ctx).GetAll()
+	if err != nil {
+		logger.WithField("error", err).Error("Firestore listSubscriptions: error retrieving subscriptions")
+		return nil, fmt.Errorf("error retrieving subscriptions")
+	}
+	subs := make([]*Subscription, 0, len(docs))
+	for _, d := range docs {
+		sub := new(Subscription)
+		if err := d.DataTo(sub); err != nil {
+			logger.WithField("error", err).Error("Firestore listSubscriptions: error coercing retrieved subscription to Subscription")
+			return nil, fmt.Errorf("error retrieving subscriptions")
+		}
+		subs = append(subs, sub)
+	}
+	return subs, nil
+}
+
 // GetConfigString returns the named configuration string
 func (s *FireStore) GetConfigString(ctx context.Context, field string) (string, error) {
 	logger := log.WithContext(ctx)
@@ -2,6 +2,7 @@ package store
 
 import (
 	"context"
+	"time"
 
 	"github.com/ThalesGroup/besec/api/models"
 	"github.com/ThalesGroup/besec/lib"
@@ -16,34 +17,128 @@ type Store interface {
 	GetProject(ctx context.Context, id string) (*models.Project, bool, error)
 	// UpdateProject replaces a project with with the contents of the project struct (ctx context.Contextthe ID in the struct is ignored)
 	UpdateProject(ctx context.Context, id string, p *models.ProjectDetails) error
-	// CreateProject creates a project and returns its new id
-	CreateProject(ctx context.Context, p *models.ProjectDetails) (string, error)
+	// CreateProject creates a project with the specified members and returns its new id
+	CreateProject(ctx context.Context, p *models.ProjectDetails, members []*models.ProjectMember) (string, error)
+	// SetProjectMembers replaces the project's owners and members
+	SetProjectMembers(ctx context.Context, id string, members []*models.ProjectMember) error
 	// DeleteProject deletes the specified project
 	DeleteProject(ctx context.Context, id string) error
 
+	// ListOrgUnits returns all of the org units
+	ListOrgUnits(ctx context.Context) ([]*models.OrgUnit, error)
+	// GetOrgUnit returns the org unit with the specified ID, or false if it can't be found
+	GetOrgUnit(ctx context.Context, id string) (*models.OrgUnit, bool, error)
+	// CreateOrgUnit creates an org unit and returns its new id
+	CreateOrgUnit(ctx context.Context, u *models.OrgUnitDetails) (string, error)
+	// UpdateOrgUnit replaces the details of the specified org unit
+	UpdateOrgUnit(ctx context.Context, id string, u *models.OrgUnitDetails) error
+	// DeleteOrgUnit deletes the specified org unit
+	DeleteOrgUnit(ctx context.Context, id string) error
+
 	// GetPlan returns the plan with the specified ID and true, or (nil,false) if it can't be found
 	GetPlan(ctx context.Context, id string) (*models.Plan, bool, error)
 	// CreatePlan creates a plan and returns its new id and revision ID
-	CreatePlan(ctx context.Context, p *lib.Plan, user *models.User) (id string, revID string, err error)
+	CreatePlan(ctx context.Context, p *lib.Plan, user *models.User, review *models.PlanReview) (id string, revID string, err error)
 	// DeletePlanAndRevisions deletes the specified plan and all of its revisions
 	DeletePlan(ctx context.Context, id string) error
 	// CreatePlanRevision creates a new revision for the plan, returning its ID
-	CreatePlanRevision(ctx context.Context, id string, p *lib.Plan, user *models.User) (string, error)
+	CreatePlanRevision(ctx context.Context, id string, p *lib.Plan, user *models.User, review *models.PlanReview) (string, error)
 	// GetPlanRevision returns the plan revision with the specified ID or false if it can't be found
 	GetPlanRevision(ctx context.Context, planID string, revID string) (*lib.Plan, bool, error)
 	// ListPlanRevisionIDs returns the revision ids of the specified plan, in date order earliest to latest or an error if it can't be found
 	ListPlanRevisionIDs(ctx context.Context, id string) ([]string, error)
+	// GetPlanRevisionChain returns all of the revisions of the specified plan in date order earliest to latest, with the plan's chain head, or false if the plan can't be found
+	GetPlanRevisionChain(ctx context.Context, id string) ([]lib.ChainedRevision, string, bool, error)
 	// GetPlanVersions returns the versions of the specified plan, in date order earliest to latest
 	GetPlanVersions(ctx context.Context, id string) ([]*models.RevisionVersion, error)
+	// UpdatePlanReview applies update to the current review of a plan revision, which is nil if it doesn't have one,
+	// and saves the result atomically. If update returns an error, the review isn't changed and that error is returned.
+	UpdatePlanReview(ctx context.Context, planID string, revID string, update func(review *models.PlanReview) error) (*models.PlanReview, error)
 
 	// GetUserData extends the referenced user with any additional data recorded in the store
 	GetUserData(ctx context.Context, user *models.User) error
+	// ListUsers returns every user with local data, with only their UID and the fields derived from the local data set
+	ListUsers(ctx context.Context) ([]*models.User, error)
 	// SaveUserData records the user's LocalData, or removes it if user.LocalData==nil
 	SaveUserData(ctx context.Context, user *models.User) error
 	// UserCreationAlertSent sets this user's CreationAlertSent to true
 	UserCreationAlertSent(ctx context.Context, UID string) error
 	// SetManuallyAuthorized sets this user's ManuallyAuthorized attribute
 	SetManuallyAuthorized(ctx context.Context, UID string, value bool) error
+	// SetUserRoles replaces the roles explicitly granted to this user
+	SetUserRoles(ctx context.Context, UID string, roles models.Roles) error
+
+	// ListAccessRequests returns the access requests with the specified status, or every request if status is empty
+	ListAccessRequests(ctx context.Context, status string) ([]*AccessRequest, error)
+	// GetAccessRequest returns the user's access request, or false if they haven't made one
+	GetAccessRequest(ctx context.Context, UID string) (*AccessRequest, bool, error)
+	// SaveAccessRequest creates or replaces the user's access request
+	SaveAccessRequest(ctx context.Context, r *AccessRequest) error
+
+	// ListAPITokens returns the API tokens that act as the owner, or every token if owner is empty
+	ListAPITokens(ctx context.Context, owner string) ([]*APIToken, error)
+	// GetAPIToken returns the API token with the specified ID, or false if it can't be found
+	GetAPIToken(ctx context.Context, id string) (*APIToken, bool, error)
+	// FindAPIToken returns the API token whose secret has the specified hash, or false if there isn't one
+	FindAPIToken(ctx context.Context, hash string) (*APIToken, bool, error)
+	// CreateAPIToken records a new API token and returns its id
+	CreateAPIToken(ctx context.Context, t *APIToken) (string, error)
+	// APITokenUsed records when the API token was last used
+	APITokenUsed(ctx context.Context, id string, when time.Time) error
+	// DeleteAPIToken deletes the specified API token, revoking it
+	DeleteAPIToken(ctx context.Context, id string) error
+
+	// RecordAuditEvent appends an event to the audit log, returning its id. Audit events are never updated or deleted.
+	RecordAuditEvent(ctx context.Context, e *AuditEvent) (string, error)
+	// ListAuditEvents returns the audit events selected by the query, most recent first
+	ListAuditEvents(ctx context.Context, q AuditQuery) ([]*AuditEvent, error)
+
+	// EnqueueNotification adds a message to the notification outbox, unless a message with the same ID was created
+	// less than window before it. Returns whether the message was added.
+	EnqueueNotification(ctx context.Context, m *OutboxMessage, window time.Duration) (bool, error)
+	// ClaimDueNotifications returns pending messages that are due to be sent at now, postponing their next attempt
+	// by lease so that they aren't claimed again while they're being sent
+	ClaimDueNotifications(ctx context.Context, now time.Time, lease time.Duration, limit int) ([]*OutboxMessage, error)
+	// UpdateNotification replaces the outbox message with the same ID
+	UpdateNotification(ctx context.Context, m *OutboxMessage) error
+	// ListNotifications returns the outbox messages selected by the query, most recently created first
+	ListNotifications(ctx context.Context, q OutboxQuery) ([]*OutboxMessage, error)
+
+	// ListReminders returns when owners were last reminded about each project's overdue plan or expired risk acceptance,
+	// keyed on project ID or on the acceptance's key
+	ListReminders(ctx context.Context) (map[string]time.Time, error)
+	// RecordReminder records that the owners were reminded about a project's overdue plan, or a risk acceptance's expiry
+	RecordReminder(ctx context.Context, key string, when time.Time) error
+
+	// LastDigest returns the end of the most recent digest sent for the period (e.g. weekly), or the zero time if none has been
+	LastDigest(ctx context.Context, period string) (time.Time, error)
+	// RecordDigest records that the digest for the period ending at end has been sent
+	RecordDigest(ctx context.Context, period string, end time.Time) error
+
+	// GetCommitPolicy returns the policy plans must meet to be committed, which is empty if none has been set
+	GetCommitPolicy(ctx context.Context) (*lib.CommitPolicy, error)
+	// SetCommitPolicy replaces the policy plans must meet to be committed
+	SetCommitPolicy(ctx context.Context, p *lib.CommitPolicy) error
+
+	// ListWebhooks returns every registered outbound webhook
+	ListWebhooks(ctx context.Context) ([]*Webhook, error)
+	// GetWebhook returns the webhook with the specified ID, or false if it can't be found
+	GetWebhook(ctx context.Context, id string) (*Webhook, bool, error)
+	// CreateWebhook registers an outbound webhook and returns its id
+	CreateWebhook(ctx context.Context, w *Webhook) (string, error)
+	// DeleteWebhook removes the webhook, messages already queued for it will fail
+	DeleteWebhook(ctx context.Context, id string) error
+
+	// SetSubscription creates or replaces the user's subscription to the project's events
+	SetSubscription(ctx context.Context, sub *Subscription) error
+	// GetSubscription returns the user's subscription to the project's events, if they have one
+	GetSubscription(ctx context.Context, uid string, projectID string) (*Subscription, bool, error)
+	// DeleteSubscription removes the user's subscription to the project's events, it is not an error if there isn't one
+	DeleteSubscription(ctx context.Context, uid string, projectID string) error
+	// ListProjectSubscriptions returns every subscription to the project's events
+	ListProjectSubscriptions(ctx context.Context, projectID string) ([]*Subscription, error)
+	// ListUserSubscriptions returns every one of the user's subscriptions
+	ListUserSubscriptions(ctx context.Context, uid string) ([]*Subscription, error)
 
 	// GetConfigString returns the named configuration string
 	GetConfigString(ctx context.Context, field string) (string, error)
@@ -57,3 +152,113 @@ type Store interface {
 	// DeletePractices removes the practices at the specified version. This will break any plans that used this version!
 	DeletePractices(ctx context.Context, version string) error
 }
+
+// APIToken is a long-lived credential for scripts and automation. Only a hash of its secret is kept.
+type APIToken struct {
+	ID             string `firestore:"-"`
+	Hash           string // hex-encoded SHA-256 of the secret
+	Prefix         string // the start of the secret, to help users identify it
+	Name           string
+	Owner          string // the UID of the user or service account the token acts as
+	OwnerProvider  string // the identity provider of a user, so their access can be checked each time the token is used
+	OwnerName      string
+	OwnerEmail     string
+	ServiceAccount bool
+	Roles          models.Roles // the roles of a service account; users' tokens have their owner's roles
+	Scopes         []string     // the permissions the token may exercise
+	CreatedBy      string
+	Created        time.Time
+	Expires        time.Time // zero if the token doesn't expire
+	LastUsed       time.Time // zero if the token hasn't been used
+}
+
+// AccessRequest is a user's request to be granted access, and the decision on it. Each user has at most one.
+type AccessRequest struct {
+	UID           string
+	Name          string
+	Email         string
+	Provider      string
+	Justification string
+	Status        string // one of the models.AccessRequestStatus values
+	Created       time.Time
+	Decided       time.Time // zero until the request is approved or denied
+	DecidedBy     string
+	DecidedByName string
+	Reason        string
+}
+
+// AuditEvent records who changed what, and when
+type AuditEvent struct {
+	ID         string `firestore:"-"`
+	Time       time.Time
+	Actor      string // the UID of the user or service account, or a description of who ran a CLI command
+	ActorName  string
+	Action     string // what was done, e.g. project.delete
+	TargetType string // the kind of thing acted on, e.g. project
+	TargetID   string
+	RequestID  string // the trace ID of the API request, empty for CLI commands
+	Before     string // a JSON summary of the target before the change, empty if it was created
+	After      string // a JSON summary of the target after the change, empty if it was deleted
+}
+
+// Outbox message statuses
+const (
+	OutboxPending   = "pending"
+	OutboxDelivered = "delivered"
+	OutboxFailed    = "failed" // delivery was abandoned after too many attempts
+)
+
+// OutboxMessage is a notification waiting to be, or that has been, delivered to a channel
+type OutboxMessage struct {
+	ID          string `firestore:"-"` // derived from the channel and key, so that duplicates can be spotted
+	Key         string // identifies duplicate notifications
+	Channel     string
+	Event       string
+	Payload     string // the JSON encoded notification
+	UID         string // the user the notification is about, if any
+	Status      string
+	Attempts    int
+	NextAttempt time.Time `firestore:",omitempty"` // cleared once the message is delivered or abandoned, so it's no longer polled
+	LastError   string
+	Created     time.Time
+	Delivered   time.Time
+	Expires     time.Time `firestore:",omitempty"` // when a delivered or abandoned message may be deleted, by a TTL policy on this field
+}
+
+// Subscription is a user's request to be notified about events affecting a project
+type Subscription struct {
+	UID       string
+	ProjectID string
+	Email     string   // where the user's notifications are sent
+	Events    []string // the event types to notify them of, as for notification routes; every event if empty
+	Created   time.Time
+}
+
+// OutboxQuery selects outbox messages. Empty fields match every message.
+type OutboxQuery struct {
+	Status  string
+	Channel string
+	Limit   int // the maximum number of messages to return, all of them if zero
+}
+
+// Webhook is an outbound webhook, which is sent the events it subscribes to as HMAC-signed JSON
+type Webhook struct {
+	ID          string `firestore:"-"`
+	URL         string
+	Description string
+	Events      []string // event types, as for notification routes
+	Secret      string   // the HMAC key; unlike API tokens it has to be kept, to sign each delivery
+	CreatedBy   string
+	Created     time.Time
+}
+
+// AuditQuery selects audit events. Empty fields match every event.
+type AuditQuery struct {
+	Actor      string
+	Action     string
+	TargetType string
+	TargetID   string
+	Since      time.Time
+	Until      time.Time
+	Limit      int // the maximum number of events to return, all of them if zero
+}
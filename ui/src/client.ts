@@ -1663,6 +1663,10 @@ export class AuthConfig implements IAuthConfig {
     gcpPublicApiKey!: string;
     gcpAuthDomain!: string;
     emulatorUrl?: string | undefined;
+    /** How users sign in. With firebase (the default), the UI uses FirebaseUI with the configured providers. With oidc, clients sign in with the oidc issuer (e.g. using the authorization code flow with PKCE), and send its ID token to the API.
+ */
+    flow?: AuthConfigFlow | undefined;
+    oidc?: OidcConfig | undefined;
 
     constructor(data?: IAuthConfig) {
         if (data) {
@@ -1686,6 +1690,8 @@ export class AuthConfig implements IAuthConfig {
             this.gcpPublicApiKey = _data["gcpPublicApiKey"];
             this.gcpAuthDomain = _data["gcpAuthDomain"];
             this.emulatorUrl = _data["emulatorUrl"];
+            this.flow = _data["flow"];
+            this.oidc = _data["oidc"] ? OidcConfig.fromJS(_data["oidc"]) : <any>undefined;
         }
     }
 
@@ -1706,6 +1712,8 @@ export class AuthConfig implements IAuthConfig {
         data["gcpPublicApiKey"] = this.gcpPublicApiKey;
         data["gcpAuthDomain"] = this.gcpAuthDomain;
         data["emulatorUrl"] = this.emulatorUrl;
+        data["flow"] = this.flow;
+        data["oidc"] = this.oidc ? this.oidc.toJSON() : <any>undefined;
         return data;
     }
 }
@@ -1716,6 +1724,171 @@ export interface IAuthConfig {
     gcpPublicApiKey: string;
     gcpAuthDomain: string;
     emulatorUrl?: string | undefined;
+    /** How users sign in. With firebase (the default), the UI uses FirebaseUI with the configured providers. With oidc, clients sign in with the oidc issuer (e.g. using the authorization code flow with PKCE), and send its ID token to the API.
+ */
+    flow?: AuthConfigFlow | undefined;
+    oidc?: OidcConfig | undefined;
+}
+
+export enum AuthConfigFlow {
+    Firebase = "firebase",
+    Oidc = "oidc",
+}
+
+/** A standard OpenID Connect identity provider, such as Keycloak */
+export class OidcConfig implements IOidcConfig {
+    /** Identifies the provider in user records. Defaults to "oidc". */
+    id?: string | undefined;
+    /** The issuer URL. The provider's configuration is discovered from <issuer>/.well-known/openid-configuration */
+    issuer!: string;
+    /** The client ID the UI uses to sign in */
+    clientId!: string;
+    /** The audience ID tokens must be issued for. Defaults to the client ID. */
+    audience?: string | undefined;
+    /** The scopes the UI requests. Defaults to openid, email and profile. */
+    scopes?: string[] | undefined;
+    /** The name shown on the sign in button */
+    providerName?: string | undefined;
+    /** True if every user from this provider has access */
+    whitelisted?: boolean | undefined;
+    claims?: OidcClaimsMap | undefined;
+
+    constructor(data?: IOidcConfig) {
+        if (data) {
+            for (var property in data) {
+                if (data.hasOwnProperty(property))
+                    (<any>this)[property] = (<any>data)[property];
+            }
+        }
+    }
+
+    init(_data?: any) {
+        if (_data) {
+            this.id = _data["id"];
+            this.issuer = _data["issuer"];
+            this.clientId = _data["clientId"];
+            this.audience = _data["audience"];
+            if (Array.isArray(_data["scopes"])) {
+                this.scopes = [] as any;
+                for (let item of _data["scopes"])
+                    this.scopes!.push(item);
+            }
+            this.providerName = _data["providerName"];
+            this.whitelisted = _data["whitelisted"];
+            this.claims = _data["claims"] ? OidcClaimsMap.fromJS(_data["claims"]) : <any>undefined;
+        }
+    }
+
+    static fromJS(data: any): OidcConfig {
+        data = typeof data === 'object' ? data : {};
+        let result = new OidcConfig();
+        result.init(data);
+        return result;
+    }
+
+    toJSON(data?: any) {
+        data = typeof data === 'object' ? data : {};
+        data["id"] = this.id;
+        data["issuer"] = this.issuer;
+        data["clientId"] = this.clientId;
+        data["audience"] = this.audience;
+        if (Array.isArray(this.scopes)) {
+            data["scopes"] = [];
+            for (let item of this.scopes)
+                data["scopes"].push(item);
+        }
+        data["providerName"] = this.providerName;
+        data["whitelisted"] = this.whitelisted;
+        data["claims"] = this.claims ? this.claims.toJSON() : <any>undefined;
+        return data;
+    }
+}
+
+/** A standard OpenID Connect identity provider, such as Keycloak */
+export interface IOidcConfig {
+    /** Identifies the provider in user records. Defaults to "oidc". */
+    id?: string | undefined;
+    /** The issuer URL. The provider's configuration is discovered from <issuer>/.well-known/openid-configuration */
+    issuer: string;
+    /** The client ID the UI uses to sign in */
+    clientId: string;
+    /** The audience ID tokens must be issued for. Defaults to the client ID. */
+    audience?: string | undefined;
+    /** The scopes the UI requests. Defaults to openid, email and profile. */
+    scopes?: string[] | undefined;
+    /** The name shown on the sign in button */
+    providerName?: string | undefined;
+    /** True if every user from this provider has access */
+    whitelisted?: boolean | undefined;
+    claims?: OidcClaimsMap | undefined;
+}
+
+/** The ID token claims that hold each user attribute, where they differ from the standard claims */
+export class OidcClaimsMap implements IOidcClaimsMap {
+    /** Defaults to sub */
+    uid?: string | undefined;
+    /** Defaults to email */
+    email?: string | undefined;
+    /** Defaults to email_verified */
+    emailVerified?: string | undefined;
+    /** Defaults to name */
+    name?: string | undefined;
+    /** Defaults to picture */
+    pictureURL?: string | undefined;
+    department?: string | undefined;
+
+    constructor(data?: IOidcClaimsMap) {
+        if (data) {
+            for (var property in data) {
+                if (data.hasOwnProperty(property))
+                    (<any>this)[property] = (<any>data)[property];
+            }
+        }
+    }
+
+    init(_data?: any) {
+        if (_data) {
+            this.uid = _data["uid"];
+            this.email = _data["email"];
+            this.emailVerified = _data["emailVerified"];
+            this.name = _data["name"];
+            this.pictureURL = _data["pictureURL"];
+            this.department = _data["department"];
+        }
+    }
+
+    static fromJS(data: any): OidcClaimsMap {
+        data = typeof data === 'object' ? data : {};
+        let result = new OidcClaimsMap();
+        result.init(data);
+        return result;
+    }
+
+    toJSON(data?: any) {
+        data = typeof data === 'object' ? data : {};
+        data["uid"] = this.uid;
+        data["email"] = this.email;
+        data["emailVerified"] = this.emailVerified;
+        data["name"] = this.name;
+        data["pictureURL"] = this.pictureURL;
+        data["department"] = this.department;
+        return data;
+    }
+}
+
+/** The ID token claims that hold each user attribute, where they differ from the standard claims */
+export interface IOidcClaimsMap {
+    /** Defaults to sub */
+    uid?: string | undefined;
+    /** Defaults to email */
+    email?: string | undefined;
+    /** Defaults to email_verified */
+    emailVerified?: string | undefined;
+    /** Defaults to name */
+    name?: string | undefined;
+    /** Defaults to picture */
+    pictureURL?: string | undefined;
+    department?: string | undefined;
 }
 
 export class AuthProvider implements IAuthProvider {
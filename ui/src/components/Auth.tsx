@@ -3,6 +3,7 @@ import { initializeApp, getApps } from 'firebase/app'
 import { getAuth, connectAuthEmulator, onAuthStateChanged, Auth, User } from 'firebase/auth'
 import FirebaseAuth from 'react-firebaseui/FirebaseAuth'
 import { useDispatch } from 'react-redux'
+import { useHistory } from 'react-router-dom'
 import {
     Button,
     Avatar,
@@ -33,10 +34,16 @@ import {
 import { useSelector } from '../redux'
 import ApiClient from '../ApiClient'
 import * as api from '../client'
+import * as oidc from '../oidc'
 
-// useFirebaseAuth initializes and returns an App-wide firebase auth instance
+// Set once the OIDC sign in has been initialized, as there is no firebase app to indicate it
+let oidcInitialized = false
+
+// useFirebaseAuth initializes and returns an App-wide firebase auth instance.
+// When the deployment uses the OIDC flow instead, it initializes the OIDC session and returns undefined.
 export function useFirebaseAuth() {
     const dispatch = useDispatch()
+    const history = useHistory()
     const config = useSelector(selectAuthConfig)
 
     // we need to re-render on changes to these
@@ -46,7 +53,36 @@ export function useFirebaseAuth() {
     // Initialize app state
     // Listen to auth changes and propagate them to the store
     useEffect(() => {
+        if (config?.flow === api.AuthConfigFlow.Oidc && config.oidc && !oidcInitialized) {
+            oidcInitialized = true
+            const oidcConfig = config.oidc
+            oidc.completeSignIn(oidcConfig, (path) => history.replace(path)).then(
+                (user) => {
+                    if (user) {
+                        ApiClient.client.getIdToken = () =>
+                            oidc.getIdToken(oidcConfig).catch((err) => {
+                                // the session has expired and couldn't be refreshed
+                                ApiClient.client.getIdToken = null
+                                dispatch(logout())
+                                throw err
+                            })
+                        ApiClient.client.loggedIn().then(
+                            () => undefined,
+                            () => undefined
+                        )
+                        dispatch(login(user))
+                    }
+                    dispatch(initialize())
+                },
+                (err) => {
+                    console.warn(err)
+                    dispatch(initialize())
+                }
+            )
+            return
+        }
         if (
+            config?.flow === api.AuthConfigFlow.Oidc ||
             // only initialize once, no matter how many components use the hook.
             getApps().length > 0 ||
             // wait for auth configuration to be retrieved from the server
@@ -103,13 +139,23 @@ export function useFirebaseAuth() {
             }
         })
         dispatch(initialize())
-    }, [config, dispatch])
+    }, [config, dispatch, history])
 
     return getApps().length > 0 ? getAuth() : undefined
 }
 
 export function Login(props: { auth?: Auth }) {
     const signInOptions = useSelector(selectSigninOptions)
+    const config = useSelector(selectAuthConfig)
+
+    if (config?.flow === api.AuthConfigFlow.Oidc && config.oidc) {
+        const oidcConfig = config.oidc
+        return (
+            <Button variant="contained" color="primary" onClick={() => oidc.signIn(oidcConfig).catch(console.warn)}>
+                Sign in with {oidcConfig.providerName ?? 'OpenID Connect'}
+            </Button>
+        )
+    }
 
     if (!props.auth || signInOptions?.length === 0) {
         return <></>
@@ -161,6 +207,8 @@ export function UserMenu() {
     const loggedIn = useSelector(selectLoggedIn)
     const userInfo = useSelector(selectUserInfo)
     const auth = useFirebaseAuth()
+    const config = useSelector(selectAuthConfig)
+    const dispatch = useDispatch()
     const [anchorEl, setAnchorEl] = React.useState<null | HTMLElement>(null)
 
     if (!(loggedIn && userInfo)) return null
@@ -169,6 +217,16 @@ export function UserMenu() {
         setAnchorEl(anchorEl ? null : event.currentTarget)
     }
 
+    const signOut = () => {
+        if (config?.flow === api.AuthConfigFlow.Oidc && config.oidc) {
+            ApiClient.client.getIdToken = null
+            dispatch(logout())
+            oidc.signOut(config.oidc).catch(console.warn)
+        } else {
+            auth?.signOut()
+        }
+    }
+
     const open = Boolean(anchorEl)
     const id = open ? 'simple-popper' : undefined
 
@@ -192,7 +250,7 @@ export function UserMenu() {
                                         <ListItemText>{userInfo.displayName}</ListItemText>
                                     </MenuItem>
                                     <Divider />
-                                    <MenuItem onClick={() => auth?.signOut()} data-testid="logoutButton">
+                                    <MenuItem onClick={signOut} data-testid="logoutButton">
                                         <ListItemText primary="Sign out" />
                                     </MenuItem>
                                 </MenuList>
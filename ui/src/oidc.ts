@@ -0,0 +1,217 @@
+import { UserInfo } from 'firebase/auth'
+
+import * as api from './client'
+
+// Signs users in with a standard OpenID Connect provider, using the authorization code flow with PKCE.
+// The ID token is sent to the API, which verifies it against the same issuer.
+
+const pendingKey = 'besec.oidc.pending'
+const tokensKey = 'besec.oidc.tokens'
+
+interface ProviderMetadata {
+    authorization_endpoint: string
+    token_endpoint: string
+    end_session_endpoint?: string
+}
+
+interface PendingSignIn {
+    state: string
+    nonce: string
+    verifier: string
+    returnTo: string
+}
+
+interface Tokens {
+    idToken: string
+    refreshToken?: string
+}
+
+let metadata: Promise<ProviderMetadata> | undefined
+
+function discover(config: api.IOidcConfig) {
+    if (!metadata) {
+        const url = config.issuer.replace(/\/$/, '') + '/.well-known/openid-configuration'
+        metadata = fetch(url).then((response) => {
+            if (!response.ok) {
+                throw new Error(`OIDC discovery failed: ${response.status} ${response.statusText}`)
+            }
+            return response.json()
+        })
+        metadata.catch(() => (metadata = undefined))
+    }
+    return metadata
+}
+
+function redirectUri() {
+    return window.location.origin + '/'
+}
+
+function randomString() {
+    const bytes = new Uint8Array(32)
+    window.crypto.getRandomValues(bytes)
+    return base64url(bytes)
+}
+
+function base64url(bytes: Uint8Array) {
+    return btoa(String.fromCharCode(...Array.from(bytes)))
+        .replace(/\+/g, '-')
+        .replace(/\//g, '_')
+        .replace(/=+$/, '')
+}
+
+async function challenge(verifier: string) {
+    const digest = await window.crypto.subtle.digest('SHA-256', new TextEncoder().encode(verifier))
+    return base64url(new Uint8Array(digest))
+}
+
+function decodeClaims(idToken: string): { [claim: string]: any } {
+    const payload = idToken.split('.')[1].replace(/-/g, '+').replace(/_/g, '/')
+    const json = decodeURIComponent(
+        Array.from(atob(payload))
+            .map((c) => '%' + ('00' + c.charCodeAt(0).toString(16)).slice(-2))
+            .join('')
+    )
+    return JSON.parse(json)
+}
+
+function expired(idToken: string) {
+    // refresh a minute early, so the token doesn't expire on the way to the API
+    return decodeClaims(idToken).exp * 1000 < Date.now() + 60 * 1000
+}
+
+function storedTokens(): Tokens | undefined {
+    const stored = sessionStorage.getItem(tokensKey)
+    return stored ? JSON.parse(stored) : undefined
+}
+
+async function requestTokens(config: api.IOidcConfig, params: { [key: string]: string }) {
+    const { token_endpoint } = await discover(config)
+    const response = await fetch(token_endpoint, {
+        method: 'POST',
+        headers: { 'Content-Type': 'application/x-www-form-urlencoded' },
+        body: new URLSearchParams({ client_id: config.clientId, ...params }),
+    })
+    if (!response.ok) {
+        throw new Error(`OIDC token request failed: ${response.status} ${response.statusText}`)
+    }
+    const body = await response.json()
+    if (!body.id_token) {
+        throw new Error('OIDC token response has no ID token')
+    }
+    const tokens: Tokens = { idToken: body.id_token, refreshToken: body.refresh_token ?? params.refresh_token }
+    sessionStorage.setItem(tokensKey, JSON.stringify(tokens))
+    return tokens
+}
+
+// signIn redirects to the provider's sign in page, which returns to the app's root
+export async function signIn(config: api.IOidcConfig) {
+    const { authorization_endpoint } = await discover(config)
+    const pending: PendingSignIn = {
+        state: randomString(),
+        nonce: randomString(),
+        verifier: randomString(),
+        returnTo: window.location.pathname + window.location.search + window.location.hash,
+    }
+    sessionStorage.setItem(pendingKey, JSON.stringify(pending))
+
+    const url = new URL(authorization_endpoint)
+    url.search = new URLSearchParams({
+        response_type: 'code',
+        client_id: config.clientId,
+        redirect_uri: redirectUri(),
+        scope: (config.scopes?.length ? config.scopes : ['openid', 'email', 'profile']).join(' '),
+        state: pending.state,
+        nonce: pending.nonce,
+        code_challenge: await challenge(pending.verifier),
+        code_challenge_method: 'S256',
+    }).toString()
+    window.location.assign(url.toString())
+}
+
+// completeSignIn exchanges the authorization code the provider redirected back with, if there is one,
+// navigating back to where the user started signing in, and returns the signed in user, if any
+export async function completeSignIn(
+    config: api.IOidcConfig,
+    navigate: (path: string) => void
+): Promise<UserInfo | undefined> {
+    const params = new URLSearchParams(window.location.search)
+    const stored = sessionStorage.getItem(pendingKey)
+    if (stored && params.has('state')) {
+        sessionStorage.removeItem(pendingKey)
+        const pending: PendingSignIn = JSON.parse(stored)
+        navigate(pending.returnTo)
+
+        if (params.get('state') !== pending.state) {
+            throw new Error('OIDC sign in failed: the state returned by the provider does not match')
+        }
+        const code = params.get('code')
+        if (!code) {
+            throw new Error('OIDC sign in failed: ' + (params.get('error_description') ?? params.get('error')))
+        }
+        const tokens = await requestTokens(config, {
+            grant_type: 'authorization_code',
+            code,
+            redirect_uri: redirectUri(),
+            code_verifier: pending.verifier,
+        })
+        if (decodeClaims(tokens.idToken).nonce !== pending.nonce) {
+            sessionStorage.removeItem(tokensKey)
+            throw new Error('OIDC sign in failed: the ID token nonce does not match')
+        }
+    }
+
+    const tokens = storedTokens()
+    if (!tokens) {
+        return undefined
+    }
+    try {
+        return userInfo(config, await getIdToken(config))
+    } catch (e) {
+        console.warn(e)
+        return undefined
+    }
+}
+
+// getIdToken returns an unexpired ID token, refreshing it if necessary
+export async function getIdToken(config: api.IOidcConfig) {
+    const tokens = storedTokens()
+    if (!tokens) {
+        throw new Error('Not signed in')
+    }
+    if (!expired(tokens.idToken)) {
+        return tokens.idToken
+    }
+    if (!tokens.refreshToken) {
+        sessionStorage.removeItem(tokensKey)
+        throw new Error('The OIDC session has expired')
+    }
+    return (await requestTokens(config, { grant_type: 'refresh_token', refresh_token: tokens.refreshToken })).idToken
+}
+
+// signOut forgets the user's tokens, and signs them out of the provider if it supports RP-initiated logout
+export async function signOut(config: api.IOidcConfig) {
+    const tokens = storedTokens()
+    sessionStorage.removeItem(tokensKey)
+    const { end_session_endpoint } = await discover(config)
+    if (tokens && end_session_endpoint) {
+        const url = new URL(end_session_endpoint)
+        url.search = new URLSearchParams({
+            id_token_hint: tokens.idToken,
+            post_logout_redirect_uri: redirectUri(),
+        }).toString()
+        window.location.assign(url.toString())
+    }
+}
+
+function userInfo(config: api.IOidcConfig, idToken: string): UserInfo {
+    const claims = decodeClaims(idToken)
+    const names: api.IOidcClaimsMap = config.claims ?? {}
+    return {
+        uid: claims[names.uid ?? 'sub'],
+        email: claims[names.email ?? 'email'] ?? null,
+        displayName: claims[names.name ?? 'name'] ?? null,
+        photoURL: claims[names.pictureURL ?? 'picture'] ?? null,
+        phoneNumber: null,
+        providerId: config.id ?? 'oidc',
+    }
+}